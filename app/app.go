@@ -273,8 +273,7 @@ func NewOsmosisApp(
 			app.IBCKeeper,
 		),
 	)
-	// Uncomment to enable postHandlers:
-	// app.SetPostHandler(NewTxPostHandler())
+	app.SetPostHandler(NewTxPostHandler(*app.ProtoRevKeeper))
 	app.SetEndBlocker(app.EndBlocker)
 
 	// Register snapshot extensions to enable state-sync for wasm.
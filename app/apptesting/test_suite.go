@@ -323,13 +323,17 @@ func (s *KeeperTestHelper) SwapAndSetSpotPrice(poolId uint64, fromAsset sdk.Coin
 	coins := sdk.Coins{sdk.NewInt64Coin(fromAsset.Denom, 100000000000000)}
 	s.FundAcc(acc1, coins)
 
-	_, err := s.App.GAMMKeeper.SwapExactAmountOut(
+	pool, err := s.App.GAMMKeeper.GetPoolAndPoke(s.Ctx, poolId)
+	s.Require().NoError(err)
+
+	_, err = s.App.GAMMKeeper.SwapExactAmountOut(
 		s.Ctx,
 		acc1,
-		poolId,
+		pool,
 		fromAsset.Denom,
 		fromAsset.Amount,
 		sdk.NewCoin(toAsset.Denom, toAsset.Amount.Quo(sdk.NewInt(4))),
+		pool.GetSwapFee(s.Ctx),
 	)
 	s.Require().NoError(err)
 
@@ -372,9 +376,15 @@ func (s *KeeperTestHelper) BuildTx(
 }
 
 // StateNotAltered validates that app state is not altered. Fails if it is.
+// The next block keeps the current block time, as time passing between blocks
+// legitimately alters state (e.g. the last block time tracked by the downtime detector).
 func (s *KeeperTestHelper) StateNotAltered() {
 	oldState := s.App.ExportState(s.Ctx)
-	s.Commit()
+	oldHeader := s.Ctx.BlockHeader()
+	s.App.Commit()
+	newHeader := tmtypes.Header{Height: oldHeader.Height + 1, ChainID: oldHeader.ChainID, Time: oldHeader.Time}
+	s.App.BeginBlock(abci.RequestBeginBlock{Header: newHeader})
+	s.Ctx = s.App.GetBaseApp().NewContext(false, newHeader)
 	newState := s.App.ExportState(s.Ctx)
 	s.Require().Equal(oldState, newState)
 }
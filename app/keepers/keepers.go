@@ -34,10 +34,19 @@ import (
 	upgradekeeper "github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
 	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
 
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity"
+	cltypes "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	downtimedetector "github.com/osmosis-labs/osmosis/v13/x/downtime-detector"
+	downtimetypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
 	ibchooks "github.com/osmosis-labs/osmosis/v13/x/ibc-hooks"
 	ibcratelimit "github.com/osmosis-labs/osmosis/v13/x/ibc-rate-limit"
 	ibcratelimittypes "github.com/osmosis-labs/osmosis/v13/x/ibc-rate-limit/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
+	protorevtypes "github.com/osmosis-labs/osmosis/v13/x/protorev/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	icahost "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host"
 	icahostkeeper "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts/host/keeper"
@@ -121,8 +130,11 @@ type AppKeepers struct {
 	ContractKeeper               *wasmkeeper.PermissionedKeeper
 	TokenFactoryKeeper           *tokenfactorykeeper.Keeper
 	ValidatorSetPreferenceKeeper *valsetpref.Keeper
-	// Note: DO NOT USE. This is not yet initialized
-	SwapRouterKeeper *swaprouter.Keeper
+	SwapRouterKeeper             *swaprouter.Keeper
+	ConcentratedLiquidityKeeper  *concentratedliquidity.Keeper
+	CosmWasmPoolKeeper           *cosmwasmpool.Keeper
+	DowntimeKeeper               *downtimedetector.Keeper
+	ProtoRevKeeper               *protorev.Keeper
 
 	// IBC modules
 	// transfer module
@@ -248,11 +260,55 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper, appKeepers.BankKeeper, appKeepers.DistrKeeper)
 	appKeepers.GAMMKeeper = &gammKeeper
 
+	appKeepers.ConcentratedLiquidityKeeper = concentratedliquidity.NewKeeper(
+		appKeepers.keys[cltypes.StoreKey],
+		appKeepers.GetSubspace(cltypes.ModuleName),
+		appKeepers.GAMMKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+	)
+
+	appKeepers.CosmWasmPoolKeeper = cosmwasmpool.NewKeeper(
+		appKeepers.keys[cosmwasmpooltypes.StoreKey],
+		appKeepers.GetSubspace(cosmwasmpooltypes.ModuleName),
+		appKeepers.GAMMKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+	)
+
+	appKeepers.SwapRouterKeeper = swaprouter.NewKeeper(
+		appKeepers.keys[swaproutertypes.StoreKey],
+		appKeepers.GetSubspace(swaproutertypes.ModuleName),
+		appKeepers.GAMMKeeper,
+		appKeepers.ConcentratedLiquidityKeeper,
+		appKeepers.CosmWasmPoolKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.DistrKeeper,
+	)
+
 	appKeepers.TwapKeeper = twap.NewKeeper(
 		appKeepers.keys[twaptypes.StoreKey],
 		appKeepers.tkeys[twaptypes.TransientStoreKey],
 		appKeepers.GetSubspace(twaptypes.ModuleName),
-		appKeepers.GAMMKeeper)
+		appKeepers.SwapRouterKeeper)
+	appKeepers.GAMMKeeper.SetTwapKeeper(appKeepers.TwapKeeper)
+
+	appKeepers.DowntimeKeeper = downtimedetector.NewKeeper(
+		appKeepers.keys[downtimetypes.StoreKey],
+		appKeepers.GetSubspace(downtimetypes.ModuleName),
+	)
+
+	appKeepers.ProtoRevKeeper = protorev.NewKeeper(
+		appKeepers.keys[protorevtypes.StoreKey],
+		appKeepers.tkeys[protorevtypes.TransientStoreKey],
+		appKeepers.GetSubspace(protorevtypes.ModuleName),
+		appKeepers.GAMMKeeper,
+		appKeepers.AccountKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.DistrKeeper,
+	)
 
 	appKeepers.LockupKeeper = lockupkeeper.NewKeeper(
 		appKeepers.keys[lockuptypes.StoreKey],
@@ -267,8 +323,8 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.AccountKeeper,
 		appKeepers.BankKeeper,
 		appKeepers.keys[txfeestypes.StoreKey],
-		appKeepers.GAMMKeeper,
-		appKeepers.GAMMKeeper,
+		appKeepers.SwapRouterKeeper,
+		appKeepers.SwapRouterKeeper,
 	)
 	appKeepers.TxFeesKeeper = &txFeesKeeper
 
@@ -309,6 +365,9 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	)
 	appKeepers.PoolIncentivesKeeper = &poolIncentivesKeeper
 	appKeepers.GAMMKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
+	appKeepers.GAMMKeeper.SetLockupKeeper(appKeepers.LockupKeeper)
+	appKeepers.SwapRouterKeeper.SetPoolIncentivesKeeper(appKeepers.PoolIncentivesKeeper)
+	appKeepers.GAMMKeeper.SetSwapRouter(appKeepers.SwapRouterKeeper)
 
 	tokenFactoryKeeper := tokenfactorykeeper.NewKeeper(
 		appKeepers.keys[tokenfactorytypes.StoreKey],
@@ -323,6 +382,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 		appKeepers.keys[valsetpreftypes.StoreKey],
 		appKeepers.GetSubspace(valsetpreftypes.ModuleName),
 		appKeepers.StakingKeeper,
+		appKeepers.DistrKeeper,
 	)
 
 	appKeepers.ValidatorSetPreferenceKeeper = &validatorSetPreferenceKeeper
@@ -331,7 +391,16 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	// if we want to allow any custom callbacks
 	supportedFeatures := "iterator,staking,stargate,osmosis,cosmwasm_1_1"
 
-	wasmOpts = append(owasm.RegisterCustomPlugins(appKeepers.GAMMKeeper, appKeepers.BankKeeper, appKeepers.TwapKeeper, appKeepers.TokenFactoryKeeper), wasmOpts...)
+	wasmOpts = append(owasm.RegisterCustomPlugins(
+		appKeepers.GAMMKeeper,
+		appKeepers.BankKeeper,
+		appKeepers.TwapKeeper,
+		appKeepers.TokenFactoryKeeper,
+		appKeepers.LockupKeeper,
+		appKeepers.IncentivesKeeper,
+		appKeepers.SuperfluidKeeper,
+		appKeepers.EpochsKeeper,
+	), wasmOpts...)
 	wasmOpts = append(owasm.RegisterStargateQueries(*bApp.GRPCQueryRouter(), appCodec), wasmOpts...)
 
 	wasmKeeper := wasm.NewKeeper(
@@ -359,6 +428,7 @@ func (appKeepers *AppKeepers) InitNormalKeepers(
 	appKeepers.ContractKeeper = wasmkeeper.NewDefaultPermissionKeeper(appKeepers.WasmKeeper)
 	appKeepers.RateLimitingICS4Wrapper.ContractKeeper = appKeepers.ContractKeeper
 	appKeepers.Ics20WasmHooks.ContractKeeper = appKeepers.ContractKeeper
+	appKeepers.CosmWasmPoolKeeper.SetWasmKeepers(appKeepers.ContractKeeper, appKeepers.WasmKeeper)
 
 	// wire up x/wasm to IBC
 	ibcRouter.AddRoute(wasm.ModuleName, wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper))
@@ -511,6 +581,11 @@ func (appKeepers *AppKeepers) initParamsKeeper(appCodec codec.BinaryCodec, legac
 	paramsKeeper.Subspace(tokenfactorytypes.ModuleName)
 	paramsKeeper.Subspace(twaptypes.ModuleName)
 	paramsKeeper.Subspace(ibcratelimittypes.ModuleName)
+	paramsKeeper.Subspace(swaproutertypes.ModuleName)
+	paramsKeeper.Subspace(downtimetypes.ModuleName)
+	paramsKeeper.Subspace(protorevtypes.ModuleName)
+	paramsKeeper.Subspace(cltypes.ModuleName)
+	paramsKeeper.Subspace(cosmwasmpooltypes.ModuleName)
 
 	return paramsKeeper
 }
@@ -535,6 +610,36 @@ func (appKeepers *AppKeepers) SetupHooks() {
 			// insert gamm hooks receivers here
 			appKeepers.PoolIncentivesKeeper.Hooks(),
 			appKeepers.TwapKeeper.GammHooks(),
+			appKeepers.SwapRouterKeeper.GammHooks(),
+			appKeepers.ProtoRevKeeper.GammHooks(),
+		),
+	)
+
+	// the swaprouter listener must run first, so that the others can reach the
+	// pool through its route.
+	appKeepers.ConcentratedLiquidityKeeper.SetPoolCreationListeners(
+		swaproutertypes.NewPoolCreationListeners(
+			appKeepers.SwapRouterKeeper.ConcentratedLiquidityListener(),
+			appKeepers.TwapKeeper.GammHooks(),
+		),
+	)
+
+	appKeepers.ConcentratedLiquidityKeeper.SetSwapListeners(
+		cltypes.NewSwapListeners(
+			appKeepers.TwapKeeper.GammHooks(),
+		),
+	)
+
+	appKeepers.CosmWasmPoolKeeper.SetPoolCreationListeners(
+		swaproutertypes.NewPoolCreationListeners(
+			appKeepers.SwapRouterKeeper.CosmWasmPoolListener(),
+			appKeepers.TwapKeeper.GammHooks(),
+		),
+	)
+
+	appKeepers.CosmWasmPoolKeeper.SetPoolListeners(
+		cosmwasmpooltypes.NewPoolListeners(
+			appKeepers.TwapKeeper.GammHooks(),
 		),
 	)
 
@@ -562,10 +667,12 @@ func (appKeepers *AppKeepers) SetupHooks() {
 		epochstypes.NewMultiEpochHooks(
 			// insert epoch hooks receivers here
 			appKeepers.TxFeesKeeper.Hooks(),
+			appKeepers.GAMMKeeper.EpochHooks(),
 			appKeepers.TwapKeeper.EpochHooks(),
 			appKeepers.SuperfluidKeeper.Hooks(),
 			appKeepers.IncentivesKeeper.Hooks(),
 			appKeepers.MintKeeper.Hooks(),
+			appKeepers.ValidatorSetPreferenceKeeper.EpochHooks(),
 		),
 	)
 
@@ -605,5 +712,10 @@ func KVStoreKeys() []string {
 		wasm.StoreKey,
 		tokenfactorytypes.StoreKey,
 		valsetpreftypes.StoreKey,
+		swaproutertypes.StoreKey,
+		downtimetypes.StoreKey,
+		protorevtypes.StoreKey,
+		cltypes.StoreKey,
+		cosmwasmpooltypes.StoreKey,
 	}
 }
@@ -28,6 +28,9 @@ import (
 	ica "github.com/cosmos/ibc-go/v3/modules/apps/27-interchain-accounts"
 
 	_ "github.com/osmosis-labs/osmosis/v13/client/docs/statik"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/clmodule"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/cosmwasmpoolmodule"
+	downtimemodule "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/downtimedetector_module"
 	"github.com/osmosis-labs/osmosis/v13/x/epochs"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm"
 	ibc_hooks "github.com/osmosis-labs/osmosis/v13/x/ibc-hooks"
@@ -37,8 +40,10 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/mint"
 	poolincentives "github.com/osmosis-labs/osmosis/v13/x/pool-incentives"
 	poolincentivesclient "github.com/osmosis-labs/osmosis/v13/x/pool-incentives/client"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/protorevmodule"
 	superfluid "github.com/osmosis-labs/osmosis/v13/x/superfluid"
 	superfluidclient "github.com/osmosis-labs/osmosis/v13/x/superfluid/client"
+	swaproutermodule "github.com/osmosis-labs/osmosis/v13/x/swaprouter/module"
 	"github.com/osmosis-labs/osmosis/v13/x/tokenfactory"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/twapmodule"
 	"github.com/osmosis-labs/osmosis/v13/x/txfees"
@@ -80,6 +85,7 @@ var AppModuleBasics = []module.AppModuleBasic{
 	transfer.AppModuleBasic{},
 	vesting.AppModuleBasic{},
 	gamm.AppModuleBasic{},
+	swaproutermodule.AppModuleBasic{},
 	twapmodule.AppModuleBasic{},
 	txfees.AppModuleBasic{},
 	incentives.AppModuleBasic{},
@@ -89,6 +95,10 @@ var AppModuleBasics = []module.AppModuleBasic{
 	superfluid.AppModuleBasic{},
 	tokenfactory.AppModuleBasic{},
 	valsetprefmodule.AppModuleBasic{},
+	downtimemodule.AppModuleBasic{},
+	protorevmodule.AppModuleBasic{},
+	clmodule.AppModuleBasic{},
+	cosmwasmpoolmodule.AppModuleBasic{},
 	wasm.AppModuleBasic{},
 	ica.AppModuleBasic{},
 	ibc_hooks.AppModuleBasic{},
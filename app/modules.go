@@ -49,6 +49,12 @@ import (
 	_ "github.com/osmosis-labs/osmosis/v13/client/docs/statik"
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/partialord"
 	"github.com/osmosis-labs/osmosis/v13/simulation/simtypes"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/clmodule"
+	cltypes "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/cosmwasmpoolmodule"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	downtimemodule "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/downtimedetector_module"
+	downtimetypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
 	"github.com/osmosis-labs/osmosis/v13/x/epochs"
 	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm"
@@ -61,8 +67,12 @@ import (
 	minttypes "github.com/osmosis-labs/osmosis/v13/x/mint/types"
 	poolincentives "github.com/osmosis-labs/osmosis/v13/x/pool-incentives"
 	poolincentivestypes "github.com/osmosis-labs/osmosis/v13/x/pool-incentives/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/protorevmodule"
+	protorevtypes "github.com/osmosis-labs/osmosis/v13/x/protorev/types"
 	superfluid "github.com/osmosis-labs/osmosis/v13/x/superfluid"
 	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
+	swaproutermodule "github.com/osmosis-labs/osmosis/v13/x/swaprouter/module"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 	"github.com/osmosis-labs/osmosis/v13/x/tokenfactory"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/types"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/twapmodule"
@@ -87,6 +97,7 @@ var moduleAccountPermissions = map[string][]string{
 	govtypes.ModuleName:                      {authtypes.Burner},
 	ibctransfertypes.ModuleName:              {authtypes.Minter, authtypes.Burner},
 	gammtypes.ModuleName:                     {authtypes.Minter, authtypes.Burner},
+	gammtypes.ProtocolRevenueCollectorName:   nil,
 	incentivestypes.ModuleName:               {authtypes.Minter, authtypes.Burner},
 	lockuptypes.ModuleName:                   {authtypes.Minter, authtypes.Burner},
 	poolincentivestypes.ModuleName:           nil,
@@ -96,6 +107,7 @@ var moduleAccountPermissions = map[string][]string{
 	wasm.ModuleName:                          {authtypes.Burner},
 	tokenfactorytypes.ModuleName:             {authtypes.Minter, authtypes.Burner},
 	valsetpreftypes.ModuleName:               {authtypes.Staking},
+	protorevtypes.ModuleName:                 {authtypes.Minter, authtypes.Burner},
 }
 
 // appModules return modules to initialize module manager.
@@ -132,6 +144,7 @@ func appModules(
 		params.NewAppModule(*app.ParamsKeeper),
 		app.RawIcs20TransferAppModule,
 		gamm.NewAppModule(appCodec, *app.GAMMKeeper, app.AccountKeeper, app.BankKeeper),
+		swaproutermodule.NewAppModule(*app.SwapRouterKeeper, app.GAMMKeeper),
 		twapmodule.NewAppModule(*app.TwapKeeper),
 		txfees.NewAppModule(*app.TxFeesKeeper),
 		incentives.NewAppModule(*app.IncentivesKeeper, app.AccountKeeper, app.BankKeeper, app.EpochsKeeper),
@@ -149,6 +162,10 @@ func appModules(
 		),
 		tokenfactory.NewAppModule(*app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper),
 		valsetprefmodule.NewAppModule(appCodec, *app.ValidatorSetPreferenceKeeper),
+		downtimemodule.NewAppModule(*app.DowntimeKeeper),
+		protorevmodule.NewAppModule(*app.ProtoRevKeeper),
+		clmodule.NewAppModule(*app.ConcentratedLiquidityKeeper),
+		cosmwasmpoolmodule.NewAppModule(*app.CosmWasmPoolKeeper),
 		ibc_hooks.NewAppModule(app.AccountKeeper),
 	}
 }
@@ -171,7 +188,8 @@ func orderBeginBlockers(allModuleNames []string) []string {
 	// IBChost came after staking, before superfluid.
 	// TODO: Come back and delete this line after testing the base change.
 	ord.Sequence(stakingtypes.ModuleName, ibchost.ModuleName, superfluidtypes.ModuleName)
-	// every remaining module's begin block is a no-op.
+	// gamm's begin block only reads twap records of prior blocks to update dynamic swap fees,
+	// and every remaining module's begin block is a no-op.
 	return ord.TotalOrdering()
 }
 
@@ -183,7 +201,7 @@ func OrderEndBlockers(allModuleNames []string) []string {
 	ord.FirstElements(govtypes.ModuleName)
 	ord.LastElements(stakingtypes.ModuleName)
 
-	// only Osmosis modules with endblock code are: twap, crisis, govtypes, staking
+	// only Osmosis modules with endblock code are: twap, crisis, govtypes, staking, validatorsetpreference
 	// we don't care about the relative ordering between them.
 	return ord.TotalOrdering()
 }
@@ -208,6 +226,9 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		ibchost.ModuleName,
 		icatypes.ModuleName,
 		gammtypes.ModuleName,
+		swaproutertypes.ModuleName,
+		cltypes.ModuleName,
+		cosmwasmpooltypes.ModuleName,
 		twaptypes.ModuleName,
 		txfeestypes.ModuleName,
 		genutiltypes.ModuleName,
@@ -220,6 +241,8 @@ func OrderInitGenesis(allModuleNames []string) []string {
 		superfluidtypes.ModuleName,
 		tokenfactorytypes.ModuleName,
 		valsetpreftypes.ModuleName,
+		downtimetypes.ModuleName,
+		protorevtypes.ModuleName,
 		incentivestypes.ModuleName,
 		epochstypes.ModuleName,
 		lockuptypes.ModuleName,
@@ -2,8 +2,15 @@ package app
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
 )
 
-func NewTxPostHandler() sdk.AnteHandler {
-	panic("not implemented")
+// NewTxPostHandler returns the handler run after a tx's msgs, whose state changes
+// are reverted along with the tx's if it fails. The events on the ctx it returns are
+// appended to the tx's, so its decorators must only emit on a fresh event manager.
+func NewTxPostHandler(protorevKeeper protorev.Keeper) sdk.AnteHandler {
+	return sdk.ChainAnteDecorators(
+		protorev.NewBackrunDecorator(protorevKeeper),
+	)
 }
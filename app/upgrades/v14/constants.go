@@ -4,6 +4,11 @@ import (
 	store "github.com/cosmos/cosmos-sdk/store/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app/upgrades"
+	cltypes "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	cosmwasmpooltypes "github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	downtimetypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+	protorevtypes "github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 	valsetpreftypes "github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 )
 
@@ -14,7 +19,7 @@ var Upgrade = upgrades.Upgrade{
 	UpgradeName:          UpgradeName,
 	CreateUpgradeHandler: CreateUpgradeHandler,
 	StoreUpgrades: store.StoreUpgrades{
-		Added:   []string{valsetpreftypes.StoreKey},
+		Added:   []string{valsetpreftypes.StoreKey, swaproutertypes.StoreKey, downtimetypes.StoreKey, protorevtypes.StoreKey, cltypes.StoreKey, cosmwasmpooltypes.StoreKey},
 		Deleted: []string{},
 	},
 }
@@ -7,8 +7,22 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/app/keepers"
 	"github.com/osmosis-labs/osmosis/v13/app/upgrades"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
+// migratePoolRoutes registers a swaprouter module route for every existing
+// x/gamm pool, so that swaps against pre-upgrade pools can be routed.
+func migratePoolRoutes(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+	pools, err := keepers.GAMMKeeper.GetPoolsAndPoke(ctx)
+	if err != nil {
+		return err
+	}
+	for _, pool := range pools {
+		keepers.SwapRouterKeeper.SetPoolRoute(ctx, pool.GetId(), pool.GetType())
+	}
+	return nil
+}
+
 func CreateUpgradeHandler(
 	mm *module.Manager,
 	configurator module.Configurator,
@@ -16,6 +30,22 @@ func CreateUpgradeHandler(
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
 	return func(ctx sdk.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
-		return mm.RunMigrations(ctx, configurator, fromVM)
+		if err := migratePoolRoutes(ctx, keepers); err != nil {
+			return nil, err
+		}
+		// The protocol takes no share of swap fees until governance sets one.
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyTakeRate, sdk.ZeroDec())
+		// No pool freeze admin is set until governance sets one.
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyPoolFreezeAdmin, "")
+		// The protocol revenue is swapped to OSMO at the end of every day.
+		keepers.GetSubspace(gammtypes.ModuleName).Set(ctx, gammtypes.KeyProtocolRevenueEpochIdentifier, "day")
+		migrations, err := mm.RunMigrations(ctx, configurator, fromVM)
+		if err != nil {
+			return nil, err
+		}
+		// RunMigrations initializes x/swaprouter from its default genesis, so its pool id
+		// counter is synced to the pools already created in x/gamm.
+		keepers.SwapRouterKeeper.SetNextPoolId(ctx, keepers.GAMMKeeper.GetNextPoolId(ctx))
+		return migrations, nil
 	}
 }
@@ -130,6 +130,21 @@ arbitrage-min-gas-fee = ".005"
 # This is the minimum gas fee any tx with high gas demand should have, denominated in uosmo per gas
 # Default value of ".0025" then means that a tx with 1 million gas costs (.0025 uosmo/gas) * 1_000_000 gas = .0025 osmo
 min-gas-price-for-high-gas-tx = ".0025"
+
+# The filters below decide which txs are arbitrage txs, and thus have to pay arbitrage-min-gas-fee.
+# A tx is an arbitrage tx if any enabled filter matches it.
+# Matches swaps that start and end in the same denom.
+arb-filter-same-in-out-denom = true
+# Matches txs with several swaps that have different in denoms.
+arb-filter-mixed-swap-in-denoms = true
+# Matches txs that both join and exit pools.
+arb-filter-join-and-exit-pool = true
+# Matches txs that swap through the same intermediate denom more than once, across all of their swaps.
+arb-filter-duplicate-intermediate-denoms = false
+# Matches swaps whose route goes through the same denom twice.
+arb-filter-cyclic-routes = false
+# Matches txs with more swap msgs than this. 0 disables the filter.
+arb-filter-max-swap-msgs = 0
 `
 
 	return OsmosisAppTemplate, OsmosisAppCfg
@@ -208,6 +208,8 @@ func ParseFieldFromArg(fVal reflect.Value, fType reflect.StructField, arg string
 			v, err = ParseCoin(arg, fType.Name)
 		} else if typeStr == "types.Int" {
 			v, err = ParseSdkInt(arg, fType.Name)
+		} else if typeStr == "types.Dec" {
+			v, err = ParseSdkDec(arg, fType.Name)
 		} else {
 			return fmt.Errorf("struct field type not recognized. Got type %v", fType)
 		}
@@ -277,3 +279,11 @@ func ParseSdkInt(arg string, fieldName string) (sdk.Int, error) {
 	}
 	return i, nil
 }
+
+func ParseSdkDec(arg string, fieldName string) (sdk.Dec, error) {
+	d, err := sdk.NewDecFromStr(arg)
+	if err != nil {
+		return sdk.Dec{}, fmt.Errorf("could not parse %s as sdk.Dec for field %s: %w", arg, fieldName, err)
+	}
+	return d, nil
+}
@@ -10,6 +10,7 @@ import (
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
+	types0 "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // MockCFMMPoolI is a mock of CFMMPoolI interface.
@@ -211,6 +212,20 @@ func (mr *MockCFMMPoolIMockRecorder) GetTotalShares() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockCFMMPoolI)(nil).GetTotalShares))
 }
 
+// GetType mocks base method.
+func (m *MockCFMMPoolI) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockCFMMPoolIMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockCFMMPoolI)(nil).GetType))
+}
+
 // IsActive mocks base method.
 func (m *MockCFMMPoolI) IsActive(ctx types.Context) bool {
 	m.ctrl.T.Helper()
@@ -567,6 +582,20 @@ func (mr *MockPoolAmountOutExtensionMockRecorder) GetTotalShares() *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).GetTotalShares))
 }
 
+// GetType mocks base method.
+func (m *MockPoolAmountOutExtension) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockPoolAmountOutExtensionMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockPoolAmountOutExtension)(nil).GetType))
+}
+
 // IncreaseLiquidity mocks base method.
 func (m *MockPoolAmountOutExtension) IncreaseLiquidity(sharesOut types.Int, coinsIn types.Coins) {
 	m.ctrl.T.Helper()
@@ -935,6 +964,20 @@ func (mr *MockWeightedPoolExtensionMockRecorder) GetTotalShares() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockWeightedPoolExtension)(nil).GetTotalShares))
 }
 
+// GetType mocks base method.
+func (m *MockWeightedPoolExtension) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockWeightedPoolExtensionMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockWeightedPoolExtension)(nil).GetType))
+}
+
 // IsActive mocks base method.
 func (m *MockWeightedPoolExtension) IsActive(ctx types.Context) bool {
 	m.ctrl.T.Helper()
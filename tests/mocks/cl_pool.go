@@ -9,6 +9,7 @@ import (
 
 	types "github.com/cosmos/cosmos-sdk/types"
 	gomock "github.com/golang/mock/gomock"
+	types0 "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // MockConcentratedPoolExtension is a mock of ConcentratedPoolExtension interface.
@@ -217,6 +218,20 @@ func (mr *MockConcentratedPoolExtensionMockRecorder) GetTotalShares() *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTotalShares", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetTotalShares))
 }
 
+// GetType mocks base method.
+func (m *MockConcentratedPoolExtension) GetType() types0.PoolType {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetType")
+	ret0, _ := ret[0].(types0.PoolType)
+	return ret0
+}
+
+// GetType indicates an expected call of GetType.
+func (mr *MockConcentratedPoolExtensionMockRecorder) GetType() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetType", reflect.TypeOf((*MockConcentratedPoolExtension)(nil).GetType))
+}
+
 // IsActive mocks base method.
 func (m *MockConcentratedPoolExtension) IsActive(ctx types.Context) bool {
 	m.ctrl.T.Helper()
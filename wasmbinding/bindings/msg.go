@@ -1,6 +1,9 @@
 package bindings
 
-import sdk "github.com/cosmos/cosmos-sdk/types"
+import (
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
 
 type OsmosisMsg struct {
 	/// Contracts can create denoms, namespaced under the contract's address.
@@ -17,6 +20,22 @@ type OsmosisMsg struct {
 	BurnTokens *BurnTokens `json:"burn_tokens,omitempty"`
 	/// Swap over one or more pools
 	Swap *SwapMsg `json:"swap,omitempty"`
+	/// Contracts can lock tokens they own in the lockup module.
+	/// If the contract already has a lock of that denom and duration,
+	/// the tokens are added to it.
+	LockTokens *LockTokens `json:"lock_tokens,omitempty"`
+	/// Contracts can begin unlocking a lock they own.
+	BeginUnlocking *BeginUnlocking `json:"begin_unlocking,omitempty"`
+	/// Contracts can add tokens to a specific lock they own.
+	AddToExistingLock *AddToExistingLock `json:"add_to_existing_lock,omitempty"`
+	/// Contracts can superfluid delegate a lock they own.
+	SuperfluidDelegate *SuperfluidDelegate `json:"superfluid_delegate,omitempty"`
+	/// Contracts can undelegate a superfluid delegated lock they own.
+	SuperfluidUndelegate *SuperfluidUndelegate `json:"superfluid_undelegate,omitempty"`
+	/// Contracts can provide liquidity to a pool.
+	JoinPool *JoinPool `json:"join_pool,omitempty"`
+	/// Contracts can remove liquidity from a pool.
+	ExitPool *ExitPool `json:"exit_pool,omitempty"`
 }
 
 // CreateDenom creates a new factory denom, of denomination:
@@ -54,3 +73,49 @@ type SwapMsg struct {
 	Route  []Step              `json:"route"`
 	Amount SwapAmountWithLimit `json:"amount"`
 }
+
+// LockTokens locks a single coin owned by the contract for the given duration.
+type LockTokens struct {
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+	// Duration is the lock duration in seconds.
+	Duration uint64 `json:"duration"`
+}
+
+// BeginUnlocking starts unlocking a lock owned by the contract.
+// If Coins is empty, the whole lock is unlocked.
+type BeginUnlocking struct {
+	LockId uint64             `json:"lock_id"`
+	Coins  []wasmvmtypes.Coin `json:"coins"`
+}
+
+type AddToExistingLock struct {
+	LockId uint64  `json:"lock_id"`
+	Denom  string  `json:"denom"`
+	Amount sdk.Int `json:"amount"`
+}
+
+type SuperfluidDelegate struct {
+	LockId  uint64 `json:"lock_id"`
+	ValAddr string `json:"val_addr"`
+}
+
+type SuperfluidUndelegate struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+// JoinPool joins a pool for exactly ShareOutAmount shares,
+// spending no more than TokenInMaxs.
+type JoinPool struct {
+	PoolId         uint64             `json:"pool_id"`
+	ShareOutAmount sdk.Int            `json:"share_out_amount"`
+	TokenInMaxs    []wasmvmtypes.Coin `json:"token_in_maxs"`
+}
+
+// ExitPool burns ShareInAmount shares of a pool,
+// receiving at least TokenOutMins.
+type ExitPool struct {
+	PoolId        uint64             `json:"pool_id"`
+	ShareInAmount sdk.Int            `json:"share_in_amount"`
+	TokenOutMins  []wasmvmtypes.Coin `json:"token_out_mins"`
+}
@@ -15,12 +15,42 @@ type OsmosisQuery struct {
 	PoolState *PoolState `json:"pool_state,omitempty"`
 	/// Return current spot price swapping In for Out on given pool ID.
 	/// Warning: this can easily be manipulated via sandwich attacks, do not use as price oracle.
-	/// We will add TWAP for more robust price feed.
+	/// Use the TWAP queries for a more robust price feed.
 	SpotPrice *SpotPrice `json:"spot_price,omitempty"`
 	/// Return current spot price swapping In for Out on given pool ID.
 	EstimateSwap *EstimateSwap `json:"estimate_swap,omitempty"`
 	/// Returns the admin of a denom, if the denom is a Token Factory denom.
 	DenomAdmin *DenomAdmin `json:"denom_admin,omitempty"`
+	/// Returns the arithmetic TWAP of the base asset in units of the quote asset,
+	/// between the given start and end times.
+	ArithmeticTwap *ArithmeticTwap `json:"arithmetic_twap,omitempty"`
+	/// Returns the arithmetic TWAP of the base asset in units of the quote asset,
+	/// from the given start time until now.
+	ArithmeticTwapToNow *ArithmeticTwapToNow `json:"arithmetic_twap_to_now,omitempty"`
+	/// Returns the geometric TWAP of the base asset in units of the quote asset,
+	/// between the given start and end times.
+	GeometricTwap *GeometricTwap `json:"geometric_twap,omitempty"`
+	/// Returns the geometric TWAP of the base asset in units of the quote asset,
+	/// from the given start time until now.
+	GeometricTwapToNow *GeometricTwapToNow `json:"geometric_twap_to_now,omitempty"`
+	/// Returns the lock with the given ID.
+	/// The response is the proto JSON of the x/lockup `LockedByID` query response.
+	LockById *LockById `json:"lock_by_id,omitempty"`
+	/// Returns all the locks of an account, including the unlocking ones.
+	/// The response is the proto JSON of the x/lockup `AccountLockedLongerDuration` query response.
+	AccountLocks *AccountLocks `json:"account_locks,omitempty"`
+	/// Returns the gauge with the given ID.
+	/// The response is the proto JSON of the x/incentives `GaugeByID` query response.
+	GaugeById *GaugeById `json:"gauge_by_id,omitempty"`
+	/// Returns the estimated rewards of the locks of an account, until the given epoch.
+	/// The response is the proto JSON of the x/incentives `RewardsEst` query response.
+	RewardsEstimate *RewardsEstimate `json:"rewards_estimate,omitempty"`
+	/// Returns the superfluid delegation of a lock, as its intermediary account.
+	/// The response is the proto JSON of the x/superfluid `ConnectedIntermediaryAccount` query response.
+	SuperfluidDelegationByLock *SuperfluidDelegationByLock `json:"superfluid_delegation_by_lock,omitempty"`
+	/// Returns the info of the epoch with the given identifier.
+	/// The response is the proto JSON of the x/epochs `EpochInfo`.
+	EpochInfo *EpochInfo `json:"epoch_info,omitempty"`
 }
 
 type FullDenom struct {
@@ -70,6 +100,51 @@ type ArithmeticTwapToNow struct {
 	StartTime int64 `json:"start_time"`
 }
 
+type GeometricTwap struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+	// NOTE: EndTime is expected to be in Unix time milliseconds.
+	EndTime int64 `json:"end_time"`
+}
+
+type GeometricTwapToNow struct {
+	PoolId          uint64 `json:"id"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	// NOTE: StartTime is expected to be in Unix time milliseconds.
+	StartTime int64 `json:"start_time"`
+}
+
+type LockById struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+type AccountLocks struct {
+	Owner string `json:"owner"`
+}
+
+type GaugeById struct {
+	GaugeId uint64 `json:"gauge_id"`
+}
+
+type RewardsEstimate struct {
+	Owner string `json:"owner"`
+	// NOTE: the rewards of all the locks of the owner are estimated if LockIds is empty.
+	LockIds  []uint64 `json:"lock_ids"`
+	EndEpoch int64    `json:"end_epoch"`
+}
+
+type SuperfluidDelegationByLock struct {
+	LockId uint64 `json:"lock_id"`
+}
+
+type EpochInfo struct {
+	Identifier string `json:"identifier"`
+}
+
 func (e *EstimateSwap) ToSwapMsg() *SwapMsg {
 	return &SwapMsg{
 		First:  e.First,
@@ -99,3 +174,13 @@ type EstimatePriceResponse struct {
 	// If you query with SwapAmount::Output, this is SwapAmount::Input.
 	Amount SwapAmount `json:"swap_amount"`
 }
+
+type ArithmeticTwapResponse struct {
+	/// The arithmetic TWAP of the base asset, in units of the quote asset
+	Twap string `json:"twap"`
+}
+
+type GeometricTwapResponse struct {
+	/// The geometric TWAP of the base asset, in units of the quote asset
+	Twap string `json:"twap"`
+}
@@ -2,6 +2,10 @@ package wasmbinding
 
 import (
 	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
 
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
@@ -12,28 +16,36 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
 	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v13/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/keeper"
 	tokenfactorytypes "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/types"
 )
 
 // CustomMessageDecorator returns decorator for custom CosmWasm bindings messages
-func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+func CustomMessageDecorator(gammKeeper *gammkeeper.Keeper, bank *bankkeeper.BaseKeeper, tokenFactory *tokenfactorykeeper.Keeper, lockup *lockupkeeper.Keeper, superfluid *superfluidkeeper.Keeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
 	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
 		return &CustomMessenger{
-			wrapped:      old,
-			bank:         bank,
-			gammKeeper:   gammKeeper,
-			tokenFactory: tokenFactory,
+			wrapped:          old,
+			bank:             bank,
+			gammKeeper:       gammKeeper,
+			tokenFactory:     tokenFactory,
+			lockupKeeper:     lockup,
+			superfluidKeeper: superfluid,
 		}
 	}
 }
 
 type CustomMessenger struct {
-	wrapped      wasmkeeper.Messenger
-	bank         *bankkeeper.BaseKeeper
-	gammKeeper   *gammkeeper.Keeper
-	tokenFactory *tokenfactorykeeper.Keeper
+	wrapped          wasmkeeper.Messenger
+	bank             *bankkeeper.BaseKeeper
+	gammKeeper       *gammkeeper.Keeper
+	tokenFactory     *tokenfactorykeeper.Keeper
+	lockupKeeper     *lockupkeeper.Keeper
+	superfluidKeeper *superfluidkeeper.Keeper
 }
 
 var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
@@ -62,6 +74,27 @@ func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddre
 		if contractMsg.Swap != nil {
 			return m.swapTokens(ctx, contractAddr, contractMsg.Swap)
 		}
+		if contractMsg.LockTokens != nil {
+			return m.lockTokens(ctx, contractAddr, contractMsg.LockTokens)
+		}
+		if contractMsg.BeginUnlocking != nil {
+			return m.beginUnlocking(ctx, contractAddr, contractMsg.BeginUnlocking)
+		}
+		if contractMsg.AddToExistingLock != nil {
+			return m.addToExistingLock(ctx, contractAddr, contractMsg.AddToExistingLock)
+		}
+		if contractMsg.SuperfluidDelegate != nil {
+			return m.superfluidDelegate(ctx, contractAddr, contractMsg.SuperfluidDelegate)
+		}
+		if contractMsg.SuperfluidUndelegate != nil {
+			return m.superfluidUndelegate(ctx, contractAddr, contractMsg.SuperfluidUndelegate)
+		}
+		if contractMsg.JoinPool != nil {
+			return m.joinPool(ctx, contractAddr, contractMsg.JoinPool)
+		}
+		if contractMsg.ExitPool != nil {
+			return m.exitPool(ctx, contractAddr, contractMsg.ExitPool)
+		}
 	}
 	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
 }
@@ -272,6 +305,280 @@ func PerformSwap(keeper *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.Ac
 	}
 }
 
+// lockTokens locks tokens owned by the contract.
+func (m *CustomMessenger) lockTokens(ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformLockTokens(m.lockupKeeper, ctx, contractAddr, lock)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform lock tokens")
+	}
+	data, err := res.Marshal()
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "lock tokens response")
+	}
+	return nil, [][]byte{data}, nil
+}
+
+// PerformLockTokens locks tokens through the lockup message server, with the contract as owner.
+func PerformLockTokens(k *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, lock *bindings.LockTokens) (*lockuptypes.MsgLockTokensResponse, error) {
+	if lock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lock tokens null lock"}
+	}
+	if lock.Amount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lock tokens null amount"}
+	}
+	if lock.Duration > uint64(math.MaxInt64/time.Second) {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lock tokens duration too long"}
+	}
+
+	duration := time.Duration(lock.Duration) * time.Second
+	coins := sdk.Coins{sdk.Coin{Denom: lock.Denom, Amount: lock.Amount}}
+	sdkMsg := lockuptypes.NewMsgLockTokens(contractAddr, duration, coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.LockTokens(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "locking tokens from message")
+	}
+	return res, nil
+}
+
+// beginUnlocking begins unlocking a lock owned by the contract.
+func (m *CustomMessenger) beginUnlocking(ctx sdk.Context, contractAddr sdk.AccAddress, unlock *bindings.BeginUnlocking) ([]sdk.Event, [][]byte, error) {
+	err := PerformBeginUnlocking(m.lockupKeeper, ctx, contractAddr, unlock)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform begin unlocking")
+	}
+	return nil, nil, nil
+}
+
+// PerformBeginUnlocking begins unlocking through the lockup message server, with the contract as owner.
+func PerformBeginUnlocking(k *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, unlock *bindings.BeginUnlocking) error {
+	if unlock == nil {
+		return wasmvmtypes.InvalidRequest{Err: "begin unlocking null unlock"}
+	}
+
+	coins, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(unlock.Coins)
+	if err != nil {
+		return err
+	}
+	sdkMsg := lockuptypes.NewMsgBeginUnlocking(contractAddr, unlock.LockId, coins)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := lockupkeeper.NewMsgServerImpl(k)
+	_, err = msgServer.BeginUnlocking(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "begin unlocking from message")
+	}
+	return nil
+}
+
+// addToExistingLock adds tokens to a lock owned by the contract.
+func (m *CustomMessenger) addToExistingLock(ctx sdk.Context, contractAddr sdk.AccAddress, add *bindings.AddToExistingLock) ([]sdk.Event, [][]byte, error) {
+	err := PerformAddToExistingLock(m.lockupKeeper, ctx, contractAddr, add)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform add to existing lock")
+	}
+	return nil, nil, nil
+}
+
+// PerformAddToExistingLock adds tokens to the given lock. The lockup keeper checks that the contract owns the lock.
+// As with MsgLockTokens, tokens can only be added to a lock that is not unlocking, in the denom it already holds.
+func PerformAddToExistingLock(k *lockupkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, add *bindings.AddToExistingLock) error {
+	if add == nil {
+		return wasmvmtypes.InvalidRequest{Err: "add to existing lock null add"}
+	}
+	if add.Amount.IsNil() {
+		return wasmvmtypes.InvalidRequest{Err: "add to existing lock null amount"}
+	}
+
+	coin := sdk.Coin{Denom: add.Denom, Amount: add.Amount}
+	if err := coin.Validate(); err != nil {
+		return err
+	}
+	if !coin.IsPositive() {
+		return wasmvmtypes.InvalidRequest{Err: "add to existing lock non-positive amount"}
+	}
+
+	lock, err := k.GetLockByID(ctx, add.LockId)
+	if err != nil {
+		return sdkerrors.Wrap(err, "get lock to add tokens to")
+	}
+	if lock.IsUnlocking() {
+		return wasmvmtypes.InvalidRequest{Err: "add to existing lock unlocking lock"}
+	}
+	if lock.Coins[0].Denom != coin.Denom {
+		return wasmvmtypes.InvalidRequest{Err: fmt.Sprintf("add to existing lock denom %s differs from lock denom %s", coin.Denom, lock.Coins[0].Denom)}
+	}
+
+	_, err = k.AddTokensToLockByID(ctx, add.LockId, contractAddr, coin)
+	if err != nil {
+		return sdkerrors.Wrap(err, "adding tokens to lock from message")
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			lockuptypes.TypeEvtAddTokensToLock,
+			sdk.NewAttribute(lockuptypes.AttributePeriodLockID, strconv.FormatUint(add.LockId, 10)),
+			sdk.NewAttribute(lockuptypes.AttributePeriodLockOwner, contractAddr.String()),
+			sdk.NewAttribute(lockuptypes.AttributePeriodLockAmount, coin.String()),
+		),
+	})
+	return nil
+}
+
+// superfluidDelegate superfluid delegates a lock owned by the contract.
+func (m *CustomMessenger) superfluidDelegate(ctx sdk.Context, contractAddr sdk.AccAddress, delegate *bindings.SuperfluidDelegate) ([]sdk.Event, [][]byte, error) {
+	err := PerformSuperfluidDelegate(m.superfluidKeeper, ctx, contractAddr, delegate)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform superfluid delegate")
+	}
+	return nil, nil, nil
+}
+
+// PerformSuperfluidDelegate delegates through the superfluid message server, with the contract as sender.
+func PerformSuperfluidDelegate(k *superfluidkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, delegate *bindings.SuperfluidDelegate) error {
+	if delegate == nil {
+		return wasmvmtypes.InvalidRequest{Err: "superfluid delegate null delegate"}
+	}
+	valAddr, err := sdk.ValAddressFromBech32(delegate.ValAddr)
+	if err != nil {
+		return sdkerrors.Wrap(err, "validator address from bech32")
+	}
+
+	sdkMsg := superfluidtypes.NewMsgSuperfluidDelegate(contractAddr, delegate.LockId, valAddr)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := superfluidkeeper.NewMsgServerImpl(k)
+	_, err = msgServer.SuperfluidDelegate(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "superfluid delegating from message")
+	}
+	return nil
+}
+
+// superfluidUndelegate undelegates a superfluid delegated lock owned by the contract.
+func (m *CustomMessenger) superfluidUndelegate(ctx sdk.Context, contractAddr sdk.AccAddress, undelegate *bindings.SuperfluidUndelegate) ([]sdk.Event, [][]byte, error) {
+	err := PerformSuperfluidUndelegate(m.superfluidKeeper, ctx, contractAddr, undelegate)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform superfluid undelegate")
+	}
+	return nil, nil, nil
+}
+
+// PerformSuperfluidUndelegate undelegates through the superfluid message server, with the contract as sender.
+func PerformSuperfluidUndelegate(k *superfluidkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, undelegate *bindings.SuperfluidUndelegate) error {
+	if undelegate == nil {
+		return wasmvmtypes.InvalidRequest{Err: "superfluid undelegate null undelegate"}
+	}
+
+	sdkMsg := superfluidtypes.NewMsgSuperfluidUndelegate(contractAddr, undelegate.LockId)
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return err
+	}
+
+	msgServer := superfluidkeeper.NewMsgServerImpl(k)
+	_, err := msgServer.SuperfluidUndelegate(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return sdkerrors.Wrap(err, "superfluid undelegating from message")
+	}
+	return nil
+}
+
+// joinPool provides liquidity to a pool from the contract.
+func (m *CustomMessenger) joinPool(ctx sdk.Context, contractAddr sdk.AccAddress, join *bindings.JoinPool) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformJoinPool(m.gammKeeper, ctx, contractAddr, join)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform join pool")
+	}
+	data, err := res.Marshal()
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "join pool response")
+	}
+	return nil, [][]byte{data}, nil
+}
+
+// PerformJoinPool joins a pool through the gamm message server, with the contract as sender.
+func PerformJoinPool(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, join *bindings.JoinPool) (*gammtypes.MsgJoinPoolResponse, error) {
+	if join == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm join pool null join"}
+	}
+	if join.ShareOutAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm join pool null share out amount"}
+	}
+
+	tokenInMaxs, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(join.TokenInMaxs)
+	if err != nil {
+		return nil, err
+	}
+	sdkMsg := &gammtypes.MsgJoinPool{
+		Sender:         contractAddr.String(),
+		PoolId:         join.PoolId,
+		ShareOutAmount: join.ShareOutAmount,
+		TokenInMaxs:    tokenInMaxs,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.JoinPool(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "joining pool from message")
+	}
+	return res, nil
+}
+
+// exitPool removes the contract's liquidity from a pool.
+func (m *CustomMessenger) exitPool(ctx sdk.Context, contractAddr sdk.AccAddress, exit *bindings.ExitPool) ([]sdk.Event, [][]byte, error) {
+	res, err := PerformExitPool(m.gammKeeper, ctx, contractAddr, exit)
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "perform exit pool")
+	}
+	data, err := res.Marshal()
+	if err != nil {
+		return nil, nil, sdkerrors.Wrap(err, "exit pool response")
+	}
+	return nil, [][]byte{data}, nil
+}
+
+// PerformExitPool exits a pool through the gamm message server, with the contract as sender.
+func PerformExitPool(k *gammkeeper.Keeper, ctx sdk.Context, contractAddr sdk.AccAddress, exit *bindings.ExitPool) (*gammtypes.MsgExitPoolResponse, error) {
+	if exit == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm exit pool null exit"}
+	}
+	if exit.ShareInAmount.IsNil() {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm exit pool null share in amount"}
+	}
+
+	tokenOutMins, err := wasmkeeper.ConvertWasmCoinsToSdkCoins(exit.TokenOutMins)
+	if err != nil {
+		return nil, err
+	}
+	sdkMsg := &gammtypes.MsgExitPool{
+		Sender:        contractAddr.String(),
+		PoolId:        exit.PoolId,
+		ShareInAmount: exit.ShareInAmount,
+		TokenOutMins:  tokenOutMins,
+	}
+	if err := sdkMsg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	msgServer := gammkeeper.NewMsgServerImpl(k)
+	res, err := msgServer.ExitPool(sdk.WrapSDKContext(ctx), sdkMsg)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "exiting pool from message")
+	}
+	return res, nil
+}
+
 // GetFullDenom is a function, not method, so the message_plugin can use it
 func GetFullDenom(contract string, subDenom string) (string, error) {
 	// Address validation
@@ -9,8 +9,16 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
+	epochskeeper "github.com/osmosis-labs/osmosis/v13/x/epochs/keeper"
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v13/x/incentives/keeper"
+	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v13/x/lockup/keeper"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/keeper"
 	twapkeeper "github.com/osmosis-labs/osmosis/v13/x/twap"
 )
@@ -19,14 +27,30 @@ type QueryPlugin struct {
 	gammKeeper         *gammkeeper.Keeper
 	twapKeeper         *twapkeeper.Keeper
 	tokenFactoryKeeper *tokenfactorykeeper.Keeper
+	lockupKeeper       *lockupkeeper.Keeper
+	incentivesKeeper   *incentiveskeeper.Keeper
+	superfluidKeeper   *superfluidkeeper.Keeper
+	epochsKeeper       *epochskeeper.Keeper
 }
 
 // NewQueryPlugin returns a reference to a new QueryPlugin.
-func NewQueryPlugin(gk *gammkeeper.Keeper, tk *twapkeeper.Keeper, tfk *tokenfactorykeeper.Keeper) *QueryPlugin {
+func NewQueryPlugin(
+	gk *gammkeeper.Keeper,
+	tk *twapkeeper.Keeper,
+	tfk *tokenfactorykeeper.Keeper,
+	lk *lockupkeeper.Keeper,
+	ik *incentiveskeeper.Keeper,
+	sk *superfluidkeeper.Keeper,
+	ek *epochskeeper.Keeper,
+) *QueryPlugin {
 	return &QueryPlugin{
 		gammKeeper:         gk,
 		twapKeeper:         tk,
 		tokenFactoryKeeper: tfk,
+		lockupKeeper:       lk,
+		incentivesKeeper:   ik,
+		superfluidKeeper:   sk,
+		epochsKeeper:       ek,
 	}
 }
 
@@ -144,3 +168,126 @@ func (qp QueryPlugin) ArithmeticTwapToNow(ctx sdk.Context, arithmeticTwap *bindi
 
 	return &twap, nil
 }
+
+func (qp QueryPlugin) GeometricTwap(ctx sdk.Context, geometricTwap *bindings.GeometricTwap) (*sdk.Dec, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+	endTime := time.UnixMilli(geometricTwap.EndTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return &twap, nil
+}
+
+func (qp QueryPlugin) GeometricTwapToNow(ctx sdk.Context, geometricTwap *bindings.GeometricTwapToNow) (*sdk.Dec, error) {
+	if geometricTwap == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "gamm geometric twap null"}
+	}
+
+	poolId := geometricTwap.PoolId
+	quoteAssetDenom := geometricTwap.QuoteAssetDenom
+	baseAssetDenom := geometricTwap.BaseAssetDenom
+	startTime := time.UnixMilli(geometricTwap.StartTime)
+
+	twap, err := qp.twapKeeper.GetGeometricTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime)
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "gamm geometric twap")
+	}
+
+	return &twap, nil
+}
+
+func (qp QueryPlugin) LockById(ctx sdk.Context, lockById *bindings.LockById) (*lockuptypes.LockedResponse, error) {
+	if lockById == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lockup lock by id null"}
+	}
+
+	res, err := lockupkeeper.NewQuerier(*qp.lockupKeeper).LockedByID(sdk.WrapSDKContext(ctx), &lockuptypes.LockedRequest{LockId: lockById.LockId})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "lockup lock by id")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) AccountLocks(ctx sdk.Context, accountLocks *bindings.AccountLocks) (*lockuptypes.AccountLockedLongerDurationResponse, error) {
+	if accountLocks == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "lockup account locks null"}
+	}
+
+	// every lock is at least zero long, so this returns all the locks of the account
+	res, err := lockupkeeper.NewQuerier(*qp.lockupKeeper).AccountLockedLongerDuration(sdk.WrapSDKContext(ctx), &lockuptypes.AccountLockedLongerDurationRequest{
+		Owner:    accountLocks.Owner,
+		Duration: 0,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "lockup account locks")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) GaugeById(ctx sdk.Context, gaugeById *bindings.GaugeById) (*incentivestypes.GaugeByIDResponse, error) {
+	if gaugeById == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "incentives gauge by id null"}
+	}
+
+	res, err := incentiveskeeper.NewQuerier(*qp.incentivesKeeper).GaugeByID(sdk.WrapSDKContext(ctx), &incentivestypes.GaugeByIDRequest{Id: gaugeById.GaugeId})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "incentives gauge by id")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) RewardsEstimate(ctx sdk.Context, rewardsEstimate *bindings.RewardsEstimate) (*incentivestypes.RewardsEstResponse, error) {
+	if rewardsEstimate == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "incentives rewards estimate null"}
+	}
+
+	res, err := incentiveskeeper.NewQuerier(*qp.incentivesKeeper).RewardsEst(sdk.WrapSDKContext(ctx), &incentivestypes.RewardsEstRequest{
+		Owner:    rewardsEstimate.Owner,
+		LockIds:  rewardsEstimate.LockIds,
+		EndEpoch: rewardsEstimate.EndEpoch,
+	})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "incentives rewards estimate")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) SuperfluidDelegationByLock(ctx sdk.Context, delegationByLock *bindings.SuperfluidDelegationByLock) (*superfluidtypes.ConnectedIntermediaryAccountResponse, error) {
+	if delegationByLock == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "superfluid delegation by lock null"}
+	}
+
+	res, err := superfluidkeeper.NewQuerier(*qp.superfluidKeeper).ConnectedIntermediaryAccount(sdk.WrapSDKContext(ctx), &superfluidtypes.ConnectedIntermediaryAccountRequest{LockId: delegationByLock.LockId})
+	if err != nil {
+		return nil, sdkerrors.Wrap(err, "superfluid delegation by lock")
+	}
+
+	return res, nil
+}
+
+func (qp QueryPlugin) EpochInfo(ctx sdk.Context, epochInfo *bindings.EpochInfo) (*epochstypes.EpochInfo, error) {
+	if epochInfo == nil {
+		return nil, wasmvmtypes.InvalidRequest{Err: "epochs epoch info null"}
+	}
+
+	info := qp.epochsKeeper.GetEpochInfo(ctx, epochInfo.Identifier)
+	if (info == epochstypes.EpochInfo{}) {
+		return nil, fmt.Errorf("epoch with identifier %s not found", epochInfo.Identifier)
+	}
+
+	return &info, nil
+}
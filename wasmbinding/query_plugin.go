@@ -9,6 +9,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/gogo/protobuf/proto"
 	abci "github.com/tendermint/tendermint/abci/types"
 
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
@@ -137,6 +138,140 @@ func CustomQuerier(qp *QueryPlugin) func(ctx sdk.Context, request json.RawMessag
 
 			return bz, nil
 
+		case contractQuery.ArithmeticTwap != nil:
+			twap, err := qp.ArithmeticTwap(ctx, contractQuery.ArithmeticTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query")
+			}
+
+			res := bindings.ArithmeticTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.ArithmeticTwapToNow != nil:
+			twap, err := qp.ArithmeticTwapToNow(ctx, contractQuery.ArithmeticTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query")
+			}
+
+			res := bindings.ArithmeticTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo arithmetic twap to now query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwap != nil:
+			twap, err := qp.GeometricTwap(ctx, contractQuery.GeometricTwap)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query")
+			}
+
+			res := bindings.GeometricTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GeometricTwapToNow != nil:
+			twap, err := qp.GeometricTwapToNow(ctx, contractQuery.GeometricTwapToNow)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query")
+			}
+
+			res := bindings.GeometricTwapResponse{Twap: twap.String()}
+			bz, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo geometric twap to now query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.LockById != nil:
+			res, err := qp.LockById(ctx, contractQuery.LockById)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lock by id query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo lock by id query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.AccountLocks != nil:
+			res, err := qp.AccountLocks(ctx, contractQuery.AccountLocks)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo account locks query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo account locks query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.GaugeById != nil:
+			res, err := qp.GaugeById(ctx, contractQuery.GaugeById)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo gauge by id query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo gauge by id query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.RewardsEstimate != nil:
+			res, err := qp.RewardsEstimate(ctx, contractQuery.RewardsEstimate)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo rewards estimate query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo rewards estimate query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.SuperfluidDelegationByLock != nil:
+			res, err := qp.SuperfluidDelegationByLock(ctx, contractQuery.SuperfluidDelegationByLock)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo superfluid delegation by lock query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo superfluid delegation by lock query response")
+			}
+
+			return bz, nil
+
+		case contractQuery.EpochInfo != nil:
+			res, err := qp.EpochInfo(ctx, contractQuery.EpochInfo)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo epoch info query")
+			}
+
+			bz, err := marshalProtoResponse(res)
+			if err != nil {
+				return nil, sdkerrors.Wrap(err, "osmo epoch info query response")
+			}
+
+			return bz, nil
+
 		default:
 			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown osmosis query variant"}
 		}
@@ -181,3 +316,10 @@ func ConvertSdkCoinToWasmCoin(coin sdk.Coin) wasmvmtypes.Coin {
 		Amount: coin.Amount.String(),
 	}
 }
+
+// marshalProtoResponse marshals the protobuf response of a module query to JSON.
+// It uses the same encoding as the responses of whitelisted stargate queries,
+// so contracts can share response types between both kinds of queries.
+func marshalProtoResponse(res proto.Message) ([]byte, error) {
+	return codec.ProtoMarshalJSON(res, nil)
+}
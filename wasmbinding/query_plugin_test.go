@@ -24,6 +24,7 @@ import (
 	gammv2types "github.com/osmosis-labs/osmosis/v13/x/gamm/v2types"
 
 	"github.com/osmosis-labs/osmosis/v13/app"
+	downtimequerytypes "github.com/osmosis-labs/osmosis/v13/x/downtime-detector/client/queryproto"
 	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 
@@ -98,6 +99,23 @@ func (suite *StargateTestSuite) TestStargateQuerier() {
 				SpotPrice: sdk.NewDecWithPrec(5, 1).String(),
 			},
 		},
+		{
+			name: "happy path downtime-detector",
+			path: "/osmosis.downtimedetector.v1beta1.Query/RecoveredSinceDowntimeOfLength",
+			requestData: func() []byte {
+				queryrequest := downtimequerytypes.RecoveredSinceDowntimeOfLengthRequest{
+					Downtime: time.Minute,
+					Recovery: 10 * time.Minute,
+				}
+				bz, err := proto.Marshal(&queryrequest)
+				suite.Require().NoError(err)
+				return bz
+			},
+			checkResponseStruct: true,
+			responseProtoStruct: &downtimequerytypes.RecoveredSinceDowntimeOfLengthResponse{
+				SuccesfullyRecovered: true,
+			},
+		},
 		{
 			name: "unregistered path(not whitelisted)",
 			path: "/osmosis.lockup.Query/AccountLockedLongerDuration",
@@ -6,19 +6,26 @@ import (
 	"os"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/CosmWasm/wasmd/x/wasm/keeper"
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
+	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app"
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	incentivestypes "github.com/osmosis-labs/osmosis/v13/x/incentives/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 )
 
 // we must pay this many uosmo for every pool we create
@@ -172,6 +179,268 @@ func TestQuerySpotPrice(t *testing.T) {
 	require.InEpsilonf(t, expected+swapFee, price, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
 }
 
+func TestQueryArithmeticTwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	epsilon := 1e-6
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	}
+	// contracts query with millisecond precision, so start at a round time
+	startTime := ctx.BlockTime().Truncate(time.Second)
+	ctx = ctx.WithBlockTime(startTime)
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+
+	reflect := instantiateReflectContract(t, ctx, osmosis, actor)
+	require.NotEmpty(t, reflect)
+
+	// 1 star is worth 1/20 osmo for the first half hour
+	initialPrice := 0.05
+
+	// then a swap moves the price
+	ctx = ctx.WithBlockTime(startTime.Add(30 * time.Minute))
+	routes := []gammtypes.SwapAmountInRoute{{PoolId: starPool, TokenOutDenom: "ustar"}}
+	_, err := osmosis.GAMMKeeper.MultihopSwapExactAmountIn(ctx, actor, routes, sdk.NewInt64Coin("uosmo", 1000000), sdk.OneInt())
+	require.NoError(t, err)
+	osmosis.TwapKeeper.EndBlock(ctx)
+	newPriceDec, err := osmosis.GAMMKeeper.CalculateSpotPrice(ctx, starPool, "uosmo", "ustar")
+	require.NoError(t, err)
+	newPrice := newPriceDec.MustFloat64()
+	require.Greater(t, newPrice, initialPrice)
+
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+
+	// before the swap
+	query := bindings.OsmosisQuery{
+		ArithmeticTwap: &bindings.ArithmeticTwap{
+			PoolId:          starPool,
+			QuoteAssetDenom: "uosmo",
+			BaseAssetDenom:  "ustar",
+			StartTime:       startTime.UnixMilli(),
+			EndTime:         startTime.Add(30 * time.Minute).UnixMilli(),
+		},
+	}
+	resp := bindings.ArithmeticTwapResponse{}
+	queryCustom(t, ctx, osmosis, reflect, query, &resp)
+
+	twap, err := strconv.ParseFloat(resp.Twap, 64)
+	require.NoError(t, err)
+	require.InEpsilonf(t, initialPrice, twap, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
+
+	// half of the window on either side of the swap
+	query = bindings.OsmosisQuery{
+		ArithmeticTwap: &bindings.ArithmeticTwap{
+			PoolId:          starPool,
+			QuoteAssetDenom: "uosmo",
+			BaseAssetDenom:  "ustar",
+			StartTime:       startTime.Add(15 * time.Minute).UnixMilli(),
+			EndTime:         startTime.Add(45 * time.Minute).UnixMilli(),
+		},
+	}
+	resp = bindings.ArithmeticTwapResponse{}
+	queryCustom(t, ctx, osmosis, reflect, query, &resp)
+
+	twap, err = strconv.ParseFloat(resp.Twap, 64)
+	require.NoError(t, err)
+	expected := (initialPrice + newPrice) / 2
+	require.InEpsilonf(t, expected, twap, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
+
+	// the whole hour until now
+	query = bindings.OsmosisQuery{
+		ArithmeticTwapToNow: &bindings.ArithmeticTwapToNow{
+			PoolId:          starPool,
+			QuoteAssetDenom: "uosmo",
+			BaseAssetDenom:  "ustar",
+			StartTime:       startTime.UnixMilli(),
+		},
+	}
+	resp = bindings.ArithmeticTwapResponse{}
+	queryCustom(t, ctx, osmosis, reflect, query, &resp)
+
+	twap, err = strconv.ParseFloat(resp.Twap, 64)
+	require.NoError(t, err)
+	require.InEpsilonf(t, expected, twap, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
+}
+
+func TestQueryGeometricTwap(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+	epsilon := 1e-6
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12000000),
+		sdk.NewInt64Coin("ustar", 240000000),
+	}
+	// contracts query with millisecond precision, so start at a round time
+	startTime := ctx.BlockTime().Truncate(time.Second)
+	ctx = ctx.WithBlockTime(startTime)
+	// 20 star to 1 osmo
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+
+	// the price is constant for an hour
+	ctx = ctx.WithBlockTime(startTime.Add(time.Hour))
+	// 1 star is worth 1/20 osmo
+	expected := 0.05
+
+	query := bindings.OsmosisQuery{
+		GeometricTwap: &bindings.GeometricTwap{
+			PoolId:          starPool,
+			QuoteAssetDenom: "uosmo",
+			BaseAssetDenom:  "ustar",
+			StartTime:       startTime.UnixMilli(),
+			EndTime:         startTime.Add(30 * time.Minute).UnixMilli(),
+		},
+	}
+	resp := bindings.GeometricTwapResponse{}
+	queryCustomPlugin(t, ctx, osmosis, query, &resp)
+
+	twap, err := strconv.ParseFloat(resp.Twap, 64)
+	require.NoError(t, err)
+	require.InEpsilonf(t, expected, twap, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
+
+	query = bindings.OsmosisQuery{
+		GeometricTwapToNow: &bindings.GeometricTwapToNow{
+			PoolId:          starPool,
+			QuoteAssetDenom: "uosmo",
+			BaseAssetDenom:  "ustar",
+			StartTime:       startTime.UnixMilli(),
+		},
+	}
+	resp = bindings.GeometricTwapResponse{}
+	queryCustomPlugin(t, ctx, osmosis, query, &resp)
+
+	twap, err = strconv.ParseFloat(resp.Twap, 64)
+	require.NoError(t, err)
+	require.InEpsilonf(t, expected, twap, epsilon, fmt.Sprintf("Outside of tolerance (%f)", epsilon))
+}
+
+func TestQueryLockup(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	lockCoins := sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000000))
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, lockCoins, time.Hour)
+	require.NoError(t, err)
+	otherLock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, lockCoins, 24*time.Hour)
+	require.NoError(t, err)
+
+	// query the lock by ID
+	query := bindings.OsmosisQuery{
+		LockById: &bindings.LockById{LockId: lock.ID},
+	}
+	lockResp := lockuptypes.LockedResponse{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &lockResp)
+	require.Equal(t, lock, *lockResp.Lock)
+
+	// query all the locks of the account, including the unlocking ones
+	err = osmosis.LockupKeeper.BeginUnlock(ctx, otherLock.ID, nil)
+	require.NoError(t, err)
+	unlockingLock, err := osmosis.LockupKeeper.GetLockByID(ctx, otherLock.ID)
+	require.NoError(t, err)
+
+	query = bindings.OsmosisQuery{
+		AccountLocks: &bindings.AccountLocks{Owner: actor.String()},
+	}
+	locksResp := lockuptypes.AccountLockedLongerDurationResponse{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &locksResp)
+	require.ElementsMatch(t, []lockuptypes.PeriodLock{lock, *unlockingLock}, locksResp.Locks)
+
+	// query a lock that does not exist
+	query = bindings.OsmosisQuery{
+		LockById: &bindings.LockById{LockId: 100},
+	}
+	queryCustomPluginError(t, ctx, osmosis, query)
+}
+
+func TestQueryIncentives(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	osmosis.IncentivesKeeper.SetLockableDurations(ctx, []time.Duration{24 * time.Hour})
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000000)), 24*time.Hour)
+	require.NoError(t, err)
+
+	gaugeCoins := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000000))
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "ustar",
+		Duration:      24 * time.Hour,
+	}
+	gaugeId, err := osmosis.IncentivesKeeper.CreateGauge(ctx, false, actor, gaugeCoins, distrTo, ctx.BlockTime(), 2)
+	require.NoError(t, err)
+
+	// query the gauge by ID
+	query := bindings.OsmosisQuery{
+		GaugeById: &bindings.GaugeById{GaugeId: gaugeId},
+	}
+	gaugeResp := incentivestypes.GaugeByIDResponse{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &gaugeResp)
+	require.Equal(t, gaugeId, gaugeResp.Gauge.Id)
+	require.Equal(t, gaugeCoins, gaugeResp.Gauge.Coins)
+	require.Equal(t, distrTo, gaugeResp.Gauge.DistributeTo)
+
+	// the only lock of the gauge gets all the rewards of the gauge
+	currentEpoch := osmosis.IncentivesKeeper.GetEpochInfo(ctx).CurrentEpoch
+	query = bindings.OsmosisQuery{
+		RewardsEstimate: &bindings.RewardsEstimate{
+			Owner:    actor.String(),
+			LockIds:  []uint64{lock.ID},
+			EndEpoch: currentEpoch + 2,
+		},
+	}
+	rewardsResp := incentivestypes.RewardsEstResponse{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &rewardsResp)
+	require.Equal(t, gaugeCoins, rewardsResp.Coins)
+}
+
+func TestQuerySuperfluidDelegationByLock(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000000)), 24*time.Hour)
+	require.NoError(t, err)
+
+	// a lock that is not superfluid delegated has no intermediary account
+	query := bindings.OsmosisQuery{
+		SuperfluidDelegationByLock: &bindings.SuperfluidDelegationByLock{LockId: lock.ID},
+	}
+	resp := superfluidtypes.ConnectedIntermediaryAccountResponse{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &resp)
+	require.Equal(t, "", resp.Account.ValAddr)
+	require.Equal(t, "", resp.Account.Address)
+}
+
+func TestQueryEpochInfo(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	query := bindings.OsmosisQuery{
+		EpochInfo: &bindings.EpochInfo{Identifier: "day"},
+	}
+	resp := epochstypes.EpochInfo{}
+	queryCustomPluginProto(t, ctx, osmosis, query, &resp)
+	require.Equal(t, osmosis.EpochsKeeper.GetEpochInfo(ctx, "day"), resp)
+
+	// query an epoch that does not exist
+	query = bindings.OsmosisQuery{
+		EpochInfo: &bindings.EpochInfo{Identifier: "fortnight"},
+	}
+	queryCustomPluginError(t, ctx, osmosis, query)
+}
+
 func TestQueryEstimateSwap(t *testing.T) {
 	actor := RandomAccountAddress()
 	osmosis, ctx := SetupCustomApp(t, actor)
@@ -290,6 +559,42 @@ func queryCustom(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, contrac
 	require.NoError(t, err)
 }
 
+// queryCustomPlugin dispatches the request directly to the custom querier,
+// for query variants that the reflect contract in testdata does not know about.
+func queryCustomPlugin(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, request bindings.OsmosisQuery, response interface{}) {
+	msgBz, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.IncentivesKeeper, osmosis.SuperfluidKeeper, osmosis.EpochsKeeper)
+	resBz, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, msgBz)
+	require.NoError(t, err)
+	err = json.Unmarshal(resBz, response)
+	require.NoError(t, err)
+}
+
+// queryCustomPluginProto dispatches the request directly to the custom querier,
+// and decodes the proto JSON response of module queries.
+func queryCustomPluginProto(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, request bindings.OsmosisQuery, response codec.ProtoMarshaler) {
+	msgBz, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.IncentivesKeeper, osmosis.SuperfluidKeeper, osmosis.EpochsKeeper)
+	resBz, err := wasmbinding.CustomQuerier(queryPlugin)(ctx, msgBz)
+	require.NoError(t, err)
+	err = osmosis.AppCodec().UnmarshalJSON(resBz, response)
+	require.NoError(t, err)
+}
+
+// queryCustomPluginError dispatches the request directly to the custom querier, and expects it to fail.
+func queryCustomPluginError(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp, request bindings.OsmosisQuery) {
+	msgBz, err := json.Marshal(request)
+	require.NoError(t, err)
+
+	queryPlugin := wasmbinding.NewQueryPlugin(osmosis.GAMMKeeper, osmosis.TwapKeeper, osmosis.TokenFactoryKeeper, osmosis.LockupKeeper, osmosis.IncentivesKeeper, osmosis.SuperfluidKeeper, osmosis.EpochsKeeper)
+	_, err = wasmbinding.CustomQuerier(queryPlugin)(ctx, msgBz)
+	require.Error(t, err)
+}
+
 func assertValidShares(t *testing.T, shares wasmvmtypes.Coin, poolID uint64) {
 	// sanity check: check the denom and ensure at least 18 decimal places
 	denom := fmt.Sprintf("gamm/pool/%d", poolID)
@@ -10,8 +10,11 @@ import (
 	"github.com/tendermint/tendermint/crypto/ed25519"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app"
 )
@@ -45,3 +48,19 @@ func RandomAccountAddress() sdk.AccAddress {
 func RandomBech32AccountAddress() string {
 	return RandomAccountAddress().String()
 }
+
+// prepareValidator creates a validator with a small self-delegation.
+func prepareValidator(t *testing.T, ctx sdk.Context, osmosis *app.OsmosisApp) sdk.ValAddress {
+	valPub := secp256k1.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(valPub.Address())
+	valAddr := sdk.ValAddress(addr)
+	selfBond := sdk.NewInt64Coin(osmosis.StakingKeeper.BondDenom(ctx), 100)
+	fundAccount(t, ctx, osmosis, addr, sdk.NewCoins(selfBond))
+
+	commission := stakingtypes.NewCommissionRates(sdk.ZeroDec(), sdk.ZeroDec(), sdk.ZeroDec())
+	msg, err := stakingtypes.NewMsgCreateValidator(valAddr, valPub, selfBond, stakingtypes.Description{}, commission, sdk.OneInt())
+	require.NoError(t, err)
+	_, err = stakingkeeper.NewMsgServerImpl(*osmosis.StakingKeeper).CreateValidator(sdk.WrapSDKContext(ctx), msg)
+	require.NoError(t, err)
+	return valAddr
+}
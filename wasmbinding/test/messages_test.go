@@ -4,11 +4,15 @@ import (
 	"fmt"
 	"math"
 	"testing"
+	"time"
 
+	wasmvmtypes "github.com/CosmWasm/wasmvm/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding"
 	"github.com/osmosis-labs/osmosis/v13/wasmbinding/bindings"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	superfluidtypes "github.com/osmosis-labs/osmosis/v13/x/superfluid/types"
 	"github.com/osmosis-labs/osmosis/v13/x/tokenfactory/types"
 
 	"github.com/stretchr/testify/assert"
@@ -829,3 +833,411 @@ func TestSwapMultiHop(t *testing.T) {
 		})
 	}
 }
+
+func TestLockTokens(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	existingLock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)), time.Hour)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		lock      *bindings.LockTokens
+		expLockId uint64
+		expErr    bool
+	}{
+		"new lock": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Amount:   sdk.NewInt(1000),
+				Duration: 86400,
+			},
+			expLockId: existingLock.ID + 1,
+		},
+		"adds to lock with same duration": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Amount:   sdk.NewInt(1000),
+				Duration: 3600,
+			},
+			expLockId: existingLock.ID,
+		},
+		"zero duration": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Amount:   sdk.NewInt(1000),
+				Duration: 0,
+			},
+			expErr: true,
+		},
+		"zero amount": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Amount:   sdk.ZeroInt(),
+				Duration: 3600,
+			},
+			expErr: true,
+		},
+		"insufficient funds": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Amount:   sdk.NewInt(1_000_000_000_000),
+				Duration: 3600,
+			},
+			expErr: true,
+		},
+		"null amount": {
+			lock: &bindings.LockTokens{
+				Denom:    "ustar",
+				Duration: 3600,
+			},
+			expErr: true,
+		},
+		"null lock": {
+			lock:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			// when
+			res, gotErr := wasmbinding.PerformLockTokens(osmosis.LockupKeeper, subCtx, actor, spec.lock)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.expLockId, res.ID)
+
+			lock, err := osmosis.LockupKeeper.GetLockByID(subCtx, res.ID)
+			require.NoError(t, err)
+			require.Equal(t, actor.String(), lock.Owner)
+			require.Equal(t, time.Duration(spec.lock.Duration)*time.Second, lock.Duration)
+		})
+	}
+}
+
+func TestBeginUnlocking(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)), time.Hour)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender      sdk.AccAddress
+		unlock      *bindings.BeginUnlocking
+		expUnlocked sdk.Coins
+		expErr      bool
+	}{
+		"whole lock": {
+			sender:      actor,
+			unlock:      &bindings.BeginUnlocking{LockId: lock.ID},
+			expUnlocked: lock.Coins,
+		},
+		"partial lock": {
+			sender: actor,
+			unlock: &bindings.BeginUnlocking{
+				LockId: lock.ID,
+				Coins:  []wasmvmtypes.Coin{{Denom: "ustar", Amount: "400"}},
+			},
+			expUnlocked: sdk.NewCoins(sdk.NewInt64Coin("ustar", 400)),
+		},
+		"more than locked": {
+			sender: actor,
+			unlock: &bindings.BeginUnlocking{
+				LockId: lock.ID,
+				Coins:  []wasmvmtypes.Coin{{Denom: "ustar", Amount: "1001"}},
+			},
+			expErr: true,
+		},
+		"not lock owner": {
+			sender: RandomAccountAddress(),
+			unlock: &bindings.BeginUnlocking{LockId: lock.ID},
+			expErr: true,
+		},
+		"lock does not exist": {
+			sender: actor,
+			unlock: &bindings.BeginUnlocking{LockId: lock.ID + 1},
+			expErr: true,
+		},
+		"null unlock": {
+			sender: actor,
+			unlock: nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformBeginUnlocking(osmosis.LockupKeeper, subCtx, spec.sender, spec.unlock)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			unlocking := osmosis.LockupKeeper.GetAccountUnlockingCoins(subCtx, actor)
+			require.Equal(t, spec.expUnlocked, unlocking)
+		})
+	}
+}
+
+func TestAddToExistingLock(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)), time.Hour)
+	require.NoError(t, err)
+
+	unlockingLock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewInt64Coin("ustar", 1000)), time.Hour*2)
+	require.NoError(t, err)
+	err = osmosis.LockupKeeper.BeginUnlock(ctx, unlockingLock.ID, nil)
+	require.NoError(t, err)
+
+	specs := map[string]struct {
+		sender   sdk.AccAddress
+		add      *bindings.AddToExistingLock
+		expCoins sdk.Coins
+		expErr   bool
+	}{
+		"same denom": {
+			sender:   actor,
+			add:      &bindings.AddToExistingLock{LockId: lock.ID, Denom: "ustar", Amount: sdk.NewInt(500)},
+			expCoins: sdk.NewCoins(sdk.NewInt64Coin("ustar", 1500)),
+		},
+		"zero amount": {
+			sender: actor,
+			add:    &bindings.AddToExistingLock{LockId: lock.ID, Denom: "ustar", Amount: sdk.ZeroInt()},
+			expErr: true,
+		},
+		"different denom": {
+			sender: actor,
+			add:    &bindings.AddToExistingLock{LockId: lock.ID, Denom: "uatom", Amount: sdk.NewInt(500)},
+			expErr: true,
+		},
+		"unlocking lock": {
+			sender: actor,
+			add:    &bindings.AddToExistingLock{LockId: unlockingLock.ID, Denom: "ustar", Amount: sdk.NewInt(500)},
+			expErr: true,
+		},
+		"not lock owner": {
+			sender: RandomAccountAddress(),
+			add:    &bindings.AddToExistingLock{LockId: lock.ID, Denom: "ustar", Amount: sdk.NewInt(500)},
+			expErr: true,
+		},
+		"lock does not exist": {
+			sender: actor,
+			add:    &bindings.AddToExistingLock{LockId: lock.ID + 1, Denom: "ustar", Amount: sdk.NewInt(500)},
+			expErr: true,
+		},
+		"null add": {
+			sender: actor,
+			add:    nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformAddToExistingLock(osmosis.LockupKeeper, subCtx, spec.sender, spec.add)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			gotLock, err := osmosis.LockupKeeper.GetLockByID(subCtx, lock.ID)
+			require.NoError(t, err)
+			require.Equal(t, spec.expCoins, gotLock.Coins)
+		})
+	}
+}
+
+func TestSuperfluidDelegate(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	bondDenom := osmosis.StakingKeeper.BondDenom(ctx)
+	fundAccount(t, ctx, osmosis, actor, defaultFunds.Add(sdk.NewInt64Coin(bondDenom, 1_000_000_000)))
+
+	poolId := preparePool(t, ctx, osmosis, actor, []sdk.Coin{
+		sdk.NewInt64Coin(bondDenom, 1_000_000),
+		sdk.NewInt64Coin("ustar", 20_000_000),
+	})
+	shareDenom := gammtypes.GetPoolShareDenom(poolId)
+	err := osmosis.SuperfluidKeeper.AddNewSuperfluidAsset(ctx, superfluidtypes.SuperfluidAsset{
+		Denom:     shareDenom,
+		AssetType: superfluidtypes.SuperfluidAssetTypeLPShare,
+	})
+	require.NoError(t, err)
+
+	// intermediary accounts create gauges for the unbonding duration
+	unbondingTime := osmosis.StakingKeeper.UnbondingTime(ctx)
+	osmosis.IncentivesKeeper.SetLockableDurations(ctx, []time.Duration{unbondingTime})
+	lock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewCoin(shareDenom, gammtypes.OneShare)), unbondingTime)
+	require.NoError(t, err)
+	shortLock, err := osmosis.LockupKeeper.CreateLock(ctx, actor, sdk.NewCoins(sdk.NewCoin(shareDenom, gammtypes.OneShare)), time.Hour)
+	require.NoError(t, err)
+
+	valAddr := prepareValidator(t, ctx, osmosis)
+
+	specs := map[string]struct {
+		delegate *bindings.SuperfluidDelegate
+		expErr   bool
+	}{
+		"valid delegation": {
+			delegate: &bindings.SuperfluidDelegate{LockId: lock.ID, ValAddr: valAddr.String()},
+		},
+		"lock shorter than unbonding time": {
+			delegate: &bindings.SuperfluidDelegate{LockId: shortLock.ID, ValAddr: valAddr.String()},
+			expErr:   true,
+		},
+		"invalid validator address": {
+			delegate: &bindings.SuperfluidDelegate{LockId: lock.ID, ValAddr: actor.String()},
+			expErr:   true,
+		},
+		"null delegate": {
+			delegate: nil,
+			expErr:   true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			// when
+			gotErr := wasmbinding.PerformSuperfluidDelegate(osmosis.SuperfluidKeeper, subCtx, actor, spec.delegate)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+
+			intermediaryAcc := osmosis.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(subCtx, spec.delegate.LockId)
+			require.NotEmpty(t, intermediaryAcc)
+
+			// only the lock owner can undelegate
+			err := wasmbinding.PerformSuperfluidUndelegate(osmosis.SuperfluidKeeper, subCtx, RandomAccountAddress(), &bindings.SuperfluidUndelegate{LockId: spec.delegate.LockId})
+			require.Error(t, err)
+
+			err = wasmbinding.PerformSuperfluidUndelegate(osmosis.SuperfluidKeeper, subCtx, actor, &bindings.SuperfluidUndelegate{LockId: spec.delegate.LockId})
+			require.NoError(t, err)
+			intermediaryAcc = osmosis.SuperfluidKeeper.GetLockIdIntermediaryAccountConnection(subCtx, spec.delegate.LockId)
+			require.Empty(t, intermediaryAcc)
+		})
+	}
+}
+
+func TestJoinExitPool(t *testing.T) {
+	actor := RandomAccountAddress()
+	osmosis, ctx := SetupCustomApp(t, actor)
+
+	fundAccount(t, ctx, osmosis, actor, defaultFunds)
+
+	poolFunds := []sdk.Coin{
+		sdk.NewInt64Coin("uosmo", 12_000_000),
+		sdk.NewInt64Coin("ustar", 240_000_000),
+	}
+	starPool := preparePool(t, ctx, osmosis, actor, poolFunds)
+	shareDenom := gammtypes.GetPoolShareDenom(starPool)
+
+	tokenInMaxs := []wasmvmtypes.Coin{
+		{Denom: "uosmo", Amount: "1200000"},
+		{Denom: "ustar", Amount: "24000000"},
+	}
+
+	specs := map[string]struct {
+		join   *bindings.JoinPool
+		expErr bool
+	}{
+		"valid join": {
+			join: &bindings.JoinPool{
+				PoolId:         starPool,
+				ShareOutAmount: gammtypes.InitPoolSharesSupply.QuoRaw(10),
+				TokenInMaxs:    tokenInMaxs,
+			},
+		},
+		"token in maxs exceeded": {
+			join: &bindings.JoinPool{
+				PoolId:         starPool,
+				ShareOutAmount: gammtypes.InitPoolSharesSupply.QuoRaw(5),
+				TokenInMaxs:    tokenInMaxs,
+			},
+			expErr: true,
+		},
+		"zero shares": {
+			join: &bindings.JoinPool{
+				PoolId:         starPool,
+				ShareOutAmount: sdk.ZeroInt(),
+				TokenInMaxs:    tokenInMaxs,
+			},
+			expErr: true,
+		},
+		"pool does not exist": {
+			join: &bindings.JoinPool{
+				PoolId:         starPool + 1,
+				ShareOutAmount: gammtypes.InitPoolSharesSupply.QuoRaw(10),
+				TokenInMaxs:    tokenInMaxs,
+			},
+			expErr: true,
+		},
+		"null join": {
+			join:   nil,
+			expErr: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			// use scratch context to avoid interference between tests
+			subCtx, _ := ctx.CacheContext()
+			sharesBefore := osmosis.BankKeeper.GetBalance(subCtx, actor, shareDenom).Amount
+			// when
+			res, gotErr := wasmbinding.PerformJoinPool(osmosis.GAMMKeeper, subCtx, actor, spec.join)
+			// then
+			if spec.expErr {
+				require.Error(t, gotErr)
+				return
+			}
+			require.NoError(t, gotErr)
+			require.Equal(t, spec.join.ShareOutAmount, res.ShareOutAmount)
+			sharesAfter := osmosis.BankKeeper.GetBalance(subCtx, actor, shareDenom).Amount
+			require.Equal(t, spec.join.ShareOutAmount, sharesAfter.Sub(sharesBefore))
+
+			// exit with the joined shares, requiring more than was put in fails
+			exit := &bindings.ExitPool{
+				PoolId:        starPool,
+				ShareInAmount: spec.join.ShareOutAmount,
+				TokenOutMins:  []wasmvmtypes.Coin{{Denom: "ustar", Amount: "24000001"}},
+			}
+			_, err := wasmbinding.PerformExitPool(osmosis.GAMMKeeper, subCtx, actor, exit)
+			require.Error(t, err)
+
+			exit.TokenOutMins = []wasmvmtypes.Coin{{Denom: "ustar", Amount: "23000000"}}
+			exitRes, err := wasmbinding.PerformExitPool(osmosis.GAMMKeeper, subCtx, actor, exit)
+			require.NoError(t, err)
+			// exiting rounds down in favour of the pool
+			for i, coin := range res.TokenIn {
+				require.Equal(t, coin.Denom, exitRes.TokenOut[i].Denom)
+				require.True(t, coin.Amount.Sub(exitRes.TokenOut[i].Amount).LTE(sdk.OneInt()))
+			}
+			require.Equal(t, sharesBefore, osmosis.BankKeeper.GetBalance(subCtx, actor, shareDenom).Amount)
+		})
+	}
+}
@@ -9,7 +9,11 @@ import (
 
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 
+	epochskeeper "github.com/osmosis-labs/osmosis/v13/x/epochs/keeper"
 	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	incentiveskeeper "github.com/osmosis-labs/osmosis/v13/x/incentives/keeper"
+	lockupkeeper "github.com/osmosis-labs/osmosis/v13/x/lockup/keeper"
+	superfluidkeeper "github.com/osmosis-labs/osmosis/v13/x/superfluid/keeper"
 	tokenfactorykeeper "github.com/osmosis-labs/osmosis/v13/x/tokenfactory/keeper"
 	twap "github.com/osmosis-labs/osmosis/v13/x/twap"
 )
@@ -19,14 +23,18 @@ func RegisterCustomPlugins(
 	bank *bankkeeper.BaseKeeper,
 	twap *twap.Keeper,
 	tokenFactory *tokenfactorykeeper.Keeper,
+	lockup *lockupkeeper.Keeper,
+	incentives *incentiveskeeper.Keeper,
+	superfluid *superfluidkeeper.Keeper,
+	epochs *epochskeeper.Keeper,
 ) []wasmkeeper.Option {
-	wasmQueryPlugin := NewQueryPlugin(gammKeeper, twap, tokenFactory)
+	wasmQueryPlugin := NewQueryPlugin(gammKeeper, twap, tokenFactory, lockup, incentives, superfluid, epochs)
 
 	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
 		Custom: CustomQuerier(wasmQueryPlugin),
 	})
 	messengerDecoratorOpt := wasmkeeper.WithMessageHandlerDecorator(
-		CustomMessageDecorator(gammKeeper, bank, tokenFactory),
+		CustomMessageDecorator(gammKeeper, bank, tokenFactory, lockup, superfluid),
 	)
 
 	return []wasm.Option{
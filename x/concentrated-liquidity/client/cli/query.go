@@ -0,0 +1,44 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		GetCmdPools(),
+		GetCmdUserPositions(),
+		osmocli.GetParams[*queryproto.ParamsRequest](types.ModuleName, queryproto.NewQueryClient),
+	)
+	return cmd
+}
+
+// GetCmdPools returns all concentrated liquidity pools.
+func GetCmdPools() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.PoolsRequest](
+		"pools",
+		"Query all concentrated liquidity pools",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
+
+// GetCmdUserPositions returns the positions of an address in all pools.
+func GetCmdUserPositions() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.UserPositionsRequest](
+		"user-positions [address]",
+		"Query the positions of an address in all concentrated liquidity pools",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} user-positions osmo1...
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
@@ -0,0 +1,52 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	txCmd.AddCommand(
+		NewCreateConcentratedPoolCmd(),
+		NewCreatePositionCmd(),
+		NewWithdrawPositionCmd(),
+		NewCollectFeesCmd(),
+	)
+
+	return txCmd
+}
+
+func NewCreateConcentratedPoolCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgCreateConcentratedPool](&osmocli.TxCliDesc{
+		Use:     "create-concentrated-pool [initial-pool-liquidity] [tick-spacing] [swap-fee]",
+		Short:   "Create a concentrated liquidity pool of two tokens, whose initial liquidity sets its price and becomes a full range position of the sender.",
+		Example: "osmosisd tx concentratedliquidity create-concentrated-pool 1000000uatom,5000000uosmo 1 0.003 --from val",
+	})
+}
+
+func NewCreatePositionCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgCreatePosition](&osmocli.TxCliDesc{
+		Use:     "create-position [pool-id] [lower-tick] [upper-tick] [token-desired-0] [token-desired-1] [token-min-amount-0] [token-min-amount-1]",
+		Short:   "Add liquidity to a position between two ticks of a pool. Negative ticks must follow a -- argument.",
+		Example: "osmosisd tx concentratedliquidity create-position -- 1 -1000 1000 100uatom 500uosmo 0 0 --from val",
+	})
+}
+
+func NewWithdrawPositionCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgWithdrawPosition](&osmocli.TxCliDesc{
+		Use:     "withdraw-position [pool-id] [lower-tick] [upper-tick] [liquidity-amount]",
+		Short:   "Remove liquidity from a position between two ticks of a pool. Negative ticks must follow a -- argument.",
+		Example: "osmosisd tx concentratedliquidity withdraw-position -- 1 -1000 1000 100.5 --from val",
+	})
+}
+
+func NewCollectFeesCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgCollectFees](&osmocli.TxCliDesc{
+		Use:     "collect-fees [pool-id] [lower-tick] [upper-tick]",
+		Short:   "Collect the fees accrued to a position between two ticks of a pool. Negative ticks must follow a -- argument.",
+		Example: "osmosisd tx concentratedliquidity collect-fees -- 1 -1000 1000 --from val",
+	})
+}
@@ -0,0 +1,45 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/client/queryproto"
+)
+
+type Querier struct {
+	K concentratedliquidity.Keeper
+}
+
+func NewQuerier(k concentratedliquidity.Keeper) Querier {
+	return Querier{k}
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	req queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
+
+func (q Querier) Pools(ctx sdk.Context,
+	req queryproto.PoolsRequest,
+) (*queryproto.PoolsResponse, error) {
+	pools := q.K.GetPools(ctx)
+	return &queryproto.PoolsResponse{Pools: pools}, nil
+}
+
+func (q Querier) UserPositions(ctx sdk.Context,
+	req queryproto.UserPositionsRequest,
+) (*queryproto.UserPositionsResponse, error) {
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	positions, err := q.K.GetUserPositions(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.UserPositionsResponse{Positions: positions}, nil
+}
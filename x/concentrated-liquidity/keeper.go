@@ -0,0 +1,110 @@
+package concentratedliquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ swaproutertypes.SwapI = &Keeper{}
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	paramSpace paramtypes.Subspace
+
+	gammKeeper          types.GammKeeper
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+
+	poolCreationListeners swaproutertypes.PoolCreationListeners
+	swapListeners         types.SwapListeners
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	gammKeeper types.GammKeeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	communityPoolKeeper types.CommunityPoolKeeper,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		storeKey:            storeKey,
+		paramSpace:          paramSpace,
+		gammKeeper:          gammKeeper,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		communityPoolKeeper: communityPoolKeeper,
+	}
+}
+
+// SetPoolCreationListeners sets the listeners notified of every pool created.
+func (k *Keeper) SetPoolCreationListeners(listeners swaproutertypes.PoolCreationListeners) *Keeper {
+	if k.poolCreationListeners != nil {
+		panic("cannot set pool creation listeners twice")
+	}
+
+	k.poolCreationListeners = listeners
+
+	return k
+}
+
+// SetSwapListeners sets the listeners notified of every swap.
+func (k *Keeper) SetSwapListeners(listeners types.SwapListeners) *Keeper {
+	if k.swapListeners != nil {
+		panic("cannot set swap listeners twice")
+	}
+
+	k.swapListeners = listeners
+
+	return k
+}
+
+// GetParams returns the total set of concentrated-liquidity parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of concentrated-liquidity parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the concentrated-liquidity module's state from a
+// provided genesis state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	for i := range genState.Pools {
+		k.setPool(ctx, &genState.Pools[i])
+	}
+	for _, tick := range genState.Ticks {
+		k.setTickInfo(ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+	for _, position := range genState.Positions {
+		k.setPosition(ctx, position)
+	}
+}
+
+// ExportGenesis returns the concentrated-liquidity module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params:    k.GetParams(ctx),
+		Pools:     k.GetPools(ctx),
+		Ticks:     k.getAllTicks(ctx),
+		Positions: k.getAllPositions(ctx),
+	}
+}
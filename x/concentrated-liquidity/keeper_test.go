@@ -0,0 +1,75 @@
+package concentratedliquidity_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	concentratedliquidity "github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+const (
+	bar = "bar"
+	foo = "foo"
+)
+
+var (
+	defaultSwapFee       = sdk.MustNewDecFromStr("0.003")
+	defaultPoolLiquidity = sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000), sdk.NewInt64Coin(foo, 1_000_000))
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+	keeper *concentratedliquidity.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.keeper = s.App.ConcentratedLiquidityKeeper
+}
+
+// createPool creates a pool of the given initial liquidity, with a tick spacing
+// of 1, as the first test account.
+func (s *KeeperTestSuite) createPool(initialLiquidity sdk.Coins, swapFee sdk.Dec) uint64 {
+	creator := s.TestAccs[0]
+	s.FundAcc(creator, initialLiquidity.Add(s.keeper.GetParams(s.Ctx).PoolCreationFee...))
+
+	poolId, err := s.keeper.CreatePool(s.Ctx, types.NewMsgCreateConcentratedPool(creator, initialLiquidity, 1, swapFee))
+	s.Require().NoError(err)
+	return poolId
+}
+
+// createPosition creates a position of the second test account between the
+// given ticks, of at most the given amounts.
+func (s *KeeperTestSuite) createPosition(poolId uint64, lowerTick, upperTick int64, amountBar, amountFoo int64) sdk.Dec {
+	owner := s.TestAccs[1]
+	tokenBar, tokenFoo := sdk.NewInt64Coin(bar, amountBar), sdk.NewInt64Coin(foo, amountFoo)
+	s.FundAcc(owner, sdk.NewCoins(tokenBar, tokenFoo))
+
+	_, _, liquidity, err := s.keeper.CreatePosition(s.Ctx, poolId, owner, tokenBar, tokenFoo, sdk.ZeroInt(), sdk.ZeroInt(), lowerTick, upperTick)
+	s.Require().NoError(err)
+	return liquidity
+}
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	s.createPosition(poolId, -1000, 1000, 10_000, 10_000)
+
+	genesis := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Pools, 1)
+	s.Require().Len(genesis.Positions, 2)
+	s.Require().Len(genesis.Ticks, 4)
+	s.Require().NoError(genesis.Validate())
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.Ctx, genesis)
+
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.Ctx))
+}
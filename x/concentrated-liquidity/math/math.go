@@ -0,0 +1,178 @@
+package math
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The functions below relate liquidity, square root prices and token amounts.
+// Within a range of square root prices [sqrtPriceA, sqrtPriceB], liquidity L
+// holds L * (sqrtPriceB - sqrtPriceA) / (sqrtPriceA * sqrtPriceB) of token0
+// and L * (sqrtPriceB - sqrtPriceA) of token1. Amounts paid to the pool are
+// rounded up and amounts paid by the pool are rounded down, so that rounding
+// always favors the pool.
+
+// Liquidity0 returns the liquidity provided by the given amount of token0
+// between two square root prices.
+func Liquidity0(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if diff.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return amount.ToDec().MulTruncate(sqrtPriceA).MulTruncate(sqrtPriceB).QuoTruncate(diff)
+}
+
+// Liquidity1 returns the liquidity provided by the given amount of token1
+// between two square root prices.
+func Liquidity1(amount sdk.Int, sqrtPriceA, sqrtPriceB sdk.Dec) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if diff.IsZero() {
+		return sdk.ZeroDec()
+	}
+	return amount.ToDec().QuoTruncate(diff)
+}
+
+// CalcAmount0Delta returns the amount of token0 held by the given liquidity
+// between two square root prices.
+func CalcAmount0Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff).QuoRoundUp(sqrtPriceB).QuoRoundUp(sqrtPriceA)
+	}
+	return liquidity.MulTruncate(diff).QuoTruncate(sqrtPriceB).QuoTruncate(sqrtPriceA)
+}
+
+// CalcAmount1Delta returns the amount of token1 held by the given liquidity
+// between two square root prices.
+func CalcAmount1Delta(liquidity, sqrtPriceA, sqrtPriceB sdk.Dec, roundUp bool) sdk.Dec {
+	sqrtPriceA, sqrtPriceB = sortSqrtPrices(sqrtPriceA, sqrtPriceB)
+	diff := sqrtPriceB.Sub(sqrtPriceA)
+	if roundUp {
+		return liquidity.Mul(diff)
+	}
+	return liquidity.MulTruncate(diff)
+}
+
+// getNextSqrtPriceFromAmount0In returns the square root price after adding
+// the given amount of token0 to the given liquidity, rounding up.
+// sqrtPriceNext = L * sqrtPrice / (L + amountIn * sqrtPrice)
+func getNextSqrtPriceFromAmount0In(sqrtPrice, liquidity, amountIn sdk.Dec) sdk.Dec {
+	if amountIn.IsZero() {
+		return sqrtPrice
+	}
+	return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Add(amountIn.MulTruncate(sqrtPrice)))
+}
+
+// getNextSqrtPriceFromAmount1In returns the square root price after adding
+// the given amount of token1 to the given liquidity, rounding down.
+// sqrtPriceNext = sqrtPrice + amountIn / L
+func getNextSqrtPriceFromAmount1In(sqrtPrice, liquidity, amountIn sdk.Dec) sdk.Dec {
+	return sqrtPrice.Add(amountIn.QuoTruncate(liquidity))
+}
+
+// getNextSqrtPriceFromAmount0Out returns the square root price after removing
+// the given amount of token0 from the given liquidity, rounding up.
+// sqrtPriceNext = L * sqrtPrice / (L - amountOut * sqrtPrice)
+func getNextSqrtPriceFromAmount0Out(sqrtPrice, liquidity, amountOut sdk.Dec) sdk.Dec {
+	if amountOut.IsZero() {
+		return sqrtPrice
+	}
+	return liquidity.Mul(sqrtPrice).QuoRoundUp(liquidity.Sub(amountOut.Mul(sqrtPrice)))
+}
+
+// getNextSqrtPriceFromAmount1Out returns the square root price after removing
+// the given amount of token1 from the given liquidity, rounding down.
+// sqrtPriceNext = sqrtPrice - amountOut / L
+func getNextSqrtPriceFromAmount1Out(sqrtPrice, liquidity, amountOut sdk.Dec) sdk.Dec {
+	return sqrtPrice.Sub(amountOut.QuoRoundUp(liquidity))
+}
+
+// ComputeSwapStepOutGivenIn swaps in as much of amountRemaining as the given
+// liquidity takes while moving the square root price from sqrtPriceCurrent
+// towards sqrtPriceTarget. Swapping token0 in moves the price down, and
+// swapping token1 in moves it up. The swap fee is charged on top of the
+// amount swapped in, out of amountRemaining.
+func ComputeSwapStepOutGivenIn(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec, zeroForOne bool) (sqrtPriceNext, amountIn, amountOut, feeCharge sdk.Dec) {
+	amountRemainingLessFee := amountRemaining.MulTruncate(sdk.OneDec().Sub(swapFee))
+
+	var amountInToTarget sdk.Dec
+	if zeroForOne {
+		amountInToTarget = CalcAmount0Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, true)
+	} else {
+		amountInToTarget = CalcAmount1Delta(liquidity, sqrtPriceCurrent, sqrtPriceTarget, true)
+	}
+
+	if amountRemainingLessFee.GTE(amountInToTarget) {
+		sqrtPriceNext, amountIn = sqrtPriceTarget, amountInToTarget
+	} else {
+		amountIn = amountRemainingLessFee
+		if zeroForOne {
+			sqrtPriceNext = getNextSqrtPriceFromAmount0In(sqrtPriceCurrent, liquidity, amountIn)
+		} else {
+			sqrtPriceNext = getNextSqrtPriceFromAmount1In(sqrtPriceCurrent, liquidity, amountIn)
+		}
+	}
+
+	if zeroForOne {
+		amountOut = CalcAmount1Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, false)
+	} else {
+		amountOut = CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, false)
+	}
+
+	if sqrtPriceNext.Equal(sqrtPriceTarget) {
+		feeCharge = sdk.MinDec(feeOnAmountIn(amountIn, swapFee), amountRemaining.Sub(amountIn))
+	} else {
+		// the remainder of amountRemaining is the fee.
+		feeCharge = amountRemaining.Sub(amountIn)
+	}
+
+	return sqrtPriceNext, amountIn, amountOut, feeCharge
+}
+
+// ComputeSwapStepInGivenOut swaps out as much of amountRemaining as the given
+// liquidity gives while moving the square root price from sqrtPriceCurrent
+// towards sqrtPriceTarget. Swapping token1 out moves the price down, and
+// swapping token0 out moves it up. The swap fee is charged on top of the
+// amount swapped in.
+func ComputeSwapStepInGivenOut(sqrtPriceCurrent, sqrtPriceTarget, liquidity, amountRemaining, swapFee sdk.Dec, zeroForOne bool) (sqrtPriceNext, amountIn, amountOut, feeCharge sdk.Dec) {
+	var amountOutToTarget sdk.Dec
+	if zeroForOne {
+		amountOutToTarget = CalcAmount1Delta(liquidity, sqrtPriceTarget, sqrtPriceCurrent, false)
+	} else {
+		amountOutToTarget = CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceTarget, false)
+	}
+
+	if amountRemaining.GTE(amountOutToTarget) {
+		sqrtPriceNext, amountOut = sqrtPriceTarget, amountOutToTarget
+	} else {
+		amountOut = amountRemaining
+		if zeroForOne {
+			sqrtPriceNext = getNextSqrtPriceFromAmount1Out(sqrtPriceCurrent, liquidity, amountOut)
+		} else {
+			sqrtPriceNext = getNextSqrtPriceFromAmount0Out(sqrtPriceCurrent, liquidity, amountOut)
+		}
+	}
+
+	if zeroForOne {
+		amountIn = CalcAmount0Delta(liquidity, sqrtPriceNext, sqrtPriceCurrent, true)
+	} else {
+		amountIn = CalcAmount1Delta(liquidity, sqrtPriceCurrent, sqrtPriceNext, true)
+	}
+
+	return sqrtPriceNext, amountIn, amountOut, feeOnAmountIn(amountIn, swapFee)
+}
+
+// feeOnAmountIn returns the fee that makes amountIn the share (1 - swapFee) of
+// the amount paid, rounding up.
+func feeOnAmountIn(amountIn, swapFee sdk.Dec) sdk.Dec {
+	return amountIn.Mul(swapFee).QuoRoundUp(sdk.OneDec().Sub(swapFee))
+}
+
+func sortSqrtPrices(sqrtPriceA, sqrtPriceB sdk.Dec) (sdk.Dec, sdk.Dec) {
+	if sqrtPriceA.GT(sqrtPriceB) {
+		return sqrtPriceB, sqrtPriceA
+	}
+	return sqrtPriceA, sqrtPriceB
+}
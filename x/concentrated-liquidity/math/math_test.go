@@ -0,0 +1,114 @@
+package math_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+)
+
+func TestTickToSqrtPrice(t *testing.T) {
+	tests := map[string]struct {
+		tick              int64
+		expectedSqrtPrice sdk.Dec
+		expectErr         bool
+	}{
+		"tick zero":      {tick: 0, expectedSqrtPrice: sdk.OneDec()},
+		"positive tick":  {tick: 100, expectedSqrtPrice: sdk.MustNewDecFromStr("1.005012269623051203")},
+		"negative tick":  {tick: -1, expectedSqrtPrice: sdk.MustNewDecFromStr("0.999950003749687527")},
+		"max tick":       {tick: math.MaxTick, expectedSqrtPrice: sdk.MustNewDecFromStr("26672538.060296937205746700")},
+		"above max tick": {tick: math.MaxTick + 1, expectErr: true},
+		"below min tick": {tick: math.MinTick - 1, expectErr: true},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPrice, err := math.TickToSqrtPrice(tc.tick)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedSqrtPrice, sqrtPrice)
+		})
+	}
+}
+
+func TestSqrtPriceToTick(t *testing.T) {
+	for _, tick := range []int64{math.MinTick, -100_000, -1, 0, 1, 100, 100_000, math.MaxTick} {
+		sqrtPrice, err := math.TickToSqrtPrice(tick)
+		require.NoError(t, err)
+
+		// the tick of a tick's own price is the tick itself.
+		actualTick, err := math.SqrtPriceToTick(sqrtPrice)
+		require.NoError(t, err)
+		require.Equal(t, tick, actualTick)
+
+		// prices strictly between two ticks belong to the lower one.
+		if tick < math.MaxTick {
+			actualTick, err = math.SqrtPriceToTick(sqrtPrice.Add(sdk.SmallestDec()))
+			require.NoError(t, err)
+			require.Equal(t, tick, actualTick)
+		}
+	}
+}
+
+func TestComputeSwapStepOutGivenIn(t *testing.T) {
+	liquidity := sdk.NewDec(1_000_000)
+	swapFee := sdk.MustNewDecFromStr("0.003")
+	sqrtPriceTarget, err := math.TickToSqrtPrice(1000)
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		amountRemaining   sdk.Dec
+		expectedSqrtPrice sdk.Dec
+		expectedAmountIn  sdk.Dec
+		expectedAmountOut sdk.Dec
+		expectedFee       sdk.Dec
+	}{
+		"step stops before the target": {
+			amountRemaining:   sdk.NewDec(1000),
+			expectedSqrtPrice: sdk.MustNewDecFromStr("1.000997"),
+			expectedAmountIn:  sdk.NewDec(997),
+			expectedAmountOut: sdk.MustNewDecFromStr("996.006981039903216493"),
+			expectedFee:       sdk.NewDec(3),
+		},
+		"step reaches the target": {
+			amountRemaining:   sdk.NewDec(100_000),
+			expectedSqrtPrice: sqrtPriceTarget,
+			expectedAmountIn:  sdk.MustNewDecFromStr("51268.46837676659"),
+			expectedAmountOut: sdk.MustNewDecFromStr("48768.197581278888407461"),
+			expectedFee:       sdk.MustNewDecFromStr("154.268209759578505517"),
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			sqrtPriceNext, amountIn, amountOut, fee := math.ComputeSwapStepOutGivenIn(sdk.OneDec(), sqrtPriceTarget, liquidity, tc.amountRemaining, swapFee, false)
+			require.Equal(t, tc.expectedSqrtPrice, sqrtPriceNext)
+			require.Equal(t, tc.expectedAmountIn, amountIn)
+			require.Equal(t, tc.expectedAmountOut, amountOut)
+			require.Equal(t, tc.expectedFee, fee)
+			require.True(t, amountIn.Add(fee).LTE(tc.amountRemaining))
+		})
+	}
+}
+
+func TestComputeSwapStepInGivenOut(t *testing.T) {
+	liquidity := sdk.NewDec(1_000_000)
+	swapFee := sdk.MustNewDecFromStr("0.003")
+	sqrtPriceTarget, err := math.TickToSqrtPrice(1000)
+	require.NoError(t, err)
+
+	sqrtPriceNext, amountIn, amountOut, fee := math.ComputeSwapStepInGivenOut(sdk.OneDec(), sqrtPriceTarget, liquidity, sdk.NewDec(1000), swapFee, false)
+	require.Equal(t, sdk.MustNewDecFromStr("1.001001001001001002"), sqrtPriceNext)
+	require.Equal(t, sdk.MustNewDecFromStr("1001.001001001002"), amountIn)
+	require.Equal(t, sdk.NewDec(1000), amountOut)
+	require.Equal(t, sdk.MustNewDecFromStr("3.012039120364098295"), fee)
+
+	// swapping the amount in back out of the pool gives at least the amount out.
+	_, _, amountOutGivenIn, _ := math.ComputeSwapStepOutGivenIn(sdk.OneDec(), sqrtPriceTarget, liquidity, amountIn.Add(fee), swapFee, false)
+	require.True(t, amountOutGivenIn.GTE(amountOut))
+}
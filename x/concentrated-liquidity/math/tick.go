@@ -0,0 +1,93 @@
+package math
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+)
+
+const (
+	// MinTick and MaxTick bound the ticks of every pool. The price at tick i
+	// is 1.0001^i, so they bound prices to roughly [1.4 * 10^-15, 7.1 * 10^14].
+	MinTick int64 = -342_000
+	MaxTick int64 = 342_000
+)
+
+var (
+	// tickBase is the ratio between the prices of two consecutive ticks.
+	tickBase = osmomath.MustNewDecFromStr("1.0001")
+	// sqrtTickBase is the ratio between the square root prices of two
+	// consecutive ticks.
+	sqrtTickBase osmomath.BigDec
+)
+
+func init() {
+	var err error
+	sqrtTickBase, err = tickBase.ApproxSqrt()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// TickToSqrtPrice returns the square root of the price at the given tick,
+// that is 1.0001^(tickIndex / 2).
+func TickToSqrtPrice(tickIndex int64) (sdk.Dec, error) {
+	if tickIndex < MinTick || tickIndex > MaxTick {
+		return sdk.Dec{}, fmt.Errorf("tick index (%d) is out of bounds [%d, %d]", tickIndex, MinTick, MaxTick)
+	}
+
+	if tickIndex < 0 {
+		return osmomath.OneDec().Quo(sqrtTickBase.Power(uint64(-tickIndex))).SDKDec(), nil
+	}
+	return sqrtTickBase.Power(uint64(tickIndex)).SDKDec(), nil
+}
+
+// SqrtPriceToTick returns the largest tick whose square root price is at most
+// the given square root price, clamped to [MinTick, MaxTick].
+func SqrtPriceToTick(sqrtPrice sdk.Dec) (int64, error) {
+	if !sqrtPrice.IsPositive() {
+		return 0, fmt.Errorf("square root price must be positive, was (%s)", sqrtPrice)
+	}
+
+	price := osmomath.BigDecFromSDKDec(sqrtPrice)
+	price = price.Mul(price)
+
+	// log_1.0001(price) estimates the tick, which is then corrected for the
+	// truncations of the logarithm and of the tick's square root price.
+	tickLog := price.TickLog()
+	tickIndex := tickLog.TruncateInt64()
+	if tickLog.IsNegative() && !tickLog.IsInteger() {
+		tickIndex--
+	}
+	if tickIndex < MinTick {
+		return MinTick, nil
+	}
+	if tickIndex > MaxTick {
+		return MaxTick, nil
+	}
+
+	for tickIndex > MinTick {
+		tickSqrtPrice, err := TickToSqrtPrice(tickIndex)
+		if err != nil {
+			return 0, err
+		}
+		if tickSqrtPrice.LTE(sqrtPrice) {
+			break
+		}
+		tickIndex--
+	}
+	for tickIndex < MaxTick {
+		nextTickSqrtPrice, err := TickToSqrtPrice(tickIndex + 1)
+		if err != nil {
+			return 0, err
+		}
+		if nextTickSqrtPrice.GT(sqrtPrice) {
+			break
+		}
+		tickIndex++
+	}
+
+	return tickIndex, nil
+}
@@ -0,0 +1,143 @@
+package concentratedliquidity
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// CreatePool creates a concentrated liquidity pool, returning its id. The
+// pool creation fee funds the community pool. The pool's id is taken from the
+// pool id sequence of x/gamm, and a dedicated module account holds its
+// tokens. The initial liquidity is sent to it and becomes a full range
+// position of the pool creator.
+func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (uint64, error) {
+	if msg.GetPoolType() != swaproutertypes.Concentrated {
+		return 0, fmt.Errorf("pool type (%s) is not concentrated liquidity", msg.GetPoolType())
+	}
+	if err := msg.Validate(ctx); err != nil {
+		return 0, err
+	}
+
+	sender := msg.PoolCreator()
+	initialPoolLiquidity := msg.InitialLiquidity()
+
+	// send pool creation fee to community pool
+	params := k.GetParams(ctx)
+	if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return 0, err
+	}
+
+	poolId := k.gammKeeper.GetNextPoolIdAndIncrement(ctx)
+	pool, err := msg.CreatePool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+
+	// create and save the pool's module account to the account keeper
+	if err := osmoutils.CreateModuleAccount(ctx, k.accountKeeper, pool.GetAddress()); err != nil {
+		return 0, fmt.Errorf("creating pool module account for id %d: %w", poolId, err)
+	}
+
+	// send initial liquidity to the pool
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), initialPoolLiquidity); err != nil {
+		return 0, err
+	}
+
+	if err := k.InitializePool(ctx, pool, sender); err != nil {
+		return 0, err
+	}
+
+	return poolId, nil
+}
+
+// InitializePool initializes the given pool, whose liquidity must already be
+// in the pool's account. The liquidity becomes a full range position of the
+// pool creator, and what is left of it once rounded to that position is
+// refunded. It then stores the pool and notifies the pool creation listeners.
+func (k Keeper) InitializePool(ctx sdk.Context, poolI swaproutertypes.PoolI, creatorAddress sdk.AccAddress) error {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return err
+	}
+
+	initialPoolLiquidity := pool.PoolLiquidity
+	lowerTick, upperTick := pool.FullRangeTicks()
+	liquidity, err := liquidityForDeposit(*pool, lowerTick, upperTick, initialPoolLiquidity.AmountOf(pool.Token0), initialPoolLiquidity.AmountOf(pool.Token1))
+	if err != nil {
+		return err
+	}
+	if !liquidity.IsPositive() {
+		return types.ErrZeroLiquidity
+	}
+
+	amount0, amount1, err := k.updatePosition(ctx, pool, creatorAddress, lowerTick, upperTick, liquidity)
+	if err != nil {
+		return err
+	}
+
+	pool.PoolLiquidity = sdk.NewCoins(
+		sdk.NewCoin(pool.Token0, amount0.Ceil().TruncateInt()),
+		sdk.NewCoin(pool.Token1, amount1.Ceil().TruncateInt()),
+	)
+	if refund := initialPoolLiquidity.Sub(pool.PoolLiquidity); !refund.IsZero() {
+		if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), creatorAddress, refund); err != nil {
+			return err
+		}
+	}
+
+	k.setPool(ctx, pool)
+	k.poolCreationListeners.AfterPoolCreated(ctx, creatorAddress, pool.Id)
+
+	return nil
+}
+
+// GetPool returns the concentrated liquidity pool with the given id.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetPoolDenoms returns the denoms of the two tokens of the pool with the
+// given id.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return pool.GetDenoms(), nil
+}
+
+// CalculateSpotPrice returns the spot price of the quote asset in terms of the
+// base asset, using the specified pool.
+func (k Keeper) CalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+) (sdk.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if !spotPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("spot price of pool (%d) is not positive", poolId)
+	}
+	return spotPrice, nil
+}
+
+func asConcentratedPool(poolI swaproutertypes.PoolI) (*types.Pool, error) {
+	pool, ok := poolI.(*types.Pool)
+	if !ok {
+		return nil, types.InvalidPoolTypeError{PoolId: poolI.GetId()}
+	}
+	return pool, nil
+}
@@ -0,0 +1,88 @@
+package concentratedliquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePool() {
+	tests := map[string]struct {
+		initialLiquidity  sdk.Coins
+		tickSpacing       uint64
+		expectedSpotPrice sdk.Dec
+		expectErr         error
+	}{
+		"balanced pool": {
+			initialLiquidity:  defaultPoolLiquidity,
+			tickSpacing:       1,
+			expectedSpotPrice: sdk.OneDec(),
+		},
+		"unbalanced pool": {
+			initialLiquidity:  sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000), sdk.NewInt64Coin(foo, 4_000_000)),
+			tickSpacing:       10,
+			expectedSpotPrice: sdk.NewDec(4),
+		},
+		"single token": {
+			initialLiquidity: sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000)),
+			tickSpacing:      1,
+			expectErr:        types.InvalidInitialLiquidityError{InitialLiquidity: sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000))},
+		},
+		"zero tick spacing": {
+			initialLiquidity: defaultPoolLiquidity,
+			tickSpacing:      0,
+			expectErr:        types.InvalidTickSpacingError{TickSpacing: 0},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			creator := s.TestAccs[2]
+			s.FundAcc(creator, tc.initialLiquidity.Add(s.keeper.GetParams(s.Ctx).PoolCreationFee...))
+			// pools of every type share the same pool ids.
+			gammPoolId := s.PrepareBalancerPool()
+
+			poolId, err := s.keeper.CreatePool(s.Ctx, types.NewMsgCreateConcentratedPool(creator, tc.initialLiquidity, tc.tickSpacing, defaultSwapFee))
+			if tc.expectErr != nil {
+				s.Require().ErrorContains(err, tc.expectErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(gammPoolId+1, poolId)
+
+			// the pool is routed, and its spot price is that of its initial liquidity.
+			swapModule, err := s.App.SwapRouterKeeper.GetPoolModule(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.keeper, swapModule)
+			spotPrice, err := s.App.SwapRouterKeeper.CalculateSpotPrice(s.Ctx, poolId, foo, bar)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedSpotPrice, spotPrice.RoundInt().ToDec())
+
+			// twap records were created for it.
+			_, err = s.App.TwapKeeper.GetBeginBlockAccumulatorRecord(s.Ctx, poolId, bar, foo)
+			s.Require().NoError(err)
+
+			// the creator holds a full range position of the pool's liquidity,
+			// and is refunded what is left.
+			pool, err := s.keeper.GetPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(swaproutertypes.Concentrated, pool.GetType())
+			poolLiquidity := pool.GetTotalPoolLiquidity(s.Ctx)
+			s.Require().Equal(poolLiquidity, s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+			s.Require().True(tc.initialLiquidity.Sub(poolLiquidity).IsEqual(s.App.BankKeeper.GetAllBalances(s.Ctx, creator)))
+
+			positions, err := s.keeper.GetUserPositions(s.Ctx, creator)
+			s.Require().NoError(err)
+			s.Require().Len(positions, 1)
+			s.Require().Equal(pool.(*types.Pool).CurrentLiquidity, positions[0].Liquidity)
+
+			// the pool's liquidity holds all the tokens the position requires.
+			lowerTick, upperTick := pool.(*types.Pool).FullRangeTicks()
+			required0, required1 := s.requiredAmounts(pool.(*types.Pool), lowerTick, upperTick, positions[0].Liquidity)
+			s.Require().Equal(required0, poolLiquidity.AmountOf(bar))
+			s.Require().Equal(required1, poolLiquidity.AmountOf(foo))
+		})
+	}
+}
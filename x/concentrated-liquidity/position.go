@@ -0,0 +1,271 @@
+package concentratedliquidity
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// CreatePosition adds liquidity to the owner's position in a pool between two
+// ticks. The position takes as much liquidity as the desired token amounts
+// allow at the current price, and errors if that takes less than the minimum
+// amount of either token.
+func (k Keeper) CreatePosition(
+	ctx sdk.Context,
+	poolId uint64,
+	owner sdk.AccAddress,
+	tokenDesired0, tokenDesired1 sdk.Coin,
+	tokenMinAmount0, tokenMinAmount1 sdk.Int,
+	lowerTick, upperTick int64,
+) (amount0, amount1 sdk.Int, liquidity sdk.Dec, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if err := pool.ValidateTicks(lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if tokenDesired0.Denom != pool.Token0 {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.DenomNotInPoolError{PoolId: poolId, Denom: tokenDesired0.Denom}
+	}
+	if tokenDesired1.Denom != pool.Token1 {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.DenomNotInPoolError{PoolId: poolId, Denom: tokenDesired1.Denom}
+	}
+
+	liquidity, err = liquidityForDeposit(*pool, lowerTick, upperTick, tokenDesired0.Amount, tokenDesired1.Amount)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	if !liquidity.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.ErrZeroLiquidity
+	}
+
+	amount0Dec, amount1Dec, err := k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidity)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	// The amounts are rounded up in favor of the pool, and are at most the
+	// desired ones by the choice of the liquidity.
+	amount0, amount1 = amount0Dec.Ceil().TruncateInt(), amount1Dec.Ceil().TruncateInt()
+	if amount0.LT(tokenMinAmount0) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.TokenAmountBelowMinError{Amount: amount0, MinAmount: tokenMinAmount0}
+	}
+	if amount1.LT(tokenMinAmount1) {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, types.TokenAmountBelowMinError{Amount: amount1, MinAmount: tokenMinAmount1}
+	}
+
+	deposit := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, owner, pool.GetAddress(), deposit); err != nil {
+		return sdk.Int{}, sdk.Int{}, sdk.Dec{}, err
+	}
+	pool.PoolLiquidity = pool.PoolLiquidity.Add(deposit...)
+	k.setPool(ctx, pool)
+
+	return amount0, amount1, liquidity, nil
+}
+
+// WithdrawPosition removes liquidity from the owner's position in a pool
+// between two ticks, and sends the tokens it held to the owner. The fees
+// accrued to the position are kept in it until collected.
+func (k Keeper) WithdrawPosition(
+	ctx sdk.Context,
+	poolId uint64,
+	owner sdk.AccAddress,
+	lowerTick, upperTick int64,
+	liquidity sdk.Dec,
+) (amount0, amount1 sdk.Int, err error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if err := pool.ValidateTicks(lowerTick, upperTick); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	if !liquidity.IsPositive() {
+		return sdk.Int{}, sdk.Int{}, types.ErrZeroLiquidity
+	}
+
+	amount0Dec, amount1Dec, err := k.updatePosition(ctx, pool, owner, lowerTick, upperTick, liquidity.Neg())
+	if err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+
+	amount0, amount1 = amount0Dec.TruncateInt(), amount1Dec.TruncateInt()
+	withdrawal := sdk.NewCoins(sdk.NewCoin(pool.Token0, amount0), sdk.NewCoin(pool.Token1, amount1))
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, withdrawal); err != nil {
+		return sdk.Int{}, sdk.Int{}, err
+	}
+	pool.PoolLiquidity = pool.PoolLiquidity.Sub(withdrawal)
+	k.setPool(ctx, pool)
+
+	return amount0, amount1, nil
+}
+
+// CollectFees sends the whole amounts of the fees accrued to the owner's
+// position in a pool between two ticks to the owner.
+func (k Keeper) CollectFees(ctx sdk.Context, poolId uint64, owner sdk.AccAddress, lowerTick, upperTick int64) (sdk.Coins, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	position, err := k.getPosition(ctx, owner, poolId, lowerTick, upperTick)
+	if err != nil {
+		return nil, err
+	}
+
+	position = k.accruePositionFees(ctx, pool, position)
+	collected, remainder := position.FeesOwed.TruncateDecimal()
+	position.FeesOwed = remainder
+	k.setPosition(ctx, position)
+
+	if collected.IsZero() {
+		return sdk.Coins{}, nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), owner, collected); err != nil {
+		return nil, err
+	}
+	pool.PoolLiquidity = pool.PoolLiquidity.Sub(collected)
+	k.setPool(ctx, pool)
+
+	return collected, nil
+}
+
+// updatePosition adds liquidityDelta, which may be negative, to the owner's
+// position in a pool between two ticks, accruing the position's fees first.
+// It updates the position's ticks and the pool's current liquidity, but does
+// not store the pool. It returns the amounts of each token held by
+// liquidityDelta, rounded up when adding liquidity and down when removing it.
+func (k Keeper) updatePosition(ctx sdk.Context, pool *types.Pool, owner sdk.AccAddress, lowerTick, upperTick int64, liquidityDelta sdk.Dec) (amount0, amount1 sdk.Dec, err error) {
+	position, err := k.getPosition(ctx, owner, pool.Id, lowerTick, upperTick)
+	if errors.As(err, &types.PositionNotFoundError{}) && liquidityDelta.IsPositive() {
+		position = types.Position{
+			Address:              owner.String(),
+			PoolId:               pool.Id,
+			LowerTick:            lowerTick,
+			UpperTick:            upperTick,
+			Liquidity:            sdk.ZeroDec(),
+			FeeGrowthInsideLast0: sdk.ZeroDec(),
+			FeeGrowthInsideLast1: sdk.ZeroDec(),
+		}
+	} else if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	if position.Liquidity.Add(liquidityDelta).IsNegative() {
+		return sdk.Dec{}, sdk.Dec{}, types.InsufficientLiquidityError{Requested: liquidityDelta.Neg(), Available: position.Liquidity}
+	}
+
+	lowerTickInfo := k.updateTickInfo(ctx, pool, lowerTick, liquidityDelta, false)
+	upperTickInfo := k.updateTickInfo(ctx, pool, upperTick, liquidityDelta, true)
+	feeGrowthInside0, feeGrowthInside1 := feeGrowthInside(*pool, lowerTick, lowerTickInfo, upperTick, upperTickInfo)
+	position = accrueFees(*pool, position, feeGrowthInside0, feeGrowthInside1)
+	position.Liquidity = position.Liquidity.Add(liquidityDelta)
+
+	k.setTickInfo(ctx, pool.Id, lowerTick, lowerTickInfo)
+	k.setTickInfo(ctx, pool.Id, upperTick, upperTickInfo)
+	k.setPosition(ctx, position)
+
+	amount0, amount1, err = amountsForLiquidity(*pool, lowerTick, upperTick, liquidityDelta.Abs(), liquidityDelta.IsPositive())
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	if pool.CurrentTick >= lowerTick && pool.CurrentTick < upperTick {
+		pool.CurrentLiquidity = pool.CurrentLiquidity.Add(liquidityDelta)
+	}
+
+	return amount0, amount1, nil
+}
+
+// amountsForLiquidity returns the token amounts held by the given liquidity
+// between two ticks at the pool's current price, rounded up if roundUp is
+// true and down otherwise.
+func amountsForLiquidity(pool types.Pool, lowerTick, upperTick int64, liquidity sdk.Dec, roundUp bool) (amount0, amount1 sdk.Dec, err error) {
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeSqrtPrices(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+
+	amount0, amount1 = sdk.ZeroDec(), sdk.ZeroDec()
+	switch {
+	case pool.CurrentTick < lowerTick:
+		amount0 = math.CalcAmount0Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp)
+	case pool.CurrentTick < upperTick:
+		amount0 = math.CalcAmount0Delta(liquidity, pool.CurrentSqrtPrice, sqrtPriceUpper, roundUp)
+		amount1 = math.CalcAmount1Delta(liquidity, sqrtPriceLower, pool.CurrentSqrtPrice, roundUp)
+	default:
+		amount1 = math.CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPriceUpper, roundUp)
+	}
+	return amount0, amount1, nil
+}
+
+// liquidityForDeposit returns the most liquidity between two ticks whose
+// token amounts at the pool's current price, rounded up, are at most the given
+// amounts. The rounding can take one unit more of a token than the liquidity
+// computed from its amount holds, in which case the liquidity is recomputed
+// from one unit less of that token.
+func liquidityForDeposit(pool types.Pool, lowerTick, upperTick int64, amount0, amount1 sdk.Int) (sdk.Dec, error) {
+	liquidityAmount0, liquidityAmount1 := amount0, amount1
+	for recomputed := false; ; recomputed = true {
+		liquidity, err := liquidityForAmounts(pool, lowerTick, upperTick, liquidityAmount0, liquidityAmount1)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		deposit0, deposit1, err := amountsForLiquidity(pool, lowerTick, upperTick, liquidity, true)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		exceeds0, exceeds1 := deposit0.Ceil().TruncateInt().GT(amount0), deposit1.Ceil().TruncateInt().GT(amount1)
+		if !exceeds0 && !exceeds1 {
+			return liquidity, nil
+		}
+		if recomputed {
+			return sdk.Dec{}, types.DepositAboveAmountsError{Amount0: deposit0, Amount1: deposit1}
+		}
+		if exceeds0 {
+			liquidityAmount0 = sdk.MaxInt(amount0.SubRaw(1), sdk.ZeroInt())
+		}
+		if exceeds1 {
+			liquidityAmount1 = sdk.MaxInt(amount1.SubRaw(1), sdk.ZeroInt())
+		}
+	}
+}
+
+// liquidityForAmounts returns the most liquidity between two ticks that the
+// given token amounts provide at the pool's current price.
+func liquidityForAmounts(pool types.Pool, lowerTick, upperTick int64, amount0, amount1 sdk.Int) (sdk.Dec, error) {
+	sqrtPriceLower, sqrtPriceUpper, err := tickRangeSqrtPrices(lowerTick, upperTick)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	switch {
+	case pool.CurrentTick < lowerTick:
+		return math.Liquidity0(amount0, sqrtPriceLower, sqrtPriceUpper), nil
+	case pool.CurrentTick < upperTick:
+		liquidity0 := math.Liquidity0(amount0, pool.CurrentSqrtPrice, sqrtPriceUpper)
+		// At exactly the lower tick's price, the position holds only token0.
+		if pool.CurrentSqrtPrice.LTE(sqrtPriceLower) {
+			return liquidity0, nil
+		}
+		liquidity1 := math.Liquidity1(amount1, sqrtPriceLower, pool.CurrentSqrtPrice)
+		return sdk.MinDec(liquidity0, liquidity1), nil
+	default:
+		return math.Liquidity1(amount1, sqrtPriceLower, sqrtPriceUpper), nil
+	}
+}
+
+func tickRangeSqrtPrices(lowerTick, upperTick int64) (sdk.Dec, sdk.Dec, error) {
+	sqrtPriceLower, err := math.TickToSqrtPrice(lowerTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	sqrtPriceUpper, err := math.TickToSqrtPrice(upperTick)
+	if err != nil {
+		return sdk.Dec{}, sdk.Dec{}, err
+	}
+	return sqrtPriceLower, sqrtPriceUpper, nil
+}
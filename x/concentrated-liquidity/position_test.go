@@ -0,0 +1,177 @@
+package concentratedliquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+func (s *KeeperTestSuite) TestCreateWithdrawPosition() {
+	tests := map[string]struct {
+		lowerTick       int64
+		upperTick       int64
+		expectedAmount0 sdk.Int
+		expectedAmount1 sdk.Int
+		expectErr       error
+	}{
+		"range around the current price holds both tokens": {
+			lowerTick:       -1000,
+			upperTick:       1000,
+			expectedAmount0: sdk.NewInt(10_000),
+			expectedAmount1: sdk.NewInt(10_000),
+		},
+		"range above the current price holds only token0": {
+			lowerTick:       1000,
+			upperTick:       2000,
+			expectedAmount0: sdk.NewInt(10_000),
+			expectedAmount1: sdk.ZeroInt(),
+		},
+		"range below the current price holds only token1": {
+			lowerTick:       -2000,
+			upperTick:       -1000,
+			expectedAmount0: sdk.ZeroInt(),
+			expectedAmount1: sdk.NewInt(10_000),
+		},
+		"inverted range": {
+			lowerTick: 1000,
+			upperTick: -1000,
+			expectErr: types.InvalidTickRangeError{LowerTick: 1000, UpperTick: -1000},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+			owner := s.TestAccs[1]
+			tokenDesired0, tokenDesired1 := sdk.NewInt64Coin(bar, 10_000), sdk.NewInt64Coin(foo, 10_000)
+			s.FundAcc(owner, sdk.NewCoins(tokenDesired0, tokenDesired1))
+
+			amount0, amount1, liquidity, err := s.keeper.CreatePosition(s.Ctx, poolId, owner, tokenDesired0, tokenDesired1, sdk.ZeroInt(), sdk.ZeroInt(), tc.lowerTick, tc.upperTick)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(err)
+			// amounts are rounded up into the pool, so may differ from the
+			// expected ones by one.
+			s.Require().True(tc.expectedAmount0.Sub(amount0).Abs().LTE(sdk.OneInt()), "amount0 %s", amount0)
+			s.Require().True(tc.expectedAmount1.Sub(amount1).Abs().LTE(sdk.OneInt()), "amount1 %s", amount1)
+
+			positions, err := s.keeper.GetUserPositions(s.Ctx, owner)
+			s.Require().NoError(err)
+			s.Require().Len(positions, 1)
+			s.Require().Equal(liquidity, positions[0].Liquidity)
+
+			// withdrawing more than the position's liquidity fails.
+			_, _, err = s.keeper.WithdrawPosition(s.Ctx, poolId, owner, tc.lowerTick, tc.upperTick, liquidity.Add(sdk.OneDec()))
+			s.Require().ErrorAs(err, &types.InsufficientLiquidityError{})
+
+			withdrawn0, withdrawn1, err := s.keeper.WithdrawPosition(s.Ctx, poolId, owner, tc.lowerTick, tc.upperTick, liquidity)
+			s.Require().NoError(err)
+			// amounts are rounded down out of the pool.
+			s.Require().True(amount0.Sub(withdrawn0).LTE(sdk.OneInt()))
+			s.Require().True(amount1.Sub(withdrawn1).LTE(sdk.OneInt()))
+
+			// the emptied position is removed.
+			positions, err = s.keeper.GetUserPositions(s.Ctx, owner)
+			s.Require().NoError(err)
+			s.Require().Empty(positions)
+			_, _, err = s.keeper.WithdrawPosition(s.Ctx, poolId, owner, tc.lowerTick, tc.upperTick, liquidity)
+			s.Require().ErrorIs(err, types.PositionNotFoundError{PoolId: poolId, LowerTick: tc.lowerTick, UpperTick: tc.upperTick})
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestCreatePositionBelowMinAmount() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	owner := s.TestAccs[1]
+	tokenDesired0, tokenDesired1 := sdk.NewInt64Coin(bar, 10_000), sdk.NewInt64Coin(foo, 5_000)
+	s.FundAcc(owner, sdk.NewCoins(tokenDesired0, tokenDesired1))
+
+	// the position is limited by the amount of foo, so takes less bar than desired.
+	_, _, _, err := s.keeper.CreatePosition(s.Ctx, poolId, owner, tokenDesired0, tokenDesired1, sdk.NewInt(10_000), sdk.ZeroInt(), -1000, 1000)
+	s.Require().ErrorAs(err, &types.TokenAmountBelowMinError{})
+}
+
+// requiredAmounts returns the token amounts, rounded up, that the given
+// liquidity between two ticks holds at the pool's current price.
+func (s *KeeperTestSuite) requiredAmounts(pool *types.Pool, lowerTick, upperTick int64, liquidity sdk.Dec) (sdk.Int, sdk.Int) {
+	sqrtPriceLower, err := math.TickToSqrtPrice(lowerTick)
+	s.Require().NoError(err)
+	sqrtPriceUpper, err := math.TickToSqrtPrice(upperTick)
+	s.Require().NoError(err)
+	sqrtPriceCurrent := sdk.MinDec(sdk.MaxDec(pool.CurrentSqrtPrice, sqrtPriceLower), sqrtPriceUpper)
+	amount0 := math.CalcAmount0Delta(liquidity, sqrtPriceCurrent, sqrtPriceUpper, true)
+	amount1 := math.CalcAmount1Delta(liquidity, sqrtPriceLower, sqrtPriceCurrent, true)
+	return amount0.Ceil().TruncateInt(), amount1.Ceil().TruncateInt()
+}
+
+// TestCreatePositionDepositsRequiredAmounts tests that a position is never
+// credited with more liquidity than the tokens deposited for it hold, and
+// that the deposit is at most the desired amounts.
+func (s *KeeperTestSuite) TestCreatePositionDepositsRequiredAmounts() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	pool := s.getPool(poolId)
+	owner := s.TestAccs[1]
+	fullRangeLower, fullRangeUpper := pool.FullRangeTicks()
+	ranges := [][2]int64{{-1000, 1000}, {-3, 11}, {1000, 2000}, {-2000, -1000}, {fullRangeLower, fullRangeUpper}}
+
+	for _, r := range ranges {
+		for desired := int64(1); desired <= 1000; desired += 37 {
+			ctx, _ := s.Ctx.CacheContext()
+			tokenDesired0, tokenDesired1 := sdk.NewInt64Coin(bar, desired), sdk.NewInt64Coin(foo, 3*desired+1)
+			s.FundAcc(owner, sdk.NewCoins(tokenDesired0, tokenDesired1))
+
+			amount0, amount1, liquidity, err := s.keeper.CreatePosition(ctx, poolId, owner, tokenDesired0, tokenDesired1, sdk.ZeroInt(), sdk.ZeroInt(), r[0], r[1])
+			if err != nil {
+				s.Require().ErrorIs(err, types.ErrZeroLiquidity, "range %v, desired %d", r, desired)
+				continue
+			}
+			required0, required1 := s.requiredAmounts(pool, r[0], r[1], liquidity)
+			s.Require().True(amount0.LTE(tokenDesired0.Amount), "range %v, desired %d", r, desired)
+			s.Require().True(amount1.LTE(tokenDesired1.Amount), "range %v, desired %d", r, desired)
+			s.Require().Equal(required0, amount0, "range %v, desired %d", r, desired)
+			s.Require().Equal(required1, amount1, "range %v, desired %d", r, desired)
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestCollectFees() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	creator, owner, trader := s.TestAccs[0], s.TestAccs[1], s.TestAccs[2]
+	s.createPosition(poolId, -1000, 1000, 100_000, 100_000)
+	// a position out of range does not earn fees.
+	s.createPosition(poolId, 2000, 3000, 100_000, 100_000)
+
+	tokenIn := sdk.NewInt64Coin(foo, 10_000)
+	s.FundAcc(trader, sdk.NewCoins(tokenIn))
+	pool, err := s.keeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	_, err = s.keeper.SwapExactAmountIn(s.Ctx, trader, pool, tokenIn, bar, sdk.OneInt(), defaultSwapFee)
+	s.Require().NoError(err)
+	fee := defaultSwapFee.MulInt(tokenIn.Amount).TruncateInt()
+
+	ownerBalance := s.App.BankKeeper.GetAllBalances(s.Ctx, owner)
+	creatorFees, err := s.keeper.CollectFees(s.Ctx, poolId, creator, -342000, 342000)
+	s.Require().NoError(err)
+	ownerFees, err := s.keeper.CollectFees(s.Ctx, poolId, owner, -1000, 1000)
+	s.Require().NoError(err)
+	outOfRangeFees, err := s.keeper.CollectFees(s.Ctx, poolId, owner, 2000, 3000)
+	s.Require().NoError(err)
+
+	// the fees are split between the positions in range, by their liquidity,
+	// up to rounding down.
+	s.Require().Empty(outOfRangeFees)
+	s.Require().True(ownerFees.AmountOf(foo).GT(creatorFees.AmountOf(foo)))
+	totalFees := creatorFees.AmountOf(foo).Add(ownerFees.AmountOf(foo))
+	s.Require().True(totalFees.LTE(fee))
+	s.Require().True(totalFees.GTE(fee.SubRaw(2)))
+	s.Require().Equal(ownerBalance.Add(ownerFees...), s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+	// fees are only collected once.
+	ownerFees, err = s.keeper.CollectFees(s.Ctx, poolId, owner, -1000, 1000)
+	s.Require().NoError(err)
+	s.Require().Empty(ownerFees)
+}
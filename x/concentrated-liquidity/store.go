@@ -0,0 +1,184 @@
+package concentratedliquidity
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// getPoolById returns the concentrated liquidity pool with the given id.
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (*types.Pool, error) {
+	store := ctx.KVStore(k.storeKey)
+	pool := &types.Pool{}
+	found, err := osmoutils.Get(store, types.KeyPool(poolId), pool)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+	return pool, nil
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool *types.Pool) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPool(pool.Id), pool)
+}
+
+// GetPools returns all concentrated liquidity pools, by increasing id.
+func (k Keeper) GetPools(ctx sdk.Context) []types.Pool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPool)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pools := []types.Pool{}
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.Pool{}
+		if err := pool.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		pools = append(pools, pool)
+	}
+	return pools
+}
+
+// getTickInfo returns the info of a pool's tick, which is empty if the tick
+// is not initialized.
+func (k Keeper) getTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64) types.TickInfo {
+	store := ctx.KVStore(k.storeKey)
+	tickInfo := types.TickInfo{}
+	found, err := osmoutils.Get(store, types.KeyTick(poolId, tickIndex), &tickInfo)
+	if err != nil {
+		panic(err)
+	}
+	if !found {
+		return types.TickInfo{
+			LiquidityGross:    sdk.ZeroDec(),
+			LiquidityNet:      sdk.ZeroDec(),
+			FeeGrowthOutside0: sdk.ZeroDec(),
+			FeeGrowthOutside1: sdk.ZeroDec(),
+		}
+	}
+	return tickInfo
+}
+
+// setTickInfo stores the info of a pool's tick, deleting the tick once no
+// position is bounded by it anymore.
+func (k Keeper) setTickInfo(ctx sdk.Context, poolId uint64, tickIndex int64, tickInfo types.TickInfo) {
+	store := ctx.KVStore(k.storeKey)
+	key := types.KeyTick(poolId, tickIndex)
+	if tickInfo.LiquidityGross.IsZero() {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &tickInfo)
+}
+
+// nextInitializedTick returns the first initialized tick of the pool that the
+// price reaches when moving from the current tick, downwards if zeroForOne
+// and upwards otherwise. Moving downwards, the current tick itself is the
+// first reached. It returns false if there is no such tick.
+func (k Keeper) nextInitializedTick(ctx sdk.Context, poolId uint64, currentTick int64, zeroForOne bool) (int64, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyTickPrefix(poolId))
+
+	var iterator sdk.Iterator
+	if zeroForOne {
+		iterator = store.ReverseIterator(nil, types.TickIndexToBytes(currentTick+1))
+	} else {
+		iterator = store.Iterator(types.TickIndexToBytes(currentTick+1), nil)
+	}
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return 0, false
+	}
+	return types.TickIndexFromBytes(iterator.Key()), true
+}
+
+func (k Keeper) getAllTicks(ctx sdk.Context) []types.Tick {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixTick)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	ticks := []types.Tick{}
+	for ; iterator.Valid(); iterator.Next() {
+		tickInfo := types.TickInfo{}
+		if err := tickInfo.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		// keys are the pool id followed by the tick index, see types.KeyTick.
+		key := iterator.Key()
+		ticks = append(ticks, types.Tick{
+			PoolId:    sdk.BigEndianToUint64(key[:8]),
+			TickIndex: types.TickIndexFromBytes(key[8:]),
+			Info:      tickInfo,
+		})
+	}
+	return ticks
+}
+
+// getPosition returns an address's position in a pool between two ticks.
+func (k Keeper) getPosition(ctx sdk.Context, owner sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) (types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	position := types.Position{}
+	found, err := osmoutils.Get(store, types.KeyPosition(owner, poolId, lowerTick, upperTick), &position)
+	if err != nil {
+		return types.Position{}, err
+	}
+	if !found {
+		return types.Position{}, types.PositionNotFoundError{PoolId: poolId, LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	return position, nil
+}
+
+// setPosition stores a position, deleting it once it has neither liquidity
+// nor fees left to collect.
+func (k Keeper) setPosition(ctx sdk.Context, position types.Position) {
+	store := ctx.KVStore(k.storeKey)
+	owner, err := sdk.AccAddressFromBech32(position.Address)
+	if err != nil {
+		panic(err)
+	}
+	key := types.KeyPosition(owner, position.PoolId, position.LowerTick, position.UpperTick)
+	if position.Liquidity.IsZero() && position.FeesOwed.IsZero() {
+		store.Delete(key)
+		return
+	}
+	osmoutils.MustSet(store, key, &position)
+}
+
+// GetUserPositions returns the positions of the given address, with their
+// fees accrued up to the current block.
+func (k Keeper) GetUserPositions(ctx sdk.Context, addr sdk.AccAddress) ([]types.Position, error) {
+	store := ctx.KVStore(k.storeKey)
+	positions, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyUserPositionsPrefix(addr), parsePosition)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, position := range positions {
+		pool, err := k.getPoolById(ctx, position.PoolId)
+		if err != nil {
+			return nil, err
+		}
+		positions[i] = k.accruePositionFees(ctx, pool, position)
+	}
+	return positions, nil
+}
+
+func (k Keeper) getAllPositions(ctx sdk.Context) []types.Position {
+	store := ctx.KVStore(k.storeKey)
+	positions, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixPosition, parsePosition)
+	if err != nil {
+		panic(err)
+	}
+	return positions
+}
+
+func parsePosition(bz []byte) (types.Position, error) {
+	position := types.Position{}
+	err := position.Unmarshal(bz)
+	return position, err
+}
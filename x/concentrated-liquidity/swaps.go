@@ -0,0 +1,274 @@
+package concentratedliquidity
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// swapResult is the outcome of a swap computed by computeSwap, before it is
+// applied to the pool.
+type swapResult struct {
+	// pool is the pool after the swap.
+	pool types.Pool
+	// amountIn is the amount swapped in, including the swap fee.
+	amountIn sdk.Dec
+	// amountOut is the amount swapped out.
+	amountOut sdk.Dec
+	// crossedTicks are the initialized ticks the price crossed, after being
+	// crossed.
+	crossedTicks []types.Tick
+}
+
+// computeSwap computes a swap against the pool, of either an exact amount in
+// if exactIn, or an exact amount out otherwise. The price moves through the
+// pool's tick ranges until the amount is swapped, crossing the initialized
+// ticks it reaches. The swap fee of each step accrues to the liquidity in
+// range during the step. It does not modify state.
+func (k Keeper) computeSwap(
+	ctx sdk.Context,
+	pool types.Pool,
+	tokenInDenom string,
+	tokenOutDenom string,
+	amountSpecified sdk.Dec,
+	exactIn bool,
+	swapFee sdk.Dec,
+) (swapResult, error) {
+	if err := validateSwapDenoms(pool, tokenInDenom, tokenOutDenom); err != nil {
+		return swapResult{}, err
+	}
+	zeroForOne := tokenInDenom == pool.Token0
+
+	amountRemaining := amountSpecified
+	amountIn, amountOut := sdk.ZeroDec(), sdk.ZeroDec()
+	crossedTicks := []types.Tick{}
+	for amountRemaining.IsPositive() {
+		nextTick, initialized := k.nextInitializedTick(ctx, pool.Id, pool.CurrentTick, zeroForOne)
+		if !initialized {
+			nextTick = math.MaxTick
+			if zeroForOne {
+				nextTick = math.MinTick
+			}
+		}
+		sqrtPriceNextTick, err := math.TickToSqrtPrice(nextTick)
+		if err != nil {
+			return swapResult{}, err
+		}
+
+		var sqrtPriceNext, stepAmountIn, stepAmountOut, feeCharge sdk.Dec
+		if exactIn {
+			sqrtPriceNext, stepAmountIn, stepAmountOut, feeCharge = math.ComputeSwapStepOutGivenIn(
+				pool.CurrentSqrtPrice, sqrtPriceNextTick, pool.CurrentLiquidity, amountRemaining, swapFee, zeroForOne)
+			amountRemaining = amountRemaining.Sub(stepAmountIn).Sub(feeCharge)
+		} else {
+			sqrtPriceNext, stepAmountIn, stepAmountOut, feeCharge = math.ComputeSwapStepInGivenOut(
+				pool.CurrentSqrtPrice, sqrtPriceNextTick, pool.CurrentLiquidity, amountRemaining, swapFee, zeroForOne)
+			amountRemaining = amountRemaining.Sub(stepAmountOut)
+		}
+		amountIn = amountIn.Add(stepAmountIn).Add(feeCharge)
+		amountOut = amountOut.Add(stepAmountOut)
+
+		if pool.CurrentLiquidity.IsPositive() {
+			feeGrowth := feeCharge.QuoTruncate(pool.CurrentLiquidity)
+			if zeroForOne {
+				pool.FeeGrowthGlobal0 = pool.FeeGrowthGlobal0.Add(feeGrowth)
+			} else {
+				pool.FeeGrowthGlobal1 = pool.FeeGrowthGlobal1.Add(feeGrowth)
+			}
+		}
+		pool.CurrentSqrtPrice = sqrtPriceNext
+
+		switch {
+		case !sqrtPriceNext.Equal(sqrtPriceNextTick):
+			pool.CurrentTick, err = math.SqrtPriceToTick(sqrtPriceNext)
+			if err != nil {
+				return swapResult{}, err
+			}
+		case !initialized:
+			// the price reached the bound of the pool's price range.
+			if amountRemaining.IsPositive() {
+				return swapResult{}, types.ErrNotEnoughLiquidity
+			}
+			pool.CurrentTick = nextTick
+		default:
+			tickInfo := crossTick(pool, k.getTickInfo(ctx, pool.Id, nextTick))
+			crossedTicks = append(crossedTicks, types.Tick{PoolId: pool.Id, TickIndex: nextTick, Info: tickInfo})
+			// crossing a tick downwards leaves the range of the positions
+			// it is the lower tick of, and enters the range of those it is
+			// the upper tick of.
+			if zeroForOne {
+				pool.CurrentLiquidity = pool.CurrentLiquidity.Sub(tickInfo.LiquidityNet)
+				pool.CurrentTick = nextTick - 1
+			} else {
+				pool.CurrentLiquidity = pool.CurrentLiquidity.Add(tickInfo.LiquidityNet)
+				pool.CurrentTick = nextTick
+			}
+		}
+	}
+
+	return swapResult{
+		pool:         pool,
+		amountIn:     amountIn,
+		amountOut:    amountOut,
+		crossedTicks: crossedTicks,
+	}, nil
+}
+
+// applySwap applies a computed swap to the pool, and transfers the tokens
+// swapped between the sender and the pool.
+func (k Keeper) applySwap(ctx sdk.Context, sender sdk.AccAddress, result swapResult, tokenIn, tokenOut sdk.Coin) error {
+	pool := result.pool
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.NewCoins(tokenOut)); err != nil {
+		return err
+	}
+	pool.PoolLiquidity = pool.PoolLiquidity.Add(tokenIn).Sub(sdk.NewCoins(tokenOut))
+
+	for _, tick := range result.crossedTicks {
+		k.setTickInfo(ctx, tick.PoolId, tick.TickIndex, tick.Info)
+	}
+	k.setPool(ctx, &pool)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTokenSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(pool.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+	))
+	k.swapListeners.AfterSwap(ctx, sender, pool.Id, sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut))
+
+	return nil
+}
+
+// SwapExactAmountIn swaps tokenIn for as much of tokenOutDenom as the pool
+// gives, erroring if that is less than tokenOutMinAmount.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeSwap(ctx, *pool, tokenIn.Denom, tokenOutDenom, tokenIn.Amount.ToDec(), true, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount := result.amountOut.TruncateInt()
+	if !tokenOutAmount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("token amount swapped out must be positive, was (%s)", tokenOutAmount)
+	}
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, swaproutertypes.TokenOutBelowMinError{TokenOutAmount: tokenOutAmount, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	if err := k.applySwap(ctx, sender, result, tokenIn, sdk.NewCoin(tokenOutDenom, tokenOutAmount)); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenOutAmount, nil
+}
+
+// CalcOutAmtGivenIn returns the amount of tokenOutDenom the pool gives for
+// tokenIn, without swapping.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeSwap(ctx, *pool, tokenIn.Denom, tokenOutDenom, tokenIn.Amount.ToDec(), true, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenOutDenom, result.amountOut.TruncateInt()), nil
+}
+
+// SwapExactAmountOut swaps as little of tokenInDenom as the pool takes for
+// tokenOut, erroring if that is more than tokenInMaxAmount.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	result, err := k.computeSwap(ctx, *pool, tokenInDenom, tokenOut.Denom, tokenOut.Amount.ToDec(), false, swapFee)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount := result.amountIn.Ceil().TruncateInt()
+	if !tokenInAmount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("token amount swapped in must be positive, was (%s)", tokenInAmount)
+	}
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, swaproutertypes.TokenInAboveMaxError{TokenInAmount: tokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	if err := k.applySwap(ctx, sender, result, sdk.NewCoin(tokenInDenom, tokenInAmount), tokenOut); err != nil {
+		return sdk.Int{}, err
+	}
+	return tokenInAmount, nil
+}
+
+// CalcInAmtGivenOut returns the amount of tokenInDenom the pool takes for
+// tokenOut, without swapping.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asConcentratedPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	result, err := k.computeSwap(ctx, *pool, tokenInDenom, tokenOut.Denom, tokenOut.Amount.ToDec(), false, swapFee)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return sdk.NewCoin(tokenInDenom, result.amountIn.Ceil().TruncateInt()), nil
+}
+
+func validateSwapDenoms(pool types.Pool, tokenInDenom, tokenOutDenom string) error {
+	for _, denom := range []string{tokenInDenom, tokenOutDenom} {
+		if denom != pool.Token0 && denom != pool.Token1 {
+			return types.DenomNotInPoolError{PoolId: pool.Id, Denom: denom}
+		}
+	}
+	if tokenInDenom == tokenOutDenom {
+		return fmt.Errorf("cannot swap (%s) for itself", tokenInDenom)
+	}
+	return nil
+}
@@ -0,0 +1,206 @@
+package concentratedliquidity_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (s *KeeperTestSuite) getPool(poolId uint64) *types.Pool {
+	pool, err := s.keeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	return pool.(*types.Pool)
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountIn() {
+	tests := map[string]struct {
+		tokenIn        sdk.Coin
+		tokenOutDenom  string
+		expectedTick   int64
+		expectCrossing bool
+		expectErr      error
+	}{
+		"swap token1 for token0 within the position's range": {
+			tokenIn:       sdk.NewInt64Coin(foo, 10_000),
+			tokenOutDenom: bar,
+			expectedTick:  9,
+		},
+		"swap token0 for token1 within the position's range": {
+			tokenIn:       sdk.NewInt64Coin(bar, 10_000),
+			tokenOutDenom: foo,
+			expectedTick:  -10,
+		},
+		"swap token1 for token0 across the position's range": {
+			tokenIn:        sdk.NewInt64Coin(foo, 2_000_000),
+			tokenOutDenom:  bar,
+			expectedTick:   13282,
+			expectCrossing: true,
+		},
+		"swap token0 for token1 across the position's range": {
+			tokenIn:        sdk.NewInt64Coin(bar, 2_000_000),
+			tokenOutDenom:  foo,
+			expectedTick:   -13283,
+			expectCrossing: true,
+		},
+		"swap more than the pool can take": {
+			tokenIn:       sdk.NewInt64Coin(foo, 1_000_000_000_000_000),
+			tokenOutDenom: bar,
+			expectErr:     types.ErrNotEnoughLiquidity,
+		},
+		"swap a denom not in the pool": {
+			tokenIn:       sdk.NewInt64Coin("baz", 10_000),
+			tokenOutDenom: bar,
+			expectErr:     types.DenomNotInPoolError{PoolId: 1, Denom: "baz"},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+			s.createPosition(poolId, -1000, 1000, 1_000_000, 1_000_000)
+			fullRangeLiquidity := s.getPool(poolId).CurrentLiquidity
+			pool := s.getPool(poolId)
+			s.Require().Equal(uint64(1), poolId)
+
+			trader := s.TestAccs[2]
+			s.FundAcc(trader, sdk.NewCoins(tc.tokenIn))
+
+			expectedTokenOut, calcErr := s.keeper.CalcOutAmtGivenIn(s.Ctx, pool, tc.tokenIn, tc.tokenOutDenom, defaultSwapFee)
+			tokenOutAmount, err := s.keeper.SwapExactAmountIn(s.Ctx, trader, pool, tc.tokenIn, tc.tokenOutDenom, sdk.OneInt(), defaultSwapFee)
+			if tc.expectErr != nil {
+				s.Require().ErrorIs(calcErr, tc.expectErr)
+				s.Require().ErrorIs(err, tc.expectErr)
+				return
+			}
+			s.Require().NoError(calcErr)
+			s.Require().NoError(err)
+			s.Require().Equal(expectedTokenOut.Amount, tokenOutAmount)
+			s.AssertEventEmitted(s.Ctx, types.TypeEvtTokenSwapped, 1)
+
+			// the trader swapped the tokens with the pool, less than one for one.
+			s.Require().True(tokenOutAmount.LT(tc.tokenIn.Amount))
+			s.Require().Equal(sdk.NewCoins(sdk.NewCoin(tc.tokenOutDenom, tokenOutAmount)), s.App.BankKeeper.GetAllBalances(s.Ctx, trader))
+			pool = s.getPool(poolId)
+			s.Require().Equal(pool.PoolLiquidity, s.App.BankKeeper.GetAllBalances(s.Ctx, pool.GetAddress()))
+
+			s.Require().Equal(tc.expectedTick, pool.CurrentTick)
+			if tc.expectCrossing {
+				s.Require().Equal(fullRangeLiquidity.Sub(s.getPositionLiquidity(poolId, -1000, 1000)), pool.CurrentLiquidity)
+			} else {
+				s.Require().Equal(fullRangeLiquidity, pool.CurrentLiquidity)
+			}
+
+			// swapping back crosses the tick again, and restores the liquidity.
+			tokenBack := sdk.NewCoin(tc.tokenOutDenom, tokenOutAmount)
+			_, err = s.keeper.SwapExactAmountIn(s.Ctx, trader, pool, tokenBack, tc.tokenIn.Denom, sdk.OneInt(), defaultSwapFee)
+			s.Require().NoError(err)
+			s.Require().Equal(fullRangeLiquidity, s.getPool(poolId).CurrentLiquidity)
+		})
+	}
+}
+
+func (s *KeeperTestSuite) getPositionLiquidity(poolId uint64, lowerTick, upperTick int64) sdk.Dec {
+	positions, err := s.keeper.GetUserPositions(s.Ctx, s.TestAccs[1])
+	s.Require().NoError(err)
+	for _, position := range positions {
+		if position.PoolId == poolId && position.LowerTick == lowerTick && position.UpperTick == upperTick {
+			return position.Liquidity
+		}
+	}
+	s.FailNow("position not found")
+	return sdk.Dec{}
+}
+
+func (s *KeeperTestSuite) TestSwapExactAmountOut() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	s.createPosition(poolId, -1000, 1000, 1_000_000, 1_000_000)
+	pool := s.getPool(poolId)
+	trader := s.TestAccs[2]
+	tokenOut := sdk.NewInt64Coin(bar, 500_000)
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewInt64Coin(foo, 1_000_000)))
+
+	expectedTokenIn, err := s.keeper.CalcInAmtGivenOut(s.Ctx, pool, tokenOut, foo, defaultSwapFee)
+	s.Require().NoError(err)
+
+	// the amount in is above the maximum.
+	_, err = s.keeper.SwapExactAmountOut(s.Ctx, trader, pool, foo, expectedTokenIn.Amount.SubRaw(1), tokenOut, defaultSwapFee)
+	s.Require().ErrorAs(err, &swaproutertypes.TokenInAboveMaxError{})
+
+	tokenInAmount, err := s.keeper.SwapExactAmountOut(s.Ctx, trader, pool, foo, expectedTokenIn.Amount, tokenOut, defaultSwapFee)
+	s.Require().NoError(err)
+	s.Require().Equal(expectedTokenIn.Amount, tokenInAmount)
+	s.Require().Equal(tokenOut.Amount, s.App.BankKeeper.GetBalance(s.Ctx, trader, bar).Amount)
+
+	// swapping the amount in the other way gives at least the amount out.
+	tokenOutGivenIn, err := s.keeper.CalcOutAmtGivenIn(s.Ctx, pool, expectedTokenIn, bar, defaultSwapFee)
+	s.Require().NoError(err)
+	s.Require().True(tokenOutGivenIn.Amount.GTE(tokenOut.Amount))
+}
+
+// TestRouting checks that concentrated liquidity pools are swapped through by
+// the swaprouter and txfees, like the pools of x/gamm.
+func (s *KeeperTestSuite) TestRouting() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	trader := s.TestAccs[2]
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewInt64Coin(foo, 20_000)))
+
+	tokenOutAmount, err := s.App.SwapRouterKeeper.RouteExactAmountIn(s.Ctx, trader,
+		[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}}, sdk.NewInt64Coin(foo, 10_000), sdk.OneInt())
+	s.Require().NoError(err)
+	s.Require().True(tokenOutAmount.IsPositive())
+
+	tokenInAmount, err := s.App.SwapRouterKeeper.RouteExactAmountOut(s.Ctx, trader,
+		[]swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: foo}}, sdk.NewInt(10_000), sdk.NewInt64Coin(bar, 1000))
+	s.Require().NoError(err)
+	s.Require().True(tokenInAmount.IsPositive())
+
+	// the swaps moved the pool's spot price.
+	spotPrice, err := s.App.SwapRouterKeeper.CalculateSpotPrice(s.Ctx, poolId, foo, bar)
+	s.Require().NoError(err)
+	s.Require().True(spotPrice.GT(sdk.OneDec()))
+
+	// txs swap through the pool with the swaprouter's msgs.
+	s.swapThroughMsgServer(poolId)
+}
+
+// TestRoutingAfterGenesis checks that pools are still routed to this module after
+// a genesis export and import, through the routes kept by the swaprouter.
+func (s *KeeperTestSuite) TestRoutingAfterGenesis() {
+	poolId := s.createPool(defaultPoolLiquidity, defaultSwapFee)
+	genesis := s.keeper.ExportGenesis(s.Ctx)
+	swaprouterGenesis := s.App.SwapRouterKeeper.ExportGenesis(s.Ctx)
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.Ctx, genesis)
+	s.App.SwapRouterKeeper.InitGenesis(s.Ctx, swaprouterGenesis)
+	// the pool's balances are restored by x/bank's genesis.
+	s.FundAcc(s.getPool(poolId).GetAddress(), defaultPoolLiquidity)
+
+	swapModule, err := s.App.SwapRouterKeeper.GetPoolModule(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper, swapModule)
+	s.swapThroughMsgServer(poolId)
+}
+
+// swapThroughMsgServer swaps foo for bar through the pool with the swaprouter's msg server,
+// as a tx would.
+func (s *KeeperTestSuite) swapThroughMsgServer(poolId uint64) {
+	trader := s.TestAccs[2]
+	tokenIn := sdk.NewInt64Coin(foo, 10_000)
+	s.FundAcc(trader, sdk.NewCoins(tokenIn))
+	balanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, trader, bar).Amount
+
+	msgServer := swaprouter.NewMsgServerImpl(s.App.SwapRouterKeeper)
+	res, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &swaproutertypes.MsgSwapExactAmountIn{
+		Sender:            trader.String(),
+		Routes:            []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}},
+		TokenIn:           tokenIn,
+		TokenOutMinAmount: sdk.OneInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().True(res.TokenOutAmount.IsPositive())
+	s.Require().Equal(res.TokenOutAmount, s.App.BankKeeper.GetBalance(s.Ctx, trader, bar).Amount.Sub(balanceBefore))
+}
@@ -0,0 +1,86 @@
+package concentratedliquidity
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/types"
+)
+
+// Fees are accounted for as in Uniswap v3. Each pool tracks the fees earned
+// per unit of liquidity over its life, and each initialized tick the share of
+// them earned on the other side of the tick from the current tick. The fees
+// earned per unit of liquidity inside a tick range follow from the two, and a
+// position accrues its liquidity times their growth since it last accrued.
+
+// updateTickInfo returns the info of a pool's tick after adding liquidityDelta
+// to a position bounded by it, from below if upper is false and from above
+// otherwise.
+func (k Keeper) updateTickInfo(ctx sdk.Context, pool *types.Pool, tickIndex int64, liquidityDelta sdk.Dec, upper bool) types.TickInfo {
+	tickInfo := k.getTickInfo(ctx, pool.Id, tickIndex)
+
+	// By convention, all the fees earned before a tick is initialized were
+	// earned below it.
+	if tickInfo.LiquidityGross.IsZero() && tickIndex <= pool.CurrentTick {
+		tickInfo.FeeGrowthOutside0 = pool.FeeGrowthGlobal0
+		tickInfo.FeeGrowthOutside1 = pool.FeeGrowthGlobal1
+	}
+
+	tickInfo.LiquidityGross = tickInfo.LiquidityGross.Add(liquidityDelta)
+	if upper {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Sub(liquidityDelta)
+	} else {
+		tickInfo.LiquidityNet = tickInfo.LiquidityNet.Add(liquidityDelta)
+	}
+	return tickInfo
+}
+
+// crossTick returns the info of a tick after the price crossed it, so that
+// the fees earned outside the tick are now on the other side of it.
+func crossTick(pool types.Pool, tickInfo types.TickInfo) types.TickInfo {
+	tickInfo.FeeGrowthOutside0 = pool.FeeGrowthGlobal0.Sub(tickInfo.FeeGrowthOutside0)
+	tickInfo.FeeGrowthOutside1 = pool.FeeGrowthGlobal1.Sub(tickInfo.FeeGrowthOutside1)
+	return tickInfo
+}
+
+// feeGrowthInside returns the fees of each token earned per unit of liquidity
+// between two ticks of the pool over its life.
+func feeGrowthInside(pool types.Pool, lowerTick int64, lowerTickInfo types.TickInfo, upperTick int64, upperTickInfo types.TickInfo) (sdk.Dec, sdk.Dec) {
+	below0, below1 := lowerTickInfo.FeeGrowthOutside0, lowerTickInfo.FeeGrowthOutside1
+	if pool.CurrentTick < lowerTick {
+		below0, below1 = pool.FeeGrowthGlobal0.Sub(below0), pool.FeeGrowthGlobal1.Sub(below1)
+	}
+
+	above0, above1 := upperTickInfo.FeeGrowthOutside0, upperTickInfo.FeeGrowthOutside1
+	if pool.CurrentTick >= upperTick {
+		above0, above1 = pool.FeeGrowthGlobal0.Sub(above0), pool.FeeGrowthGlobal1.Sub(above1)
+	}
+
+	return pool.FeeGrowthGlobal0.Sub(below0).Sub(above0), pool.FeeGrowthGlobal1.Sub(below1).Sub(above1)
+}
+
+// accrueFees returns the position after accruing the fees earned by its
+// liquidity since it last accrued, given the current fees earned per unit of
+// liquidity inside its range.
+func accrueFees(pool types.Pool, position types.Position, feeGrowthInside0, feeGrowthInside1 sdk.Dec) types.Position {
+	if position.Liquidity.IsPositive() {
+		earned0 := feeGrowthInside0.Sub(position.FeeGrowthInsideLast0).MulTruncate(position.Liquidity)
+		earned1 := feeGrowthInside1.Sub(position.FeeGrowthInsideLast1).MulTruncate(position.Liquidity)
+		position.FeesOwed = position.FeesOwed.Add(
+			sdk.NewDecCoinFromDec(pool.Token0, sdk.MaxDec(earned0, sdk.ZeroDec())),
+			sdk.NewDecCoinFromDec(pool.Token1, sdk.MaxDec(earned1, sdk.ZeroDec())),
+		)
+	}
+
+	position.FeeGrowthInsideLast0 = feeGrowthInside0
+	position.FeeGrowthInsideLast1 = feeGrowthInside1
+	return position
+}
+
+// accruePositionFees returns the position after accruing the fees earned by
+// its liquidity up to the current block.
+func (k Keeper) accruePositionFees(ctx sdk.Context, pool *types.Pool, position types.Position) types.Position {
+	lowerTickInfo := k.getTickInfo(ctx, pool.Id, position.LowerTick)
+	upperTickInfo := k.getTickInfo(ctx, pool.Id, position.UpperTick)
+	feeGrowthInside0, feeGrowthInside1 := feeGrowthInside(*pool, position.LowerTick, lowerTickInfo, position.UpperTick, upperTickInfo)
+	return accrueFees(*pool, position, feeGrowthInside0, feeGrowthInside1)
+}
@@ -0,0 +1,40 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&Pool{}, "osmosis/concentratedliquidity/Pool", nil)
+	cdc.RegisterConcrete(&MsgCreateConcentratedPool{}, "osmosis/concentratedliquidity/MsgCreateConcentratedPool", nil)
+	cdc.RegisterConcrete(&MsgCreatePosition{}, "osmosis/concentratedliquidity/MsgCreatePosition", nil)
+	cdc.RegisterConcrete(&MsgWithdrawPosition{}, "osmosis/concentratedliquidity/MsgWithdrawPosition", nil)
+	cdc.RegisterConcrete(&MsgCollectFees{}, "osmosis/concentratedliquidity/MsgCollectFees", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterInterface(
+		"osmosis.swaprouter.v1beta1.PoolI",
+		(*swaproutertypes.PoolI)(nil),
+		&Pool{},
+	)
+
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateConcentratedPool{},
+		&MsgCreatePosition{},
+		&MsgWithdrawPosition{},
+		&MsgCollectFees{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
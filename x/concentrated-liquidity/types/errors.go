@@ -0,0 +1,119 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+)
+
+var (
+	ErrNotEnoughLiquidity = errors.New("not enough liquidity in the pool to complete the swap")
+	ErrZeroLiquidity      = errors.New("the given token amounts provide zero liquidity to the position")
+)
+
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("concentrated liquidity pool (%d) not found", e.PoolId)
+}
+
+type InvalidPoolTypeError struct {
+	PoolId uint64
+}
+
+func (e InvalidPoolTypeError) Error() string {
+	return fmt.Sprintf("pool (%d) is not a concentrated liquidity pool", e.PoolId)
+}
+
+type InvalidInitialLiquidityError struct {
+	InitialLiquidity sdk.Coins
+}
+
+func (e InvalidInitialLiquidityError) Error() string {
+	return fmt.Sprintf("initial liquidity must be positive amounts of exactly two tokens, was (%s)", e.InitialLiquidity)
+}
+
+type InvalidTickSpacingError struct {
+	TickSpacing uint64
+}
+
+func (e InvalidTickSpacingError) Error() string {
+	return fmt.Sprintf("tick spacing must be positive and at most %d, was (%d)", math.MaxTick, e.TickSpacing)
+}
+
+type InvalidSwapFeeError struct {
+	SwapFee sdk.Dec
+}
+
+func (e InvalidSwapFeeError) Error() string {
+	return fmt.Sprintf("swap fee must be in [0, 1), was (%s)", e.SwapFee)
+}
+
+type InvalidTickRangeError struct {
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e InvalidTickRangeError) Error() string {
+	return fmt.Sprintf("lower tick (%d) must be less than upper tick (%d), and both within [%d, %d]", e.LowerTick, e.UpperTick, math.MinTick, math.MaxTick)
+}
+
+type TickSpacingMismatchError struct {
+	TickIndex   int64
+	TickSpacing uint64
+}
+
+func (e TickSpacingMismatchError) Error() string {
+	return fmt.Sprintf("tick (%d) is not a multiple of the pool's tick spacing (%d)", e.TickIndex, e.TickSpacing)
+}
+
+type DenomNotInPoolError struct {
+	PoolId uint64
+	Denom  string
+}
+
+func (e DenomNotInPoolError) Error() string {
+	return fmt.Sprintf("denom (%s) is not in pool (%d)", e.Denom, e.PoolId)
+}
+
+type PositionNotFoundError struct {
+	PoolId    uint64
+	LowerTick int64
+	UpperTick int64
+}
+
+func (e PositionNotFoundError) Error() string {
+	return fmt.Sprintf("position in pool (%d) between ticks (%d) and (%d) not found", e.PoolId, e.LowerTick, e.UpperTick)
+}
+
+type InsufficientLiquidityError struct {
+	Requested sdk.Dec
+	Available sdk.Dec
+}
+
+func (e InsufficientLiquidityError) Error() string {
+	return fmt.Sprintf("requested liquidity (%s) is more than the position's liquidity (%s)", e.Requested, e.Available)
+}
+
+type TokenAmountBelowMinError struct {
+	Amount    sdk.Int
+	MinAmount sdk.Int
+}
+
+func (e TokenAmountBelowMinError) Error() string {
+	return fmt.Sprintf("token amount (%s) is less than the minimum amount (%s)", e.Amount, e.MinAmount)
+}
+
+type DepositAboveAmountsError struct {
+	Amount0 sdk.Dec
+	Amount1 sdk.Dec
+}
+
+func (e DepositAboveAmountsError) Error() string {
+	return fmt.Sprintf("no liquidity holds at most the given token amounts once rounded up, got (%s) and (%s)", e.Amount0, e.Amount1)
+}
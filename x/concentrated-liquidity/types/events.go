@@ -0,0 +1,18 @@
+package types
+
+const (
+	TypeEvtPoolCreated      = "pool_created"
+	TypeEvtCreatePosition   = "create_position"
+	TypeEvtWithdrawPosition = "withdraw_position"
+	TypeEvtCollectFees      = "collect_fees"
+	TypeEvtTokenSwapped     = "token_swapped"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyLowerTick  = "lower_tick"
+	AttributeKeyUpperTick  = "upper_tick"
+	AttributeKeyLiquidity  = "liquidity"
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyFees       = "fees"
+)
@@ -0,0 +1,30 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// GammKeeper defines the contract needed from the x/gamm keeper. Pools of
+// every model share x/gamm's pool id sequence, so that each pool id has a
+// single swaprouter module route.
+type GammKeeper interface {
+	GetNextPoolIdAndIncrement(ctx sdk.Context) uint64
+}
+
+// AccountKeeper defines the contract needed for AccountKeeper related APIs.
+type AccountKeeper interface {
+	NewAccount(sdk.Context, authtypes.AccountI) authtypes.AccountI
+	GetAccount(ctx sdk.Context, addr sdk.AccAddress) authtypes.AccountI
+	SetAccount(ctx sdk.Context, acc authtypes.AccountI)
+}
+
+// BankKeeper defines the contract needed for supply related APIs.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// CommunityPoolKeeper defines the contract needed from the distribution keeper.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
@@ -0,0 +1,56 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default concentrated-liquidity genesis state, without any pools.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params:    DefaultParams(),
+		Pools:     []Pool{},
+		Ticks:     []Tick{},
+		Positions: []Position{},
+	}
+}
+
+// Validate performs basic genesis state validation. Ticks and positions must
+// belong to a pool of the genesis state and be within its valid tick range.
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+
+	pools := make(map[uint64]Pool, len(g.Pools))
+	for _, pool := range g.Pools {
+		if _, ok := pools[pool.Id]; ok {
+			return fmt.Errorf("duplicate pool (%d)", pool.Id)
+		}
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		pools[pool.Id] = pool
+	}
+
+	for _, tick := range g.Ticks {
+		pool, ok := pools[tick.PoolId]
+		if !ok {
+			return PoolNotFoundError{PoolId: tick.PoolId}
+		}
+		if err := pool.ValidateTick(tick.TickIndex); err != nil {
+			return err
+		}
+	}
+
+	for _, position := range g.Positions {
+		pool, ok := pools[position.PoolId]
+		if !ok {
+			return PoolNotFoundError{PoolId: position.PoolId}
+		}
+		if err := pool.ValidateTicks(position.LowerTick, position.UpperTick); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
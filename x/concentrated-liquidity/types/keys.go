@@ -0,0 +1,65 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	ModuleName = "concentratedliquidity"
+
+	StoreKey  = ModuleName
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixPool defines the prefix under which pools are stored, by id.
+	KeyPrefixPool = []byte{0x01}
+
+	// KeyPrefixTick defines the prefix under which initialized ticks are stored, by pool id and tick index.
+	KeyPrefixTick = []byte{0x02}
+
+	// KeyPrefixPosition defines the prefix under which positions are stored, by owner, pool id and tick range.
+	KeyPrefixPosition = []byte{0x03}
+)
+
+// KeyPool returns the store key of the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return append(KeyPrefixPool, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTickPrefix returns the store prefix of the initialized ticks of the given pool.
+func KeyTickPrefix(poolId uint64) []byte {
+	return append(KeyPrefixTick, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+// KeyTick returns the store key of a pool's tick. Tick indexes are encoded so
+// that their byte order matches their numeric order, which lets the next
+// initialized tick in either direction be found by iteration.
+func KeyTick(poolId uint64, tickIndex int64) []byte {
+	return append(KeyTickPrefix(poolId), TickIndexToBytes(tickIndex)...)
+}
+
+// TickIndexToBytes encodes a tick index as 8 bytes ordered like the index.
+func TickIndexToBytes(tickIndex int64) []byte {
+	return sdk.Uint64ToBigEndian(uint64(tickIndex) ^ (1 << 63))
+}
+
+// TickIndexFromBytes decodes a tick index encoded by TickIndexToBytes.
+func TickIndexFromBytes(bz []byte) int64 {
+	return int64(sdk.BigEndianToUint64(bz) ^ (1 << 63))
+}
+
+// KeyUserPositionsPrefix returns the store prefix of the positions of the given address.
+func KeyUserPositionsPrefix(addr sdk.AccAddress) []byte {
+	return append(KeyPrefixPosition, address.MustLengthPrefix(addr)...)
+}
+
+// KeyPosition returns the store key of an address's position in a pool between two ticks.
+func KeyPosition(addr sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) []byte {
+	key := append(KeyUserPositionsPrefix(addr), sdk.Uint64ToBigEndian(poolId)...)
+	key = append(key, TickIndexToBytes(lowerTick)...)
+	return append(key, TickIndexToBytes(upperTick)...)
+}
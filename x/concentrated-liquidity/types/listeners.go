@@ -0,0 +1,22 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// SwapListener is notified of the swaps against concentrated liquidity pools.
+type SwapListener interface {
+	// AfterSwap is called after a swap against a pool.
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+}
+
+type SwapListeners []SwapListener
+
+func (l SwapListeners) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+// NewSwapListeners returns the given swap listeners.
+func NewSwapListeners(listeners ...SwapListener) SwapListeners {
+	return listeners
+}
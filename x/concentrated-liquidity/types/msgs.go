@@ -0,0 +1,236 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/osmomath"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// constants
+const (
+	TypeMsgCreateConcentratedPool = "create_concentrated_pool"
+	TypeMsgCreatePosition         = "create_position"
+	TypeMsgWithdrawPosition       = "withdraw_position"
+	TypeMsgCollectFees            = "collect_fees"
+)
+
+var (
+	_ sdk.Msg                       = &MsgCreateConcentratedPool{}
+	_ swaproutertypes.CreatePoolMsg = &MsgCreateConcentratedPool{}
+)
+
+// NewMsgCreateConcentratedPool creates a msg to create a concentrated liquidity pool.
+func NewMsgCreateConcentratedPool(sender sdk.AccAddress, initialLiquidity sdk.Coins, tickSpacing uint64, swapFee sdk.Dec) *MsgCreateConcentratedPool {
+	return &MsgCreateConcentratedPool{
+		Sender:               sender.String(),
+		InitialPoolLiquidity: initialLiquidity,
+		TickSpacing:          tickSpacing,
+		SwapFee:              swapFee,
+	}
+}
+
+func (msg MsgCreateConcentratedPool) Route() string { return RouterKey }
+func (msg MsgCreateConcentratedPool) Type() string  { return TypeMsgCreateConcentratedPool }
+func (msg MsgCreateConcentratedPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if len(msg.InitialPoolLiquidity) != 2 || !msg.InitialPoolLiquidity.IsValid() {
+		return InvalidInitialLiquidityError{InitialLiquidity: msg.InitialPoolLiquidity}
+	}
+
+	if err := validateTickSpacing(msg.TickSpacing); err != nil {
+		return err
+	}
+
+	return validateSwapFee(msg.SwapFee)
+}
+
+func (msg MsgCreateConcentratedPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateConcentratedPool) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateConcentratedPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateConcentratedPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+func (msg MsgCreateConcentratedPool) InitialLiquidity() sdk.Coins {
+	return msg.InitialPoolLiquidity
+}
+
+// CreatePool creates a pool of the two initial liquidity denoms, priced at
+// the ratio of their initial liquidity amounts.
+func (msg MsgCreateConcentratedPool) CreatePool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	token0, token1 := msg.InitialPoolLiquidity[0], msg.InitialPoolLiquidity[1]
+
+	price := osmomath.NewDecFromBigInt(token1.Amount.BigInt()).Quo(osmomath.NewDecFromBigInt(token0.Amount.BigInt()))
+	sqrtPrice, err := price.ApproxSqrt()
+	if err != nil {
+		return nil, err
+	}
+
+	pool, err := NewConcentratedPool(poolId, token0.Denom, token1.Denom, msg.TickSpacing, msg.SwapFee, sqrtPrice.SDKDec())
+	if err != nil {
+		return nil, err
+	}
+	// The initial liquidity is sent to the pool's account before the pool is
+	// initialized.
+	pool.PoolLiquidity = msg.InitialPoolLiquidity
+
+	return &pool, nil
+}
+
+func (msg MsgCreateConcentratedPool) GetPoolType() swaproutertypes.PoolType {
+	return swaproutertypes.Concentrated
+}
+
+var _ sdk.Msg = &MsgCreatePosition{}
+
+// NewMsgCreatePosition creates a msg to add liquidity to a position.
+func NewMsgCreatePosition(sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, tokenDesired0, tokenDesired1 sdk.Coin, tokenMinAmount0, tokenMinAmount1 sdk.Int) *MsgCreatePosition {
+	return &MsgCreatePosition{
+		Sender:          sender.String(),
+		PoolId:          poolId,
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		TokenDesired0:   tokenDesired0,
+		TokenDesired1:   tokenDesired1,
+		TokenMinAmount0: tokenMinAmount0,
+		TokenMinAmount1: tokenMinAmount1,
+	}
+}
+
+func (msg MsgCreatePosition) Route() string { return RouterKey }
+func (msg MsgCreatePosition) Type() string  { return TypeMsgCreatePosition }
+func (msg MsgCreatePosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidTickRangeError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	for _, token := range []sdk.Coin{msg.TokenDesired0, msg.TokenDesired1} {
+		if !token.IsValid() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid desired token (%s)", token)
+		}
+	}
+	if !msg.TokenDesired0.IsPositive() && !msg.TokenDesired1.IsPositive() {
+		return ErrZeroLiquidity
+	}
+
+	for _, minAmount := range []sdk.Int{msg.TokenMinAmount0, msg.TokenMinAmount1} {
+		if minAmount.IsNil() || minAmount.IsNegative() {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid min token amount (%s)", minAmount)
+		}
+	}
+
+	return nil
+}
+
+func (msg MsgCreatePosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreatePosition) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgWithdrawPosition{}
+
+// NewMsgWithdrawPosition creates a msg to remove liquidity from a position.
+func NewMsgWithdrawPosition(sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64, liquidityAmount sdk.Dec) *MsgWithdrawPosition {
+	return &MsgWithdrawPosition{
+		Sender:          sender.String(),
+		PoolId:          poolId,
+		LowerTick:       lowerTick,
+		UpperTick:       upperTick,
+		LiquidityAmount: liquidityAmount,
+	}
+}
+
+func (msg MsgWithdrawPosition) Route() string { return RouterKey }
+func (msg MsgWithdrawPosition) Type() string  { return TypeMsgWithdrawPosition }
+func (msg MsgWithdrawPosition) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidTickRangeError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	if msg.LiquidityAmount.IsNil() || !msg.LiquidityAmount.IsPositive() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidRequest, "Invalid liquidity amount (%s)", msg.LiquidityAmount)
+	}
+
+	return nil
+}
+
+func (msg MsgWithdrawPosition) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgWithdrawPosition) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgCollectFees{}
+
+// NewMsgCollectFees creates a msg to collect the fees accrued to a position.
+func NewMsgCollectFees(sender sdk.AccAddress, poolId uint64, lowerTick, upperTick int64) *MsgCollectFees {
+	return &MsgCollectFees{
+		Sender:    sender.String(),
+		PoolId:    poolId,
+		LowerTick: lowerTick,
+		UpperTick: upperTick,
+	}
+}
+
+func (msg MsgCollectFees) Route() string { return RouterKey }
+func (msg MsgCollectFees) Type() string  { return TypeMsgCollectFees }
+func (msg MsgCollectFees) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.LowerTick >= msg.UpperTick {
+		return InvalidTickRangeError{LowerTick: msg.LowerTick, UpperTick: msg.UpperTick}
+	}
+
+	return nil
+}
+
+func (msg MsgCollectFees) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCollectFees) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
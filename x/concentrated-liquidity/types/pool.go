@@ -0,0 +1,189 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/osmosis-labs/osmosis/v13/x/concentrated-liquidity/math"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ swaproutertypes.PoolI = &Pool{}
+
+// NewPoolAddress returns the address of the account holding the tokens of the
+// pool with the given id.
+func NewPoolAddress(poolId uint64) sdk.AccAddress {
+	key := append([]byte("pool"), sdk.Uint64ToBigEndian(poolId)...)
+	return address.Module(ModuleName, key)
+}
+
+// NewConcentratedPool returns a pool of the two given denoms with no liquidity,
+// at the given square root price of token0 in terms of token1.
+func NewConcentratedPool(poolId uint64, token0, token1 string, tickSpacing uint64, swapFee sdk.Dec, sqrtPrice sdk.Dec) (Pool, error) {
+	if token0 >= token1 {
+		return Pool{}, fmt.Errorf("token0 (%s) must sort before token1 (%s)", token0, token1)
+	}
+	if err := validateTickSpacing(tickSpacing); err != nil {
+		return Pool{}, err
+	}
+	if err := validateSwapFee(swapFee); err != nil {
+		return Pool{}, err
+	}
+
+	currentTick, err := math.SqrtPriceToTick(sqrtPrice)
+	if err != nil {
+		return Pool{}, err
+	}
+
+	return Pool{
+		Address:          NewPoolAddress(poolId).String(),
+		Id:               poolId,
+		Token0:           token0,
+		Token1:           token1,
+		TickSpacing:      tickSpacing,
+		SwapFee:          swapFee,
+		CurrentSqrtPrice: sqrtPrice,
+		CurrentTick:      currentTick,
+		CurrentLiquidity: sdk.ZeroDec(),
+		FeeGrowthGlobal0: sdk.ZeroDec(),
+		FeeGrowthGlobal1: sdk.ZeroDec(),
+		PoolLiquidity:    sdk.Coins{},
+	}, nil
+}
+
+func (p Pool) GetAddress() sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(p.Address)
+	if err != nil {
+		panic(fmt.Sprintf("could not bech32 decode address of pool with id: %d", p.GetId()))
+	}
+	return addr
+}
+
+func (p Pool) String() string {
+	out, err := json.Marshal(p)
+	if err != nil {
+		panic(err)
+	}
+	return string(out)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.Id
+}
+
+func (p Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.Concentrated
+}
+
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	return p.SwapFee
+}
+
+// GetExitFee returns zero, as withdrawing a position is free.
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	return true
+}
+
+// GetTotalShares returns zero, as liquidity is held in positions rather than
+// LP shares.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	return p.PoolLiquidity
+}
+
+// GetDenoms returns the denoms of the pool's two tokens.
+func (p Pool) GetDenoms() []string {
+	return []string{p.Token0, p.Token1}
+}
+
+// SpotPrice returns the price of the quote asset in terms of the base asset,
+// like the x/gamm pool models. The pool's price is that of token0 in terms
+// of token1, the square of its current square root price.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	price := p.CurrentSqrtPrice.Mul(p.CurrentSqrtPrice)
+	switch {
+	case baseAssetDenom == p.Token1 && quoteAssetDenom == p.Token0:
+		return price, nil
+	case baseAssetDenom == p.Token0 && quoteAssetDenom == p.Token1:
+		if price.IsZero() {
+			return sdk.Dec{}, fmt.Errorf("pool (%d) has a zero price", p.Id)
+		}
+		return sdk.OneDec().Quo(price), nil
+	default:
+		return sdk.Dec{}, fmt.Errorf("denoms (%s, %s) are not the two tokens of pool (%d)", baseAssetDenom, quoteAssetDenom, p.Id)
+	}
+}
+
+// Validate checks that the pool's static parameters are valid.
+func (p Pool) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(p.Address); err != nil {
+		return fmt.Errorf("invalid address of pool (%d): %w", p.Id, err)
+	}
+	if err := sdk.ValidateDenom(p.Token0); err != nil {
+		return err
+	}
+	if err := sdk.ValidateDenom(p.Token1); err != nil {
+		return err
+	}
+	if p.Token0 >= p.Token1 {
+		return fmt.Errorf("token0 (%s) must sort before token1 (%s)", p.Token0, p.Token1)
+	}
+	if err := validateTickSpacing(p.TickSpacing); err != nil {
+		return err
+	}
+	return validateSwapFee(p.SwapFee)
+}
+
+// ValidateTicks checks that the given ticks bound a valid range of the pool.
+func (p Pool) ValidateTicks(lowerTick, upperTick int64) error {
+	if lowerTick >= upperTick || lowerTick < math.MinTick || upperTick > math.MaxTick {
+		return InvalidTickRangeError{LowerTick: lowerTick, UpperTick: upperTick}
+	}
+	if err := p.ValidateTick(lowerTick); err != nil {
+		return err
+	}
+	return p.ValidateTick(upperTick)
+}
+
+// ValidateTick returns an error if the tick is not within the tick bounds, or
+// not a multiple of the pool's tick spacing.
+func (p Pool) ValidateTick(tick int64) error {
+	if tick < math.MinTick || tick > math.MaxTick {
+		return InvalidTickRangeError{LowerTick: tick, UpperTick: tick}
+	}
+	if tick%int64(p.TickSpacing) != 0 {
+		return TickSpacingMismatchError{TickIndex: tick, TickSpacing: p.TickSpacing}
+	}
+	return nil
+}
+
+// FullRangeTicks returns the lowest and highest ticks of the pool's tick
+// spacing.
+func (p Pool) FullRangeTicks() (lowerTick, upperTick int64) {
+	spacing := int64(p.TickSpacing)
+	return math.MinTick / spacing * spacing, math.MaxTick / spacing * spacing
+}
+
+func validateTickSpacing(tickSpacing uint64) error {
+	if tickSpacing == 0 || tickSpacing > uint64(math.MaxTick) {
+		return InvalidTickSpacingError{TickSpacing: tickSpacing}
+	}
+	return nil
+}
+
+func validateSwapFee(swapFee sdk.Dec) error {
+	if swapFee.IsNil() || swapFee.IsNegative() || swapFee.GTE(sdk.OneDec()) {
+		return InvalidSwapFeeError{SwapFee: swapFee}
+	}
+	return nil
+}
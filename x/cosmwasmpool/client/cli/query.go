@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		GetCmdPools(),
+		osmocli.GetParams[*queryproto.ParamsRequest](types.ModuleName, queryproto.NewQueryClient),
+	)
+	return cmd
+}
+
+// GetCmdPools returns all cosmwasm pools.
+func GetCmdPools() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.PoolsRequest](
+		"pools",
+		"Query all cosmwasm pools",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} pools
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
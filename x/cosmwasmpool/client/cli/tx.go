@@ -0,0 +1,57 @@
+package cli
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	txCmd.AddCommand(
+		NewCreateCosmWasmPoolCmd(),
+		NewJoinPoolCmd(),
+		NewExitPoolCmd(),
+	)
+
+	return txCmd
+}
+
+func NewCreateCosmWasmPoolCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "create-cosmwasm-pool [code-id] [instantiate-msg]",
+		Short:            "Create a pool whose contract is instantiated from a whitelisted code id, with the given json instantiate msg.",
+		Example:          `osmosisd tx cosmwasmpool create-cosmwasm-pool 1 '{"pool_asset_denoms":["uatom","ibc/..."]}' --from val`,
+		NumArgs:          2,
+		ParseAndBuildMsg: NewMsgCreateCosmWasmPool,
+	}.BuildCommandCustomFn()
+}
+
+func NewJoinPoolCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgJoinPool](&osmocli.TxCliDesc{
+		Use:     "join-pool [pool-id] [tokens-in]",
+		Short:   "Add tokens to a cosmwasm pool.",
+		Example: "osmosisd tx cosmwasmpool join-pool 1 1000000uatom --from val",
+	})
+}
+
+func NewExitPoolCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgExitPool](&osmocli.TxCliDesc{
+		Use:     "exit-pool [pool-id] [tokens-out]",
+		Short:   "Withdraw tokens from a cosmwasm pool.",
+		Example: "osmosisd tx cosmwasmpool exit-pool 1 1000000uatom --from val",
+	})
+}
+
+func NewMsgCreateCosmWasmPool(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	codeId, err := osmocli.ParseUint(args[0], "code-id")
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgCreateCosmWasmPool(clientCtx.GetFromAddress(), codeId, []byte(args[1])), nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/client/queryproto"
+)
+
+type Querier struct {
+	K cosmwasmpool.Keeper
+}
+
+func NewQuerier(k cosmwasmpool.Keeper) Querier {
+	return Querier{k}
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	req queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
+
+func (q Querier) Pools(ctx sdk.Context,
+	req queryproto.PoolsRequest,
+) (*queryproto.PoolsResponse, error) {
+	pools := q.K.GetPools(ctx)
+	return &queryproto.PoolsResponse{Pools: pools}, nil
+}
@@ -0,0 +1,10 @@
+package cosmwasmpool
+
+import "github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+
+// SetWasmKeepersForTest replaces the x/wasm keepers, which SetWasmKeepers
+// only sets once.
+func (k *Keeper) SetWasmKeepersForTest(contractKeeper types.ContractKeeper, wasmKeeper types.WasmKeeper) {
+	k.contractKeeper = contractKeeper
+	k.wasmKeeper = wasmKeeper
+}
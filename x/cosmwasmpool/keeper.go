@@ -0,0 +1,117 @@
+package cosmwasmpool
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ swaproutertypes.SwapI = &Keeper{}
+
+type Keeper struct {
+	storeKey sdk.StoreKey
+
+	paramSpace paramtypes.Subspace
+
+	gammKeeper          types.GammKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+
+	// the x/wasm keepers are set once created, see SetWasmKeepers.
+	contractKeeper types.ContractKeeper
+	wasmKeeper     types.WasmKeeper
+
+	poolCreationListeners swaproutertypes.PoolCreationListeners
+	poolListeners         types.PoolListeners
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	paramSpace paramtypes.Subspace,
+	gammKeeper types.GammKeeper,
+	bankKeeper types.BankKeeper,
+	communityPoolKeeper types.CommunityPoolKeeper,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		storeKey:            storeKey,
+		paramSpace:          paramSpace,
+		gammKeeper:          gammKeeper,
+		bankKeeper:          bankKeeper,
+		communityPoolKeeper: communityPoolKeeper,
+	}
+}
+
+// SetWasmKeepers sets the x/wasm keepers used to instantiate, sudo and query
+// pool contracts. The x/wasm keeper is created after the swaprouter keeper,
+// which depends on this one, so they cannot be passed to NewKeeper.
+func (k *Keeper) SetWasmKeepers(contractKeeper types.ContractKeeper, wasmKeeper types.WasmKeeper) *Keeper {
+	if k.contractKeeper != nil || k.wasmKeeper != nil {
+		panic("cannot set wasm keepers twice")
+	}
+
+	k.contractKeeper = contractKeeper
+	k.wasmKeeper = wasmKeeper
+
+	return k
+}
+
+// SetPoolCreationListeners sets the listeners notified of every pool created.
+func (k *Keeper) SetPoolCreationListeners(listeners swaproutertypes.PoolCreationListeners) *Keeper {
+	if k.poolCreationListeners != nil {
+		panic("cannot set pool creation listeners twice")
+	}
+
+	k.poolCreationListeners = listeners
+
+	return k
+}
+
+// SetPoolListeners sets the listeners notified of every swap, join and exit.
+func (k *Keeper) SetPoolListeners(listeners types.PoolListeners) *Keeper {
+	if k.poolListeners != nil {
+		panic("cannot set pool listeners twice")
+	}
+
+	k.poolListeners = listeners
+
+	return k
+}
+
+// GetParams returns the total set of cosmwasmpool parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of cosmwasmpool parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the cosmwasmpool module's state from a provided
+// genesis state. The contracts of the pools are part of the x/wasm genesis.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	for _, pool := range genState.Pools {
+		k.setPool(ctx, pool)
+	}
+}
+
+// ExportGenesis returns the cosmwasmpool module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Pools:  k.GetPools(ctx),
+	}
+}
@@ -0,0 +1,88 @@
+package cosmwasmpool_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+)
+
+const (
+	bar = "bar"
+	foo = "foo"
+
+	transmuterCodeId = 1
+)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+	keeper *cosmwasmpool.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.keeper = s.App.CosmWasmPoolKeeper
+	transmuter := newTransmuter(*s.App.BankKeeper)
+	s.keeper.SetWasmKeepersForTest(transmuter, transmuter)
+
+	params := s.keeper.GetParams(s.Ctx)
+	params.CodeIdWhitelist = []uint64{transmuterCodeId}
+	s.keeper.SetParams(s.Ctx, params)
+}
+
+// createPool creates a transmuter pool of the given denoms as the first test
+// account.
+func (s *KeeperTestSuite) createPool(denoms ...string) uint64 {
+	creator := s.TestAccs[0]
+	s.FundAcc(creator, s.keeper.GetParams(s.Ctx).PoolCreationFee)
+
+	poolId, err := s.keeper.CreatePool(s.Ctx, types.NewMsgCreateCosmWasmPool(creator, transmuterCodeId, transmuterInstantiateMsg(denoms...)))
+	s.Require().NoError(err)
+	return poolId
+}
+
+// joinPool adds the given tokens to a pool as the second test account.
+func (s *KeeperTestSuite) joinPool(poolId uint64, tokensIn sdk.Coins) {
+	owner := s.TestAccs[1]
+	s.FundAcc(owner, tokensIn)
+
+	_, err := s.keeper.JoinPool(s.Ctx, owner, poolId, tokensIn)
+	s.Require().NoError(err)
+}
+
+func transmuterInstantiateMsg(denoms ...string) []byte {
+	quoted := make([]string, len(denoms))
+	for i, denom := range denoms {
+		quoted[i] = fmt.Sprintf("%q", denom)
+	}
+	return []byte(fmt.Sprintf(`{"pool_asset_denoms":[%s]}`, strings.Join(quoted, ",")))
+}
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	poolId := s.createPool(bar, foo)
+
+	genesis := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Len(genesis.Pools, 1)
+	s.Require().NoError(genesis.Validate())
+	swaprouterGenesis := s.App.SwapRouterKeeper.ExportGenesis(s.Ctx)
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.Ctx, genesis)
+	s.App.SwapRouterKeeper.InitGenesis(s.Ctx, swaprouterGenesis)
+
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.Ctx))
+	// the pool is still routed to the module, through the routes kept by the swaprouter.
+	swapModule, err := s.App.SwapRouterKeeper.GetPoolModule(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(s.keeper, swapModule)
+}
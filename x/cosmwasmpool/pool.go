@@ -0,0 +1,219 @@
+package cosmwasmpool
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// CreatePool creates a cosmwasm pool, returning its id. The pool's code id
+// must be whitelisted by governance, and the pool creation fee funds the
+// community pool. The pool's id is taken from the pool id sequence of x/gamm.
+func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (uint64, error) {
+	if msg.GetPoolType() != swaproutertypes.CosmWasm {
+		return 0, fmt.Errorf("pool type (%s) is not cosmwasm", msg.GetPoolType())
+	}
+	if err := msg.Validate(ctx); err != nil {
+		return 0, err
+	}
+
+	sender := msg.PoolCreator()
+	params := k.GetParams(ctx)
+
+	poolId := k.gammKeeper.GetNextPoolIdAndIncrement(ctx)
+	poolI, err := msg.CreatePool(ctx, poolId)
+	if err != nil {
+		return 0, err
+	}
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return 0, err
+	}
+	if !params.IsCodeIdWhitelisted(pool.CodeId) {
+		return 0, types.CodeIdNotWhitelistedError{CodeId: pool.CodeId}
+	}
+
+	// send pool creation fee to community pool
+	if err := k.communityPoolKeeper.FundCommunityPool(ctx, params.PoolCreationFee, sender); err != nil {
+		return 0, err
+	}
+
+	if err := k.InitializePool(ctx, pool, sender); err != nil {
+		return 0, err
+	}
+
+	return poolId, nil
+}
+
+// InitializePool instantiates the given pool's contract, without an admin so
+// that it cannot be migrated. The contract must hold at least two distinct
+// denoms. It then stores the pool and notifies the pool creation listeners.
+func (k Keeper) InitializePool(ctx sdk.Context, poolI swaproutertypes.PoolI, creatorAddress sdk.AccAddress) error {
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return err
+	}
+
+	label := fmt.Sprintf("cosmwasm pool %d", pool.PoolId)
+	contractAddress, _, err := k.contractKeeper.Instantiate(ctx, pool.CodeId, creatorAddress, nil, pool.InstantiateMsg, label, nil)
+	if err != nil {
+		return err
+	}
+	pool.ContractAddress = contractAddress.String()
+	pool.WasmKeeper = k.wasmKeeper
+
+	denoms, err := queryPoolDenoms(ctx, pool)
+	if err != nil {
+		return err
+	}
+	if err := validatePoolDenoms(pool.PoolId, denoms); err != nil {
+		return err
+	}
+
+	k.setPool(ctx, pool.CosmWasmPool)
+	k.poolCreationListeners.AfterPoolCreated(ctx, creatorAddress, pool.PoolId)
+
+	return nil
+}
+
+// GetPool returns the cosmwasm pool with the given id.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.getPoolById(ctx, poolId)
+}
+
+// GetPoolDenoms returns the denoms of the pool with the given id, as reported
+// by its contract.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return queryPoolDenoms(ctx, pool)
+}
+
+// CalculateSpotPrice returns the spot price of the quote asset in terms of the
+// base asset, using the specified pool.
+func (k Keeper) CalculateSpotPrice(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+) (sdk.Dec, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+
+	spotPrice, err := pool.SpotPrice(ctx, baseAssetDenom, quoteAssetDenom)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	if spotPrice.IsNil() || !spotPrice.IsPositive() {
+		return sdk.Dec{}, fmt.Errorf("spot price of pool (%d) is not positive", poolId)
+	}
+	return spotPrice, nil
+}
+
+// JoinPool sends tokensIn to the pool's contract, which then accounts the
+// shares they are worth to the sender. It returns the amount of shares.
+func (k Keeper) JoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins) (sdk.Int, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), tokensIn); err != nil {
+		return sdk.Int{}, err
+	}
+
+	res := types.JoinPoolResponse{}
+	req := types.SudoMsg{JoinPool: &types.JoinPool{Sender: sender.String(), TokensIn: tokensIn}}
+	if err := k.sudo(ctx, pool, req, &res); err != nil {
+		return sdk.Int{}, err
+	}
+	if res.ShareOutAmount.IsNil() || res.ShareOutAmount.IsNegative() {
+		return sdk.Int{}, types.InvalidContractResponseError{PoolId: poolId, Response: "share out amount must not be negative"}
+	}
+
+	k.poolListeners.AfterJoinPool(ctx, sender, poolId, tokensIn, res.ShareOutAmount)
+
+	return res.ShareOutAmount, nil
+}
+
+// ExitPool has the pool's contract burn the shares of the sender that
+// tokensOut are worth, then sends tokensOut to the sender. It returns the
+// amount of shares.
+func (k Keeper) ExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins) (sdk.Int, error) {
+	pool, err := k.getPoolById(ctx, poolId)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	res := types.ExitPoolResponse{}
+	req := types.SudoMsg{ExitPool: &types.ExitPool{Sender: sender.String(), TokensOut: tokensOut}}
+	if err := k.sudo(ctx, pool, req, &res); err != nil {
+		return sdk.Int{}, err
+	}
+	if res.ShareInAmount.IsNil() || res.ShareInAmount.IsNegative() {
+		return sdk.Int{}, types.InvalidContractResponseError{PoolId: poolId, Response: "share in amount must not be negative"}
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, tokensOut); err != nil {
+		return sdk.Int{}, err
+	}
+
+	k.poolListeners.AfterExitPool(ctx, sender, poolId, res.ShareInAmount, tokensOut)
+
+	return res.ShareInAmount, nil
+}
+
+// sudo sends the sudo msg to the pool's contract, and unmarshals its response
+// into res.
+func (k Keeper) sudo(ctx sdk.Context, pool *types.Pool, req types.SudoMsg, res interface{}) error {
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resBz, err := k.contractKeeper.Sudo(ctx, pool.GetAddress(), bz)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(resBz, res); err != nil {
+		return types.InvalidContractResponseError{PoolId: pool.PoolId, Response: err.Error()}
+	}
+	return nil
+}
+
+func queryPoolDenoms(ctx sdk.Context, pool *types.Pool) ([]string, error) {
+	res := types.GetPoolDenomsResponse{}
+	if err := pool.Query(ctx, types.QueryMsg{GetPoolDenoms: &types.EmptyStruct{}}, &res); err != nil {
+		return nil, err
+	}
+	return res.PoolDenoms, nil
+}
+
+func validatePoolDenoms(poolId uint64, denoms []string) error {
+	seen := make(map[string]bool, len(denoms))
+	for _, denom := range denoms {
+		if err := sdk.ValidateDenom(denom); err != nil || seen[denom] {
+			return types.InvalidPoolDenomsError{PoolId: poolId, Denoms: denoms}
+		}
+		seen[denom] = true
+	}
+	if len(denoms) < 2 {
+		return types.InvalidPoolDenomsError{PoolId: poolId, Denoms: denoms}
+	}
+	return nil
+}
+
+func asCosmWasmPool(poolI swaproutertypes.PoolI) (*types.Pool, error) {
+	pool, ok := poolI.(*types.Pool)
+	if !ok {
+		return nil, types.InvalidPoolTypeError{PoolId: poolI.GetId()}
+	}
+	return pool, nil
+}
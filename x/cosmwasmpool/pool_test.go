@@ -0,0 +1,102 @@
+package cosmwasmpool_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (s *KeeperTestSuite) TestCreatePool() {
+	tests := map[string]struct {
+		codeId    uint64
+		denoms    []string
+		expectErr error
+	}{
+		"transmuter pool": {
+			codeId: transmuterCodeId,
+			denoms: []string{bar, foo},
+		},
+		"code id not whitelisted": {
+			codeId:    transmuterCodeId + 1,
+			denoms:    []string{bar, foo},
+			expectErr: types.CodeIdNotWhitelistedError{CodeId: transmuterCodeId + 1},
+		},
+		"single denom": {
+			codeId:    transmuterCodeId,
+			denoms:    []string{bar},
+			expectErr: types.InvalidPoolDenomsError{PoolId: 2, Denoms: []string{bar}},
+		},
+		"duplicate denoms": {
+			codeId:    transmuterCodeId,
+			denoms:    []string{bar, bar},
+			expectErr: types.InvalidPoolDenomsError{PoolId: 2, Denoms: []string{bar, bar}},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			creator := s.TestAccs[2]
+			s.FundAcc(creator, s.keeper.GetParams(s.Ctx).PoolCreationFee)
+			// pools of every type share the same pool ids.
+			gammPoolId := s.PrepareBalancerPool()
+
+			poolId, err := s.keeper.CreatePool(s.Ctx, types.NewMsgCreateCosmWasmPool(creator, tc.codeId, transmuterInstantiateMsg(tc.denoms...)))
+			if tc.expectErr != nil {
+				s.Require().ErrorContains(err, tc.expectErr.Error())
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().Equal(gammPoolId+1, poolId)
+
+			// the pool is routed, and its denoms and spot price are those of
+			// its contract.
+			swapModule, err := s.App.SwapRouterKeeper.GetPoolModule(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(s.keeper, swapModule)
+			denoms, err := s.App.SwapRouterKeeper.GetPoolDenoms(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.denoms, denoms)
+			spotPrice, err := s.App.SwapRouterKeeper.CalculateSpotPrice(s.Ctx, poolId, foo, bar)
+			s.Require().NoError(err)
+			s.Require().Equal(sdk.OneDec(), spotPrice)
+
+			// twap records were created for it.
+			_, err = s.App.TwapKeeper.GetBeginBlockAccumulatorRecord(s.Ctx, poolId, bar, foo)
+			s.Require().NoError(err)
+
+			pool, err := s.keeper.GetPool(s.Ctx, poolId)
+			s.Require().NoError(err)
+			s.Require().Equal(swaproutertypes.CosmWasm, pool.GetType())
+			s.Require().True(pool.IsActive(s.Ctx))
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, creator).IsZero())
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestJoinExitPool() {
+	poolId := s.createPool(bar, foo)
+	owner := s.TestAccs[1]
+	tokensIn := sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000), sdk.NewInt64Coin(foo, 2_000))
+	s.FundAcc(owner, tokensIn)
+
+	shareOutAmount, err := s.keeper.JoinPool(s.Ctx, owner, poolId, tokensIn)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(3_000), shareOutAmount)
+
+	pool, err := s.keeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(tokensIn, pool.GetTotalPoolLiquidity(s.Ctx))
+	s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, owner).IsZero())
+
+	tokensOut := sdk.NewCoins(sdk.NewInt64Coin(foo, 2_000))
+	shareInAmount, err := s.keeper.ExitPool(s.Ctx, owner, poolId, tokensOut)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(2_000), shareInAmount)
+	s.Require().Equal(tokensOut, s.App.BankKeeper.GetAllBalances(s.Ctx, owner))
+
+	// the contract refuses exits beyond the owner's shares.
+	_, err = s.keeper.ExitPool(s.Ctx, owner, poolId, tokensIn)
+	s.Require().Error(err)
+}
@@ -0,0 +1,46 @@
+package cosmwasmpool
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+)
+
+// getPoolById returns the cosmwasm pool with the given id, querying the
+// contract it is bound to.
+func (k Keeper) getPoolById(ctx sdk.Context, poolId uint64) (*types.Pool, error) {
+	store := ctx.KVStore(k.storeKey)
+	pool := types.CosmWasmPool{}
+	found, err := osmoutils.Get(store, types.KeyPool(poolId), &pool)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.PoolNotFoundError{PoolId: poolId}
+	}
+	return &types.Pool{CosmWasmPool: pool, WasmKeeper: k.wasmKeeper}, nil
+}
+
+func (k Keeper) setPool(ctx sdk.Context, pool types.CosmWasmPool) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.KeyPool(pool.PoolId), &pool)
+}
+
+// GetPools returns all cosmwasm pools, by increasing id.
+func (k Keeper) GetPools(ctx sdk.Context) []types.CosmWasmPool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixPool)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	pools := []types.CosmWasmPool{}
+	for ; iterator.Valid(); iterator.Next() {
+		pool := types.CosmWasmPool{}
+		if err := pool.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		pools = append(pools, pool)
+	}
+	return pools
+}
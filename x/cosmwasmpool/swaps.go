@@ -0,0 +1,194 @@
+package cosmwasmpool
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// SwapExactAmountIn sends tokenIn to the pool's contract, which then computes
+// the amount of tokenOutDenom it gives for it. It errors if that is less than
+// tokenOutMinAmount.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if tokenIn.Denom == tokenOutDenom {
+		return sdk.Int{}, fmt.Errorf("cannot swap (%s) for itself", tokenIn.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	res := types.SwapExactAmountInResponse{}
+	req := types.SudoMsg{SwapExactAmountIn: &types.SwapExactAmountIn{
+		Sender:            sender.String(),
+		TokenIn:           tokenIn,
+		TokenOutDenom:     tokenOutDenom,
+		TokenOutMinAmount: tokenOutMinAmount,
+		SwapFee:           swapFee,
+	}}
+	if err := k.sudo(ctx, pool, req, &res); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenOutAmount := res.TokenOutAmount
+	if tokenOutAmount.IsNil() || !tokenOutAmount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("token amount swapped out must be positive, was (%s)", tokenOutAmount)
+	}
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, swaproutertypes.TokenOutBelowMinError{TokenOutAmount: tokenOutAmount, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	tokenOut := sdk.NewCoin(tokenOutDenom, tokenOutAmount)
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	k.emitSwap(ctx, sender, pool.PoolId, tokenIn, tokenOut)
+	return tokenOutAmount, nil
+}
+
+// CalcOutAmtGivenIn returns the amount of tokenOutDenom the pool's contract
+// gives for tokenIn, without swapping.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	res := types.CalcOutAmtGivenInResponse{}
+	req := types.QueryMsg{CalcOutAmtGivenIn: &types.CalcOutAmtGivenIn{
+		TokenIn:       tokenIn,
+		TokenOutDenom: tokenOutDenom,
+		SwapFee:       swapFee,
+	}}
+	if err := pool.Query(ctx, req, &res); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := validateCalcResponse(pool.PoolId, res.TokenOut, tokenOutDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.TokenOut, nil
+}
+
+// SwapExactAmountOut has the pool's contract compute the amount of
+// tokenInDenom it takes for tokenOut, erroring if that is more than
+// tokenInMaxAmount. It then sends that amount to the contract, and tokenOut to
+// the sender.
+func (k Keeper) SwapExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolI swaproutertypes.PoolI,
+	tokenInDenom string,
+	tokenInMaxAmount sdk.Int,
+	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if tokenInDenom == tokenOut.Denom {
+		return sdk.Int{}, fmt.Errorf("cannot swap (%s) for itself", tokenInDenom)
+	}
+
+	res := types.SwapExactAmountOutResponse{}
+	req := types.SudoMsg{SwapExactAmountOut: &types.SwapExactAmountOut{
+		Sender:           sender.String(),
+		TokenInDenom:     tokenInDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+		TokenOut:         tokenOut,
+		SwapFee:          swapFee,
+	}}
+	if err := k.sudo(ctx, pool, req, &res); err != nil {
+		return sdk.Int{}, err
+	}
+
+	tokenInAmount := res.TokenInAmount
+	if tokenInAmount.IsNil() || !tokenInAmount.IsPositive() {
+		return sdk.Int{}, fmt.Errorf("token amount swapped in must be positive, was (%s)", tokenInAmount)
+	}
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, swaproutertypes.TokenInAboveMaxError{TokenInAmount: tokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	tokenIn := sdk.NewCoin(tokenInDenom, tokenInAmount)
+	if err := k.bankKeeper.SendCoins(ctx, sender, pool.GetAddress(), sdk.NewCoins(tokenIn)); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.bankKeeper.SendCoins(ctx, pool.GetAddress(), sender, sdk.NewCoins(tokenOut)); err != nil {
+		return sdk.Int{}, err
+	}
+
+	k.emitSwap(ctx, sender, pool.PoolId, tokenIn, tokenOut)
+	return tokenInAmount, nil
+}
+
+// CalcInAmtGivenOut returns the amount of tokenInDenom the pool's contract
+// takes for tokenOut, without swapping.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (sdk.Coin, error) {
+	pool, err := asCosmWasmPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	res := types.CalcInAmtGivenOutResponse{}
+	req := types.QueryMsg{CalcInAmtGivenOut: &types.CalcInAmtGivenOut{
+		TokenOut:     tokenOut,
+		TokenInDenom: tokenInDenom,
+		SwapFee:      swapFee,
+	}}
+	if err := pool.Query(ctx, req, &res); err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := validateCalcResponse(pool.PoolId, res.TokenIn, tokenInDenom); err != nil {
+		return sdk.Coin{}, err
+	}
+	return res.TokenIn, nil
+}
+
+func (k Keeper) emitSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, tokenIn, tokenOut sdk.Coin) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtTokenSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, tokenOut.String()),
+	))
+	k.poolListeners.AfterSwap(ctx, sender, poolId, sdk.NewCoins(tokenIn), sdk.NewCoins(tokenOut))
+}
+
+func validateCalcResponse(poolId uint64, token sdk.Coin, denom string) error {
+	if token.Denom != denom || token.Amount.IsNil() || token.Amount.IsNegative() {
+		return types.InvalidContractResponseError{PoolId: poolId, Response: token.String()}
+	}
+	return nil
+}
@@ -0,0 +1,90 @@
+package cosmwasmpool_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter"
+	swaprouterclient "github.com/osmosis-labs/osmosis/v13/x/swaprouter/client"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/client/queryproto"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+func (s *KeeperTestSuite) TestRouting() {
+	poolId := s.createPool(bar, foo)
+	s.joinPool(poolId, sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000), sdk.NewInt64Coin(foo, 1_000_000)))
+	trader := s.TestAccs[2]
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewInt64Coin(foo, 20_000)))
+
+	// the contract's estimates go through the swaprouter queries.
+	querier := swaprouterclient.Querier{K: *s.App.SwapRouterKeeper}
+	estimateIn, err := querier.EstimateSwapExactAmountIn(s.Ctx, queryproto.EstimateSwapExactAmountInRequest{
+		TokenIn: "10000foo",
+		Routes:  []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(10_000), estimateIn.TokenOutAmount)
+	estimateOut, err := querier.EstimateSwapExactAmountOut(s.Ctx, queryproto.EstimateSwapExactAmountOutRequest{
+		Sender:   trader.String(),
+		TokenOut: "1000bar",
+		Routes:   []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: foo}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewInt(1_000), estimateOut.TokenInAmount)
+
+	// and so do those of x/gamm.
+	gammQuerier := gammkeeper.NewQuerier(*s.App.GAMMKeeper)
+	gammEstimateIn, err := gammQuerier.EstimateSwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &gammtypes.QuerySwapExactAmountInRequest{
+		Sender:  trader.String(),
+		PoolId:  poolId,
+		TokenIn: "10000foo",
+		Routes:  []gammtypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(estimateIn.TokenOutAmount, gammEstimateIn.TokenOutAmount)
+	gammEstimateOut, err := gammQuerier.EstimateSwapExactAmountOut(sdk.WrapSDKContext(s.Ctx), &gammtypes.QuerySwapExactAmountOutRequest{
+		Sender:   trader.String(),
+		PoolId:   poolId,
+		TokenOut: "1000bar",
+		Routes:   []gammtypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: foo}},
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(estimateOut.TokenInAmount, gammEstimateOut.TokenInAmount)
+
+	// txs swap through the pool with the swaprouter's msgs.
+	msgServer := swaprouter.NewMsgServerImpl(s.App.SwapRouterKeeper)
+	swapIn, err := msgServer.SwapExactAmountIn(sdk.WrapSDKContext(s.Ctx), &swaproutertypes.MsgSwapExactAmountIn{
+		Sender:            trader.String(),
+		Routes:            []swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}},
+		TokenIn:           sdk.NewInt64Coin(foo, 10_000),
+		TokenOutMinAmount: sdk.OneInt(),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(estimateIn.TokenOutAmount, swapIn.TokenOutAmount)
+
+	swapOut, err := msgServer.SwapExactAmountOut(sdk.WrapSDKContext(s.Ctx), &swaproutertypes.MsgSwapExactAmountOut{
+		Sender:           trader.String(),
+		Routes:           []swaproutertypes.SwapAmountOutRoute{{PoolId: poolId, TokenInDenom: foo}},
+		TokenInMaxAmount: sdk.NewInt(10_000),
+		TokenOut:         sdk.NewInt64Coin(bar, 1_000),
+	})
+	s.Require().NoError(err)
+	s.Require().Equal(estimateOut.TokenInAmount, swapOut.TokenInAmount)
+
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bar, 11_000), sdk.NewInt64Coin(foo, 9_000)), s.App.BankKeeper.GetAllBalances(s.Ctx, trader))
+	pool, err := s.keeper.GetPool(s.Ctx, poolId)
+	s.Require().NoError(err)
+	s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(bar, 989_000), sdk.NewInt64Coin(foo, 1_011_000)), pool.GetTotalPoolLiquidity(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestSwapBelowMinAmount() {
+	poolId := s.createPool(bar, foo)
+	s.joinPool(poolId, sdk.NewCoins(sdk.NewInt64Coin(bar, 1_000_000), sdk.NewInt64Coin(foo, 1_000_000)))
+	trader := s.TestAccs[2]
+	s.FundAcc(trader, sdk.NewCoins(sdk.NewInt64Coin(foo, 10_000)))
+
+	_, err := s.App.SwapRouterKeeper.RouteExactAmountIn(s.Ctx, trader,
+		[]swaproutertypes.SwapAmountInRoute{{PoolId: poolId, TokenOutDenom: bar}}, sdk.NewInt64Coin(foo, 10_000), sdk.NewInt(10_001))
+	s.Require().ErrorContains(err, swaproutertypes.TokenOutBelowMinError{TokenOutAmount: sdk.NewInt(10_000), TokenOutMinAmount: sdk.NewInt(10_001)}.Error())
+}
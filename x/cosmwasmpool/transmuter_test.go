@@ -0,0 +1,143 @@
+package cosmwasmpool_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+
+	"github.com/osmosis-labs/osmosis/v13/x/cosmwasmpool/types"
+)
+
+// transmuter stands in for the x/wasm keepers, running pool contracts which
+// swap their denoms 1:1 and give a share for each token joined.
+type transmuter struct {
+	bankKeeper bankkeeper.Keeper
+	contracts  map[string]*transmuterState
+}
+
+type transmuterState struct {
+	denoms []string
+	shares map[string]sdk.Int
+}
+
+type transmuterInitMsg struct {
+	PoolAssetDenoms []string `json:"pool_asset_denoms"`
+}
+
+func newTransmuter(bankKeeper bankkeeper.Keeper) *transmuter {
+	return &transmuter{bankKeeper: bankKeeper, contracts: map[string]*transmuterState{}}
+}
+
+func (t *transmuter) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.AccAddress, initMsg []byte, label string, deposit sdk.Coins) (sdk.AccAddress, []byte, error) {
+	msg := transmuterInitMsg{}
+	if err := json.Unmarshal(initMsg, &msg); err != nil {
+		return nil, nil, err
+	}
+	contractAddress := sdk.AccAddress(address.Module("transmuter", []byte(label)))
+	t.contracts[contractAddress.String()] = &transmuterState{denoms: msg.PoolAssetDenoms, shares: map[string]sdk.Int{}}
+	return contractAddress, nil, nil
+}
+
+func (t *transmuter) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	state := t.contracts[contractAddress.String()]
+	req := types.SudoMsg{}
+	if err := json.Unmarshal(msg, &req); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case req.SwapExactAmountIn != nil:
+		swap := req.SwapExactAmountIn
+		if err := t.checkSwap(ctx, contractAddress, state, swap.TokenIn.Denom, sdk.NewCoin(swap.TokenOutDenom, swap.TokenIn.Amount)); err != nil {
+			return nil, err
+		}
+		return json.Marshal(types.SwapExactAmountInResponse{TokenOutAmount: swap.TokenIn.Amount})
+	case req.SwapExactAmountOut != nil:
+		swap := req.SwapExactAmountOut
+		if err := t.checkSwap(ctx, contractAddress, state, swap.TokenInDenom, swap.TokenOut); err != nil {
+			return nil, err
+		}
+		return json.Marshal(types.SwapExactAmountOutResponse{TokenInAmount: swap.TokenOut.Amount})
+	case req.JoinPool != nil:
+		shares := sumAmounts(req.JoinPool.TokensIn)
+		state.shares[req.JoinPool.Sender] = state.sharesOf(req.JoinPool.Sender).Add(shares)
+		return json.Marshal(types.JoinPoolResponse{ShareOutAmount: shares})
+	case req.ExitPool != nil:
+		shares := sumAmounts(req.ExitPool.TokensOut)
+		if state.sharesOf(req.ExitPool.Sender).LT(shares) {
+			return nil, fmt.Errorf("insufficient shares")
+		}
+		state.shares[req.ExitPool.Sender] = state.sharesOf(req.ExitPool.Sender).Sub(shares)
+		return json.Marshal(types.ExitPoolResponse{ShareInAmount: shares})
+	}
+	return nil, fmt.Errorf("unknown sudo msg")
+}
+
+func (t *transmuter) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error) {
+	state := t.contracts[contractAddr.String()]
+	query := types.QueryMsg{}
+	if err := json.Unmarshal(req, &query); err != nil {
+		return nil, err
+	}
+
+	switch {
+	case query.GetPoolDenoms != nil:
+		return json.Marshal(types.GetPoolDenomsResponse{PoolDenoms: state.denoms})
+	case query.GetSwapFee != nil:
+		return json.Marshal(types.GetSwapFeeResponse{SwapFee: sdk.ZeroDec()})
+	case query.IsActive != nil:
+		return json.Marshal(types.IsActiveResponse{IsActive: true})
+	case query.GetTotalPoolLiquidity != nil:
+		return json.Marshal(types.GetTotalPoolLiquidityResponse{TotalPoolLiquidity: t.bankKeeper.GetAllBalances(ctx, contractAddr)})
+	case query.SpotPrice != nil:
+		return json.Marshal(types.SpotPriceResponse{SpotPrice: sdk.OneDec()})
+	case query.CalcOutAmtGivenIn != nil:
+		calc := query.CalcOutAmtGivenIn
+		return json.Marshal(types.CalcOutAmtGivenInResponse{TokenOut: sdk.NewCoin(calc.TokenOutDenom, calc.TokenIn.Amount)})
+	case query.CalcInAmtGivenOut != nil:
+		calc := query.CalcInAmtGivenOut
+		return json.Marshal(types.CalcInAmtGivenOutResponse{TokenIn: sdk.NewCoin(calc.TokenInDenom, calc.TokenOut.Amount)})
+	}
+	return nil, fmt.Errorf("unknown query")
+}
+
+// checkSwap errors if the swap is not between two denoms of the pool, or the
+// contract cannot pay tokenOut.
+func (t *transmuter) checkSwap(ctx sdk.Context, contractAddress sdk.AccAddress, state *transmuterState, tokenInDenom string, tokenOut sdk.Coin) error {
+	for _, denom := range []string{tokenInDenom, tokenOut.Denom} {
+		if !state.hasDenom(denom) {
+			return fmt.Errorf("denom (%s) is not in the pool", denom)
+		}
+	}
+	if t.bankKeeper.GetBalance(ctx, contractAddress, tokenOut.Denom).IsLT(tokenOut) {
+		return fmt.Errorf("insufficient (%s) in the pool", tokenOut.Denom)
+	}
+	return nil
+}
+
+func (s *transmuterState) hasDenom(denom string) bool {
+	for _, d := range s.denoms {
+		if d == denom {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *transmuterState) sharesOf(sender string) sdk.Int {
+	if shares, ok := s.shares[sender]; ok {
+		return shares
+	}
+	return sdk.ZeroInt()
+}
+
+func sumAmounts(coins sdk.Coins) sdk.Int {
+	sum := sdk.ZeroInt()
+	for _, coin := range coins {
+		sum = sum.Add(coin.Amount)
+	}
+	return sum
+}
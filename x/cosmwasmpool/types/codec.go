@@ -0,0 +1,29 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+func RegisterCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&MsgCreateCosmWasmPool{}, "osmosis/cosmwasmpool/MsgCreateCosmWasmPool", nil)
+	cdc.RegisterConcrete(&MsgJoinPool{}, "osmosis/cosmwasmpool/MsgJoinPool", nil)
+	cdc.RegisterConcrete(&MsgExitPool{}, "osmosis/cosmwasmpool/MsgExitPool", nil)
+}
+
+func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgCreateCosmWasmPool{},
+		&MsgJoinPool{},
+		&MsgExitPool{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
+
+var (
+	Amino     = codec.NewLegacyAmino()
+	ModuleCdc = codec.NewProtoCodec(cdctypes.NewInterfaceRegistry())
+)
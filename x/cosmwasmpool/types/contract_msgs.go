@@ -0,0 +1,131 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// The types below define the interface pool contracts implement. The module
+// moves the pool's tokens in and out of the contract's account itself; the
+// contract computes the amounts, and updates its own state.
+
+// SudoMsg is the sudo msg the module sends a pool contract. Exactly one of
+// its fields is set.
+type SudoMsg struct {
+	// SwapExactAmountIn is sent once TokenIn is in the contract's account.
+	// The contract responds with a SwapExactAmountInResponse, and the module
+	// then sends the amount out to the sender.
+	SwapExactAmountIn *SwapExactAmountIn `json:"swap_exact_amount_in,omitempty"`
+	// SwapExactAmountOut is sent before any token moves. The contract
+	// responds with a SwapExactAmountOutResponse, and the module then sends
+	// the amount in to the contract and TokenOut to the sender.
+	SwapExactAmountOut *SwapExactAmountOut `json:"swap_exact_amount_out,omitempty"`
+	// JoinPool is sent once TokensIn are in the contract's account. The
+	// contract responds with a JoinPoolResponse.
+	JoinPool *JoinPool `json:"join_pool,omitempty"`
+	// ExitPool is sent before any token moves. The contract responds with an
+	// ExitPoolResponse, and the module then sends TokensOut to the sender.
+	ExitPool *ExitPool `json:"exit_pool,omitempty"`
+}
+
+type SwapExactAmountIn struct {
+	Sender            string   `json:"sender"`
+	TokenIn           sdk.Coin `json:"token_in"`
+	TokenOutDenom     string   `json:"token_out_denom"`
+	TokenOutMinAmount sdk.Int  `json:"token_out_min_amount"`
+	SwapFee           sdk.Dec  `json:"swap_fee"`
+}
+
+type SwapExactAmountInResponse struct {
+	TokenOutAmount sdk.Int `json:"token_out_amount"`
+}
+
+type SwapExactAmountOut struct {
+	Sender           string   `json:"sender"`
+	TokenInDenom     string   `json:"token_in_denom"`
+	TokenInMaxAmount sdk.Int  `json:"token_in_max_amount"`
+	TokenOut         sdk.Coin `json:"token_out"`
+	SwapFee          sdk.Dec  `json:"swap_fee"`
+}
+
+type SwapExactAmountOutResponse struct {
+	TokenInAmount sdk.Int `json:"token_in_amount"`
+}
+
+type JoinPool struct {
+	Sender   string    `json:"sender"`
+	TokensIn sdk.Coins `json:"tokens_in"`
+}
+
+type JoinPoolResponse struct {
+	ShareOutAmount sdk.Int `json:"share_out_amount"`
+}
+
+type ExitPool struct {
+	Sender    string    `json:"sender"`
+	TokensOut sdk.Coins `json:"tokens_out"`
+}
+
+type ExitPoolResponse struct {
+	ShareInAmount sdk.Int `json:"share_in_amount"`
+}
+
+// EmptyStruct is the value of the queries without arguments.
+type EmptyStruct struct{}
+
+// QueryMsg is the smart query the module sends a pool contract. Exactly one
+// of its fields is set.
+type QueryMsg struct {
+	GetPoolDenoms         *EmptyStruct       `json:"get_pool_denoms,omitempty"`
+	GetSwapFee            *EmptyStruct       `json:"get_swap_fee,omitempty"`
+	IsActive              *EmptyStruct       `json:"is_active,omitempty"`
+	GetTotalPoolLiquidity *EmptyStruct       `json:"get_total_pool_liquidity,omitempty"`
+	SpotPrice             *SpotPrice         `json:"spot_price,omitempty"`
+	CalcOutAmtGivenIn     *CalcOutAmtGivenIn `json:"calc_out_amt_given_in,omitempty"`
+	CalcInAmtGivenOut     *CalcInAmtGivenOut `json:"calc_in_amt_given_out,omitempty"`
+}
+
+type GetPoolDenomsResponse struct {
+	PoolDenoms []string `json:"pool_denoms"`
+}
+
+type GetSwapFeeResponse struct {
+	SwapFee sdk.Dec `json:"swap_fee"`
+}
+
+type IsActiveResponse struct {
+	IsActive bool `json:"is_active"`
+}
+
+type GetTotalPoolLiquidityResponse struct {
+	TotalPoolLiquidity sdk.Coins `json:"total_pool_liquidity"`
+}
+
+// SpotPrice queries the price of the quote asset in units of the base asset.
+type SpotPrice struct {
+	BaseAssetDenom  string `json:"base_asset_denom"`
+	QuoteAssetDenom string `json:"quote_asset_denom"`
+}
+
+type SpotPriceResponse struct {
+	SpotPrice sdk.Dec `json:"spot_price"`
+}
+
+type CalcOutAmtGivenIn struct {
+	TokenIn       sdk.Coin `json:"token_in"`
+	TokenOutDenom string   `json:"token_out_denom"`
+	SwapFee       sdk.Dec  `json:"swap_fee"`
+}
+
+type CalcOutAmtGivenInResponse struct {
+	TokenOut sdk.Coin `json:"token_out"`
+}
+
+type CalcInAmtGivenOut struct {
+	TokenOut     sdk.Coin `json:"token_out"`
+	TokenInDenom string   `json:"token_in_denom"`
+	SwapFee      sdk.Dec  `json:"swap_fee"`
+}
+
+type CalcInAmtGivenOutResponse struct {
+	TokenIn sdk.Coin `json:"token_in"`
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"fmt"
+)
+
+type PoolNotFoundError struct {
+	PoolId uint64
+}
+
+func (e PoolNotFoundError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) not found", e.PoolId)
+}
+
+type InvalidPoolTypeError struct {
+	PoolId uint64
+}
+
+func (e InvalidPoolTypeError) Error() string {
+	return fmt.Sprintf("pool (%d) is not a cosmwasm pool", e.PoolId)
+}
+
+type CodeIdNotWhitelistedError struct {
+	CodeId uint64
+}
+
+func (e CodeIdNotWhitelistedError) Error() string {
+	return fmt.Sprintf("code id (%d) is not whitelisted for cosmwasm pools", e.CodeId)
+}
+
+type InvalidPoolDenomsError struct {
+	PoolId uint64
+	Denoms []string
+}
+
+func (e InvalidPoolDenomsError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) must have at least two distinct denoms, had (%v)", e.PoolId, e.Denoms)
+}
+
+type InvalidContractResponseError struct {
+	PoolId   uint64
+	Response string
+}
+
+func (e InvalidContractResponseError) Error() string {
+	return fmt.Sprintf("cosmwasm pool (%d) contract returned an invalid response (%s)", e.PoolId, e.Response)
+}
@@ -0,0 +1,13 @@
+package types
+
+const (
+	TypeEvtPoolCreated  = "pool_created"
+	TypeEvtPoolJoined   = "pool_joined"
+	TypeEvtPoolExited   = "pool_exited"
+	TypeEvtTokenSwapped = "token_swapped"
+
+	AttributeValueCategory = ModuleName
+	AttributeKeyPoolId     = "pool_id"
+	AttributeKeyTokensIn   = "tokens_in"
+	AttributeKeyTokensOut  = "tokens_out"
+)
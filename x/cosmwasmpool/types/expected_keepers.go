@@ -0,0 +1,42 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GammKeeper defines the contract needed from the x/gamm keeper. Pools of
+// every model share x/gamm's pool id sequence, so that each pool id has a
+// single swaprouter module route.
+type GammKeeper interface {
+	GetNextPoolIdAndIncrement(ctx sdk.Context) uint64
+}
+
+// BankKeeper defines the contract needed for supply related APIs.
+type BankKeeper interface {
+	SendCoins(ctx sdk.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
+}
+
+// CommunityPoolKeeper defines the contract needed from the distribution keeper.
+type CommunityPoolKeeper interface {
+	FundCommunityPool(ctx sdk.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// ContractKeeper defines the contract needed from the x/wasm permissioned
+// keeper, to instantiate pool contracts and to sudo them.
+type ContractKeeper interface {
+	Instantiate(
+		ctx sdk.Context,
+		codeID uint64,
+		creator, admin sdk.AccAddress,
+		initMsg []byte,
+		label string,
+		deposit sdk.Coins,
+	) (sdk.AccAddress, []byte, error)
+	Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error)
+}
+
+// WasmKeeper defines the contract needed from the x/wasm keeper, to query
+// pool contracts.
+type WasmKeeper interface {
+	QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte) ([]byte, error)
+}
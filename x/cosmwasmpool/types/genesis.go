@@ -0,0 +1,33 @@
+package types
+
+import (
+	"fmt"
+)
+
+// DefaultGenesis returns the default cosmwasmpool genesis state, without any pools.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Pools:  []CosmWasmPool{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+
+	poolIds := make(map[uint64]bool, len(g.Pools))
+	for _, pool := range g.Pools {
+		if poolIds[pool.PoolId] {
+			return fmt.Errorf("duplicate pool (%d)", pool.PoolId)
+		}
+		if err := pool.Validate(); err != nil {
+			return err
+		}
+		poolIds[pool.PoolId] = true
+	}
+
+	return nil
+}
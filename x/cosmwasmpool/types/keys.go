@@ -0,0 +1,22 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	ModuleName = "cosmwasmpool"
+
+	StoreKey  = ModuleName
+	RouterKey = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+// KeyPrefixPool defines the prefix under which pools are stored, by id.
+var KeyPrefixPool = []byte{0x01}
+
+// KeyPool returns the store key of the pool with the given id.
+func KeyPool(poolId uint64) []byte {
+	return append(KeyPrefixPool, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -0,0 +1,40 @@
+package types
+
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
+// PoolListener is notified of the swaps, joins and exits of cosmwasm pools.
+// Its methods match those of the x/gamm hooks, so that they can listen to
+// both.
+type PoolListener interface {
+	// AfterSwap is called after a swap against a pool.
+	AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins)
+	// AfterJoinPool is called after tokens are added to a pool.
+	AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int)
+	// AfterExitPool is called after tokens are removed from a pool.
+	AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins)
+}
+
+type PoolListeners []PoolListener
+
+func (l PoolListeners) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	for i := range l {
+		l[i].AfterSwap(ctx, sender, poolId, input, output)
+	}
+}
+
+func (l PoolListeners) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+	for i := range l {
+		l[i].AfterJoinPool(ctx, sender, poolId, enterCoins, shareOutAmount)
+	}
+}
+
+func (l PoolListeners) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+	for i := range l {
+		l[i].AfterExitPool(ctx, sender, poolId, shareInAmount, exitCoins)
+	}
+}
+
+// NewPoolListeners returns the given pool listeners.
+func NewPoolListeners(listeners ...PoolListener) PoolListeners {
+	return listeners
+}
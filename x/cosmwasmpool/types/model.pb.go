@@ -0,0 +1,429 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/cosmwasmpool/v1beta1/model.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// CosmWasmPool is the stored model of a pool whose math is implemented by a
+// CosmWasm contract. The contract holds the pool's tokens.
+type CosmWasmPool struct {
+	// contract_address is the address of the contract, which is also the
+	// pool's address.
+	ContractAddress string `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty" yaml:"contract_address"`
+	PoolId          uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	// code_id is the code the contract is instantiated from. It must be
+	// whitelisted in the module params when the pool is created.
+	CodeId uint64 `protobuf:"varint,3,opt,name=code_id,json=codeId,proto3" json:"code_id,omitempty" yaml:"code_id"`
+	// instantiate_msg is the msg the contract is instantiated with.
+	InstantiateMsg []byte `protobuf:"bytes,4,opt,name=instantiate_msg,json=instantiateMsg,proto3" json:"instantiate_msg,omitempty" yaml:"instantiate_msg"`
+}
+
+func (m *CosmWasmPool) Reset()         { *m = CosmWasmPool{} }
+func (m *CosmWasmPool) String() string { return proto.CompactTextString(m) }
+func (*CosmWasmPool) ProtoMessage()    {}
+func (*CosmWasmPool) Descriptor() ([]byte, []int) {
+	return fileDescriptor_317100e4262ccda2, []int{0}
+}
+func (m *CosmWasmPool) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosmWasmPool) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CosmWasmPool.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CosmWasmPool) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosmWasmPool.Merge(m, src)
+}
+func (m *CosmWasmPool) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosmWasmPool) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosmWasmPool.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosmWasmPool proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*CosmWasmPool)(nil), "osmosis.cosmwasmpool.v1beta1.CosmWasmPool")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/cosmwasmpool/v1beta1/model.proto", fileDescriptor_317100e4262ccda2)
+}
+
+var fileDescriptor_317100e4262ccda2 = []byte{
+	// 318 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc5, 0x73, 0x5a, 0x2a, 0x86, 0xd2, 0x4a, 0x10, 0x2d, 0x55, 0x92, 0x72, 0x53, 0x40, 0xcc,
+	0x51, 0xba, 0x48, 0x37, 0x53, 0x10, 0x3a, 0x08, 0x92, 0x45, 0x70, 0x29, 0x97, 0xdc, 0x11, 0x03,
+	0xb9, 0xfe, 0x4b, 0xff, 0x67, 0xb5, 0xdf, 0xc0, 0xd1, 0x8f, 0xe0, 0xc7, 0x71, 0xec, 0xe8, 0x54,
+	0xa4, 0xfd, 0x06, 0x5d, 0x5d, 0xe4, 0x9a, 0x14, 0x6a, 0xb7, 0x77, 0xef, 0xfd, 0x1e, 0x07, 0xef,
+	0x6f, 0xfb, 0x80, 0x0a, 0x30, 0x43, 0x96, 0x00, 0xaa, 0x57, 0x8e, 0x6a, 0x0c, 0x90, 0xb3, 0x69,
+	0x27, 0x96, 0x9a, 0x77, 0x98, 0x02, 0x21, 0xf3, 0x60, 0x3c, 0x01, 0x0d, 0xce, 0x65, 0x49, 0x06,
+	0xbb, 0x64, 0x50, 0x92, 0xad, 0xd3, 0x14, 0x52, 0xd8, 0x80, 0xcc, 0xa8, 0xa2, 0x43, 0x7f, 0x89,
+	0x5d, 0xeb, 0x03, 0xaa, 0x47, 0x8e, 0xea, 0x01, 0x20, 0x77, 0xee, 0xec, 0x93, 0x04, 0x46, 0x7a,
+	0xc2, 0x13, 0x3d, 0xe4, 0x42, 0x4c, 0x24, 0x62, 0x93, 0xb4, 0x89, 0x7f, 0x1c, 0x5e, 0xac, 0x17,
+	0xde, 0xf9, 0x8c, 0xab, 0xbc, 0x47, 0xf7, 0x09, 0x1a, 0x35, 0xb6, 0xd6, 0x6d, 0xe1, 0x38, 0x57,
+	0xf6, 0x91, 0xf9, 0x7e, 0x98, 0x89, 0xe6, 0x41, 0x9b, 0xf8, 0x95, 0xd0, 0x59, 0x2f, 0xbc, 0x7a,
+	0x51, 0x2f, 0x03, 0x1a, 0x55, 0x8d, 0x1a, 0x08, 0x03, 0x27, 0x20, 0xa4, 0x81, 0x0f, 0xf7, 0xe1,
+	0x32, 0xa0, 0x51, 0xd5, 0xa8, 0x81, 0x70, 0xfa, 0x76, 0x23, 0x1b, 0xa1, 0xe6, 0x23, 0x9d, 0x71,
+	0x2d, 0x87, 0x0a, 0xd3, 0x66, 0xa5, 0x4d, 0xfc, 0x5a, 0xd8, 0x5a, 0x2f, 0xbc, 0xb3, 0xa2, 0xb4,
+	0x07, 0xd0, 0xa8, 0xbe, 0xe3, 0xdc, 0x63, 0xda, 0xab, 0xbc, 0x7f, 0x7a, 0x56, 0x18, 0x7d, 0x2d,
+	0x5d, 0x32, 0x5f, 0xba, 0xe4, 0x67, 0xe9, 0x92, 0x8f, 0x95, 0x6b, 0xcd, 0x57, 0xae, 0xf5, 0xbd,
+	0x72, 0xad, 0xa7, 0x9b, 0x34, 0xd3, 0xcf, 0x2f, 0x71, 0x90, 0x80, 0x62, 0xe5, 0xac, 0xd7, 0x39,
+	0x8f, 0x71, 0xfb, 0x60, 0xd3, 0x4e, 0x97, 0xbd, 0xfd, 0xbf, 0x89, 0x9e, 0x8d, 0x25, 0xc6, 0xd5,
+	0xcd, 0xb0, 0xdd, 0xbf, 0x01, 0x00, 0xe4, 0x3e, 0x70, 0x75, 0xb8, 0x01, 0x00, 0x00,
+}
+
+func (m *CosmWasmPool) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosmWasmPool) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosmWasmPool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.InstantiateMsg) > 0 {
+		i -= len(m.InstantiateMsg)
+		copy(dAtA[i:], m.InstantiateMsg)
+		i = encodeVarintModel(dAtA, i, uint64(len(m.InstantiateMsg)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.CodeId != 0 {
+		i = encodeVarintModel(dAtA, i, uint64(m.CodeId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintModel(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintModel(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintModel(dAtA []byte, offset int, v uint64) int {
+	offset -= sovModel(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *CosmWasmPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovModel(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovModel(uint64(m.PoolId))
+	}
+	if m.CodeId != 0 {
+		n += 1 + sovModel(uint64(m.CodeId))
+	}
+	l = len(m.InstantiateMsg)
+	if l > 0 {
+		n += 1 + l + sovModel(uint64(l))
+	}
+	return n
+}
+
+func sovModel(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozModel(x uint64) (n int) {
+	return sovModel(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *CosmWasmPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosmWasmPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosmWasmPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeId", wireType)
+			}
+			m.CodeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CodeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InstantiateMsg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthModel
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthModel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InstantiateMsg = append(m.InstantiateMsg[:0], dAtA[iNdEx:postIndex]...)
+			if m.InstantiateMsg == nil {
+				m.InstantiateMsg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipModel(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthModel
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipModel(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowModel
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowModel
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthModel
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupModel
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthModel
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthModel        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowModel          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupModel = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// constants
+const (
+	TypeMsgCreateCosmWasmPool = "create_cosmwasm_pool"
+	TypeMsgJoinPool           = "join_cosmwasm_pool"
+	TypeMsgExitPool           = "exit_cosmwasm_pool"
+)
+
+var (
+	_ sdk.Msg                       = &MsgCreateCosmWasmPool{}
+	_ swaproutertypes.CreatePoolMsg = &MsgCreateCosmWasmPool{}
+)
+
+// NewMsgCreateCosmWasmPool creates a msg to create a pool whose contract is
+// instantiated from the given code id.
+func NewMsgCreateCosmWasmPool(sender sdk.AccAddress, codeId uint64, instantiateMsg []byte) *MsgCreateCosmWasmPool {
+	return &MsgCreateCosmWasmPool{
+		Sender:         sender.String(),
+		CodeId:         codeId,
+		InstantiateMsg: instantiateMsg,
+	}
+}
+
+func (msg MsgCreateCosmWasmPool) Route() string { return RouterKey }
+func (msg MsgCreateCosmWasmPool) Type() string  { return TypeMsgCreateCosmWasmPool }
+func (msg MsgCreateCosmWasmPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.CodeId == 0 {
+		return fmt.Errorf("code id must be set")
+	}
+
+	if !json.Valid(msg.InstantiateMsg) {
+		return fmt.Errorf("instantiate msg must be valid json")
+	}
+
+	return nil
+}
+
+func (msg MsgCreateCosmWasmPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgCreateCosmWasmPool) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+/// Implement the CreatePoolMsg interface
+
+func (msg MsgCreateCosmWasmPool) PoolCreator() sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return sender
+}
+
+func (msg MsgCreateCosmWasmPool) Validate(ctx sdk.Context) error {
+	return msg.ValidateBasic()
+}
+
+// InitialLiquidity returns nil. Liquidity is added to a cosmwasm pool by
+// joining it once its contract is instantiated.
+func (msg MsgCreateCosmWasmPool) InitialLiquidity() sdk.Coins {
+	return nil
+}
+
+// CreatePool returns a pool whose contract is instantiated when the pool is
+// initialized.
+func (msg MsgCreateCosmWasmPool) CreatePool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return &Pool{CosmWasmPool: NewCosmWasmPool(poolId, msg.CodeId, msg.InstantiateMsg)}, nil
+}
+
+func (msg MsgCreateCosmWasmPool) GetPoolType() swaproutertypes.PoolType {
+	return swaproutertypes.CosmWasm
+}
+
+var _ sdk.Msg = &MsgJoinPool{}
+
+// NewMsgJoinPool creates a msg to add the given tokens to a cosmwasm pool.
+func NewMsgJoinPool(sender sdk.AccAddress, poolId uint64, tokensIn sdk.Coins) *MsgJoinPool {
+	return &MsgJoinPool{
+		Sender:   sender.String(),
+		PoolId:   poolId,
+		TokensIn: tokensIn,
+	}
+}
+
+func (msg MsgJoinPool) Route() string { return RouterKey }
+func (msg MsgJoinPool) Type() string  { return TypeMsgJoinPool }
+func (msg MsgJoinPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.TokensIn.Empty() || !msg.TokensIn.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid tokens in (%s)", msg.TokensIn)
+	}
+
+	return nil
+}
+
+func (msg MsgJoinPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgJoinPool) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgExitPool{}
+
+// NewMsgExitPool creates a msg to withdraw the given tokens from a cosmwasm
+// pool.
+func NewMsgExitPool(sender sdk.AccAddress, poolId uint64, tokensOut sdk.Coins) *MsgExitPool {
+	return &MsgExitPool{
+		Sender:    sender.String(),
+		PoolId:    poolId,
+		TokensOut: tokensOut,
+	}
+}
+
+func (msg MsgExitPool) Route() string { return RouterKey }
+func (msg MsgExitPool) Type() string  { return TypeMsgExitPool }
+func (msg MsgExitPool) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.TokensOut.Empty() || !msg.TokensOut.IsValid() {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidCoins, "Invalid tokens out (%s)", msg.TokensOut)
+	}
+
+	return nil
+}
+
+func (msg MsgExitPool) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgExitPool) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(msg.Sender)
+	return []sdk.AccAddress{sender}
+}
@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+)
+
+// Parameter store keys.
+var (
+	KeyCodeIdWhitelist = []byte("CodeIdWhitelist")
+	KeyPoolCreationFee = []byte("PoolCreationFee")
+)
+
+// ParamKeyTable for the cosmwasmpool module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(codeIdWhitelist []uint64, poolCreationFee sdk.Coins) Params {
+	return Params{
+		CodeIdWhitelist: codeIdWhitelist,
+		PoolCreationFee: poolCreationFee,
+	}
+}
+
+// DefaultParams are the default cosmwasmpool module parameters. No code is
+// whitelisted until governance whitelists it.
+func DefaultParams() Params {
+	return Params{
+		CodeIdWhitelist: []uint64{},
+		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+	}
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateCodeIdWhitelist(p.CodeIdWhitelist); err != nil {
+		return err
+	}
+	return validatePoolCreationFee(p.PoolCreationFee)
+}
+
+// IsCodeIdWhitelisted returns whether pools can be instantiated from the code
+// with the given id.
+func (p Params) IsCodeIdWhitelisted(codeId uint64) bool {
+	for _, whitelistedCodeId := range p.CodeIdWhitelist {
+		if whitelistedCodeId == codeId {
+			return true
+		}
+	}
+	return false
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyCodeIdWhitelist, &p.CodeIdWhitelist, validateCodeIdWhitelist),
+		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+	}
+}
+
+func validateCodeIdWhitelist(i interface{}) error {
+	v, ok := i.([]uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	seen := make(map[uint64]bool, len(v))
+	for _, codeId := range v {
+		if codeId == 0 {
+			return fmt.Errorf("invalid code id: %d", codeId)
+		}
+		if seen[codeId] {
+			return fmt.Errorf("duplicate code id: %d", codeId)
+		}
+		seen[codeId] = true
+	}
+	return nil
+}
+
+func validatePoolCreationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.Validate() != nil {
+		return fmt.Errorf("invalid pool creation fee: %+v", i)
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ swaproutertypes.PoolI = &Pool{}
+
+// Pool is a cosmwasm pool. Its methods which depend on the pool's state query
+// its contract.
+type Pool struct {
+	CosmWasmPool
+	WasmKeeper WasmKeeper
+}
+
+// NewCosmWasmPool returns the model of a pool whose contract is yet to be
+// instantiated.
+func NewCosmWasmPool(poolId, codeId uint64, instantiateMsg []byte) CosmWasmPool {
+	return CosmWasmPool{
+		PoolId:         poolId,
+		CodeId:         codeId,
+		InstantiateMsg: instantiateMsg,
+	}
+}
+
+// Validate returns an error if the pool's contract is not instantiated, or
+// its ids are not set.
+func (p CosmWasmPool) Validate() error {
+	if p.PoolId == 0 {
+		return fmt.Errorf("pool id must be set")
+	}
+	if p.CodeId == 0 {
+		return fmt.Errorf("pool (%d) code id must be set", p.PoolId)
+	}
+	if _, err := sdk.AccAddressFromBech32(p.ContractAddress); err != nil {
+		return fmt.Errorf("pool (%d) contract address is invalid: %w", p.PoolId, err)
+	}
+	return nil
+}
+
+// GetAddress returns the address of the pool's contract, which holds its
+// tokens.
+func (p Pool) GetAddress() sdk.AccAddress {
+	return sdk.MustAccAddressFromBech32(p.ContractAddress)
+}
+
+func (p Pool) GetId() uint64 {
+	return p.PoolId
+}
+
+func (p Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.CosmWasm
+}
+
+// GetSwapFee returns the swap fee of the pool's contract, or zero if it cannot
+// be queried.
+func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
+	res := GetSwapFeeResponse{}
+	if err := p.Query(ctx, QueryMsg{GetSwapFee: &EmptyStruct{}}, &res); err != nil || res.SwapFee.IsNil() {
+		return sdk.ZeroDec()
+	}
+	return res.SwapFee
+}
+
+// GetExitFee returns zero. Any fee on exits is the contract's.
+func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
+	return sdk.ZeroDec()
+}
+
+// IsActive returns whether the pool's contract has swaps enabled. A contract
+// which cannot be queried is inactive.
+func (p Pool) IsActive(ctx sdk.Context) bool {
+	res := IsActiveResponse{}
+	if err := p.Query(ctx, QueryMsg{IsActive: &EmptyStruct{}}, &res); err != nil {
+		return false
+	}
+	return res.IsActive
+}
+
+// GetTotalShares returns zero, as the shares of cosmwasm pools are accounted
+// by their contract.
+func (p Pool) GetTotalShares() sdk.Int {
+	return sdk.ZeroInt()
+}
+
+// GetTotalPoolLiquidity returns the liquidity of the pool's contract, or nil
+// if it cannot be queried.
+func (p Pool) GetTotalPoolLiquidity(ctx sdk.Context) sdk.Coins {
+	res := GetTotalPoolLiquidityResponse{}
+	if err := p.Query(ctx, QueryMsg{GetTotalPoolLiquidity: &EmptyStruct{}}, &res); err != nil {
+		return nil
+	}
+	return res.TotalPoolLiquidity
+}
+
+// SpotPrice returns the price of the quote asset in units of the base asset,
+// as computed by the pool's contract.
+func (p Pool) SpotPrice(ctx sdk.Context, baseAssetDenom string, quoteAssetDenom string) (sdk.Dec, error) {
+	res := SpotPriceResponse{}
+	req := QueryMsg{SpotPrice: &SpotPrice{BaseAssetDenom: baseAssetDenom, QuoteAssetDenom: quoteAssetDenom}}
+	if err := p.Query(ctx, req, &res); err != nil {
+		return sdk.Dec{}, err
+	}
+	return res.SpotPrice, nil
+}
+
+// Query sends the smart query to the pool's contract, and unmarshals its
+// response into res.
+func (p Pool) Query(ctx sdk.Context, req QueryMsg, res interface{}) error {
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+	resBz, err := p.WasmKeeper.QuerySmart(ctx, p.GetAddress(), bz)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(resBz, res)
+}
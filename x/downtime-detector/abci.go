@@ -0,0 +1,38 @@
+package downtimedetector
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// BeginBlock records the downtime between the last block and the current one,
+// for every tracked downtime duration that it exceeds, and then stores the
+// current block time as the last block time.
+func (k Keeper) BeginBlock(ctx sdk.Context) {
+	curTime := ctx.BlockTime()
+	lastBlockTime, err := k.GetLastBlockTime(ctx)
+	if err != nil {
+		ctx.Logger().Error("Downtime-detector, could not get last block time, did initialization happen correctly. " + err.Error())
+		k.StoreLastBlockTime(ctx, curTime)
+		return
+	}
+
+	downtime := curTime.Sub(lastBlockTime)
+	k.saveDowntimeUpdates(ctx, downtime)
+	k.StoreLastBlockTime(ctx, curTime)
+}
+
+// saveDowntimeUpdates sets the current block time as the last downtime
+// for every tracked downtime duration that is at most the observed downtime.
+func (k Keeper) saveDowntimeUpdates(ctx sdk.Context, downtime time.Duration) {
+	for _, downtimeDur := range types.DowntimeDurations {
+		// DowntimeDurations is sorted in increasing order.
+		if downtime < downtimeDur {
+			break
+		}
+		k.storeLastDowntimeOfLength(ctx, downtimeDur, ctx.BlockTime())
+	}
+}
@@ -0,0 +1,124 @@
+package downtimedetector_test
+
+import (
+	"time"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+type blockEvent struct {
+	// time since the previous block (or since baseTime for the first block)
+	blockGap time.Duration
+}
+
+type recoveryQuery struct {
+	downtime time.Duration
+	recovery time.Duration
+
+	expectedRecovered bool
+	expectErr         bool
+}
+
+func (s *KeeperTestSuite) TestBeginBlockAndRecoveredSinceDowntimeOfLength() {
+	tests := map[string]struct {
+		blocks  []blockEvent
+		queries []recoveryQuery
+	}{
+		"no downtime, everything is recovered": {
+			blocks: []blockEvent{{5 * time.Second}, {5 * time.Second}},
+			queries: []recoveryQuery{
+				{downtime: 30 * time.Second, recovery: time.Hour, expectedRecovered: true},
+				{downtime: 48 * time.Hour, recovery: 0, expectedRecovered: true},
+			},
+		},
+		"1 min downtime in the last block": {
+			blocks: []blockEvent{{5 * time.Second}, {time.Minute}},
+			queries: []recoveryQuery{
+				{downtime: 30 * time.Second, recovery: time.Second, expectedRecovered: false},
+				{downtime: time.Minute, recovery: time.Second, expectedRecovered: false},
+				{downtime: time.Minute, recovery: 0, expectedRecovered: true},
+				{downtime: 2 * time.Minute, recovery: time.Hour, expectedRecovered: true},
+			},
+		},
+		"10 min downtime, then 10 minutes of blocks": {
+			blocks: []blockEvent{{10 * time.Minute}, {5 * time.Minute}, {5 * time.Minute}},
+			queries: []recoveryQuery{
+				{downtime: 10 * time.Minute, recovery: 9 * time.Minute, expectedRecovered: true},
+				{downtime: 10 * time.Minute, recovery: 10 * time.Minute, expectedRecovered: true},
+				{downtime: 10 * time.Minute, recovery: 11 * time.Minute, expectedRecovered: false},
+				// the 5 minute gaps are also downtimes of 5 minutes
+				{downtime: 5 * time.Minute, recovery: time.Minute, expectedRecovered: false},
+				{downtime: 20 * time.Minute, recovery: time.Second, expectedRecovered: true},
+			},
+		},
+		"unsupported downtime duration": {
+			blocks: []blockEvent{{5 * time.Second}},
+			queries: []recoveryQuery{
+				{downtime: 7 * time.Minute, recovery: time.Second, expectErr: true},
+			},
+		},
+		"negative recovery duration": {
+			blocks: []blockEvent{{5 * time.Second}},
+			queries: []recoveryQuery{
+				{downtime: time.Minute, recovery: -time.Second, expectErr: true},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			curTime := baseTime
+			for _, block := range tc.blocks {
+				curTime = curTime.Add(block.blockGap)
+				s.Ctx = s.Ctx.WithBlockTime(curTime)
+				s.keeper.BeginBlock(s.Ctx)
+
+				lastBlockTime, err := s.keeper.GetLastBlockTime(s.Ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(curTime, lastBlockTime)
+			}
+
+			for _, query := range tc.queries {
+				recovered, err := s.keeper.RecoveredSinceDowntimeOfLength(s.Ctx, query.downtime, query.recovery)
+				if query.expectErr {
+					s.Require().Error(err)
+					continue
+				}
+				s.Require().NoError(err)
+				s.Require().Equal(query.expectedRecovered, recovered, "downtime %s, recovery %s", query.downtime, query.recovery)
+			}
+		})
+	}
+}
+
+func (s *KeeperTestSuite) TestImportExportGenesis() {
+	s.SetupTest()
+	// downtime of 1 hour, then a regular block
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Hour))
+	s.keeper.BeginBlock(s.Ctx)
+	s.Ctx = s.Ctx.WithBlockTime(baseTime.Add(time.Hour + 5*time.Second))
+	s.keeper.BeginBlock(s.Ctx)
+
+	genesis := s.keeper.ExportGenesis(s.Ctx)
+	s.Require().Equal(baseTime.Add(time.Hour+5*time.Second), genesis.LastBlockTime)
+	// every tracked duration up to and including 1 hour has an entry.
+	s.Require().Len(genesis.Downtimes, 12)
+	for _, entry := range genesis.Downtimes {
+		s.Require().LessOrEqual(entry.DowntimeDuration, time.Hour)
+		s.Require().Equal(baseTime.Add(time.Hour), entry.LastDowntime)
+	}
+
+	s.SetupTest()
+	s.keeper.InitGenesis(s.Ctx, genesis)
+	s.Require().Equal(genesis, s.keeper.ExportGenesis(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestInitGenesisDefaultLastBlockTime() {
+	s.SetupTest()
+	s.keeper.InitGenesis(s.Ctx, types.DefaultGenesis())
+
+	lastBlockTime, err := s.keeper.GetLastBlockTime(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(s.Ctx.BlockTime(), lastBlockTime)
+}
@@ -0,0 +1,29 @@
+package downtimedetector
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// RecoveredSinceDowntimeOfLength returns true if it has been at least recoveryDuration
+// since the chain last recovered from a downtime of at least downtimeDuration.
+// If the chain never had such a downtime, it is considered recovered.
+// downtimeDuration must be one of types.DowntimeDurations.
+func (k Keeper) RecoveredSinceDowntimeOfLength(ctx sdk.Context, downtimeDuration time.Duration, recoveryDuration time.Duration) (bool, error) {
+	if !types.IsSupportedDowntimeDuration(downtimeDuration) {
+		return false, types.UnsupportedDowntimeDurationError{Downtime: downtimeDuration}
+	}
+	if recoveryDuration < 0 {
+		return false, fmt.Errorf("recovery duration must be non-negative, got %s", recoveryDuration)
+	}
+
+	lastDowntime, found := k.getLastDowntimeOfLength(ctx, downtimeDuration)
+	if !found {
+		return true, nil
+	}
+	return !ctx.BlockTime().Before(lastDowntime.Add(recoveryDuration)), nil
+}
@@ -0,0 +1,33 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		GetCmdRecoveredSinceDowntimeOfLength(),
+		osmocli.GetParams[*queryproto.ParamsRequest](types.ModuleName, queryproto.NewQueryClient),
+	)
+	return cmd
+}
+
+// GetCmdRecoveredSinceDowntimeOfLength returns whether the chain has been up for
+// the recovery duration since the last downtime of the given length.
+func GetCmdRecoveredSinceDowntimeOfLength() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.RecoveredSinceDowntimeOfLengthRequest](
+		"recovered-since-downtime-of-length [downtime-duration] [recovery-duration]",
+		"Queries if it has been at least [recovery-duration] since the chain was down for [downtime-duration]",
+		`{{.Short}}
+[downtime-duration] must be one of: 30s, 1m, 2m, 3m, 4m, 5m, 10m, 20m, 30m, 40m, 50m, 1h, 1h30m, 2h, 2h30m, 3h, 4h, 5h, 6h, 9h, 12h, 18h, 24h, 36h, 48h{{.ExampleHeader}}
+{{.CommandPrefix}} recovered-since-downtime-of-length 1m 10m
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
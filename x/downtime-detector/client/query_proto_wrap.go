@@ -0,0 +1,35 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	downtimedetector "github.com/osmosis-labs/osmosis/v13/x/downtime-detector"
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/client/queryproto"
+)
+
+type Querier struct {
+	K downtimedetector.Keeper
+}
+
+func NewQuerier(k downtimedetector.Keeper) Querier {
+	return Querier{k}
+}
+
+func (q Querier) RecoveredSinceDowntimeOfLength(ctx sdk.Context,
+	req queryproto.RecoveredSinceDowntimeOfLengthRequest,
+) (*queryproto.RecoveredSinceDowntimeOfLengthResponse, error) {
+	val, err := q.K.RecoveredSinceDowntimeOfLength(ctx, req.Downtime, req.Recovery)
+	if err != nil {
+		return nil, err
+	}
+	return &queryproto.RecoveredSinceDowntimeOfLengthResponse{
+		SuccesfullyRecovered: val,
+	}, nil
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	req queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
@@ -40,7 +40,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Query for has it been at least $RECOVERY_DURATION units of time,
 // since the chain has been down for $DOWNTIME_DURATION.
-// Note: $DOWNTIME_DURATION must be in set {SPECIFY_SET}
+// Note: $DOWNTIME_DURATION must be in set {30s, 1m, 2m, 3m, 4m, 5m, 10m, 20m,
+// 30m, 40m, 50m, 1h, 1.5h, 2h, 2.5h, 3h, 4h, 5h, 6h, 9h, 12h, 18h, 24h, 36h,
+// 48h}
 type RecoveredSinceDowntimeOfLengthRequest struct {
 	Downtime time.Duration `protobuf:"bytes,1,opt,name=downtime,proto3,stdduration" json:"downtime" yaml:"downtime_duration"`
 	Recovery time.Duration `protobuf:"bytes,2,opt,name=recovery,proto3,stdduration" json:"recovery" yaml:"recovery_duration"`
@@ -231,7 +233,7 @@ func init() {
 }
 
 var fileDescriptor_b748b3d07fa8b8cb = []byte{
-	// 527 bytes of a gzipped FileDescriptorProto
+	// 524 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0x4f, 0x8b, 0xd3, 0x4c,
 	0x18, 0x6f, 0xf6, 0x7d, 0x2d, 0xcb, 0x88, 0x0a, 0x61, 0x85, 0x6e, 0x91, 0x74, 0x09, 0x2a, 0xab,
 	0xd2, 0x8c, 0xdd, 0xde, 0xbc, 0x59, 0x17, 0x75, 0x41, 0x50, 0xe3, 0x45, 0x14, 0x29, 0x93, 0xe9,
@@ -264,7 +266,7 @@ var fileDescriptor_b748b3d07fa8b8cb = []byte{
 	0xdb, 0xdc, 0xb1, 0xde, 0x2d, 0x9c, 0xd6, 0xc9, 0xc2, 0x69, 0x7d, 0x59, 0x38, 0xad, 0x17, 0x07,
 	0x61, 0x24, 0x0f, 0xf3, 0xc0, 0xa3, 0x3c, 0x31, 0x36, 0xfd, 0x98, 0x04, 0xa2, 0xf6, 0x9c, 0x0d,
 	0x86, 0xf8, 0xcd, 0x1f, 0x9c, 0x69, 0x1c, 0x01, 0x93, 0xea, 0x3b, 0xa3, 0x5e, 0x8b, 0x76, 0xf5,
-	0x33, 0xfc, 0x1d, 0x00, 0x00, 0xff, 0xff, 0xb1, 0x09, 0x15, 0x2a, 0x40, 0x05, 0x00, 0x00,
+	0x33, 0xfc, 0x3d, 0x00, 0xb1, 0x09, 0x15, 0x2a, 0x40, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -0,0 +1,52 @@
+package downtimedetector
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// InitGenesis initializes the downtime-detector module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	// A genesis without a last block time is a fresh chain, which has not been down.
+	// So we count the genesis block time as the last block time.
+	lastBlockTime := genState.LastBlockTime
+	if lastBlockTime.IsZero() {
+		lastBlockTime = ctx.BlockTime()
+	}
+	k.StoreLastBlockTime(ctx, lastBlockTime)
+
+	for _, downtime := range genState.Downtimes {
+		k.storeLastDowntimeOfLength(ctx, downtime.DowntimeDuration, downtime.LastDowntime)
+	}
+
+	k.SetParams(ctx, genState.Params)
+}
+
+// ExportGenesis returns the downtime-detector module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	downtimes := []types.GenesisDowntimeEntry{}
+	for _, downtimeDur := range types.DowntimeDurations {
+		lastDowntime, found := k.getLastDowntimeOfLength(ctx, downtimeDur)
+		if !found {
+			continue
+		}
+		downtimes = append(downtimes, types.NewGenesisDowntimeEntry(downtimeDur, lastDowntime))
+	}
+
+	lastBlockTime, err := k.GetLastBlockTime(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return &types.GenesisState{
+		Downtimes:     downtimes,
+		LastBlockTime: lastBlockTime,
+		Params:        k.GetParams(ctx),
+	}
+}
@@ -16,37 +16,20 @@ type Keeper struct {
 
 func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace) *Keeper {
 	// set KeyTable if it has not already been set
-	// if !paramSpace.HasKeyTable() {
-	// 	paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
-	// }
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
 
 	return &Keeper{storeKey: storeKey, paramSpace: paramSpace}
 }
 
-// GetParams returns the total set of twap parameters.
-// func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
-// 	k.paramSpace.GetParamSet(ctx, &params)
-// 	return params
-// }
-
-// // SetParams sets the total set of twap parameters.
-// func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
-// 	k.paramSpace.SetParamSet(ctx, &params)
-// }
-
-// InitGenesis initializes the twap module's state from a provided genesis
-// state.
-func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
-	if err := genState.Validate(); err != nil {
-		panic(err)
-	}
-
-	// k.SetParams(ctx, genState.Params)
+// GetParams returns the total set of downtime-detector parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
 }
 
-// ExportGenesis returns the twap module's exported genesis.
-func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
-	return &types.GenesisState{
-		// Params: k.GetParams(ctx),
-	}
+// SetParams sets the total set of downtime-detector parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
 }
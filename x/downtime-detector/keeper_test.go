@@ -0,0 +1,29 @@
+package downtimedetector_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	downtimedetector "github.com/osmosis-labs/osmosis/v13/x/downtime-detector"
+)
+
+var baseTime = time.Unix(1257894000, 0).UTC()
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+	keeper *downtimedetector.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.keeper = s.App.DowntimeKeeper
+	s.Ctx = s.Ctx.WithBlockTime(baseTime)
+	s.keeper.StoreLastBlockTime(s.Ctx, baseTime)
+}
@@ -0,0 +1,50 @@
+package downtimedetector
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+// StoreLastBlockTime stores the provided time as the last block time.
+func (k Keeper) StoreLastBlockTime(ctx sdk.Context, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastBlockTimestampKey(), sdk.FormatTimeBytes(t))
+}
+
+// GetLastBlockTime returns the time of the last block processed by the module.
+func (k Keeper) GetLastBlockTime(ctx sdk.Context) (time.Time, error) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastBlockTimestampKey())
+	if bz == nil {
+		return time.Time{}, errors.New("no last block time stored in state. Should not happen, did initialization happen correctly?")
+	}
+	return sdk.ParseTimeBytes(bz)
+}
+
+// storeLastDowntimeOfLength stores t as the last time that the chain
+// recovered from a downtime of at least downtimeDur.
+func (k Keeper) storeLastDowntimeOfLength(ctx sdk.Context, downtimeDur time.Duration, t time.Time) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetLastDowntimeOfLengthKey(downtimeDur), sdk.FormatTimeBytes(t))
+}
+
+// getLastDowntimeOfLength returns the last time that the chain recovered
+// from a downtime of at least downtimeDur.
+// found is false if the chain never experienced such a downtime.
+func (k Keeper) getLastDowntimeOfLength(ctx sdk.Context, downtimeDur time.Duration) (t time.Time, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetLastDowntimeOfLengthKey(downtimeDur))
+	if bz == nil {
+		return time.Time{}, false
+	}
+	t, err := sdk.ParseTimeBytes(bz)
+	// Only this module writes to this key, so the value is always a valid time.
+	if err != nil {
+		panic(err)
+	}
+	return t, true
+}
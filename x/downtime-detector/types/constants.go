@@ -1,9 +1,54 @@
 package types
 
+import (
+	"time"
+)
+
 const (
-	ModuleName = "downtime-detector"
+	ModuleName = "downtimedetector"
 	StoreKey   = ModuleName
 	RouterKey  = ModuleName
 
 	QuerierRoute = ModuleName
 )
+
+// DowntimeDurations are the only downtime lengths that are tracked by the module,
+// and thus the only ones that can be queried. They are sorted in increasing order.
+var DowntimeDurations = []time.Duration{
+	30 * time.Second,
+	1 * time.Minute,
+	2 * time.Minute,
+	3 * time.Minute,
+	4 * time.Minute,
+	5 * time.Minute,
+	10 * time.Minute,
+	20 * time.Minute,
+	30 * time.Minute,
+	40 * time.Minute,
+	50 * time.Minute,
+	1 * time.Hour,
+	90 * time.Minute,
+	2 * time.Hour,
+	150 * time.Minute,
+	3 * time.Hour,
+	4 * time.Hour,
+	5 * time.Hour,
+	6 * time.Hour,
+	9 * time.Hour,
+	12 * time.Hour,
+	18 * time.Hour,
+	24 * time.Hour,
+	36 * time.Hour,
+	48 * time.Hour,
+}
+
+// IsSupportedDowntimeDuration returns true iff the provided duration
+// is one of the DowntimeDurations tracked by the module.
+func IsSupportedDowntimeDuration(downtime time.Duration) bool {
+	for _, d := range DowntimeDurations {
+		if d == downtime {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+type UnsupportedDowntimeDurationError struct {
+	Downtime time.Duration
+}
+
+func (e UnsupportedDowntimeDurationError) Error() string {
+	return fmt.Sprintf("downtime duration %s is not supported, must be one of %v", e.Downtime, DowntimeDurations)
+}
@@ -1,9 +1,32 @@
 package types
 
+import (
+	"fmt"
+	"time"
+)
+
 func DefaultGenesis() *GenesisState {
-	return &GenesisState{}
+	return &GenesisState{
+		Downtimes:     []GenesisDowntimeEntry{},
+		LastBlockTime: time.Time{},
+		Params:        DefaultParams(),
+	}
 }
 
 func (g *GenesisState) Validate() error {
-	return nil
+	seen := make(map[time.Duration]bool, len(g.Downtimes))
+	for _, entry := range g.Downtimes {
+		if !IsSupportedDowntimeDuration(entry.DowntimeDuration) {
+			return UnsupportedDowntimeDurationError{Downtime: entry.DowntimeDuration}
+		}
+		if seen[entry.DowntimeDuration] {
+			return fmt.Errorf("duplicate genesis entry for downtime duration %s", entry.DowntimeDuration)
+		}
+		seen[entry.DowntimeDuration] = true
+	}
+	return g.Params.Validate()
+}
+
+func NewGenesisDowntimeEntry(downtimeDur time.Duration, lastDowntime time.Time) GenesisDowntimeEntry {
+	return GenesisDowntimeEntry{DowntimeDuration: downtimeDur, LastDowntime: lastDowntime}
 }
@@ -0,0 +1,59 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/osmosis-labs/osmosis/v13/x/downtime-detector/types"
+)
+
+func TestGenesisValidate(t *testing.T) {
+	baseTime := time.Unix(1257894000, 0).UTC()
+	tests := map[string]struct {
+		genesis   *types.GenesisState
+		expectErr bool
+	}{
+		"default genesis": {
+			genesis: types.DefaultGenesis(),
+		},
+		"supported downtimes": {
+			genesis: &types.GenesisState{
+				Downtimes: []types.GenesisDowntimeEntry{
+					types.NewGenesisDowntimeEntry(30*time.Second, baseTime),
+					types.NewGenesisDowntimeEntry(90*time.Minute, baseTime),
+				},
+				LastBlockTime: baseTime,
+			},
+		},
+		"unsupported downtime": {
+			genesis: &types.GenesisState{
+				Downtimes: []types.GenesisDowntimeEntry{
+					types.NewGenesisDowntimeEntry(7*time.Minute, baseTime),
+				},
+			},
+			expectErr: true,
+		},
+		"duplicate downtime": {
+			genesis: &types.GenesisState{
+				Downtimes: []types.GenesisDowntimeEntry{
+					types.NewGenesisDowntimeEntry(time.Minute, baseTime),
+					types.NewGenesisDowntimeEntry(time.Minute, baseTime.Add(time.Hour)),
+				},
+			},
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+var (
+	lastBlockTimestampKey      = []byte("last_block_timestamp")
+	lastDowntimeOfLengthPrefix = "last_downtime_of_length"
+)
+
+func GetLastBlockTimestampKey() []byte {
+	return lastBlockTimestampKey
+}
+
+// GetLastDowntimeOfLengthKey returns the key under which the last time
+// a downtime of (at least) the provided length ended is stored.
+func GetLastDowntimeOfLengthKey(downtimeDur time.Duration) []byte {
+	return []byte(fmt.Sprintf("%s/%s", lastDowntimeOfLengthPrefix, downtimeDur))
+}
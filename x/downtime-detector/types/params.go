@@ -0,0 +1,29 @@
+package types
+
+import (
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// ParamKeyTable for the downtime-detector module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams() Params {
+	return Params{}
+}
+
+// DefaultParams returns the default parameters for the module.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	return nil
+}
+
+// ParamSetPairs implements params.ParamSet. The module currently has no parameters.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{}
+}
@@ -329,7 +329,7 @@ func (s *IntegrationTestSuite) TestNewCreatePoolCmd() {
 				fmt.Sprintf("--%s=true", flags.FlagSkipConfirmation),
 				fmt.Sprintf("--%s=%s", flags.FlagBroadcastMode, flags.BroadcastBlock),
 				osmoutils.DefaultFeeString(s.cfg),
-				fmt.Sprintf("--%s=%s", flags.FlagGas, fmt.Sprint(300000)),
+				fmt.Sprintf("--%s=%s", flags.FlagGas, fmt.Sprint(350000)),
 			}
 
 			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, args)
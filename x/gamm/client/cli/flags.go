@@ -40,6 +40,21 @@ const (
 	FlagSwapRouteDenoms = "swap-route-denoms"
 	// FlagScalingFactors represents the flag name for the scaling factors.
 	FlagScalingFactors = "scaling-factors"
+
+	// Will be parsed to []sdk.DecCoin.
+	FlagTargetPoolWeights = "target-pool-weights"
+	// Will be parsed to time.Duration.
+	FlagWeightChangeDuration = "weight-change-duration"
+	// Will be parsed to time.Time in RFC3339 format.
+	FlagWeightChangeStartTime = "weight-change-start-time"
+
+	// Will be parsed to time.Duration.
+	FlagRampDuration = "ramp-duration"
+	// Will be parsed to time.Time in RFC3339 format.
+	FlagRampStartTime = "ramp-start-time"
+
+	// Will be parsed to uint64.
+	FlagLockId = "lock-id"
 )
 
 type createBalancerPoolInputs struct {
@@ -117,8 +132,30 @@ func FlagSetJustPoolId() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetMigrateShares() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.Uint64(FlagLockId, 0, "The id of the lock to migrate the shares out of, if they are locked")
+	return fs
+}
+
 func FlagSetAdjustScalingFactors() *flag.FlagSet {
 	fs := FlagSetJustPoolId()
 	fs.String(FlagScalingFactors, "", "The scaling factors")
 	return fs
 }
+
+func FlagSetRampScalingFactors() *flag.FlagSet {
+	fs := FlagSetAdjustScalingFactors()
+	fs.String(FlagRampDuration, "", "The duration of the ramp, e.g. 72h")
+	fs.String(FlagRampStartTime, "", "The start time of the ramp in RFC3339 format, defaults to the block time")
+	return fs
+}
+
+func FlagSetUpdatePoolParams() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagTargetPoolWeights, "", "The target weights of a balancer pool weight change, e.g. 2uatom,1uosmo")
+	fs.String(FlagWeightChangeDuration, "", "The duration of the weight change, e.g. 72h")
+	fs.String(FlagWeightChangeStartTime, "", "The start time of the weight change in RFC3339 format, defaults to the block time")
+	return fs
+}
@@ -18,6 +18,7 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
@@ -38,6 +39,11 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdTotalPoolLiquidity(),
 		GetCmdQueryPoolsWithFilter(),
 		GetCmdPoolType(),
+		GetCmdWeightSchedule(),
+		GetCmdScalingFactorRamp(),
+		GetCmdProtocolRevenue(),
+		GetCmdPoolProtocolRevenue(),
+		GetCmdEffectiveSwapFee(),
 	)
 
 	return cmd
@@ -192,6 +198,42 @@ Example:
 	)
 }
 
+func GetCmdProtocolRevenue() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryProtocolRevenueRequest](
+		"protocol-revenue",
+		"Query protocol-revenue",
+		`Query the protocol's take of swap fees collected across all pools.
+Example:
+{{.CommandPrefix}} protocol-revenue
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdPoolProtocolRevenue() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryPoolProtocolRevenueRequest](
+		"pool-protocol-revenue [poolID]",
+		"Query pool-protocol-revenue",
+		`Query the protocol's take of swap fees collected from a pool.
+Example:
+{{.CommandPrefix}} pool-protocol-revenue 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
+func GetCmdEffectiveSwapFee() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.QueryEffectiveSwapFeeRequest](
+		"effective-swap-fee [poolID]",
+		"Query effective-swap-fee",
+		`Query the swap fee currently charged by a pool, and its dynamic swap fee params if it has any.
+Example:
+{{.CommandPrefix}} effective-swap-fee 1
+`,
+		types.ModuleName, types.NewQueryClient,
+	)
+}
+
 func GetCmdSpotPrice() *cobra.Command {
 	//nolint:staticcheck
 	return osmocli.SimpleQueryCmd[*types.QuerySpotPriceRequest](
@@ -385,3 +427,29 @@ Example:
 		types.ModuleName, types.NewQueryClient,
 	)
 }
+
+// GetCmdWeightSchedule returns the current weights and weight change of a balancer pool.
+func GetCmdWeightSchedule() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*balancer.QueryWeightScheduleRequest](
+		"weight-schedule [poolID]",
+		"Query the current weights and scheduled weight change of a balancer pool",
+		`Query the current weights and scheduled weight change of a balancer pool.
+Example:
+{{.CommandPrefix}} weight-schedule 1
+`,
+		types.ModuleName, balancer.NewQueryClient,
+	)
+}
+
+// GetCmdScalingFactorRamp returns the current scaling factors and scaling factor ramp of a stableswap pool.
+func GetCmdScalingFactorRamp() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*stableswap.QueryScalingFactorRampRequest](
+		"scaling-factor-ramp [poolID]",
+		"Query the current scaling factors and scaling factor ramp of a stableswap pool",
+		`Query the current scaling factors and scaling factor ramp of a stableswap pool.
+Example:
+{{.CommandPrefix}} scaling-factor-ramp 1
+`,
+		types.ModuleName, stableswap.NewQueryClient,
+	)
+}
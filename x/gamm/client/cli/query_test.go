@@ -8,6 +8,8 @@ import (
 	"github.com/stretchr/testify/suite"
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
@@ -19,8 +21,9 @@ type QueryTestSuite struct {
 func (s *QueryTestSuite) SetupSuite() {
 	s.Setup()
 	s.queryClient = types.NewQueryClient(s.QueryHelper)
-	// create a new pool
+	// create new pools
 	s.PrepareBalancerPool()
+	s.PrepareBasicStableswapPool()
 	s.Commit()
 }
 
@@ -56,6 +59,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryPoolRequest{PoolId: 1},
 			&types.QueryPoolsResponse{},
 		},
+		{
+			"Query effective swap fee",
+			"/osmosis.gamm.v1beta1.Query/EffectiveSwapFee",
+			&types.QueryEffectiveSwapFeeRequest{PoolId: 1},
+			&types.QueryEffectiveSwapFeeResponse{},
+		},
 		{
 			"Query num pools",
 			"/osmosis.gamm.v1beta1.Query/NumPools",
@@ -116,6 +125,18 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.QueryCalcExitPoolCoinsFromSharesRequest{PoolId: 1, ShareInAmount: sdk.OneInt()},
 			&types.QueryCalcExitPoolCoinsFromSharesResponse{},
 		},
+		{
+			"Query weight schedule",
+			"/osmosis.gamm.poolmodels.balancer.v1beta1.Query/WeightSchedule",
+			&balancer.QueryWeightScheduleRequest{PoolId: 1},
+			&balancer.QueryWeightScheduleResponse{},
+		},
+		{
+			"Query scaling factor ramp",
+			"/osmosis.gamm.poolmodels.stableswap.v1beta1.Query/ScalingFactorRamp",
+			&stableswap.QueryScalingFactorRampRequest{PoolId: 2},
+			&stableswap.QueryScalingFactorRampResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -41,6 +41,13 @@ func NewTxCmd() *cobra.Command {
 		NewExitSwapExternAmountOut(),
 		NewExitSwapShareAmountIn(),
 		NewStableSwapAdjustScalingFactorsCmd(),
+		NewStableSwapRampScalingFactorsCmd(),
+		NewUpdatePoolParamsCmd(),
+		NewScheduleWeightChangeCmd(),
+		NewSetDynamicSwapFeeCmd(),
+		NewRemoveDynamicSwapFeeCmd(),
+		NewMigrateSharesCmd(),
+		NewSetPoolFrozenCmd(),
 	)
 
 	return txCmd
@@ -220,6 +227,21 @@ func NewExitSwapShareAmountIn() *cobra.Command {
 	return cmd
 }
 
+func NewMigrateSharesCmd() *cobra.Command {
+	cmd := osmocli.BuildTxCli[*types.MsgMigrateShares](&osmocli.TxCliDesc{
+		Use:   "migrate-shares [pool-id-leaving] [pool-id-entering] [share-in-amount] [share-out-min-amount]",
+		Short: "migrate shares from one pool to another of the same denoms",
+		Long: `Exit a pool and join another pool of the same denoms with the tokens exited, in a single step.
+If the shares are locked, the lock they are taken out of is given with --lock-id, and the shares joined
+are locked for the lock's remaining duration.`,
+		Example:             "osmosisd tx gamm migrate-shares 1 2 1000000000000000000 1 --lock-id 5",
+		CustomFlagOverrides: map[string]string{"lockid": FlagLockId},
+	})
+
+	cmd.Flags().AddFlagSet(FlagSetMigrateShares())
+	return cmd
+}
+
 // TODO: Change these flags to args. Required flags don't make that much sense.
 func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	cmd := osmocli.TxCliDesc{
@@ -236,6 +258,89 @@ func NewStableSwapAdjustScalingFactorsCmd() *cobra.Command {
 	return cmd
 }
 
+func NewStableSwapRampScalingFactorsCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:              "ramp-scaling-factors --pool-id=[pool-id] --scaling-factors=[scaling-factors] --ramp-duration=[duration]",
+		Short:            "linearly ramp scaling factors to new values",
+		Example:          "osmosisd ramp-scaling-factors --pool-id=1 --scaling-factors=\"100,101\" --ramp-duration=72h",
+		NumArgs:          0,
+		ParseAndBuildMsg: NewStableSwapRampScalingFactorsMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetRampScalingFactors())
+	_ = cmd.MarkFlagRequired(FlagPoolId)
+	_ = cmd.MarkFlagRequired(FlagScalingFactors)
+	_ = cmd.MarkFlagRequired(FlagRampDuration)
+	return cmd
+}
+
+func NewUpdatePoolParamsCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:   "update-pool-params [pool-id] [swap-fee] [exit-fee]",
+		Short: "update the fees and weight change of a pool, signed by its future governor",
+		Long: `Update the swap fee and exit fee of a pool. The transaction must be signed by the pool's future governor.
+For balancer pools, a new weight change can be scheduled with --target-pool-weights and --weight-change-duration.
+It starts from the pool's current weights. Omitting it freezes the pool at its current weights.`,
+		Example:          "osmosisd tx gamm update-pool-params 1 0.002 0 --target-pool-weights=2uatom,1uosmo --weight-change-duration=72h",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewBuildUpdatePoolParamsMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().AddFlagSet(FlagSetUpdatePoolParams())
+	return cmd
+}
+
+func NewSetDynamicSwapFeeCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-dynamic-swap-fee [pool-id] [min-swap-fee] [max-swap-fee] [window] [volatility-multiplier]",
+		Short: "opt a pool into a swap fee rising with the volatility of its prices, signed by its future governor",
+		Long: `Opt a pool into a dynamic swap fee. The transaction must be signed by the pool's future governor.
+Every block, the pool's swap fee is set to its own swap fee plus the volatility of its prices over the window
+times the volatility multiplier, bounded by the min and max swap fees. The volatility is the ratio of the
+arithmetic to the geometric twap of the pool's prices, minus one.`,
+		Example:          "osmosisd tx gamm set-dynamic-swap-fee 1 0.001 0.01 1h 100",
+		NumArgs:          5,
+		ParseAndBuildMsg: NewBuildSetDynamicSwapFeeMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewRemoveDynamicSwapFeeCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "remove-dynamic-swap-fee [pool-id]",
+		Short:            "opt a pool out of its dynamic swap fee, signed by its future governor",
+		Example:          "osmosisd tx gamm remove-dynamic-swap-fee 1",
+		NumArgs:          1,
+		ParseAndBuildMsg: NewBuildRemoveDynamicSwapFeeMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewSetPoolFrozenCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-pool-frozen [pool-id] [frozen]",
+		Short: "freeze or unfreeze a pool, signed by the pool freeze admin",
+		Long: `Freeze or unfreeze a pool in an emergency. The transaction must be signed by the pool freeze admin set in the gamm params.
+A frozen pool rejects swaps and joins, but can still be exited.`,
+		Example:          "osmosisd tx gamm set-pool-frozen 1 true",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewBuildSetPoolFrozenMsg,
+	}.BuildCommandCustomFn()
+}
+
+func NewScheduleWeightChangeCmd() *cobra.Command {
+	cmd := osmocli.TxCliDesc{
+		Use:   "schedule-weight-change [pool-id] [target-pool-weights] [duration]",
+		Short: "schedule a weight change of a balancer pool, signed by its future governor",
+		Long: `Replace the weight change of a balancer pool with one that moves from the pool's current weights to the target weights over the given duration.
+The transaction must be signed by the pool's future governor. The change starts at the block time, or at --weight-change-start-time if set.`,
+		Example:          "osmosisd tx gamm schedule-weight-change 1 2uatom,1uosmo 72h --weight-change-start-time=2023-01-01T00:00:00Z",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewBuildScheduleWeightChangeMsg,
+	}.BuildCommandCustomFn()
+
+	cmd.Flags().String(FlagWeightChangeStartTime, "", "The start time of the weight change in RFC3339 format, defaults to the block time")
+	return cmd
+}
+
 func NewBuildCreateBalancerPoolMsg(clientCtx client.Context, fs *flag.FlagSet) (sdk.Msg, error) {
 	pool, err := parseCreateBalancerPoolFlags(fs)
 	if err != nil {
@@ -580,6 +685,57 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		return nil, err
 	}
 
+	scalingFactors, err := parseScalingFactorsFlag(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
+		Sender:         clientCtx.GetFromAddress().String(),
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+	}
+
+	return msg, nil
+}
+
+func NewStableSwapRampScalingFactorsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := fs.GetUint64(FlagPoolId)
+	if err != nil {
+		return nil, err
+	}
+
+	scalingFactors, err := parseScalingFactorsFlag(fs)
+	if err != nil {
+		return nil, err
+	}
+
+	durationStr, err := fs.GetString(FlagRampDuration)
+	if err != nil {
+		return nil, err
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	startTimeStr, err := fs.GetString(FlagRampStartTime)
+	if err != nil {
+		return nil, err
+	}
+	var startTime time.Time
+	if startTimeStr != "" {
+		startTime, err = time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse time: %w", err)
+		}
+	}
+
+	msg := stableswap.NewMsgStableSwapRampScalingFactors(clientCtx.GetFromAddress().String(), poolID, scalingFactors, startTime, duration)
+	return &msg, nil
+}
+
+func parseScalingFactorsFlag(fs *flag.FlagSet) ([]uint64, error) {
 	scalingFactorsStr, err := fs.GetString(FlagScalingFactors)
 	if err != nil {
 		return nil, err
@@ -596,13 +752,7 @@ func NewStableSwapAdjustScalingFactorsMsg(clientCtx client.Context, _args []stri
 		scalingFactors[i] = scalingFactor
 	}
 
-	msg := &stableswap.MsgStableSwapAdjustScalingFactors{
-		Sender:         clientCtx.GetFromAddress().String(),
-		PoolID:         poolID,
-		ScalingFactors: scalingFactors,
-	}
-
-	return msg, nil
+	return scalingFactors, nil
 }
 
 // ParseCoinsNoSort parses coins from coinsStr but does not sort them.
@@ -620,3 +770,177 @@ func ParseCoinsNoSort(coinsStr string) (sdk.Coins, error) {
 	}
 	return sdk.NormalizeCoins(decCoins), nil
 }
+
+func NewBuildUpdatePoolParamsMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	swapFee, err := sdk.NewDecFromStr(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	exitFee, err := sdk.NewDecFromStr(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	targetWeightsStr, err := fs.GetString(FlagTargetPoolWeights)
+	if err != nil {
+		return nil, err
+	}
+
+	durationStr, err := fs.GetString(FlagWeightChangeDuration)
+	if err != nil {
+		return nil, err
+	}
+
+	startTimeStr, err := fs.GetString(FlagWeightChangeStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	var smoothWeightParams *balancer.SmoothWeightChangeParams
+	if targetWeightsStr != "" || durationStr != "" || startTimeStr != "" {
+		if targetWeightsStr == "" || durationStr == "" {
+			return nil, fmt.Errorf("both --%s and --%s must be set to schedule a weight change", FlagTargetPoolWeights, FlagWeightChangeDuration)
+		}
+
+		params, err := parseWeightChange(targetWeightsStr, durationStr, startTimeStr)
+		if err != nil {
+			return nil, err
+		}
+		smoothWeightParams = &params
+	}
+
+	msg := balancer.NewMsgUpdatePoolParams(clientCtx.GetFromAddress(), poolID, swapFee, exitFee, smoothWeightParams)
+	return &msg, nil
+}
+
+func NewBuildSetDynamicSwapFeeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	minSwapFee, err := sdk.NewDecFromStr(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	maxSwapFee, err := sdk.NewDecFromStr(args[2])
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := time.ParseDuration(args[3])
+	if err != nil {
+		return nil, err
+	}
+
+	volatilityMultiplier, err := sdk.NewDecFromStr(args[4])
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDynamicSwapFee{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolId: poolID,
+		Params: &types.DynamicSwapFeeParams{
+			MinSwapFee:           minSwapFee,
+			MaxSwapFee:           maxSwapFee,
+			Window:               window,
+			VolatilityMultiplier: volatilityMultiplier,
+		},
+	}, nil
+}
+
+func NewBuildRemoveDynamicSwapFeeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetDynamicSwapFee{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolId: poolID,
+	}, nil
+}
+
+func NewBuildSetPoolFrozenMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPoolFrozen{
+		Sender: clientCtx.GetFromAddress().String(),
+		PoolId: poolID,
+		Frozen: frozen,
+	}, nil
+}
+
+func NewBuildScheduleWeightChangeMsg(clientCtx client.Context, args []string, fs *flag.FlagSet) (sdk.Msg, error) {
+	poolID, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return nil, err
+	}
+
+	startTimeStr, err := fs.GetString(FlagWeightChangeStartTime)
+	if err != nil {
+		return nil, err
+	}
+
+	smoothWeightParams, err := parseWeightChange(args[1], args[2], startTimeStr)
+	if err != nil {
+		return nil, err
+	}
+
+	msg := balancer.NewMsgScheduleWeightChange(clientCtx.GetFromAddress(), poolID, smoothWeightParams)
+	return &msg, nil
+}
+
+// parseWeightChange parses target weights such as "2uatom,1uosmo", a duration such as "72h"
+// and an optional RFC3339 start time into a weight change.
+func parseWeightChange(targetWeightsStr, durationStr, startTimeStr string) (balancer.SmoothWeightChangeParams, error) {
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, fmt.Errorf("could not parse duration: %w", err)
+	}
+
+	targetPoolAssetCoins, err := sdk.ParseDecCoins(targetWeightsStr)
+	if err != nil {
+		return balancer.SmoothWeightChangeParams{}, err
+	}
+
+	targetPoolAssets := make([]balancer.PoolAsset, len(targetPoolAssetCoins))
+	for i, weight := range targetPoolAssetCoins {
+		targetPoolAssets[i] = balancer.PoolAsset{
+			Weight: weight.Amount.RoundInt(),
+			Token:  sdk.NewCoin(weight.Denom, sdk.ZeroInt()),
+		}
+	}
+
+	smoothWeightParams := balancer.SmoothWeightChangeParams{
+		Duration:          duration,
+		TargetPoolWeights: targetPoolAssets,
+	}
+
+	if startTimeStr != "" {
+		startTime, err := time.Parse(time.RFC3339, startTimeStr)
+		if err != nil {
+			return balancer.SmoothWeightChangeParams{}, fmt.Errorf("could not parse time: %w", err)
+		}
+
+		smoothWeightParams.StartTime = startTime
+	}
+
+	return smoothWeightParams, nil
+}
@@ -0,0 +1,210 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// setDynamicSwapFee opts the given pool into a dynamic swap fee of the given params,
+// or out of it if params is nil. It errors unless sender is the pool's future governor.
+func (k Keeper) setDynamicSwapFee(ctx sdk.Context, poolId uint64, sender string, params *types.DynamicSwapFeeParams) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	var futureGovernor string
+	switch pool := pool.(type) {
+	case *balancer.Pool:
+		futureGovernor = pool.FuturePoolGovernor
+	case *stableswap.Pool:
+		futureGovernor = pool.FuturePoolGovernor
+	default:
+		return fmt.Errorf("pool id %d of type %T does not support a dynamic swap fee", poolId, pool)
+	}
+	if err := k.checkFutureGovernor(ctx, poolId, futureGovernor, sender); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if params == nil {
+		store.Delete(types.GetKeyDynamicSwapFeeParams(poolId))
+		store.Delete(types.GetKeyEffectiveSwapFee(poolId))
+		return nil
+	}
+
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	k.setDynamicSwapFeeParams(ctx, poolId, *params)
+	k.updateEffectiveSwapFee(ctx, pool, *params)
+	return nil
+}
+
+func (k Keeper) setDynamicSwapFeeParams(ctx sdk.Context, poolId uint64, params types.DynamicSwapFeeParams) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.GetKeyDynamicSwapFeeParams(poolId), &params)
+}
+
+// GetDynamicSwapFeeParams returns the dynamic swap fee params of the given pool,
+// and false if it has no dynamic swap fee.
+func (k Keeper) GetDynamicSwapFeeParams(ctx sdk.Context, poolId uint64) (types.DynamicSwapFeeParams, bool) {
+	store := ctx.KVStore(k.storeKey)
+	params := types.DynamicSwapFeeParams{}
+	found, err := osmoutils.Get(store, types.GetKeyDynamicSwapFeeParams(poolId), &params)
+	if err != nil {
+		panic(err)
+	}
+	return params, found
+}
+
+// getPoolDynamicSwapFees returns the dynamic swap fee params of every pool
+// with a dynamic swap fee, ordered by pool id.
+func (k Keeper) getPoolDynamicSwapFees(ctx sdk.Context) []types.PoolDynamicSwapFee {
+	iter := k.iterator(ctx, types.KeyPrefixDynamicSwapFeeParams)
+	defer iter.Close()
+
+	poolDynamicSwapFees := []types.PoolDynamicSwapFee{}
+	for ; iter.Valid(); iter.Next() {
+		params := types.DynamicSwapFeeParams{}
+		if err := k.cdc.Unmarshal(iter.Value(), &params); err != nil {
+			panic(err)
+		}
+		poolId := sdk.BigEndianToUint64(iter.Key()[len(types.KeyPrefixDynamicSwapFeeParams):])
+		poolDynamicSwapFees = append(poolDynamicSwapFees, types.PoolDynamicSwapFee{PoolId: poolId, Params: params})
+	}
+	return poolDynamicSwapFees
+}
+
+// GetEffectiveSwapFee returns the swap fee currently charged by the given pool.
+// It is the pool's swap fee, unless the pool has a dynamic swap fee.
+func (k Keeper) GetEffectiveSwapFee(ctx sdk.Context, pool swaproutertypes.PoolI) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyEffectiveSwapFee(pool.GetId())
+	if !store.Has(key) {
+		return pool.GetSwapFee(ctx)
+	}
+	return osmoutils.MustGetDec(store, key)
+}
+
+// getSwapFeeForSwap returns the swap fee to charge a swap through the given pool,
+// for which the caller provided swapFee. If the pool has a dynamic swap fee, swapFee
+// is scaled by the ratio of the pool's effective swap fee to its swap fee, so that
+// discounts applied by the caller, e.g. to multi-hops routed through OSMO, carry over.
+func (k Keeper) getSwapFeeForSwap(ctx sdk.Context, pool swaproutertypes.PoolI, swapFee sdk.Dec) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetKeyEffectiveSwapFee(pool.GetId())
+	if !store.Has(key) {
+		return swapFee
+	}
+
+	effectiveSwapFee := osmoutils.MustGetDec(store, key)
+	poolSwapFee := pool.GetSwapFee(ctx)
+	if poolSwapFee.IsZero() {
+		return effectiveSwapFee
+	}
+	return swapFee.Mul(effectiveSwapFee).Quo(poolSwapFee)
+}
+
+// UpdateEffectiveSwapFees recomputes the effective swap fee of up to
+// MaxDynamicSwapFeeUpdatesPerBlock pools with a dynamic swap fee, from the volatility
+// of their prices over their window. It goes through the pools in order of pool id,
+// picking up where it left off in the previous block, so that every pool is updated
+// at least once every ceil(pools / MaxDynamicSwapFeeUpdatesPerBlock) blocks.
+// A pool whose update fails keeps its previous effective swap fee.
+// It is called at the beginning of every block.
+func (k Keeper) UpdateEffectiveSwapFees(ctx sdk.Context) {
+	poolDynamicSwapFees := k.getPoolDynamicSwapFeesFrom(ctx, k.getDynamicSwapFeeCursor(ctx), types.MaxDynamicSwapFeeUpdatesPerBlock)
+	for _, poolDynamicSwapFee := range poolDynamicSwapFees {
+		err := osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			pool, err := k.GetPoolAndPoke(cacheCtx, poolDynamicSwapFee.PoolId)
+			if err != nil {
+				return err
+			}
+			k.updateEffectiveSwapFee(cacheCtx, pool, poolDynamicSwapFee.Params)
+			return nil
+		})
+		if err != nil {
+			ctx.Logger().Error("Error updating the effective swap fee of pool", "pool_id", poolDynamicSwapFee.PoolId, "error", err)
+		}
+	}
+
+	if len(poolDynamicSwapFees) > 0 {
+		k.setDynamicSwapFeeCursor(ctx, poolDynamicSwapFees[len(poolDynamicSwapFees)-1].PoolId+1)
+	}
+}
+
+// getPoolDynamicSwapFeesFrom returns the dynamic swap fee params of up to limit pools
+// with a dynamic swap fee, in order of pool id from startPoolId, then wrapping around
+// to the pools before it.
+func (k Keeper) getPoolDynamicSwapFeesFrom(ctx sdk.Context, startPoolId uint64, limit int) []types.PoolDynamicSwapFee {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixDynamicSwapFeeParams)
+	startKey := sdk.Uint64ToBigEndian(startPoolId)
+
+	poolDynamicSwapFees := []types.PoolDynamicSwapFee{}
+	for _, bounds := range [][2][]byte{{startKey, nil}, {nil, startKey}} {
+		iter := store.Iterator(bounds[0], bounds[1])
+		for ; iter.Valid() && len(poolDynamicSwapFees) < limit; iter.Next() {
+			params := types.DynamicSwapFeeParams{}
+			if err := k.cdc.Unmarshal(iter.Value(), &params); err != nil {
+				panic(err)
+			}
+			poolDynamicSwapFees = append(poolDynamicSwapFees, types.PoolDynamicSwapFee{PoolId: sdk.BigEndianToUint64(iter.Key()), Params: params})
+		}
+		iter.Close()
+	}
+	return poolDynamicSwapFees
+}
+
+func (k Keeper) getDynamicSwapFeeCursor(ctx sdk.Context) uint64 {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyDynamicSwapFeeCursor)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+func (k Keeper) setDynamicSwapFeeCursor(ctx sdk.Context, poolId uint64) {
+	ctx.KVStore(k.storeKey).Set(types.KeyDynamicSwapFeeCursor, sdk.Uint64ToBigEndian(poolId))
+}
+
+func (k Keeper) updateEffectiveSwapFee(ctx sdk.Context, pool types.CFMMPoolI, params types.DynamicSwapFeeParams) {
+	volatility := k.getVolatility(ctx, pool, ctx.BlockTime().Add(-params.Window))
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSetDec(store, types.GetKeyEffectiveSwapFee(pool.GetId()), params.EffectiveSwapFee(pool.GetSwapFee(ctx), volatility))
+}
+
+// getVolatility returns the volatility of the prices of the given pool since startTime.
+// The arithmetic twap of prices exceeds their geometric twap the more they vary, so the
+// volatility is the largest ratio of the arithmetic to the geometric twap, minus one,
+// of any denom of the pool against its first denom.
+// Prices without twaps since startTime, e.g. those of pools younger than that, are skipped.
+func (k Keeper) getVolatility(ctx sdk.Context, pool types.CFMMPoolI, startTime time.Time) sdk.Dec {
+	volatility := sdk.ZeroDec()
+	if k.twapKeeper == nil {
+		return volatility
+	}
+
+	liquidity := pool.GetTotalPoolLiquidity(ctx)
+	for _, coin := range liquidity[1:] {
+		arithmeticTwap, err := k.twapKeeper.GetArithmeticTwapToNow(ctx, pool.GetId(), coin.Denom, liquidity[0].Denom, startTime)
+		if err != nil {
+			continue
+		}
+		geometricTwap, err := k.twapKeeper.GetGeometricTwapToNow(ctx, pool.GetId(), coin.Denom, liquidity[0].Denom, startTime)
+		if err != nil || !geometricTwap.IsPositive() {
+			continue
+		}
+		volatility = sdk.MaxDec(volatility, arithmeticTwap.Quo(geometricTwap).Sub(sdk.OneDec()))
+	}
+	return volatility
+}
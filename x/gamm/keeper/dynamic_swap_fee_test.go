@@ -0,0 +1,247 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var defaultDynamicSwapFeeParams = types.DynamicSwapFeeParams{
+	MinSwapFee:           sdk.MustNewDecFromStr("0.005"),
+	MaxSwapFee:           sdk.MustNewDecFromStr("0.05"),
+	Window:               10 * time.Second,
+	VolatilityMultiplier: sdk.NewDec(10),
+}
+
+// TestSetDynamicSwapFee tests that only a pool's future governor can opt it into
+// a dynamic swap fee, and that its effective swap fee is bounded by the params.
+func (suite *KeeperTestSuite) TestSetDynamicSwapFee() {
+	testcases := map[string]struct {
+		swapFee              sdk.Dec
+		params               types.DynamicSwapFeeParams
+		senderIsGovernor     bool
+		expectedEffectiveFee sdk.Dec
+		expectedErr          string
+	}{
+		"swap fee within bounds": {
+			swapFee:              sdk.MustNewDecFromStr("0.01"),
+			params:               defaultDynamicSwapFeeParams,
+			senderIsGovernor:     true,
+			expectedEffectiveFee: sdk.MustNewDecFromStr("0.01"),
+		},
+		"swap fee below min": {
+			swapFee:              sdk.ZeroDec(),
+			params:               defaultDynamicSwapFeeParams,
+			senderIsGovernor:     true,
+			expectedEffectiveFee: sdk.MustNewDecFromStr("0.005"),
+		},
+		"swap fee above max": {
+			swapFee:              sdk.MustNewDecFromStr("0.1"),
+			params:               defaultDynamicSwapFeeParams,
+			senderIsGovernor:     true,
+			expectedEffectiveFee: sdk.MustNewDecFromStr("0.05"),
+		},
+		"min above max": {
+			swapFee: sdk.MustNewDecFromStr("0.01"),
+			params: types.DynamicSwapFeeParams{
+				MinSwapFee:           sdk.MustNewDecFromStr("0.05"),
+				MaxSwapFee:           sdk.MustNewDecFromStr("0.005"),
+				Window:               time.Minute,
+				VolatilityMultiplier: sdk.OneDec(),
+			},
+			senderIsGovernor: true,
+			expectedErr:      "is greater than max swap fee",
+		},
+		"window too long": {
+			swapFee: sdk.MustNewDecFromStr("0.01"),
+			params: types.DynamicSwapFeeParams{
+				MinSwapFee:           sdk.ZeroDec(),
+				MaxSwapFee:           sdk.MustNewDecFromStr("0.05"),
+				Window:               types.MaxDynamicSwapFeeWindow + time.Second,
+				VolatilityMultiplier: sdk.OneDec(),
+			},
+			senderIsGovernor: true,
+			expectedErr:      "must be positive and at most",
+		},
+		"volatility multiplier too large": {
+			swapFee: sdk.MustNewDecFromStr("0.01"),
+			params: types.DynamicSwapFeeParams{
+				MinSwapFee:           sdk.ZeroDec(),
+				MaxSwapFee:           sdk.MustNewDecFromStr("0.05"),
+				Window:               time.Minute,
+				VolatilityMultiplier: types.MaxVolatilityMultiplier.Add(sdk.OneDec()),
+			},
+			senderIsGovernor: true,
+			expectedErr:      "volatility multiplier must be between 0 and",
+		},
+		"wrong sender": {
+			swapFee:     sdk.MustNewDecFromStr("0.01"),
+			params:      defaultDynamicSwapFeeParams,
+			expectedErr: types.ErrNotFutureGovernor.Error(),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: tc.swapFee, ExitFee: sdk.ZeroDec()})
+			suite.setFutureGovernor(poolId, suite.TestAccs[0].String())
+
+			sender := suite.TestAccs[1].String()
+			if tc.senderIsGovernor {
+				sender = suite.TestAccs[0].String()
+			}
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+
+			// System under test.
+			_, err := msgServer.SetDynamicSwapFee(sdk.WrapSDKContext(ctx), &types.MsgSetDynamicSwapFee{
+				Sender: sender,
+				PoolId: poolId,
+				Params: &tc.params,
+			})
+
+			if tc.expectedErr != "" {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+				suite.AssertEventEmitted(ctx, types.TypeEvtDynamicSwapFeeSet, 0)
+				_, found := suite.App.GAMMKeeper.GetDynamicSwapFeeParams(suite.Ctx, poolId)
+				suite.Require().False(found)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtDynamicSwapFeeSet, 1)
+
+			params, found := suite.App.GAMMKeeper.GetDynamicSwapFeeParams(suite.Ctx, poolId)
+			suite.Require().True(found)
+			suite.Require().Equal(tc.params, params)
+
+			res, err := suite.queryClient.EffectiveSwapFee(suite.Ctx.Context(), &types.QueryEffectiveSwapFeeRequest{PoolId: poolId})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedEffectiveFee, res.SwapFee)
+			suite.Require().Equal(&tc.params, res.DynamicSwapFeeParams)
+
+			// opting out restores the pool's swap fee.
+			_, err = msgServer.SetDynamicSwapFee(sdk.WrapSDKContext(ctx), &types.MsgSetDynamicSwapFee{
+				Sender: sender,
+				PoolId: poolId,
+			})
+			suite.Require().NoError(err)
+			_, found = suite.App.GAMMKeeper.GetDynamicSwapFeeParams(suite.Ctx, poolId)
+			suite.Require().False(found)
+
+			res, err = suite.queryClient.EffectiveSwapFee(suite.Ctx.Context(), &types.QueryEffectiveSwapFeeRequest{PoolId: poolId})
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.swapFee, res.SwapFee)
+			suite.Require().Nil(res.DynamicSwapFeeParams)
+		})
+	}
+}
+
+// TestDynamicSwapFeeVolatility tests that the effective swap fee of a pool rises
+// with the volatility of its prices, and is the one charged by its swaps.
+func (suite *KeeperTestSuite) TestDynamicSwapFeeVolatility() {
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+	suite.setFutureGovernor(poolId, suite.TestAccs[0].String())
+	_, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).SetDynamicSwapFee(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetDynamicSwapFee{
+		Sender: suite.TestAccs[0].String(),
+		PoolId: poolId,
+		Params: &defaultDynamicSwapFeeParams,
+	})
+	suite.Require().NoError(err)
+
+	// swap back and forth over more than the window, so that the pool's prices vary.
+	for i := 0; i < 15; i++ {
+		tokenIn, tokenOutDenom := sdk.NewInt64Coin("foo", 2_000_000), "bar"
+		if i%2 == 1 {
+			tokenIn, tokenOutDenom = sdk.NewInt64Coin("bar", 1_000_000), "foo"
+		}
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+		suite.Require().NoError(err)
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, tokenIn, tokenOutDenom, sdk.OneInt(), swapFee)
+		suite.Require().NoError(err)
+		suite.App.TwapKeeper.EndBlock(suite.Ctx)
+		suite.Commit()
+	}
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	effectiveSwapFee := suite.App.GAMMKeeper.GetEffectiveSwapFee(suite.Ctx, pool)
+	suite.Require().True(effectiveSwapFee.GT(swapFee), "effective swap fee %s", effectiveSwapFee)
+	suite.Require().True(effectiveSwapFee.LTE(defaultDynamicSwapFeeParams.MaxSwapFee), "effective swap fee %s", effectiveSwapFee)
+
+	// swaps are charged the effective swap fee rather than the pool's.
+	tokenIn := sdk.NewInt64Coin("foo", 100_000)
+	expectedTokenOut, err := pool.CalcOutAmtGivenIn(suite.Ctx, sdk.NewCoins(tokenIn), "bar", effectiveSwapFee)
+	suite.Require().NoError(err)
+	tokenOut, err := suite.App.GAMMKeeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", swapFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenOut, tokenOut)
+
+	tokenOutAmount, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, tokenIn, "bar", sdk.OneInt(), swapFee)
+	suite.Require().NoError(err)
+	suite.Require().Equal(expectedTokenOut.Amount, tokenOutAmount)
+}
+
+// TestUpdateEffectiveSwapFeesBatches tests that the pools with a dynamic swap fee
+// are updated in batches, going around the pools in order of pool id.
+func (suite *KeeperTestSuite) TestUpdateEffectiveSwapFeesBatches() {
+	poolIds := []uint64{}
+	for i := 0; i < 3; i++ {
+		poolId := suite.PrepareBalancerPool()
+		suite.setFutureGovernor(poolId, suite.TestAccs[0].String())
+		_, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).SetDynamicSwapFee(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetDynamicSwapFee{
+			Sender: suite.TestAccs[0].String(),
+			PoolId: poolId,
+			Params: &defaultDynamicSwapFeeParams,
+		})
+		suite.Require().NoError(err)
+		poolIds = append(poolIds, poolId)
+	}
+
+	// all pools fit in a block's batch, after which the next batch starts over.
+	suite.App.GAMMKeeper.UpdateEffectiveSwapFees(suite.Ctx)
+	suite.Require().Equal(poolIds[2]+1, suite.App.GAMMKeeper.GetDynamicSwapFeeCursor(suite.Ctx))
+
+	expectedBatches := map[uint64][]uint64{
+		0:              {poolIds[0], poolIds[1]},
+		poolIds[1]:     {poolIds[1], poolIds[2]},
+		poolIds[2]:     {poolIds[2], poolIds[0]},
+		poolIds[2] + 1: {poolIds[0], poolIds[1]},
+	}
+	for startPoolId, expectedBatch := range expectedBatches {
+		batch := []uint64{}
+		for _, poolDynamicSwapFee := range suite.App.GAMMKeeper.GetPoolDynamicSwapFeesFrom(suite.Ctx, startPoolId, 2) {
+			batch = append(batch, poolDynamicSwapFee.PoolId)
+		}
+		suite.Require().Equal(expectedBatch, batch, "start pool id %d", startPoolId)
+	}
+}
+
+func (suite *KeeperTestSuite) TestDynamicSwapFeeGenesis() {
+	poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: sdk.MustNewDecFromStr("0.01"), ExitFee: sdk.ZeroDec()})
+	suite.PrepareBalancerPool()
+	suite.setFutureGovernor(poolId, suite.TestAccs[0].String())
+	_, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).SetDynamicSwapFee(sdk.WrapSDKContext(suite.Ctx), &types.MsgSetDynamicSwapFee{
+		Sender: suite.TestAccs[0].String(),
+		PoolId: poolId,
+		Params: &defaultDynamicSwapFeeParams,
+	})
+	suite.Require().NoError(err)
+
+	genesis := suite.App.GAMMKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.PoolDynamicSwapFee{
+		{PoolId: poolId, Params: defaultDynamicSwapFeeParams},
+	}, genesis.PoolDynamicSwapFees)
+
+	suite.SetupTest()
+	suite.App.GAMMKeeper.InitGenesis(suite.Ctx, *genesis, suite.App.AppCodec())
+	suite.Require().Equal(genesis.PoolDynamicSwapFees, suite.App.GAMMKeeper.ExportGenesis(suite.Ctx).PoolDynamicSwapFees)
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.MustNewDecFromStr("0.01"), suite.App.GAMMKeeper.GetEffectiveSwapFee(suite.Ctx, pool))
+}
@@ -17,10 +17,6 @@ func (k Keeper) SetPool(ctx sdk.Context, pool swaproutertypes.PoolI) error {
 	return k.setPool(ctx, pool)
 }
 
-func (k Keeper) GetNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
-	return k.getNextPoolIdAndIncrement(ctx)
-}
-
 func (k Keeper) SetStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, sender string) error {
 	return k.setStableSwapScalingFactors(ctx, poolId, scalingFactors, sender)
 }
@@ -38,3 +34,11 @@ func (k Keeper) UnmarshalPoolLegacy(bz []byte) (swaproutertypes.PoolI, error) {
 	var acc swaproutertypes.PoolI
 	return acc, k.cdc.UnmarshalInterface(bz, &acc)
 }
+
+func (k Keeper) GetPoolDynamicSwapFeesFrom(ctx sdk.Context, startPoolId uint64, limit int) []types.PoolDynamicSwapFee {
+	return k.getPoolDynamicSwapFeesFrom(ctx, startPoolId, limit)
+}
+
+func (k Keeper) GetDynamicSwapFeeCursor(ctx sdk.Context) uint64 {
+	return k.getDynamicSwapFeeCursor(ctx)
+}
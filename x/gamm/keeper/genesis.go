@@ -4,6 +4,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
@@ -34,6 +35,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState, unpack
 	}
 
 	k.setTotalLiquidity(ctx, liquidity)
+
+	for _, poolRevenue := range genState.PoolProtocolRevenues {
+		for _, coin := range poolRevenue.Revenue {
+			k.setPoolProtocolRevenue(ctx, poolRevenue.PoolId, coin)
+		}
+	}
+
+	// Effective swap fees are recomputed from twaps from the next block on.
+	for _, poolDynamicSwapFee := range genState.PoolDynamicSwapFees {
+		pool, err := k.GetPoolAndPoke(ctx, poolDynamicSwapFee.PoolId)
+		if err != nil {
+			panic(err)
+		}
+		k.setDynamicSwapFeeParams(ctx, poolDynamicSwapFee.PoolId, poolDynamicSwapFee.Params)
+		osmoutils.MustSetDec(ctx.KVStore(k.storeKey), types.GetKeyEffectiveSwapFee(poolDynamicSwapFee.PoolId),
+			poolDynamicSwapFee.Params.EffectiveSwapFee(pool.GetSwapFee(ctx), sdk.ZeroDec()))
+	}
 }
 
 // ExportGenesis returns the capability module's exported genesis.
@@ -51,8 +69,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		poolAnys = append(poolAnys, any)
 	}
 	return &types.GenesisState{
-		NextPoolNumber: k.GetNextPoolId(ctx),
-		Pools:          poolAnys,
-		Params:         k.GetParams(ctx),
+		NextPoolNumber:       k.GetNextPoolId(ctx),
+		Pools:                poolAnys,
+		Params:               k.GetParams(ctx),
+		PoolProtocolRevenues: k.getPoolProtocolRevenues(ctx),
+		PoolDynamicSwapFees:  k.getPoolDynamicSwapFees(ctx),
 	}
 }
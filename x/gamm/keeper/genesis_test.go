@@ -44,6 +44,9 @@ func TestGammInitGenesis(t *testing.T) {
 		NextPoolNumber: 2,
 		Params: types.Params{
 			PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)},
+			TakeRate:        sdk.ZeroDec(),
+
+			ProtocolRevenueEpochIdentifier: "day",
 		},
 	}, app.AppCodec())
 
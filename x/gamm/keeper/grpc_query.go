@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -14,11 +15,16 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/v2types"
 )
 
-var _ types.QueryServer = Querier{}
+var (
+	_ types.QueryServer      = Querier{}
+	_ balancer.QueryServer   = BalancerQuerier{}
+	_ stableswap.QueryServer = StableswapQuerier{}
+)
 
 // Querier defines a wrapper around the x/gamm keeper providing gRPC method
 // handlers.
@@ -40,6 +46,26 @@ func NewV2Querier(k Keeper) QuerierV2 {
 	return QuerierV2{Keeper: k}
 }
 
+// BalancerQuerier defines a wrapper around the x/gamm keeper providing gRPC method
+// handlers for balancer pool queries.
+type BalancerQuerier struct {
+	Keeper
+}
+
+func NewBalancerQuerier(k Keeper) BalancerQuerier {
+	return BalancerQuerier{Keeper: k}
+}
+
+// StableswapQuerier defines a wrapper around the x/gamm keeper providing gRPC method
+// handlers for stableswap pool queries.
+type StableswapQuerier struct {
+	Keeper
+}
+
+func NewStableswapQuerier(k Keeper) StableswapQuerier {
+	return StableswapQuerier{Keeper: k}
+}
+
 // Pool checks if a pool exists and their respective poolWeights.
 func (q Querier) Pool(
 	ctx context.Context,
@@ -332,6 +358,28 @@ func (q Querier) TotalShares(ctx context.Context, req *types.QueryTotalSharesReq
 	}, nil
 }
 
+// EffectiveSwapFee returns the swap fee currently charged by a pool.
+func (q Querier) EffectiveSwapFee(ctx context.Context, req *types.QueryEffectiveSwapFeeRequest) (*types.QueryEffectiveSwapFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	res := &types.QueryEffectiveSwapFeeResponse{
+		SwapFee: q.Keeper.GetEffectiveSwapFee(sdkCtx, pool),
+	}
+	if params, found := q.Keeper.GetDynamicSwapFeeParams(sdkCtx, req.PoolId); found {
+		res.DynamicSwapFeeParams = &params
+	}
+	return res, nil
+}
+
 // SpotPrice returns target pool asset prices on base and quote assets.
 // nolint: staticcheck
 func (q Querier) SpotPrice(ctx context.Context, req *types.QuerySpotPriceRequest) (*types.QuerySpotPriceResponse, error) {
@@ -384,6 +432,74 @@ func (q QuerierV2) SpotPrice(ctx context.Context, req *v2types.QuerySpotPriceReq
 	}, nil
 }
 
+// WeightSchedule returns the current weights of a balancer pool and its pending or in progress weight change.
+func (q BalancerQuerier) WeightSchedule(ctx context.Context, req *balancer.QueryWeightScheduleRequest) (*balancer.QueryWeightScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("pool id %d is not of type balancer pool", req.PoolId))
+	}
+
+	weightChange := balancerPool.PoolParams.SmoothWeightChangeParams
+	remainingDuration := time.Duration(0)
+	if weightChange != nil {
+		endTime := weightChange.StartTime.Add(weightChange.Duration)
+		if endTime.After(sdkCtx.BlockTime()) {
+			remainingDuration = endTime.Sub(sdkCtx.BlockTime())
+		}
+	}
+
+	return &balancer.QueryWeightScheduleResponse{
+		CurrentWeights:           balancerPool.GetAllPoolAssets(),
+		SmoothWeightChangeParams: weightChange,
+		RemainingDuration:        remainingDuration,
+	}, nil
+}
+
+// ScalingFactorRamp returns the current scaling factors of a stableswap pool and its pending or in progress ramp.
+func (q StableswapQuerier) ScalingFactorRamp(ctx context.Context, req *stableswap.QueryScalingFactorRampRequest) (*stableswap.QueryScalingFactorRampResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	pool, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("pool id %d is not of type stableswap pool", req.PoolId))
+	}
+
+	ramp := stableswapPool.ScalingFactorRamp
+	remainingDuration := time.Duration(0)
+	if ramp != nil {
+		endTime := ramp.StartTime.Add(ramp.Duration)
+		if endTime.After(sdkCtx.BlockTime()) {
+			remainingDuration = endTime.Sub(sdkCtx.BlockTime())
+		}
+	}
+
+	return &stableswap.QueryScalingFactorRampResponse{
+		CurrentScalingFactors: stableswapPool.GetScalingFactors(),
+		ScalingFactorRamp:     ramp,
+		RemainingDuration:     remainingDuration,
+	}, nil
+}
+
 // TotalLiquidity returns total liquidity across all pools.
 func (q Querier) TotalLiquidity(ctx context.Context, _ *types.QueryTotalLiquidityRequest) (*types.QueryTotalLiquidityResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
@@ -393,6 +509,32 @@ func (q Querier) TotalLiquidity(ctx context.Context, _ *types.QueryTotalLiquidit
 	}, nil
 }
 
+// ProtocolRevenue returns the protocol revenue taken from swaps across all pools.
+func (q Querier) ProtocolRevenue(ctx context.Context, _ *types.QueryProtocolRevenueRequest) (*types.QueryProtocolRevenueResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	return &types.QueryProtocolRevenueResponse{
+		Revenue: q.Keeper.GetProtocolRevenue(sdkCtx),
+	}, nil
+}
+
+// PoolProtocolRevenue returns the protocol revenue taken from swaps through a pool.
+func (q Querier) PoolProtocolRevenue(ctx context.Context, req *types.QueryPoolProtocolRevenueRequest) (*types.QueryPoolProtocolRevenueResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if _, err := q.Keeper.GetPoolAndPoke(sdkCtx, req.PoolId); err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPoolProtocolRevenueResponse{
+		Revenue: q.Keeper.GetPoolProtocolRevenue(sdkCtx, req.PoolId),
+	}, nil
+}
+
 // EstimateSwapExactAmountIn estimates input token amount for a swap.
 func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.QuerySwapExactAmountInRequest) (*types.QuerySwapExactAmountInResponse, error) {
 	if req == nil {
@@ -410,7 +552,7 @@ func (q Querier) EstimateSwapExactAmountIn(ctx context.Context, req *types.Query
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenOutAmount, err := q.Keeper.MultihopEstimateOutGivenExactAmountIn(sdkCtx, req.Routes, tokenIn)
+	tokenOutAmount, err := q.Keeper.routerEstimateOutGivenExactAmountIn(sdkCtx, req.Routes, tokenIn)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -437,7 +579,7 @@ func (q Querier) EstimateSwapExactAmountOut(ctx context.Context, req *types.Quer
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-	tokenInAmount, err := q.Keeper.MultihopEstimateInGivenExactAmountOut(sdkCtx, req.Routes, tokenOut)
+	tokenInAmount, err := q.Keeper.routerEstimateInGivenExactAmountOut(sdkCtx, req.Routes, tokenOut)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
@@ -3,11 +3,13 @@ package keeper_test
 import (
 	gocontext "context"
 	"errors"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	balancertypes "github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
@@ -510,6 +512,104 @@ func (suite *KeeperTestSuite) TestPoolType() {
 	suite.Require().Equal(stableswap.PoolTypeName, res.PoolType)
 }
 
+func (suite *KeeperTestSuite) TestWeightSchedule() {
+	poolIdBalancer := suite.PrepareBalancerPool()
+	poolIdStableswap := suite.PrepareBasicStableswapPool()
+	querier := keeper.NewBalancerQuerier(*suite.App.GAMMKeeper)
+	// weight changes start on whole seconds.
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+
+	// error when querying a stableswap pool
+	_, err := querier.WeightSchedule(sdk.WrapSDKContext(ctx), &balancer.QueryWeightScheduleRequest{PoolId: poolIdStableswap})
+	suite.Require().Error(err)
+
+	// no scheduled weight change
+	res, err := querier.WeightSchedule(sdk.WrapSDKContext(ctx), &balancer.QueryWeightScheduleRequest{PoolId: poolIdBalancer})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(time.Duration(0), res.RemainingDuration)
+	// assets are sorted by denom, so the weight of foo is at index 2.
+	suite.Require().Equal(sdk.NewInt(100*balancer.GuaranteedWeightPrecision), res.CurrentWeights[2].Weight)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolIdBalancer)
+	suite.Require().NoError(err)
+	balancerPool := pool.(*balancer.Pool)
+	err = balancerPool.SetPoolParams(balancer.PoolParams{
+		SwapFee: balancerPool.PoolParams.SwapFee,
+		ExitFee: balancerPool.PoolParams.ExitFee,
+		SmoothWeightChangeParams: &balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+				{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+				{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+			},
+		},
+	}, ctx.BlockTime())
+	suite.Require().NoError(err)
+	suite.App.GAMMKeeper.SetPool(ctx, balancerPool)
+
+	// half way through the weight change
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	res, err = querier.WeightSchedule(sdk.WrapSDKContext(ctx), &balancer.QueryWeightScheduleRequest{PoolId: poolIdBalancer})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(30*time.Minute, res.RemainingDuration)
+	suite.Require().Equal(sdk.NewInt(200*balancer.GuaranteedWeightPrecision), res.CurrentWeights[2].Weight)
+
+	// after the weight change
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = querier.WeightSchedule(sdk.WrapSDKContext(ctx), &balancer.QueryWeightScheduleRequest{PoolId: poolIdBalancer})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.SmoothWeightChangeParams)
+	suite.Require().Equal(time.Duration(0), res.RemainingDuration)
+	suite.Require().Equal(sdk.NewInt(300*balancer.GuaranteedWeightPrecision), res.CurrentWeights[2].Weight)
+}
+
+func (suite *KeeperTestSuite) TestScalingFactorRamp() {
+	poolIdBalancer := suite.PrepareBalancerPool()
+	poolIdStableswap := suite.PrepareBasicStableswapPool()
+	querier := keeper.NewStableswapQuerier(*suite.App.GAMMKeeper)
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+	m := uint64(types.ScalingFactorMultiplier)
+
+	// error when querying a balancer pool
+	_, err := querier.ScalingFactorRamp(sdk.WrapSDKContext(ctx), &stableswap.QueryScalingFactorRampRequest{PoolId: poolIdBalancer})
+	suite.Require().Error(err)
+
+	// no scaling factor ramp
+	res, err := querier.ScalingFactorRamp(sdk.WrapSDKContext(ctx), &stableswap.QueryScalingFactorRampRequest{PoolId: poolIdStableswap})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.ScalingFactorRamp)
+	suite.Require().Equal(time.Duration(0), res.RemainingDuration)
+	suite.Require().Equal([]uint64{m, m, m}, res.CurrentScalingFactors)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolIdStableswap)
+	suite.Require().NoError(err)
+	stableswapPool := pool.(*stableswap.Pool)
+	stableswapPool.ScalingFactorController = suite.TestAccs[0].String()
+	err = stableswapPool.RampScalingFactors(ctx, []uint64{3, 3, 3}, time.Time{}, time.Hour, suite.TestAccs[0].String())
+	suite.Require().NoError(err)
+	suite.Require().NoError(suite.App.GAMMKeeper.SetPool(ctx, stableswapPool))
+
+	// half way through the ramp
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	res, err = querier.ScalingFactorRamp(sdk.WrapSDKContext(ctx), &stableswap.QueryScalingFactorRampRequest{PoolId: poolIdStableswap})
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res.ScalingFactorRamp)
+	suite.Require().Equal(30*time.Minute, res.RemainingDuration)
+	suite.Require().Equal([]uint64{2 * m, 2 * m, 2 * m}, res.CurrentScalingFactors)
+
+	// after the ramp
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	res, err = querier.ScalingFactorRamp(sdk.WrapSDKContext(ctx), &stableswap.QueryScalingFactorRampRequest{PoolId: poolIdStableswap})
+	suite.Require().NoError(err)
+	suite.Require().Nil(res.ScalingFactorRamp)
+	suite.Require().Equal(time.Duration(0), res.RemainingDuration)
+	suite.Require().Equal([]uint64{3 * m, 3 * m, 3 * m}, res.CurrentScalingFactors)
+}
+
 func (suite *KeeperTestSuite) TestQueryNumPools1() {
 	res, err := suite.queryClient.NumPools(gocontext.Background(), &types.QueryNumPoolsRequest{})
 	suite.Require().NoError(err)
@@ -10,23 +10,31 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
-const poolBalanceInvariantName = "pool-account-balance-equals-expected"
+const (
+	poolBalanceInvariantName     = "pool-account-balance-equals-expected"
+	poolTotalSharesInvariantName = "pool-total-shares-equals-share-supply"
+)
 
 // RegisterInvariants registers all gamm invariants.
 func RegisterInvariants(ir sdk.InvariantRegistry, keeper Keeper, bk types.BankKeeper) {
 	ir.RegisterRoute(types.ModuleName, poolBalanceInvariantName, PoolAccountInvariant(keeper, bk))
+	ir.RegisterRoute(types.ModuleName, poolTotalSharesInvariantName, PoolTotalSharesInvariant(keeper, bk))
 }
 
 // AllInvariants runs all invariants of the gamm module
 func AllInvariants(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		msg, broke := PoolAccountInvariant(keeper, bk)(ctx)
-		return msg, broke
+		if broke {
+			return msg, broke
+		}
+		return PoolTotalSharesInvariant(keeper, bk)(ctx)
 	}
 }
 
 // PoolAccountInvariant checks that the pool account balance reflects the sum of
-// pool assets
+// pool assets. Frozen pools are skipped, as pools breaking it are frozen once swapped
+// through, joined or exited, see freezePoolIfBroken.
 func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		pools, err := keeper.GetPoolsAndPoke(ctx)
@@ -36,16 +44,68 @@ func PoolAccountInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
 		}
 
 		for _, pool := range pools {
-			expectedCoins := pool.GetTotalPoolLiquidity(ctx)
-			actualCoins := bk.GetAllBalances(ctx, pool.GetAddress())
-			if !actualCoins.IsAllGTE(expectedCoins) {
-				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
-					fmt.Sprintf("\tgamm pool id %d\n\t pool-expected coins: %s\n\t account coins: %s\n",
-						pool.GetId(), expectedCoins, actualCoins)), true
+			if isPoolFrozen(pool) {
+				continue
+			}
+			if msg, broken := checkPoolAccountBalance(ctx, pool, bk); broken {
+				return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName, msg), true
 			}
 		}
 
 		return sdk.FormatInvariant(types.ModuleName, poolBalanceInvariantName,
-			"\tgamm all pool asset coins and account coins match\n"), false
+			"\tgamm all pool asset coins and account coins match, or their pools are frozen\n"), false
+	}
+}
+
+// PoolTotalSharesInvariant checks that the total shares of each pool equal the
+// supply of its share denom. Frozen pools are skipped, as for PoolAccountInvariant.
+func PoolTotalSharesInvariant(keeper Keeper, bk types.BankKeeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		pools, err := keeper.GetPoolsAndPoke(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName,
+				"\tgamm pool retrieval failed"), true
+		}
+
+		for _, pool := range pools {
+			if isPoolFrozen(pool) {
+				continue
+			}
+			if msg, broken := checkPoolTotalShares(ctx, pool, bk); broken {
+				return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName, msg), true
+			}
+		}
+
+		return sdk.FormatInvariant(types.ModuleName, poolTotalSharesInvariantName,
+			"\tgamm all pool total shares and share supplies match, or their pools are frozen\n"), false
 	}
 }
+
+// checkPoolAccountBalance returns whether the pool's account holds less than the pool's liquidity.
+func checkPoolAccountBalance(ctx sdk.Context, pool types.CFMMPoolI, bk types.BankKeeper) (string, bool) {
+	expectedCoins := pool.GetTotalPoolLiquidity(ctx)
+	for _, expectedCoin := range expectedCoins {
+		actualCoin := bk.GetBalance(ctx, pool.GetAddress(), expectedCoin.Denom)
+		if actualCoin.IsLT(expectedCoin) {
+			return fmt.Sprintf("\tgamm pool id %d\n\t pool-expected coins: %s\n\t account coins: %s\n",
+				pool.GetId(), expectedCoins, bk.GetAllBalances(ctx, pool.GetAddress())), true
+		}
+	}
+	return "", false
+}
+
+// checkPoolTotalShares returns whether the pool's total shares differ from the supply of its share denom.
+func checkPoolTotalShares(ctx sdk.Context, pool types.CFMMPoolI, bk types.BankKeeper) (string, bool) {
+	expectedShares := pool.GetTotalShares()
+	shareSupply := bk.GetSupply(ctx, types.GetPoolShareDenom(pool.GetId())).Amount
+	if !expectedShares.Equal(shareSupply) {
+		return fmt.Sprintf("\tgamm pool id %d\n\t pool total shares: %s\n\t share supply: %s\n",
+			pool.GetId(), expectedShares, shareSupply), true
+	}
+	return "", false
+}
+
+func isPoolFrozen(pool types.CFMMPoolI) bool {
+	freezablePool, ok := pool.(types.FreezablePoolExtension)
+	return ok && freezablePool.IsFrozen()
+}
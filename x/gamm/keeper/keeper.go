@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -11,6 +12,8 @@ import (
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
+var _ swaproutertypes.SwapI = Keeper{}
+
 func permContains(perms []string, perm string) bool {
 	for _, v := range perms {
 		if v == perm {
@@ -33,6 +36,9 @@ type Keeper struct {
 	bankKeeper           types.BankKeeper
 	communityPoolKeeper  types.CommunityPoolKeeper
 	poolIncentivesKeeper types.PoolIncentivesKeeper
+	lockupKeeper         types.LockupKeeper
+	twapKeeper           types.TwapKeeper
+	swapRouter           types.SwapRouter
 }
 
 func NewKeeper(cdc codec.BinaryCodec, storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper, communityPoolKeeper types.CommunityPoolKeeper) Keeper {
@@ -76,6 +82,20 @@ func (k *Keeper) SetPoolIncentivesKeeper(poolIncentivesKeeper types.PoolIncentiv
 	k.poolIncentivesKeeper = poolIncentivesKeeper
 }
 
+func (k *Keeper) SetLockupKeeper(lockupKeeper types.LockupKeeper) {
+	k.lockupKeeper = lockupKeeper
+}
+
+// SetSwapRouter sets the swaprouter through which the swap estimate queries
+// estimate swaps through pools of any pool module.
+func (k *Keeper) SetSwapRouter(swapRouter types.SwapRouter) {
+	k.swapRouter = swapRouter
+}
+
+func (k *Keeper) SetTwapKeeper(twapKeeper types.TwapKeeper) {
+	k.twapKeeper = twapKeeper
+}
+
 // GetParams returns the total set params.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
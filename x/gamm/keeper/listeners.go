@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochstypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+)
+
+var _ epochstypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+func (k Keeper) EpochHooks() epochstypes.EpochHooks {
+	return &epochhook{k}
+}
+
+// AfterEpochEnd swaps the protocol revenue collected during the epoch to OSMO,
+// at the end of the epochs of the ProtocolRevenueEpochIdentifier param.
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier != hook.k.GetParams(ctx).ProtocolRevenueEpochIdentifier {
+		return nil
+	}
+	hook.k.swapProtocolRevenueToBaseDenom(ctx)
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
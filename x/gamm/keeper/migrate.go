@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+)
+
+// MigrateShares exits shareInAmount shares of poolIdLeaving, and joins poolIdEntering, which must
+// hold the same denoms, with all the tokens exited. It errors if fewer than shareOutMinAmount shares
+// of poolIdEntering are joined.
+// If lockId is non-zero, the shares are taken out of that lock, which must be sender's and not be
+// superfluid delegated, and the shares joined are locked for the lock's remaining duration, unlocking
+// if the lock was.
+// Returns the amount of shares joined, and the id of their lock if they were locked.
+func (k Keeper) MigrateShares(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	poolIdLeaving uint64,
+	poolIdEntering uint64,
+	shareInAmount sdk.Int,
+	shareOutMinAmount sdk.Int,
+	lockId uint64,
+) (sharesOut sdk.Int, newLockId uint64, err error) {
+	// Steps for migrating shares:
+	// 1) Check that both pools hold the same denoms.
+	// 2) If the shares are locked, validate the lock, get its remaining duration and unlock the shares.
+	// 3) Exit the leaving pool, with no minimum out, as the minimum is checked on the shares joined.
+	// 4) Join the entering pool with all the tokens exited.
+	// 5) If the shares were locked, lock the shares joined for the remaining duration.
+	if err := k.validateMigrationPools(ctx, poolIdLeaving, poolIdEntering); err != nil {
+		return sdk.Int{}, 0, err
+	}
+
+	var lock *lockuptypes.PeriodLock
+	if lockId != 0 {
+		lock, err = k.validateLockForMigration(ctx, sender, poolIdLeaving, lockId)
+		if err != nil {
+			return sdk.Int{}, 0, err
+		}
+		sharesToUnlock := sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolIdLeaving), shareInAmount))
+		if err := k.lockupKeeper.PartialForceUnlock(ctx, *lock, sharesToUnlock); err != nil {
+			return sdk.Int{}, 0, err
+		}
+	}
+
+	exitCoins, err := k.ExitPool(ctx, sender, poolIdLeaving, shareInAmount, sdk.Coins{})
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+
+	sharesOut, err = k.JoinSwapExactAmountIn(ctx, sender, poolIdEntering, exitCoins, shareOutMinAmount)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+
+	if lock == nil {
+		return sharesOut, 0, nil
+	}
+
+	remainingDuration := getLockRemainingDuration(ctx, lock)
+	if remainingDuration <= 0 {
+		// the lock had finished unlocking, so the shares joined are left unlocked.
+		return sharesOut, 0, nil
+	}
+	newLock, err := k.lockupKeeper.CreateLock(ctx, sender, sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolIdEntering), sharesOut)), remainingDuration)
+	if err != nil {
+		return sdk.Int{}, 0, err
+	}
+	if lock.IsUnlocking() {
+		if err := k.lockupKeeper.BeginUnlock(ctx, newLock.ID, nil); err != nil {
+			return sdk.Int{}, 0, err
+		}
+	}
+	return sharesOut, newLock.ID, nil
+}
+
+// validateMigrationPools returns an error unless shares of poolIdLeaving can be migrated to
+// poolIdEntering, that is unless the two are different pools holding the same denoms.
+func (k Keeper) validateMigrationPools(ctx sdk.Context, poolIdLeaving, poolIdEntering uint64) error {
+	if poolIdLeaving == poolIdEntering {
+		return types.ErrSamePoolMigration
+	}
+
+	leavingPool, err := k.GetPoolAndPoke(ctx, poolIdLeaving)
+	if err != nil {
+		return err
+	}
+	enteringPool, err := k.GetPoolAndPoke(ctx, poolIdEntering)
+	if err != nil {
+		return err
+	}
+
+	leavingLiquidity := leavingPool.GetTotalPoolLiquidity(ctx)
+	enteringLiquidity := enteringPool.GetTotalPoolLiquidity(ctx)
+	if !leavingLiquidity.DenomsSubsetOf(enteringLiquidity) || !enteringLiquidity.DenomsSubsetOf(leavingLiquidity) {
+		return sdkerrors.Wrapf(types.ErrMigrationDenomsMismatch, "pool %d holds %s, pool %d holds %s",
+			poolIdLeaving, leavingLiquidity, poolIdEntering, enteringLiquidity)
+	}
+	return nil
+}
+
+// validateLockForMigration returns the lock of the given id, or an error unless it is sender's lock
+// of shares of poolIdLeaving only, and is not superfluid delegated or unbonding.
+func (k Keeper) validateLockForMigration(ctx sdk.Context, sender sdk.AccAddress, poolIdLeaving uint64, lockId uint64) (*lockuptypes.PeriodLock, error) {
+	lock, err := k.lockupKeeper.GetLockByID(ctx, lockId)
+	if err != nil {
+		return nil, err
+	}
+
+	if lock.Owner != sender.String() {
+		return nil, lockuptypes.ErrNotLockOwner
+	}
+
+	if lock.Coins.Len() != 1 || lock.Coins[0].Denom != types.GetPoolShareDenom(poolIdLeaving) {
+		return nil, sdkerrors.Wrapf(types.ErrMigrationLockNotAllowed, "lock %d is not of shares of pool %d only", lockId, poolIdLeaving)
+	}
+
+	// superfluid staked locks must be superfluid undelegated first, which x/gamm can't do.
+	if k.lockupKeeper.HasAnySyntheticLockups(ctx, lockId) {
+		return nil, sdkerrors.Wrapf(types.ErrMigrationLockNotAllowed, "lock %d is superfluid delegated or unbonding", lockId)
+	}
+
+	return lock, nil
+}
+
+// getLockRemainingDuration returns the time left until the given lock finishes unlocking,
+// if it is unlocking, or its duration otherwise.
+func getLockRemainingDuration(ctx sdk.Context, lock *lockuptypes.PeriodLock) time.Duration {
+	if lock.IsUnlocking() {
+		return lock.EndTime.Sub(ctx.BlockTime())
+	}
+	return lock.Duration
+}
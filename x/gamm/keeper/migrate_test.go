@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"errors"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+)
+
+// TestMigrateShares tests that shares of a balancer pool are migrated to a stableswap pool
+// of the same denoms, along with the lock they are in, if any.
+func (suite *KeeperTestSuite) TestMigrateShares() {
+	const lockDuration = 14 * 24 * time.Hour
+	sharesInPool := types.InitPoolSharesSupply
+	// the sender, holding all the shares of the pool, locks half of them if they are locked,
+	// as all the shares of a pool can't be exited.
+	lockedShares := sharesInPool.QuoRaw(2)
+
+	testcases := map[string]struct {
+		shareInAmount sdk.Int
+		// isLocked locks lockedShares before migrating, and unlockingFor begins
+		// unlocking them that long before.
+		isLocked          bool
+		unlockingFor      time.Duration
+		isSuperfluid      bool
+		senderIsNotOwner  bool
+		enteringPoolCoins sdk.Coins
+		shareOutMinAmount sdk.Int
+		expectedErr       error
+	}{
+		"unlocked shares": {
+			shareInAmount: sharesInPool.QuoRaw(2),
+		},
+		"locked shares": {
+			shareInAmount: lockedShares.QuoRaw(2),
+			isLocked:      true,
+		},
+		"all the shares of an unlocking lock": {
+			shareInAmount: lockedShares,
+			isLocked:      true,
+			unlockingFor:  24 * time.Hour,
+		},
+		"lock of another account": {
+			shareInAmount:    sharesInPool.QuoRaw(2),
+			isLocked:         true,
+			senderIsNotOwner: true,
+			expectedErr:      lockuptypes.ErrNotLockOwner,
+		},
+		"superfluid delegated lock": {
+			shareInAmount: sharesInPool.QuoRaw(2),
+			isLocked:      true,
+			isSuperfluid:  true,
+			expectedErr:   types.ErrMigrationLockNotAllowed,
+		},
+		"more shares than locked": {
+			shareInAmount: lockedShares.AddRaw(1),
+			isLocked:      true,
+			expectedErr:   errors.New("requested amount to unlock exceeds locked tokens"),
+		},
+		"pools of different denoms": {
+			shareInAmount:     sharesInPool.QuoRaw(2),
+			enteringPoolCoins: sdk.NewCoins(sdk.NewInt64Coin("foo", 10_000_000), sdk.NewInt64Coin("bar", 10_000_000)),
+			expectedErr:       types.ErrMigrationDenomsMismatch,
+		},
+		"fewer shares out than the minimum": {
+			shareInAmount:     sharesInPool.QuoRaw(2),
+			shareOutMinAmount: sharesInPool,
+			expectedErr:       types.ErrLimitMinAmount,
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			sender := suite.TestAccs[0]
+			leavingPoolId := suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("foo", 10_000_000), sdk.NewInt64Coin("bar", 10_000_000), sdk.NewInt64Coin("baz", 10_000_000))
+			enteringPoolId := suite.PrepareBasicStableswapPool()
+			if tc.enteringPoolCoins != nil {
+				enteringPoolId = suite.PrepareBalancerPoolWithCoins(tc.enteringPoolCoins...)
+			}
+			leavingShareDenom := types.GetPoolShareDenom(leavingPoolId)
+			enteringShareDenom := types.GetPoolShareDenom(enteringPoolId)
+			enteringSharesBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, sender, enteringShareDenom).Amount
+
+			var lock lockuptypes.PeriodLock
+			if tc.isLocked {
+				var err error
+				lock, err = suite.App.LockupKeeper.CreateLock(suite.Ctx, sender, sdk.NewCoins(sdk.NewCoin(leavingShareDenom, lockedShares)), lockDuration)
+				suite.Require().NoError(err)
+				if tc.isSuperfluid {
+					err = suite.App.LockupKeeper.CreateSyntheticLockup(suite.Ctx, lock.ID, leavingShareDenom+"/superbonding/val", lockDuration, false)
+					suite.Require().NoError(err)
+				}
+				if tc.unlockingFor != 0 {
+					err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+					suite.Require().NoError(err)
+					suite.Ctx = suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Add(tc.unlockingFor))
+				}
+			}
+
+			msgSender := sender
+			if tc.senderIsNotOwner {
+				msgSender = suite.TestAccs[1]
+			}
+			shareOutMinAmount := tc.shareOutMinAmount
+			if shareOutMinAmount.IsNil() {
+				shareOutMinAmount = sdk.OneInt()
+			}
+
+			// System under test.
+			res, err := keeper.NewMsgServerImpl(suite.App.GAMMKeeper).MigrateShares(sdk.WrapSDKContext(suite.Ctx), &types.MsgMigrateShares{
+				Sender:            msgSender.String(),
+				PoolIdLeaving:     leavingPoolId,
+				PoolIdEntering:    enteringPoolId,
+				ShareInAmount:     tc.shareInAmount,
+				ShareOutMinAmount: shareOutMinAmount,
+				LockId:            lock.ID,
+			})
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().True(res.ShareOutAmount.IsPositive())
+
+			// the sender's shares of the leaving pool were exited, and its tokens joined to the entering pool.
+			leavingPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, leavingPoolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sharesInPool.Sub(tc.shareInAmount), leavingPool.GetTotalShares())
+			enteringPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, enteringPoolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sharesInPool.Add(res.ShareOutAmount), enteringPool.GetTotalShares())
+
+			if !tc.isLocked {
+				suite.Require().Zero(res.LockId)
+				suite.Require().Equal(sharesInPool.Sub(tc.shareInAmount), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, leavingShareDenom).Amount)
+				suite.Require().Equal(enteringSharesBefore.Add(res.ShareOutAmount), suite.App.BankKeeper.GetBalance(suite.Ctx, sender, enteringShareDenom).Amount)
+				return
+			}
+
+			// the shares joined are locked for the remaining duration of the lock they were migrated out of.
+			suite.Require().Equal(enteringSharesBefore, suite.App.BankKeeper.GetBalance(suite.Ctx, sender, enteringShareDenom).Amount)
+			newLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, res.LockId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(sender.String(), newLock.Owner)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(enteringShareDenom, res.ShareOutAmount)), newLock.Coins)
+			if tc.unlockingFor != 0 {
+				suite.Require().True(newLock.IsUnlocking())
+				suite.Require().Equal(lockDuration-tc.unlockingFor, newLock.Duration)
+				suite.Require().Equal(suite.Ctx.BlockTime().Add(lockDuration-tc.unlockingFor), newLock.EndTime)
+			} else {
+				suite.Require().False(newLock.IsUnlocking())
+				suite.Require().Equal(lockDuration, newLock.Duration)
+			}
+
+			oldLock, err := suite.App.LockupKeeper.GetLockByID(suite.Ctx, lock.ID)
+			if tc.shareInAmount.Equal(lockedShares) {
+				suite.Require().Error(err)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(leavingShareDenom, lockedShares.Sub(tc.shareInAmount))), oldLock.Coins)
+		})
+	}
+}
@@ -64,6 +64,139 @@ func (server msgServer) StableSwapAdjustScalingFactors(goCtx context.Context, ms
 	return &stableswap.MsgStableSwapAdjustScalingFactorsResponse{}, nil
 }
 
+func (server msgServer) StableSwapRampScalingFactors(goCtx context.Context, msg *stableswap.MsgStableSwapRampScalingFactors) (*stableswap.MsgStableSwapRampScalingFactorsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.rampStableSwapScalingFactors(ctx, msg.PoolID, msg.ScalingFactors, msg.StartTime, msg.Duration, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	return &stableswap.MsgStableSwapRampScalingFactorsResponse{}, nil
+}
+
+// UpdatePoolParams sets a pool's swap fee, exit fee and smooth weight change params.
+// It may only be called by the pool's future governor.
+func (server msgServer) UpdatePoolParams(goCtx context.Context, msg *balancer.MsgUpdatePoolParams) (*balancer.MsgUpdatePoolParamsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.updatePoolParams(ctx, msg.PoolID, msg.Sender, msg.SwapFee, msg.ExitFee, msg.SmoothWeightChangeParams); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtPoolParamsUpdated,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+			sdk.NewAttribute(types.AttributeKeySwapFee, msg.SwapFee.String()),
+			sdk.NewAttribute(types.AttributeKeyExitFee, msg.ExitFee.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgUpdatePoolParamsResponse{}, nil
+}
+
+// ScheduleWeightChange replaces the weight change of a balancer pool.
+// It may only be called by the pool's future governor.
+func (server msgServer) ScheduleWeightChange(goCtx context.Context, msg *balancer.MsgScheduleWeightChange) (*balancer.MsgScheduleWeightChangeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.scheduleWeightChange(ctx, msg.PoolID, msg.Sender, msg.SmoothWeightChangeParams); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtWeightChangeScheduled,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolID, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &balancer.MsgScheduleWeightChangeResponse{}, nil
+}
+
+// SetDynamicSwapFee opts a pool into, or out of, a dynamic swap fee.
+// It may only be called by the pool's future governor.
+func (server msgServer) SetDynamicSwapFee(goCtx context.Context, msg *types.MsgSetDynamicSwapFee) (*types.MsgSetDynamicSwapFeeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setDynamicSwapFee(ctx, msg.PoolId, msg.Sender, msg.Params); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtDynamicSwapFeeSet,
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(msg.PoolId, 10)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetDynamicSwapFeeResponse{}, nil
+}
+
+// MigrateShares exits a position in one pool and joins another pool of the same denoms with
+// the tokens exited, moving the lock of the shares along with them if they are locked.
+func (server msgServer) MigrateShares(goCtx context.Context, msg *types.MsgMigrateShares) (*types.MsgMigrateSharesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sharesOut, lockId, err := server.keeper.MigrateShares(ctx, sender, msg.PoolIdLeaving, msg.PoolIdEntering, msg.ShareInAmount, msg.ShareOutMinAmount, msg.LockId)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgMigrateSharesResponse{
+		ShareOutAmount: sharesOut,
+		LockId:         lockId,
+	}, nil
+}
+
+// SetPoolFrozen freezes or unfreezes a pool in an emergency.
+// It may only be called by the pool freeze admin.
+func (server msgServer) SetPoolFrozen(goCtx context.Context, msg *types.MsgSetPoolFrozen) (*types.MsgSetPoolFrozenResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := server.keeper.setPoolFrozenByAdmin(ctx, msg.PoolId, msg.Sender, msg.Frozen); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Sender),
+		),
+	})
+
+	return &types.MsgSetPoolFrozenResponse{}, nil
+}
+
 // CreatePool attempts to create a pool returning the newly created pool ID or an error upon failure.
 // The pool creation fee is used to fund the community pool.
 // It will create a dedicated module account for the pool and sends the initial liquidity to the created module account.
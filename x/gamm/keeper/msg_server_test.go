@@ -1,9 +1,15 @@
 package keeper_test
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 )
 
@@ -355,3 +361,479 @@ func (suite *KeeperTestSuite) TestExitPool_Events() {
 		})
 	}
 }
+
+// TestUpdatePoolParams tests that only a pool's future governor can update its params,
+// and that the new params are applied to the pool.
+func (suite *KeeperTestSuite) TestUpdatePoolParams() {
+	const lockDuration = 24 * time.Hour
+	newSwapFee := sdk.NewDecWithPrec(2, 2)
+	newExitFee := sdk.NewDecWithPrec(1, 2)
+
+	testcases := map[string]struct {
+		isStableswapPool bool
+		// governor is set as the pool's future governor, with "%s" replaced by the governor account.
+		governor string
+		// lockedShares are locked for lockDuration by the governor and another account respectively.
+		governorLockedShares int64
+		otherLockedShares    int64
+		senderIsGovernor     bool
+		targetWeights        []balancer.PoolAsset
+		// startTimeOffset is added to the block time to get the start time of the weight change.
+		startTimeOffset time.Duration
+		// weightChangeInProgress schedules a weight change on the pool before the update.
+		weightChangeInProgress bool
+		expectedErr            error
+	}{
+		"address governor": {
+			governor:         "%s",
+			senderIsGovernor: true,
+		},
+		"address governor, weight change in progress is kept": {
+			governor:               "%s",
+			senderIsGovernor:       true,
+			weightChangeInProgress: true,
+		},
+		"address governor, balancer weight change": {
+			governor:         "%s",
+			senderIsGovernor: true,
+			targetWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+			},
+		},
+		"address governor, weight change with wrong denoms": {
+			governor:         "%s",
+			senderIsGovernor: true,
+			targetWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("atom", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+			},
+			expectedErr: types.ErrPoolParamsInvalidDenom,
+		},
+		"address governor, weight change starting in the past": {
+			governor:         "%s",
+			senderIsGovernor: true,
+			targetWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+			},
+			startTimeOffset: -time.Hour,
+			expectedErr:     fmt.Errorf("is before the current block time"),
+		},
+		"address governor, wrong sender": {
+			governor:    "%s",
+			expectedErr: types.ErrNotFutureGovernor,
+		},
+		"no governor": {
+			senderIsGovernor: true,
+			expectedErr:      types.ErrNotFutureGovernor,
+		},
+		"lock governor, sender holds majority": {
+			governor:             "24h",
+			governorLockedShares: 60,
+			otherLockedShares:    40,
+			senderIsGovernor:     true,
+		},
+		"lock governor, sender holds half": {
+			governor:             "24h",
+			governorLockedShares: 50,
+			otherLockedShares:    50,
+			senderIsGovernor:     true,
+			expectedErr:          types.ErrNotFutureGovernor,
+		},
+		"lock governor, sender's lock is too short": {
+			governor:             "48h",
+			governorLockedShares: 60,
+			otherLockedShares:    40,
+			senderIsGovernor:     true,
+			expectedErr:          types.ErrNotFutureGovernor,
+		},
+		"stableswap address governor": {
+			isStableswapPool: true,
+			governor:         "%s",
+			senderIsGovernor: true,
+		},
+		"stableswap weight change": {
+			isStableswapPool: true,
+			governor:         "%s",
+			senderIsGovernor: true,
+			targetWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+			},
+			expectedErr: fmt.Errorf("pool id 1 is a stableswap pool and does not support weight changes"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			ctx := suite.Ctx
+			governorAddr, otherAddr := suite.TestAccs[1], suite.TestAccs[2]
+
+			var poolId uint64
+			if tc.isStableswapPool {
+				poolId = suite.PrepareBasicStableswapPool()
+			} else {
+				poolId = suite.PrepareBalancerPool()
+			}
+
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			suite.Require().NoError(err)
+			governor := tc.governor
+			if strings.Contains(governor, "%s") {
+				governor = fmt.Sprintf(governor, governorAddr)
+			}
+			suite.setFutureGovernor(poolId, governor)
+
+			shareDenom := types.GetPoolShareDenom(poolId)
+			if tc.governorLockedShares > 0 {
+				suite.LockTokens(governorAddr, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, tc.governorLockedShares)), lockDuration)
+			}
+			if tc.otherLockedShares > 0 {
+				suite.LockTokens(otherAddr, sdk.NewCoins(sdk.NewInt64Coin(shareDenom, tc.otherLockedShares)), lockDuration)
+			}
+
+			sender := otherAddr
+			if tc.senderIsGovernor {
+				sender = governorAddr
+			}
+
+			var weightChangeInProgress *balancer.SmoothWeightChangeParams
+			if tc.weightChangeInProgress {
+				scheduleMsg := balancer.NewMsgScheduleWeightChange(governorAddr, poolId, balancer.SmoothWeightChangeParams{
+					Duration: 2 * time.Hour,
+					TargetPoolWeights: []balancer.PoolAsset{
+						{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+						{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+						{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+						{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(1)},
+					},
+				})
+				_, err = keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper).ScheduleWeightChange(sdk.WrapSDKContext(ctx), &scheduleMsg)
+				suite.Require().NoError(err)
+				pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				suite.Require().NoError(err)
+				weightChangeInProgress = pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+				// the update happens halfway through the weight change.
+				ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			}
+
+			var weightChange *balancer.SmoothWeightChangeParams
+			if tc.targetWeights != nil {
+				weightChange = &balancer.SmoothWeightChangeParams{
+					Duration:          time.Hour,
+					TargetPoolWeights: tc.targetWeights,
+				}
+				if tc.startTimeOffset != 0 {
+					weightChange.StartTime = ctx.BlockTime().Add(tc.startTimeOffset)
+				}
+			}
+
+			ctx = ctx.WithEventManager(sdk.NewEventManager())
+			msg := balancer.NewMsgUpdatePoolParams(sender, poolId, newSwapFee, newExitFee, weightChange)
+			msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+
+			// System under test.
+			_, err = msgServer.UpdatePoolParams(sdk.WrapSDKContext(ctx), &msg)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(ctx, types.TypeEvtPoolParamsUpdated, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolParamsUpdated, 1)
+
+			updatedPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().Equal(newSwapFee, updatedPool.GetSwapFee(ctx))
+			suite.Require().Equal(newExitFee, updatedPool.GetExitFee(ctx))
+
+			balancerPool, ok := updatedPool.(*balancer.Pool)
+			if !ok {
+				return
+			}
+			if tc.targetWeights == nil {
+				suite.Require().Equal(weightChangeInProgress, balancerPool.PoolParams.SmoothWeightChangeParams)
+				return
+			}
+
+			// The weight change starts from the pool's previous weights and ends at the target weights.
+			params := balancerPool.PoolParams.SmoothWeightChangeParams
+			suite.Require().NotNil(params)
+			suite.Require().Equal(ctx.BlockTime().Unix(), params.StartTime.Unix())
+			for i, asset := range pool.(*balancer.Pool).GetAllPoolAssets() {
+				suite.Require().Equal(asset.Weight, params.InitialPoolWeights[i].Weight)
+			}
+
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+			balancerPool.PokePool(ctx.BlockTime())
+			for _, asset := range balancerPool.GetAllPoolAssets() {
+				suite.Require().Equal(sdk.NewInt(balancer.GuaranteedWeightPrecision), asset.Weight)
+			}
+		})
+	}
+}
+
+// TestScheduleWeightChange tests that the future governor can replace the weight change
+// of a balancer pool, and that the new change starts from the pool's current weights.
+func (suite *KeeperTestSuite) TestScheduleWeightChange() {
+	targetWeights := []balancer.PoolAsset{
+		{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+		{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+		{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+		{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(300)},
+	}
+
+	testcases := map[string]struct {
+		isStableswapPool bool
+		senderIsGovernor bool
+		// startTimeOffset is added to the block time to get the start time, unless zero.
+		startTimeOffset time.Duration
+		expectedErr     error
+	}{
+		"starts at block time": {
+			senderIsGovernor: true,
+		},
+		"starts in the future": {
+			senderIsGovernor: true,
+			startTimeOffset:  time.Hour,
+		},
+		"starts in the past": {
+			senderIsGovernor: true,
+			startTimeOffset:  -time.Hour,
+			expectedErr:      fmt.Errorf("is before the current block time"),
+		},
+		"not the governor": {
+			expectedErr: types.ErrNotFutureGovernor,
+		},
+		"stableswap pool": {
+			isStableswapPool: true,
+			senderIsGovernor: true,
+			expectedErr:      fmt.Errorf("pool id 1 is not of type balancer pool"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			governorAddr, otherAddr := suite.TestAccs[1], suite.TestAccs[2]
+
+			var poolId uint64
+			if tc.isStableswapPool {
+				poolId = suite.PrepareBasicStableswapPool()
+			} else {
+				poolId = suite.PrepareBalancerPool()
+			}
+			suite.setFutureGovernor(poolId, governorAddr.String())
+
+			sender := otherAddr
+			if tc.senderIsGovernor {
+				sender = governorAddr
+			}
+
+			weightChange := balancer.SmoothWeightChangeParams{
+				Duration:          time.Hour,
+				TargetPoolWeights: append([]balancer.PoolAsset{}, targetWeights...),
+			}
+			if tc.startTimeOffset != 0 {
+				weightChange.StartTime = suite.Ctx.BlockTime().Add(tc.startTimeOffset)
+			}
+			startTime := suite.Ctx.BlockTime().Add(tc.startTimeOffset)
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msg := balancer.NewMsgScheduleWeightChange(sender, poolId, weightChange)
+			msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+
+			// System under test.
+			_, err := msgServer.ScheduleWeightChange(sdk.WrapSDKContext(ctx), &msg)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(ctx, types.TypeEvtWeightChangeScheduled, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtWeightChangeScheduled, 1)
+
+			// The weights don't move until the start time, then reach the targets after the duration.
+			initialAssets := suite.getBalancerPoolAssets(ctx, poolId)
+			ctx = ctx.WithBlockTime(startTime)
+			suite.Require().Equal(initialAssets, suite.getBalancerPoolAssets(ctx, poolId))
+
+			ctx = ctx.WithBlockTime(startTime.Add(time.Hour + time.Second))
+			for _, asset := range suite.getBalancerPoolAssets(ctx, poolId) {
+				suite.Require().Equal(sdk.NewInt(300*balancer.GuaranteedWeightPrecision), asset.Weight)
+			}
+		})
+	}
+}
+
+// TestScheduleWeightChange_Replace tests that scheduling a weight change while another is
+// in progress starts the new one from the interpolated weights.
+func (suite *KeeperTestSuite) TestScheduleWeightChange_Replace() {
+	suite.Setup()
+	governorAddr := suite.TestAccs[1]
+	poolId := suite.PrepareBalancerPool()
+	suite.setFutureGovernor(poolId, governorAddr.String())
+	msgServer := keeper.NewBalancerMsgServerImpl(suite.App.GAMMKeeper)
+
+	scheduleTo := func(ctx sdk.Context, weight int64) {
+		msg := balancer.NewMsgScheduleWeightChange(governorAddr, poolId, balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{Token: sdk.NewCoin("foo", sdk.ZeroInt()), Weight: sdk.NewInt(weight)},
+				{Token: sdk.NewCoin("bar", sdk.ZeroInt()), Weight: sdk.NewInt(weight)},
+				{Token: sdk.NewCoin("baz", sdk.ZeroInt()), Weight: sdk.NewInt(weight)},
+				{Token: sdk.NewCoin("uosmo", sdk.ZeroInt()), Weight: sdk.NewInt(weight)},
+			},
+		})
+		_, err := msgServer.ScheduleWeightChange(sdk.WrapSDKContext(ctx), &msg)
+		suite.Require().NoError(err)
+	}
+
+	// weight changes start on whole seconds.
+	ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+	scheduleTo(ctx, 300)
+
+	// Half way through, foo has moved from 100 to 200.
+	// Assets are sorted by denom, so foo is at index 2.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(30 * time.Minute))
+	midwayAssets := suite.getBalancerPoolAssets(ctx, poolId)
+	suite.Require().Equal(sdk.NewInt(200*balancer.GuaranteedWeightPrecision), midwayAssets[2].Weight)
+
+	scheduleTo(ctx, 100)
+
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+	suite.Require().NoError(err)
+	params := pool.(*balancer.Pool).PoolParams.SmoothWeightChangeParams
+	suite.Require().NotNil(params)
+	suite.Require().Equal(ctx.BlockTime().Unix(), params.StartTime.Unix())
+	for i, asset := range midwayAssets {
+		suite.Require().Equal(asset.Weight, params.InitialPoolWeights[i].Weight)
+	}
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(2 * time.Hour))
+	for _, asset := range suite.getBalancerPoolAssets(ctx, poolId) {
+		suite.Require().Equal(sdk.NewInt(100*balancer.GuaranteedWeightPrecision), asset.Weight)
+	}
+}
+
+// setFutureGovernor sets the future governor of the given pool.
+func (suite *KeeperTestSuite) setFutureGovernor(poolId uint64, governor string) {
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+	suite.Require().NoError(err)
+	switch pool := pool.(type) {
+	case *balancer.Pool:
+		pool.FuturePoolGovernor = governor
+	case *stableswap.Pool:
+		pool.FuturePoolGovernor = governor
+	}
+	suite.App.GAMMKeeper.SetPool(suite.Ctx, pool)
+}
+
+// getBalancerPoolAssets returns the pool assets of a balancer pool at the block time of ctx.
+func (suite *KeeperTestSuite) getBalancerPoolAssets(ctx sdk.Context, poolId uint64) []balancer.PoolAsset {
+	pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+	suite.Require().NoError(err)
+	return pool.(*balancer.Pool).GetAllPoolAssets()
+}
+
+func (suite *KeeperTestSuite) TestStableSwapRampScalingFactors() {
+	testcases := map[string]struct {
+		isBalancerPool     bool
+		senderIsController bool
+		// startTimeOffset is added to the block time to get the start time, unless zero.
+		startTimeOffset time.Duration
+		expectedErr     error
+	}{
+		"starts at block time": {
+			senderIsController: true,
+		},
+		"starts in the future": {
+			senderIsController: true,
+			startTimeOffset:    time.Hour,
+		},
+		"starts in the past": {
+			senderIsController: true,
+			startTimeOffset:    -time.Hour,
+			expectedErr:        fmt.Errorf("is before the current block time"),
+		},
+		"not the controller": {
+			expectedErr: types.ErrNotScalingFactorGovernor,
+		},
+		"balancer pool": {
+			isBalancerPool:     true,
+			senderIsController: true,
+			expectedErr:        fmt.Errorf("pool id 1 is not of type stableswap pool"),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.Setup()
+			controllerAddr, otherAddr := suite.TestAccs[1], suite.TestAccs[2]
+			// scaling factors move in milliseconds, so keep the arithmetic exact.
+			ctx := suite.Ctx.WithBlockTime(suite.Ctx.BlockTime().Truncate(time.Second))
+
+			var poolId uint64
+			if tc.isBalancerPool {
+				poolId = suite.PrepareBalancerPool()
+			} else {
+				poolId = suite.PrepareBasicStableswapPool()
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				suite.Require().NoError(err)
+				stableswapPool := pool.(*stableswap.Pool)
+				stableswapPool.ScalingFactorController = controllerAddr.String()
+				suite.Require().NoError(suite.App.GAMMKeeper.SetPool(ctx, stableswapPool))
+			}
+
+			sender := otherAddr
+			if tc.senderIsController {
+				sender = controllerAddr
+			}
+
+			var startTime time.Time
+			if tc.startTimeOffset != 0 {
+				startTime = ctx.BlockTime().Add(tc.startTimeOffset)
+			}
+			expectedStartTime := ctx.BlockTime().Add(tc.startTimeOffset)
+
+			msg := stableswap.NewMsgStableSwapRampScalingFactors(sender.String(), poolId, []uint64{3, 3, 3}, startTime, time.Hour)
+			msgServer := keeper.NewStableswapMsgServerImpl(suite.App.GAMMKeeper)
+
+			// System under test.
+			_, err := msgServer.StableSwapRampScalingFactors(sdk.WrapSDKContext(ctx), &msg)
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+
+			getScalingFactors := func(ctx sdk.Context) []uint64 {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				suite.Require().NoError(err)
+				return pool.(*stableswap.Pool).GetScalingFactors()
+			}
+			m := uint64(types.ScalingFactorMultiplier)
+
+			// The scaling factors don't move until the start time, then reach the targets after the duration.
+			ctx = ctx.WithBlockTime(expectedStartTime)
+			suite.Require().Equal([]uint64{m, m, m}, getScalingFactors(ctx))
+
+			ctx = ctx.WithBlockTime(expectedStartTime.Add(30 * time.Minute))
+			suite.Require().Equal([]uint64{2 * m, 2 * m, 2 * m}, getScalingFactors(ctx))
+
+			ctx = ctx.WithBlockTime(expectedStartTime.Add(time.Hour + time.Second))
+			suite.Require().Equal([]uint64{3 * m, 3 * m, 3 * m}, getScalingFactors(ctx))
+		})
+	}
+}
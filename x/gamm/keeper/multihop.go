@@ -6,6 +6,7 @@ import (
 
 	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // MultihopSwapExactAmountIn defines the input denom and input amount for the first pool,
@@ -119,7 +120,7 @@ func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
 		}
 
-		tokenOut, err := pool.CalcOutAmtGivenIn(ctx, sdk.Coins{tokenIn}, route.TokenOutDenom, swapFee)
+		tokenOut, err := k.CalcOutAmtGivenIn(ctx, pool, tokenIn, route.TokenOutDenom, swapFee)
 		if err != nil {
 			return sdk.Int{}, err
 		}
@@ -323,7 +324,7 @@ func (k Keeper) createMultihopExpectedSwapOuts(
 			return nil, err
 		}
 
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, pool.GetSwapFee(ctx))
+		tokenIn, err := k.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, pool.GetSwapFee(ctx))
 		if err != nil {
 			return nil, err
 		}
@@ -352,7 +353,7 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 		}
 
 		swapFee := pool.GetSwapFee(ctx)
-		tokenIn, err := pool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), route.TokenInDenom, cumulativeRouteSwapFee.Mul((swapFee.Quo(sumOfSwapFees))))
+		tokenIn, err := k.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, cumulativeRouteSwapFee.Mul((swapFee.Quo(sumOfSwapFees))))
 		if err != nil {
 			return nil, err
 		}
@@ -363,3 +364,32 @@ func (k Keeper) createOsmoMultihopExpectedSwapOuts(
 
 	return insExpected, nil
 }
+
+// routerEstimateOutGivenExactAmountIn estimates the amount of the last token out of a swap
+// along the routes, through pools of any pool module once the swaprouter is set, or only
+// through pools of x/gamm otherwise.
+func (k Keeper) routerEstimateOutGivenExactAmountIn(ctx sdk.Context, routes []types.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error) {
+	if k.swapRouter == nil {
+		return k.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn)
+	}
+
+	routerRoutes := make([]swaproutertypes.SwapAmountInRoute, 0, len(routes))
+	for _, route := range routes {
+		routerRoutes = append(routerRoutes, swaproutertypes.SwapAmountInRoute{PoolId: route.PoolId, TokenOutDenom: route.TokenOutDenom})
+	}
+	return k.swapRouter.MultihopEstimateOutGivenExactAmountIn(ctx, routerRoutes, tokenIn)
+}
+
+// routerEstimateInGivenExactAmountOut estimates the amount of the first token in of a swap
+// along the routes, as routerEstimateOutGivenExactAmountIn does.
+func (k Keeper) routerEstimateInGivenExactAmountOut(ctx sdk.Context, routes []types.SwapAmountOutRoute, tokenOut sdk.Coin) (sdk.Int, error) {
+	if k.swapRouter == nil {
+		return k.MultihopEstimateInGivenExactAmountOut(ctx, routes, tokenOut)
+	}
+
+	routerRoutes := make([]swaproutertypes.SwapAmountOutRoute, 0, len(routes))
+	for _, route := range routes {
+		routerRoutes = append(routerRoutes, swaproutertypes.SwapAmountOutRoute{PoolId: route.PoolId, TokenInDenom: route.TokenInDenom})
+	}
+	return k.swapRouter.MultihopEstimateInGivenExactAmountOut(ctx, routerRoutes, tokenOut)
+}
@@ -263,7 +263,9 @@ func (suite *KeeperTestSuite) TestBalancerPoolMultihopSwapExactAmountIn() {
 				nextTokenIn := test.param.tokenIn
 				// we then do individual swaps until we reach the end of the swap route
 				for _, hop := range test.param.routes {
-					tokenOut, err := keeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], hop.PoolId, nextTokenIn, hop.TokenOutDenom, sdk.OneInt())
+					hopPool, err := keeper.GetPoolAndPoke(cacheCtx, hop.PoolId)
+					suite.Require().NoError(err)
+					tokenOut, err := keeper.SwapExactAmountIn(cacheCtx, suite.TestAccs[0], hopPool, nextTokenIn, hop.TokenOutDenom, sdk.OneInt(), hopPool.GetSwapFee(cacheCtx))
 					suite.Require().NoError(err)
 					nextTokenIn = sdk.NewCoin(hop.TokenOutDenom, tokenOut)
 				}
@@ -533,7 +535,9 @@ func (suite *KeeperTestSuite) TestBalancerPoolMultihopSwapExactAmountOut() {
 				// we then do individual swaps until we reach the end of the swap route
 				for i := len(test.param.routes) - 1; i >= 0; i-- {
 					hop := test.param.routes[i]
-					tokenOut, err := keeper.SwapExactAmountOut(cacheCtx, suite.TestAccs[0], hop.PoolId, hop.TokenInDenom, sdk.NewInt(100000000), nextTokenOut)
+					hopPool, err := keeper.GetPoolAndPoke(cacheCtx, hop.PoolId)
+					suite.Require().NoError(err)
+					tokenOut, err := keeper.SwapExactAmountOut(cacheCtx, suite.TestAccs[0], hopPool, hop.TokenInDenom, sdk.NewInt(100000000), nextTokenOut, hopPool.GetSwapFee(cacheCtx))
 					suite.Require().NoError(err)
 					nextTokenOut = sdk.NewCoin(hop.TokenInDenom, tokenOut)
 				}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"fmt"
+	"time"
 
 	gogotypes "github.com/gogo/protobuf/types"
 
@@ -12,6 +13,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/stableswap"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
 	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -25,7 +27,8 @@ func (k Keeper) UnmarshalPool(bz []byte) (types.CFMMPoolI, error) {
 }
 
 // GetPoolAndPoke returns a PoolI based on it's identifier if one exists. If poolId corresponds
-// to a pool with weights (e.g. balancer), the weights of the pool are updated via PokePool prior to returning.
+// to a pool with time dependent parameters (e.g. balancer weights or stableswap scaling factors),
+// they are updated via PokePool prior to returning.
 // TODO: Consider rename to GetPool due to downstream API confusion.
 func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	store := ctx.KVStore(k.storeKey)
@@ -41,13 +44,19 @@ func (k Keeper) GetPoolAndPoke(ctx sdk.Context, poolId uint64) (types.CFMMPoolI,
 		return nil, err
 	}
 
-	if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+	if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 		pokePool.PokePool(ctx.BlockTime())
 	}
 
 	return pool, nil
 }
 
+// GetPool returns the pool with the given id as a swaproutertypes.PoolI,
+// poking it prior to returning if it is a weighted pool.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error) {
+	return k.GetPoolAndPoke(ctx, poolId)
+}
+
 // Get pool and check if the pool is active, i.e. allowed to be swapped against.
 func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.CFMMPoolI, error) {
 	pool, err := k.GetPoolAndPoke(ctx, poolId)
@@ -78,7 +87,7 @@ func (k Keeper) GetPoolsAndPoke(ctx sdk.Context) (res []types.CFMMPoolI, err err
 			return nil, err
 		}
 
-		if pokePool, ok := pool.(types.WeightedPoolExtension); ok {
+		if pokePool, ok := pool.(types.PokablePoolExtension); ok {
 			pokePool.PokePool(ctx.BlockTime())
 		}
 		res = append(res, pool)
@@ -260,8 +269,9 @@ func (k Keeper) GetPoolType(ctx sdk.Context, poolId uint64) (string, error) {
 	}
 }
 
-// getNextPoolIdAndIncrement returns the next pool Id, and increments the corresponding state entry.
-func (k Keeper) getNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
+// GetNextPoolIdAndIncrement returns the next pool Id, and increments the corresponding state entry.
+// Pools of every type draw their ids from this counter, so that pool ids are unique across modules.
+func (k Keeper) GetNextPoolIdAndIncrement(ctx sdk.Context) uint64 {
 	nextPoolId := k.GetNextPoolId(ctx)
 	k.setNextPoolId(ctx, nextPoolId+1)
 	return nextPoolId
@@ -286,10 +296,158 @@ func (k Keeper) setStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scal
 	return k.setPool(ctx, stableswapPool)
 }
 
+func (k Keeper) rampStableSwapScalingFactors(ctx sdk.Context, poolId uint64, scalingFactors []uint64, startTime time.Time, duration time.Duration, sender string) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	stableswapPool, ok := pool.(*stableswap.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type stableswap pool", poolId)
+	}
+	if err := stableswapPool.RampScalingFactors(ctx, scalingFactors, startTime, duration, sender); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, stableswapPool)
+}
+
+// updatePoolParams sets the swap fee, exit fee and, for balancer pools, the smooth weight change params
+// of the given pool. A balancer pool keeps its weight change in progress unless a new one is given.
+// It errors unless sender is the pool's future governor.
+func (k Keeper) updatePoolParams(ctx sdk.Context, poolId uint64, sender string, swapFee, exitFee sdk.Dec, smoothWeightChangeParams *balancer.SmoothWeightChangeParams) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	switch pool := pool.(type) {
+	case *balancer.Pool:
+		if err := k.checkFutureGovernor(ctx, poolId, pool.FuturePoolGovernor, sender); err != nil {
+			return err
+		}
+		if smoothWeightChangeParams == nil {
+			params := balancer.PoolParams{
+				SwapFee: swapFee,
+				ExitFee: exitFee,
+			}
+			if err := params.Validate(pool.GetAllPoolAssets()); err != nil {
+				return err
+			}
+			// keep the pool's weight change in progress, if any, rather than restarting it.
+			params.SmoothWeightChangeParams = pool.PoolParams.SmoothWeightChangeParams
+			pool.PoolParams = params
+			break
+		}
+		if !smoothWeightChangeParams.StartTime.IsZero() && smoothWeightChangeParams.StartTime.Before(ctx.BlockTime()) {
+			return fmt.Errorf("weight change start time %s is before the current block time %s", smoothWeightChangeParams.StartTime, ctx.BlockTime())
+		}
+		params := balancer.PoolParams{
+			SwapFee:                  swapFee,
+			ExitFee:                  exitFee,
+			SmoothWeightChangeParams: smoothWeightChangeParams,
+		}
+		if err := pool.SetPoolParams(params, ctx.BlockTime()); err != nil {
+			return err
+		}
+	case *stableswap.Pool:
+		if err := k.checkFutureGovernor(ctx, poolId, pool.FuturePoolGovernor, sender); err != nil {
+			return err
+		}
+		if smoothWeightChangeParams != nil {
+			return fmt.Errorf("pool id %d is a stableswap pool and does not support weight changes", poolId)
+		}
+		params := stableswap.PoolParams{
+			SwapFee: swapFee,
+			ExitFee: exitFee,
+		}
+		if err := pool.SetPoolParams(params); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("pool id %d of type %T does not support updating pool params", poolId, pool)
+	}
+
+	return k.setPool(ctx, pool)
+}
+
+// scheduleWeightChange replaces the weight change of a balancer pool with the given one,
+// starting from the pool's current weights. It errors unless sender is the pool's future governor.
+func (k Keeper) scheduleWeightChange(ctx sdk.Context, poolId uint64, sender string, weightChange balancer.SmoothWeightChangeParams) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+	balancerPool, ok := pool.(*balancer.Pool)
+	if !ok {
+		return fmt.Errorf("pool id %d is not of type balancer pool", poolId)
+	}
+	if err := k.checkFutureGovernor(ctx, poolId, balancerPool.FuturePoolGovernor, sender); err != nil {
+		return err
+	}
+
+	if !weightChange.StartTime.IsZero() && weightChange.StartTime.Before(ctx.BlockTime()) {
+		return fmt.Errorf("weight change start time %s is before the current block time %s", weightChange.StartTime, ctx.BlockTime())
+	}
+
+	params := balancer.PoolParams{
+		SwapFee:                  balancerPool.PoolParams.SwapFee,
+		ExitFee:                  balancerPool.PoolParams.ExitFee,
+		SmoothWeightChangeParams: &weightChange,
+	}
+	if err := balancerPool.SetPoolParams(params, ctx.BlockTime()); err != nil {
+		return err
+	}
+
+	return k.setPool(ctx, balancerPool)
+}
+
+// checkFutureGovernor returns an error unless sender is allowed to act as the given future governor.
+// An address governor must be the sender itself. A lock governor is any account holding more than
+// half of the governing denom locked for at least the governing duration.
+func (k Keeper) checkFutureGovernor(ctx sdk.Context, poolId uint64, futureGovernor string, sender string) error {
+	if futureGovernor == "" {
+		return sdkerrors.Wrapf(types.ErrNotFutureGovernor, "pool %d has no future governor", poolId)
+	}
+
+	governor, err := types.ParseFutureGovernor(futureGovernor, types.GetPoolShareDenom(poolId))
+	if err != nil {
+		return err
+	}
+
+	senderAddr, err := sdk.AccAddressFromBech32(sender)
+	if err != nil {
+		return err
+	}
+
+	if governor.IsAddress() {
+		if !governor.Address.Equals(senderAddr) {
+			return types.ErrNotFutureGovernor
+		}
+		return nil
+	}
+
+	totalLocked := k.lockupKeeper.GetPeriodLocksAccumulation(ctx, lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         governor.LockDenom,
+		Duration:      governor.LockDuration,
+	})
+	senderLocked := sdk.ZeroInt()
+	for _, lock := range k.lockupKeeper.GetAccountLockedLongerDurationDenom(ctx, senderAddr, governor.LockDenom, governor.LockDuration) {
+		senderLocked = senderLocked.Add(lock.Coins.AmountOf(governor.LockDenom))
+	}
+
+	// the sender must hold a strict majority of the governing locks.
+	if !senderLocked.MulRaw(2).GT(totalLocked) {
+		return sdkerrors.Wrapf(types.ErrNotFutureGovernor, "sender has %s of %s %s locked for at least %s",
+			senderLocked, totalLocked, governor.LockDenom, governor.LockDuration)
+	}
+	return nil
+}
+
 // convertToCFMMPool converts PoolI to CFMMPoolI by casting the input.
 // Returns the pool of the CFMMPoolI or error if the given pool does not implement
 // CFMMPoolI.
-// nolint: unused
 func convertToCFMMPool(pool swaproutertypes.PoolI) (types.CFMMPoolI, error) {
 	cfmmPool, ok := pool.(types.CFMMPoolI)
 	if !ok {
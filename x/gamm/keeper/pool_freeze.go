@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// setPoolFrozenByAdmin freezes or unfreezes the given pool.
+// It errors unless sender is the pool freeze admin set in the module params.
+func (k Keeper) setPoolFrozenByAdmin(ctx sdk.Context, poolId uint64, sender string, frozen bool) error {
+	admin := k.GetParams(ctx).PoolFreezeAdmin
+	if admin == "" || sender != admin {
+		return sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrNotPoolFreezeAdmin.Error())
+	}
+
+	return k.setPoolFrozen(ctx, poolId, frozen)
+}
+
+// setPoolFrozen freezes or unfreezes the given pool, and emits an event recording it.
+// A frozen pool rejects swaps and joins, but can still be exited.
+func (k Keeper) setPoolFrozen(ctx sdk.Context, poolId uint64, frozen bool) error {
+	pool, err := k.GetPoolAndPoke(ctx, poolId)
+	if err != nil {
+		return err
+	}
+
+	freezablePool, ok := pool.(types.FreezablePoolExtension)
+	if !ok {
+		return sdkerrors.Wrapf(types.ErrPoolNotFreezable, "pool id %d of type %T", poolId, pool)
+	}
+	freezablePool.SetFrozen(frozen)
+	if err := k.setPool(ctx, freezablePool); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtPoolFrozenSet,
+		sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+		sdk.NewAttribute(types.AttributeKeyFrozen, strconv.FormatBool(frozen)),
+	))
+	return nil
+}
+
+// freezePoolIfBroken freezes the given pool, if it isn't frozen already, when it breaks
+// one of the module's invariants: its account holds less than its liquidity, or its total
+// shares differ from the supply of its share denom. It is called after every swap, join
+// and exit through the pool, so that broken pools are frozen rather than halting the chain.
+func (k Keeper) freezePoolIfBroken(ctx sdk.Context, pool swaproutertypes.PoolI) {
+	cfmmPool, err := convertToCFMMPool(pool)
+	if err != nil || isPoolFrozen(cfmmPool) {
+		return
+	}
+
+	invariantName, msg, broken := poolBalanceInvariantName, "", false
+	if msg, broken = checkPoolAccountBalance(ctx, cfmmPool, k.bankKeeper); !broken {
+		invariantName = poolTotalSharesInvariantName
+		msg, broken = checkPoolTotalShares(ctx, cfmmPool, k.bankKeeper)
+	}
+	if !broken {
+		return
+	}
+
+	ctx.Logger().Error(fmt.Sprintf("gamm invariant %s broken, freezing pool %d", invariantName, pool.GetId()), "details", msg)
+	if err := k.setPoolFrozen(ctx, pool.GetId(), true); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("failed to freeze pool %d", pool.GetId()), "error", err)
+	}
+}
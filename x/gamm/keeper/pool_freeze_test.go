@@ -0,0 +1,195 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// TestSetPoolFrozen tests that only the pool freeze admin can freeze a pool, and that
+// a frozen pool rejects swaps and joins but can still be exited.
+func (suite *KeeperTestSuite) TestSetPoolFrozen() {
+	testcases := map[string]struct {
+		isStableswap     bool
+		noAdmin          bool
+		senderIsNotAdmin bool
+		poolId           uint64
+		expectedErr      error
+	}{
+		"balancer pool": {},
+		"stableswap pool": {
+			isStableswap: true,
+		},
+		"sender is not the admin": {
+			senderIsNotAdmin: true,
+			expectedErr:      types.ErrNotPoolFreezeAdmin,
+		},
+		"no admin set": {
+			noAdmin:     true,
+			expectedErr: types.ErrNotPoolFreezeAdmin,
+		},
+		"pool does not exist": {
+			poolId:      2,
+			expectedErr: types.PoolDoesNotExistError{PoolId: 2},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			admin := suite.TestAccs[0]
+			if !tc.noAdmin {
+				suite.setPoolFreezeAdmin(admin.String())
+			}
+			var poolId uint64
+			if tc.isStableswap {
+				poolId = suite.PrepareBasicStableswapPool()
+			} else {
+				poolId = suite.PrepareBalancerPool()
+			}
+			if tc.poolId != 0 {
+				poolId = tc.poolId
+			}
+			sender := admin
+			if tc.senderIsNotAdmin {
+				sender = suite.TestAccs[1]
+			}
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			msgServer := keeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+
+			// System under test.
+			_, err := msgServer.SetPoolFrozen(sdk.WrapSDKContext(ctx), &types.MsgSetPoolFrozen{
+				Sender: sender.String(),
+				PoolId: poolId,
+				Frozen: true,
+			})
+
+			if tc.expectedErr != nil {
+				suite.Require().ErrorContains(err, tc.expectedErr.Error())
+				suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 1)
+
+			// the pool query shows the pool frozen.
+			poolRes, err := suite.queryClient.Pool(suite.Ctx.Context(), &types.QueryPoolRequest{PoolId: poolId})
+			suite.Require().NoError(err)
+			var pool types.CFMMPoolI
+			err = suite.App.InterfaceRegistry().UnpackAny(poolRes.Pool, &pool)
+			suite.Require().NoError(err)
+			suite.Require().True(pool.(types.FreezablePoolExtension).IsFrozen())
+			suite.Require().False(pool.IsActive(suite.Ctx))
+
+			// swaps and joins are rejected.
+			tokenIn := sdk.NewInt64Coin("foo", 1000)
+			_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, admin, pool, tokenIn, "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+			suite.Require().ErrorIs(err, types.ErrPoolLocked)
+			_, _, err = suite.App.GAMMKeeper.JoinPoolNoSwap(suite.Ctx, admin, poolId, types.OneShare, nil)
+			suite.Require().ErrorIs(err, types.ErrPoolLocked)
+			_, err = suite.App.GAMMKeeper.JoinSwapExactAmountIn(suite.Ctx, admin, poolId, sdk.NewCoins(tokenIn), sdk.OneInt())
+			suite.Require().ErrorIs(err, types.ErrPoolLocked)
+
+			// exits are still allowed.
+			_, err = suite.App.GAMMKeeper.ExitPool(suite.Ctx, admin, poolId, types.OneShare, sdk.Coins{})
+			suite.Require().NoError(err)
+
+			// unfreezing the pool allows swaps again.
+			_, err = msgServer.SetPoolFrozen(sdk.WrapSDKContext(ctx), &types.MsgSetPoolFrozen{
+				Sender: sender.String(),
+				PoolId: poolId,
+				Frozen: false,
+			})
+			suite.Require().NoError(err)
+			pool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, admin, pool, tokenIn, "bar", sdk.OneInt(), pool.GetSwapFee(suite.Ctx))
+			suite.Require().NoError(err)
+		})
+	}
+}
+
+// TestBrokenPoolsFrozen tests that pools breaking an invariant are reported by the
+// invariants, which are read-only, and frozen once swapped through, after which they
+// are no longer reported.
+func (suite *KeeperTestSuite) TestBrokenPoolsFrozen() {
+	testcases := map[string]struct {
+		breakPool func(poolId uint64)
+	}{
+		"pool account balance below the pool's liquidity": {
+			breakPool: func(poolId uint64) {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+				suite.Require().NoError(err)
+				err = suite.App.BankKeeper.SendCoins(suite.Ctx, pool.GetAddress(), suite.TestAccs[1], sdk.NewCoins(sdk.NewInt64Coin("foo", 1)))
+				suite.Require().NoError(err)
+			},
+		},
+		"share supply above the pool's total shares": {
+			breakPool: func(poolId uint64) {
+				err := suite.App.BankKeeper.MintCoins(suite.Ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(types.GetPoolShareDenom(poolId), types.OneShare)))
+				suite.Require().NoError(err)
+			},
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			brokenPoolId := suite.PrepareBalancerPool()
+			poolId := suite.PrepareBasicStableswapPool()
+			invariant := keeper.AllInvariants(*suite.App.GAMMKeeper, suite.App.BankKeeper)
+			swap := func(ctx sdk.Context, poolId uint64) {
+				pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(ctx, poolId)
+				suite.Require().NoError(err)
+				_, err = suite.App.GAMMKeeper.SwapExactAmountIn(ctx, suite.TestAccs[0], pool, sdk.NewInt64Coin("foo", 1000), "bar", sdk.OneInt(), pool.GetSwapFee(ctx))
+				suite.Require().NoError(err)
+			}
+
+			_, broken := invariant(suite.Ctx)
+			suite.Require().False(broken)
+
+			tc.breakPool(brokenPoolId)
+
+			// the invariant reports the broken pool without freezing it.
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, broken = invariant(ctx)
+			suite.Require().True(broken)
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 0)
+			brokenPool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, brokenPoolId)
+			suite.Require().NoError(err)
+			suite.Require().True(brokenPool.IsActive(suite.Ctx))
+
+			// swapping through the other pool leaves it active.
+			ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			swap(ctx, poolId)
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 0)
+
+			// System under test.
+			ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			swap(ctx, brokenPoolId)
+
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 1)
+			brokenPool, err = suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, brokenPoolId)
+			suite.Require().NoError(err)
+			suite.Require().False(brokenPool.IsActive(suite.Ctx))
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			suite.Require().True(pool.IsActive(suite.Ctx))
+
+			// the frozen pool is no longer reported, and is not frozen again when exited.
+			msg, broken := invariant(suite.Ctx)
+			suite.Require().False(broken, msg)
+			ctx = suite.Ctx.WithEventManager(sdk.NewEventManager())
+			_, err = suite.App.GAMMKeeper.ExitPool(ctx, suite.TestAccs[0], brokenPoolId, types.OneShare, sdk.Coins{})
+			suite.Require().NoError(err)
+			suite.AssertEventEmitted(ctx, types.TypeEvtPoolFrozenSet, 0)
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) setPoolFreezeAdmin(admin string) {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.PoolFreezeAdmin = admin
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+}
@@ -129,7 +129,7 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (
 		return 0, err
 	}
 
-	poolId := k.getNextPoolIdAndIncrement(ctx)
+	poolId := k.GetNextPoolIdAndIncrement(ctx)
 	pool, err := msg.CreatePool(ctx, poolId)
 	if err != nil {
 		return 0, err
@@ -150,17 +150,34 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (
 		return 0, err
 	}
 
+	if err := k.InitializePool(ctx, pool, sender); err != nil {
+		return 0, err
+	}
+
+	return pool.GetId(), nil
+}
+
+// InitializePool initializes the given pool, whose initial liquidity must
+// already be in the pool's account. It mints the initial LP shares to the
+// pool creator, registers the share denom's metadata in x/bank, and stores
+// the pool.
+func (k Keeper) InitializePool(ctx sdk.Context, pool swaproutertypes.PoolI, creatorAddress sdk.AccAddress) error {
+	cfmmPool, err := convertToCFMMPool(pool)
+	if err != nil {
+		return err
+	}
+
 	// Mint the initial pool shares share token to the sender
-	err = k.MintPoolShareToAccount(ctx, pool, sender, pool.GetTotalShares())
+	err = k.MintPoolShareToAccount(ctx, cfmmPool, creatorAddress, cfmmPool.GetTotalShares())
 	if err != nil {
-		return 0, err
+		return err
 	}
 
 	// Finally, add the share token's meta data to the bank keeper.
-	poolShareBaseDenom := types.GetPoolShareDenom(pool.GetId())
-	poolShareDisplayDenom := fmt.Sprintf("GAMM-%d", pool.GetId())
+	poolShareBaseDenom := types.GetPoolShareDenom(cfmmPool.GetId())
+	poolShareDisplayDenom := fmt.Sprintf("GAMM-%d", cfmmPool.GetId())
 	k.bankKeeper.SetDenomMetaData(ctx, banktypes.Metadata{
-		Description: fmt.Sprintf("The share token of the gamm pool %d", pool.GetId()),
+		Description: fmt.Sprintf("The share token of the gamm pool %d", cfmmPool.GetId()),
 		DenomUnits: []*banktypes.DenomUnit{
 			{
 				Denom:    poolShareBaseDenom,
@@ -179,14 +196,14 @@ func (k Keeper) CreatePool(ctx sdk.Context, msg swaproutertypes.CreatePoolMsg) (
 		Display: poolShareDisplayDenom,
 	})
 
-	if err := k.setPool(ctx, pool); err != nil {
-		return 0, err
+	if err := k.setPool(ctx, cfmmPool); err != nil {
+		return err
 	}
 
-	k.hooks.AfterPoolCreated(ctx, sender, pool.GetId())
-	k.RecordTotalLiquidityIncrease(ctx, initialPoolLiquidity)
+	k.hooks.AfterPoolCreated(ctx, creatorAddress, cfmmPool.GetId())
+	k.RecordTotalLiquidityIncrease(ctx, cfmmPool.GetTotalPoolLiquidity(ctx))
 
-	return pool.GetId(), nil
+	return nil
 }
 
 // JoinPoolNoSwap aims to LP exactly enough to pool #{poolId} to get shareOutAmount number of LP shares.
@@ -216,6 +233,9 @@ func (k Keeper) JoinPoolNoSwap(
 	if err != nil {
 		return nil, sdk.ZeroInt(), err
 	}
+	if !pool.IsActive(ctx) {
+		return nil, sdk.ZeroInt(), sdkerrors.Wrapf(types.ErrPoolLocked, "join on inactive pool")
+	}
 
 	// we do an abstract calculation on the lp liquidity coins needed to have
 	// the designated amount of given shares of the pool without performing swap
@@ -431,7 +451,11 @@ func (k Keeper) ExitSwapShareAmountIn(
 		if coin.Denom == tokenOutDenom {
 			continue
 		}
-		swapOut, err := k.SwapExactAmountIn(ctx, sender, poolId, coin, tokenOutDenom, sdk.ZeroInt())
+		pool, err := k.getPoolForSwap(ctx, poolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		swapOut, err := k.swapExactAmountIn(ctx, sender, pool, coin, tokenOutDenom, sdk.ZeroInt(), pool.GetSwapFee(ctx))
 		if err != nil {
 			return sdk.Int{}, err
 		}
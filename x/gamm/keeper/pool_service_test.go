@@ -273,6 +273,9 @@ func (suite *KeeperTestSuite) TestPoolCreationFee() {
 		// set pool creation fee
 		gammKeeper.SetParams(suite.Ctx, types.Params{
 			PoolCreationFee: test.poolCreationFee,
+			TakeRate:        sdk.ZeroDec(),
+
+			ProtocolRevenueEpochIdentifier: "day",
 		})
 
 		// fund sender test account
@@ -0,0 +1,211 @@
+package keeper
+
+import (
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// splitSwapFee splits the swapFee charged to sender into the swap fee paid to
+// the pool, and the rate of the swap's input taken as protocol revenue.
+// The pool's swap fee is lowered such that the pool, swapping the input net
+// of the protocol's take, returns the same amount as it would have without it:
+// (1 - poolSwapFee) * (1 - protocolRate) = 1 - swapFee.
+// The protocol revenue collector itself is never charged, so that swapping
+// the collected revenue at epoch end is not taxed again.
+// Only swaps through pools of this module are charged, not those through pools
+// of other pool modules routed by the swaprouter.
+func (k Keeper) splitSwapFee(ctx sdk.Context, sender sdk.AccAddress, swapFee sdk.Dec) (poolSwapFee sdk.Dec, protocolRate sdk.Dec) {
+	if swapFee.IsZero() || sender.Equals(authtypes.NewModuleAddress(types.ProtocolRevenueCollectorName)) {
+		return swapFee, sdk.ZeroDec()
+	}
+	takeRate := k.GetParams(ctx).TakeRate
+	if takeRate.IsZero() {
+		return swapFee, sdk.ZeroDec()
+	}
+
+	protocolRate = swapFee.Mul(takeRate)
+	poolSwapFee = swapFee.Sub(protocolRate).Quo(sdk.OneDec().Sub(protocolRate))
+	return poolSwapFee, protocolRate
+}
+
+// chargeProtocolRevenue sends revenue, taken from a swap of sender through the
+// given pool, to the protocol revenue collector and records it against the pool.
+func (k Keeper) chargeProtocolRevenue(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, revenue sdk.Coin) error {
+	if !revenue.IsPositive() {
+		return nil
+	}
+
+	err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ProtocolRevenueCollectorName, sdk.Coins{revenue})
+	if err != nil {
+		return err
+	}
+	k.setPoolProtocolRevenue(ctx, poolId, revenue.AddAmount(k.getPoolDenomProtocolRevenue(ctx, poolId, revenue.Denom)))
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.TypeEvtProtocolRevenueCollected,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(types.AttributeKeyPoolId, strconv.FormatUint(poolId, 10)),
+			sdk.NewAttribute(types.AttributeKeyRevenue, revenue.String()),
+		),
+	)
+	return nil
+}
+
+func (k Keeper) setPoolProtocolRevenue(ctx sdk.Context, poolId uint64, revenue sdk.Coin) {
+	store := ctx.KVStore(k.storeKey)
+	bz, err := revenue.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(types.GetKeyPoolProtocolRevenue(poolId, revenue.Denom), bz)
+}
+
+func (k Keeper) getPoolDenomProtocolRevenue(ctx sdk.Context, poolId uint64, denom string) sdk.Int {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetKeyPoolProtocolRevenue(poolId, denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return amount
+}
+
+// GetPoolProtocolRevenue returns the protocol revenue ever taken from swaps
+// through the given pool.
+func (k Keeper) GetPoolProtocolRevenue(ctx sdk.Context, poolId uint64) sdk.Coins {
+	revenue := sdk.Coins{}
+	k.iterateProtocolRevenue(ctx, types.GetKeyPrefixPoolProtocolRevenue(poolId), func(_ uint64, coin sdk.Coin) bool {
+		revenue = revenue.Add(coin)
+		return false
+	})
+	return revenue
+}
+
+// GetProtocolRevenue returns the protocol revenue ever taken from swaps
+// through any pool.
+func (k Keeper) GetProtocolRevenue(ctx sdk.Context) sdk.Coins {
+	revenue := sdk.Coins{}
+	k.iterateProtocolRevenue(ctx, types.KeyPrefixProtocolRevenue, func(_ uint64, coin sdk.Coin) bool {
+		revenue = revenue.Add(coin)
+		return false
+	})
+	return revenue
+}
+
+// getPoolProtocolRevenues returns the protocol revenue of every pool it was
+// taken from, ordered by pool id.
+func (k Keeper) getPoolProtocolRevenues(ctx sdk.Context) []types.PoolProtocolRevenue {
+	poolRevenues := []types.PoolProtocolRevenue{}
+	k.iterateProtocolRevenue(ctx, types.KeyPrefixProtocolRevenue, func(poolId uint64, coin sdk.Coin) bool {
+		if len(poolRevenues) == 0 || poolRevenues[len(poolRevenues)-1].PoolId != poolId {
+			poolRevenues = append(poolRevenues, types.PoolProtocolRevenue{PoolId: poolId, Revenue: sdk.Coins{}})
+		}
+		last := &poolRevenues[len(poolRevenues)-1]
+		last.Revenue = last.Revenue.Add(coin)
+		return false
+	})
+	return poolRevenues
+}
+
+// iterateProtocolRevenue iterates over the protocol revenue stored under
+// keyPrefix, calling cb with the pool and revenue of each denom until it
+// returns true.
+func (k Keeper) iterateProtocolRevenue(ctx sdk.Context, keyPrefix []byte, cb func(poolId uint64, coin sdk.Coin) bool) {
+	store := ctx.KVStore(k.storeKey)
+	prefixStore := prefix.NewStore(store, types.KeyPrefixProtocolRevenue)
+
+	iterator := sdk.KVStorePrefixIterator(prefixStore, keyPrefix[len(types.KeyPrefixProtocolRevenue):])
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+
+		if cb(sdk.BigEndianToUint64(key[:8]), sdk.NewCoin(string(key[8:]), amount)) {
+			break
+		}
+	}
+}
+
+// swapProtocolRevenueToBaseDenom swaps the non-OSMO balances of the protocol
+// revenue collector to OSMO, each through the active pool of both denoms holding
+// the most OSMO. The OSMO is kept by the collector.
+// The pools are iterated over once, and at most MaxProtocolRevenueSwapsPerEpoch
+// denoms are swapped, in denom order. A denom left over or whose swap failed is
+// swapped at a later epoch end.
+func (k Keeper) swapProtocolRevenueToBaseDenom(ctx sdk.Context) {
+	collectorAddr := authtypes.NewModuleAddress(types.ProtocolRevenueCollectorName)
+	balances := k.bankKeeper.GetAllBalances(ctx, collectorAddr)
+	if balances.Empty() || (len(balances) == 1 && balances[0].Denom == appparams.BaseCoinUnit) {
+		return
+	}
+
+	pools, err := k.GetPoolsAndPoke(ctx)
+	if err != nil {
+		ctx.Logger().Error("Error getting pools to swap protocol revenue", err)
+		return
+	}
+
+	// Find the pool with the most OSMO of each revenue denom.
+	bestPoolIds := map[string]uint64{}
+	bestBaseLiquidities := map[string]sdk.Int{}
+	for _, pool := range pools {
+		if !pool.IsActive(ctx) {
+			continue
+		}
+		liquidity := pool.GetTotalPoolLiquidity(ctx)
+		baseLiquidity := liquidity.AmountOf(appparams.BaseCoinUnit)
+		if !baseLiquidity.IsPositive() {
+			continue
+		}
+		for _, poolCoin := range liquidity {
+			if poolCoin.Denom == appparams.BaseCoinUnit || !balances.AmountOf(poolCoin.Denom).IsPositive() {
+				continue
+			}
+			if bestBaseLiquidity, ok := bestBaseLiquidities[poolCoin.Denom]; ok && !baseLiquidity.GT(bestBaseLiquidity) {
+				continue
+			}
+			bestPoolIds[poolCoin.Denom], bestBaseLiquidities[poolCoin.Denom] = pool.GetId(), baseLiquidity
+		}
+	}
+
+	swaps := 0
+	for _, coin := range balances {
+		bestPoolId, found := bestPoolIds[coin.Denom]
+		if !found {
+			continue
+		}
+		if swaps == types.MaxProtocolRevenueSwapsPerEpoch {
+			return
+		}
+		swaps++
+
+		// Do the swap of this revenue denom to base denom.
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			// We allow full slippage, as in x/txfees. The pool with the most OSMO is
+			// the one most costly to move the price of.
+			minAmountOut := sdk.ZeroInt()
+			pool, err := k.GetPool(cacheCtx, bestPoolId)
+			if err != nil {
+				return err
+			}
+			_, err = k.SwapExactAmountIn(cacheCtx, collectorAddr, pool, coin, appparams.BaseCoinUnit, minAmountOut, pool.GetSwapFee(cacheCtx))
+			return err
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/pool-models/balancer"
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var protocolRevenueCollector = authtypes.NewModuleAddress(types.ProtocolRevenueCollectorName)
+
+func (suite *KeeperTestSuite) setTakeRate(takeRate sdk.Dec) {
+	params := suite.App.GAMMKeeper.GetParams(suite.Ctx)
+	params.TakeRate = takeRate
+	suite.App.GAMMKeeper.SetParams(suite.Ctx, params)
+}
+
+func (suite *KeeperTestSuite) TestSwapProtocolRevenue() {
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	tests := map[string]struct {
+		takeRate        sdk.Dec
+		expectedRevenue sdk.Int
+	}{
+		"no take rate": {
+			takeRate:        sdk.ZeroDec(),
+			expectedRevenue: sdk.ZeroInt(),
+		},
+		"half of the swap fee": {
+			takeRate:        sdk.MustNewDecFromStr("0.5"),
+			expectedRevenue: sdk.NewInt(500),
+		},
+		"the whole swap fee": {
+			takeRate:        sdk.OneDec(),
+			expectedRevenue: sdk.NewInt(1000),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name+", exact amount in", func() {
+			suite.SetupTest()
+			suite.setTakeRate(tc.takeRate)
+			poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			tokenIn := sdk.NewInt64Coin("foo", 100_000)
+			expectedTokenOut, err := suite.App.GAMMKeeper.CalcOutAmtGivenIn(suite.Ctx, pool, tokenIn, "bar", swapFee)
+			suite.Require().NoError(err)
+
+			tokenOutAmount, err := suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, tokenIn, "bar", sdk.OneInt(), swapFee)
+			suite.Require().NoError(err)
+
+			// the user gets the same out of the swap whatever the take rate,
+			// up to the truncation of the protocol's take.
+			suite.Require().True(tokenOutAmount.Sub(expectedTokenOut.Amount).Abs().LTE(sdk.OneInt()))
+			suite.assertProtocolRevenue(poolId, sdk.NewCoin("foo", tc.expectedRevenue))
+		})
+
+		suite.Run(name+", exact amount out", func() {
+			suite.SetupTest()
+			suite.setTakeRate(tc.takeRate)
+			poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+			pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, poolId)
+			suite.Require().NoError(err)
+			tokenOut := sdk.NewInt64Coin("bar", 100_000)
+			expectedTokenIn, err := suite.App.GAMMKeeper.CalcInAmtGivenOut(suite.Ctx, pool, tokenOut, "foo", swapFee)
+			suite.Require().NoError(err)
+
+			tokenInAmount, err := suite.App.GAMMKeeper.SwapExactAmountOut(suite.Ctx, suite.TestAccs[0], pool, "foo", sdk.NewInt(1_000_000), tokenOut, swapFee)
+			suite.Require().NoError(err)
+
+			suite.Require().True(tokenInAmount.Sub(expectedTokenIn.Amount).Abs().LTE(sdk.OneInt()))
+			expectedRevenue := swapFee.Mul(tc.takeRate).MulInt(tokenInAmount).TruncateInt()
+			suite.Require().True(expectedRevenue.Sub(suite.App.GAMMKeeper.GetPoolProtocolRevenue(suite.Ctx, poolId).AmountOf("foo")).Abs().LTE(sdk.OneInt()))
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) assertProtocolRevenue(poolId uint64, expectedRevenue sdk.Coin) {
+	expectedRevenues := sdk.NewCoins(expectedRevenue)
+	suite.Require().True(expectedRevenues.IsEqual(suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolRevenueCollector)))
+	suite.Require().True(expectedRevenues.IsEqual(suite.App.GAMMKeeper.GetPoolProtocolRevenue(suite.Ctx, poolId)))
+
+	poolRes, err := suite.queryClient.PoolProtocolRevenue(suite.Ctx.Context(), &types.QueryPoolProtocolRevenueRequest{PoolId: poolId})
+	suite.Require().NoError(err)
+	suite.Require().True(expectedRevenues.IsEqual(poolRes.Revenue))
+	res, err := suite.queryClient.ProtocolRevenue(suite.Ctx.Context(), &types.QueryProtocolRevenueRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(expectedRevenues.IsEqual(res.Revenue))
+}
+
+func (suite *KeeperTestSuite) TestProtocolRevenueGenesis() {
+	suite.setTakeRate(sdk.MustNewDecFromStr("0.5"))
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	firstPoolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+	secondPoolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+	for _, swap := range []struct {
+		poolId  uint64
+		tokenIn sdk.Coin
+	}{
+		{firstPoolId, sdk.NewInt64Coin("foo", 100_000)},
+		{firstPoolId, sdk.NewInt64Coin("bar", 200_000)},
+		{secondPoolId, sdk.NewInt64Coin("foo", 300_000)},
+	} {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, swap.poolId)
+		suite.Require().NoError(err)
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, swap.tokenIn, "baz", sdk.OneInt(), swapFee)
+		suite.Require().NoError(err)
+	}
+
+	genesis := suite.App.GAMMKeeper.ExportGenesis(suite.Ctx)
+	suite.Require().Equal([]types.PoolProtocolRevenue{
+		{PoolId: firstPoolId, Revenue: sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 500))},
+		{PoolId: secondPoolId, Revenue: sdk.NewCoins(sdk.NewInt64Coin("foo", 1500))},
+	}, genesis.PoolProtocolRevenues)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("bar", 1000), sdk.NewInt64Coin("foo", 2000)), suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx))
+
+	suite.SetupTest()
+	suite.App.GAMMKeeper.InitGenesis(suite.Ctx, *genesis, suite.App.AppCodec())
+	suite.Require().Equal(genesis.PoolProtocolRevenues, suite.App.GAMMKeeper.ExportGenesis(suite.Ctx).PoolProtocolRevenues)
+}
+
+func (suite *KeeperTestSuite) TestSwapProtocolRevenueAtEpochEnd() {
+	suite.setTakeRate(sdk.MustNewDecFromStr("0.5"))
+	swapFee := sdk.MustNewDecFromStr("0.01")
+	poolId := suite.PrepareBalancerPoolWithPoolParams(balancer.PoolParams{SwapFee: swapFee, ExitFee: sdk.ZeroDec()})
+	// a pool without OSMO, the revenue of which can't be swapped.
+	suite.PrepareBalancerPoolWithCoins(sdk.NewInt64Coin("bar", 1_000_000), sdk.NewInt64Coin("qux", 1_000_000))
+	for _, swap := range []struct {
+		poolId  uint64
+		tokenIn sdk.Coin
+	}{
+		{poolId, sdk.NewInt64Coin("foo", 100_000)},
+		{poolId, sdk.NewInt64Coin("uosmo", 100_000)},
+	} {
+		pool, err := suite.App.GAMMKeeper.GetPoolAndPoke(suite.Ctx, swap.poolId)
+		suite.Require().NoError(err)
+		_, err = suite.App.GAMMKeeper.SwapExactAmountIn(suite.Ctx, suite.TestAccs[0], pool, swap.tokenIn, "baz", sdk.OneInt(), swapFee)
+		suite.Require().NoError(err)
+	}
+	suite.FundModuleAcc(types.ProtocolRevenueCollectorName, sdk.NewCoins(sdk.NewInt64Coin("qux", 1000)))
+	revenue := suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx)
+	balancesBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolRevenueCollector)
+
+	// the revenue is only swapped at the end of the epochs of the protocol revenue epoch identifier.
+	err := suite.App.GAMMKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, "week", 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(balancesBefore, suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolRevenueCollector))
+
+	err = suite.App.GAMMKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, "day", 1)
+	suite.Require().NoError(err)
+
+	// the foo revenue was swapped to OSMO, without being taxed again,
+	// while the qux revenue has no pool to be swapped through.
+	balances := suite.App.BankKeeper.GetAllBalances(suite.Ctx, protocolRevenueCollector)
+	suite.Require().True(balances.AmountOf("foo").IsZero())
+	suite.Require().Equal(sdk.NewInt(1000), balances.AmountOf("qux"))
+	suite.Require().True(balances.AmountOf("uosmo").GT(sdk.NewInt(500)))
+	suite.Require().Equal(revenue, suite.App.GAMMKeeper.GetProtocolRevenue(suite.Ctx))
+}
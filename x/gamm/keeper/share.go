@@ -27,6 +27,7 @@ func (k Keeper) applyJoinPoolStateChange(ctx sdk.Context, pool swaproutertypes.P
 	events.EmitAddLiquidityEvent(ctx, joiner, pool.GetId(), joinCoins)
 	k.hooks.AfterJoinPool(ctx, joiner, pool.GetId(), joinCoins, numShares)
 	k.RecordTotalLiquidityIncrease(ctx, joinCoins)
+	k.freezePoolIfBroken(ctx, pool)
 	return nil
 }
 
@@ -49,6 +50,7 @@ func (k Keeper) applyExitPoolStateChange(ctx sdk.Context, pool swaproutertypes.P
 	events.EmitRemoveLiquidityEvent(ctx, exiter, pool.GetId(), exitCoins)
 	k.hooks.AfterExitPool(ctx, exiter, pool.GetId(), numShares, exitCoins)
 	k.RecordTotalLiquidityDecrease(ctx, exitCoins)
+	k.freezePoolIfBroken(ctx, pool)
 	return nil
 }
 
@@ -9,28 +9,34 @@ import (
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // SwapExactAmountIn attempts to swap one asset, tokenIn, for another asset
-// denominated via tokenOutDenom through a pool denoted by poolId specifying that
+// denominated via tokenOutDenom through the given pool specifying that
 // tokenOutMinAmount must be returned in the resulting asset returning an error
-// upon failure. Upon success, the resulting tokens swapped for are returned. A
-// swap fee is applied determined by the pool's parameters.
+// upon failure. Upon success, the resulting tokens swapped for are returned.
+// The provided swapFee is charged, allowing the caller to apply discounts,
+// e.g. for multi-hops routed through OSMO.
 func (k Keeper) SwapExactAmountIn(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenIn sdk.Coin,
 	tokenOutDenom string,
 	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
 ) (sdk.Int, error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	if !pool.IsActive(ctx) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+
+	cfmmPool, err := convertToCFMMPool(pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+	return k.swapExactAmountIn(ctx, sender, cfmmPool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
 }
 
 // swapExactAmountIn is an internal method for swapping an exact amount of tokens
@@ -49,7 +55,6 @@ func (k Keeper) swapExactAmountIn(
 	if tokenIn.Denom == tokenOutDenom {
 		return sdk.Int{}, errors.New("cannot trade same denomination in and out")
 	}
-	tokensIn := sdk.Coins{tokenIn}
 
 	defer func() {
 		if r := recover(); r != nil {
@@ -58,9 +63,16 @@ func (k Keeper) swapExactAmountIn(
 		}
 	}()
 
+	// The protocol's take of the swap fee is taken out of tokenIn before it
+	// reaches the pool, which charges the rest of the swap fee.
+	swapFee = k.getSwapFeeForSwap(ctx, pool, swapFee)
+	poolSwapFee, protocolRate := k.splitSwapFee(ctx, sender, swapFee)
+	protocolRevenue := sdk.NewCoin(tokenIn.Denom, protocolRate.MulInt(tokenIn.Amount).TruncateInt())
+	poolTokenIn := tokenIn.Sub(protocolRevenue)
+
 	// Executes the swap in the pool and stores the output. Updates pool assets but
 	// does not actually transfer any tokens to or from the pool.
-	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, tokensIn, tokenOutDenom, swapFee)
+	tokenOutCoin, err := pool.SwapOutAmtGivenIn(ctx, sdk.Coins{poolTokenIn}, tokenOutDenom, poolSwapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
@@ -77,27 +89,40 @@ func (k Keeper) swapExactAmountIn(
 
 	// Settles balances between the tx sender and the pool to match the swap that was executed earlier.
 	// Also emits swap event and updates related liquidity metrics
-	if err := k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOutCoin); err != nil {
+	if err := k.updatePoolForSwap(ctx, pool, sender, poolTokenIn, tokenOutCoin); err != nil {
+		return sdk.Int{}, err
+	}
+	if err := k.chargeProtocolRevenue(ctx, sender, pool.GetId(), protocolRevenue); err != nil {
 		return sdk.Int{}, err
 	}
 
 	return tokenOutAmount, nil
 }
 
+// SwapExactAmountOut attempts to swap enough of tokenInDenom through the given
+// pool to get exactly tokenOut, erroring if more than tokenInMaxAmount would be
+// required. Upon success, the amount of tokens swapped in is returned.
+// The provided swapFee is charged, allowing the caller to apply discounts,
+// e.g. for multi-hops routed through OSMO.
 func (k Keeper) SwapExactAmountOut(
 	ctx sdk.Context,
 	sender sdk.AccAddress,
-	poolId uint64,
+	pool swaproutertypes.PoolI,
 	tokenInDenom string,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
+	swapFee sdk.Dec,
 ) (tokenInAmount sdk.Int, err error) {
-	pool, err := k.getPoolForSwap(ctx, poolId)
+	if !pool.IsActive(ctx) {
+		return sdk.Int{}, sdkerrors.Wrapf(types.ErrPoolLocked, "swap on inactive pool")
+	}
+
+	cfmmPool, err := convertToCFMMPool(pool)
 	if err != nil {
 		return sdk.Int{}, err
 	}
-	swapFee := pool.GetSwapFee(ctx)
-	return k.swapExactAmountOut(ctx, sender, pool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
+
+	return k.swapExactAmountOut(ctx, sender, cfmmPool, tokenInDenom, tokenInMaxAmount, tokenOut, swapFee)
 }
 
 // swapExactAmountOut is an internal method for swapping to get an exact number of tokens out of a pool,
@@ -130,10 +155,16 @@ func (k Keeper) swapExactAmountOut(
 			"can't get more tokens out than there are tokens in the pool")
 	}
 
-	tokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, swapFee)
+	// The pool charges its share of the swap fee on the tokens it swaps in,
+	// and the protocol's take of the swap fee is charged on top of them.
+	swapFee = k.getSwapFeeForSwap(ctx, pool, swapFee)
+	poolSwapFee, protocolRate := k.splitSwapFee(ctx, sender, swapFee)
+	poolTokenIn, err := pool.SwapInAmtGivenOut(ctx, sdk.Coins{tokenOut}, tokenInDenom, poolSwapFee)
 	if err != nil {
 		return sdk.Int{}, err
 	}
+	protocolRevenue := sdk.NewCoin(tokenInDenom, protocolRate.MulInt(poolTokenIn.Amount).Quo(sdk.OneDec().Sub(protocolRate)).TruncateInt())
+	tokenIn := poolTokenIn.Add(protocolRevenue)
 	tokenInAmount = tokenIn.Amount
 
 	if tokenInAmount.LTE(sdk.ZeroInt()) {
@@ -144,13 +175,51 @@ func (k Keeper) swapExactAmountOut(
 		return sdk.Int{}, sdkerrors.Wrapf(types.ErrLimitMaxAmount, "Swap requires %s, which is greater than the amount %s", tokenIn, tokenInMaxAmount)
 	}
 
-	err = k.updatePoolForSwap(ctx, pool, sender, tokenIn, tokenOut)
+	err = k.updatePoolForSwap(ctx, pool, sender, poolTokenIn, tokenOut)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	err = k.chargeProtocolRevenue(ctx, sender, pool.GetId(), protocolRevenue)
 	if err != nil {
 		return sdk.Int{}, err
 	}
 	return tokenInAmount, nil
 }
 
+// CalcOutAmtGivenIn returns how many tokenOutDenom coins would be returned for
+// swapping tokenIn through the given pool with the provided swapFee.
+// This does not mutate the pool, or state.
+func (k Keeper) CalcOutAmtGivenIn(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	swapFee sdk.Dec,
+) (tokenOut sdk.Coin, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return cfmmPool.CalcOutAmtGivenIn(ctx, sdk.NewCoins(tokenIn), tokenOutDenom, k.getSwapFeeForSwap(ctx, poolI, swapFee))
+}
+
+// CalcInAmtGivenOut returns how many tokenInDenom coins would need to be swapped
+// through the given pool with the provided swapFee to get exactly tokenOut.
+// This does not mutate the pool, or state.
+func (k Keeper) CalcInAmtGivenOut(
+	ctx sdk.Context,
+	poolI swaproutertypes.PoolI,
+	tokenOut sdk.Coin,
+	tokenInDenom string,
+	swapFee sdk.Dec,
+) (tokenIn sdk.Coin, err error) {
+	cfmmPool, err := convertToCFMMPool(poolI)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	return cfmmPool.CalcInAmtGivenOut(ctx, sdk.NewCoins(tokenOut), tokenInDenom, k.getSwapFeeForSwap(ctx, poolI, swapFee))
+}
+
 // updatePoolForSwap takes a pool, sender, and tokenIn, tokenOut amounts
 // It then updates the pool's balances to the new reserve amounts, and
 // sends the in tokens from the sender to the pool, and the out tokens from the pool to the sender.
@@ -187,6 +256,7 @@ func (k Keeper) updatePoolForSwap(
 	k.hooks.AfterSwap(ctx, sender, pool.GetId(), tokensIn, tokensOut)
 	k.RecordTotalLiquidityIncrease(ctx, tokensIn)
 	k.RecordTotalLiquidityDecrease(ctx, tokensOut)
+	k.freezePoolIfBroken(ctx, pool)
 
 	return err
 }
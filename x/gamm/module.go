@@ -75,8 +75,10 @@ func (b AppModuleBasic) RegisterRESTRoutes(ctx client.Context, r *mux.Router) {
 }
 
 func (b AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
-	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))     //nolint:errcheck
-	v2types.RegisterQueryHandlerClient(context.Background(), mux, v2types.NewQueryClient(clientCtx)) //nolint:errcheck
+	types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx))           //nolint:errcheck
+	v2types.RegisterQueryHandlerClient(context.Background(), mux, v2types.NewQueryClient(clientCtx))       //nolint:errcheck
+	balancer.RegisterQueryHandlerClient(context.Background(), mux, balancer.NewQueryClient(clientCtx))     //nolint:errcheck
+	stableswap.RegisterQueryHandlerClient(context.Background(), mux, stableswap.NewQueryClient(clientCtx)) //nolint:errcheck
 }
 
 func (b AppModuleBasic) GetTxCmd() *cobra.Command {
@@ -108,6 +110,8 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	stableswap.RegisterMsgServer(cfg.MsgServer(), keeper.NewStableswapMsgServerImpl(&am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
 	v2types.RegisterQueryServer(cfg.QueryServer(), keeper.NewV2Querier(am.keeper))
+	balancer.RegisterQueryServer(cfg.QueryServer(), keeper.NewBalancerQuerier(am.keeper))
+	stableswap.RegisterQueryServer(cfg.QueryServer(), keeper.NewStableswapQuerier(am.keeper))
 }
 
 func NewAppModule(cdc codec.Codec, keeper keeper.Keeper,
@@ -158,8 +162,10 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
-// BeginBlock performs a no-op.
-func (AppModule) BeginBlock(_ sdk.Context, _ abci.RequestBeginBlock) {}
+// BeginBlock updates the swap fees of pools with a dynamic swap fee.
+func (am AppModule) BeginBlock(ctx sdk.Context, _ abci.RequestBeginBlock) {
+	am.keeper.UpdateEffectiveSwapFees(ctx)
+}
 
 // EndBlock returns the end blocker for the gamm module. It returns no validator
 // updates.
@@ -252,6 +252,8 @@ type Pool struct {
 	PoolAssets []PoolAsset `protobuf:"bytes,6,rep,name=pool_assets,json=poolAssets,proto3" json:"pool_assets" yaml:"pool_assets"`
 	// sum of all non-normalized pool weights
 	TotalWeight github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,7,opt,name=total_weight,json=totalWeight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"total_weight" yaml:"total_weight"`
+	// frozen pools reject swaps and joins, but can still be exited.
+	Frozen bool `protobuf:"varint,8,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -298,59 +300,61 @@ func init() {
 }
 
 var fileDescriptor_7e991f749f68c2a4 = []byte{
-	// 831 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x93, 0x40, 0x96, 0xc9, 0x76, 0x2b, 0x66, 0x73, 0x30, 0x41, 0x8d, 0xd1, 0x54, 0xaa,
-	0x56, 0xd5, 0xc6, 0x56, 0x76, 0x7b, 0xda, 0xcb, 0x6a, 0x03, 0xb4, 0xe2, 0x46, 0x4d, 0x25, 0x4a,
-	0x85, 0x64, 0x4d, 0x92, 0x89, 0x6d, 0x61, 0x7b, 0x2c, 0xcf, 0x24, 0xc0, 0x37, 0xe8, 0x91, 0x23,
-	0xbd, 0x71, 0xef, 0xb5, 0xfd, 0x0e, 0xa8, 0xbd, 0x70, 0xac, 0x7a, 0x70, 0x2b, 0xe8, 0xa9, 0xc7,
-	0x7c, 0x82, 0x6a, 0xfe, 0x38, 0x09, 0x34, 0x51, 0x41, 0x3d, 0x65, 0xde, 0x9b, 0xf7, 0x7e, 0xef,
-	0xf7, 0xde, 0xfb, 0x8d, 0x03, 0xbe, 0xa0, 0x2c, 0xa6, 0x2c, 0x64, 0x8e, 0x8f, 0xe3, 0xd8, 0x49,
-	0x29, 0x8d, 0xda, 0x31, 0x1d, 0x90, 0x88, 0x39, 0x3d, 0x1c, 0xe1, 0xa4, 0x4f, 0xb2, 0xe9, 0x61,
-	0x9f, 0xd2, 0xc8, 0x4e, 0x33, 0xca, 0x29, 0x6c, 0xe8, 0x2c, 0x5b, 0x64, 0xd9, 0xe3, 0x4e, 0x8f,
-	0x70, 0xdc, 0x69, 0x6e, 0xf4, 0xa5, 0xdb, 0x93, 0x31, 0x8e, 0x32, 0x54, 0x42, 0xb3, 0xe1, 0x53,
-	0x9f, 0x2a, 0xbf, 0x38, 0x69, 0x6f, 0xcb, 0xa7, 0xd4, 0x8f, 0x88, 0x23, 0xad, 0xde, 0x68, 0xe8,
-	0x0c, 0x46, 0x19, 0xe6, 0x21, 0x4d, 0xf4, 0xbd, 0xf5, 0xf0, 0x9e, 0x87, 0x31, 0x61, 0x1c, 0xc7,
-	0x69, 0x01, 0xa0, 0x8a, 0x38, 0x78, 0xc4, 0x03, 0x47, 0xd3, 0x90, 0xc6, 0x83, 0xfb, 0x1e, 0x66,
-	0x64, 0x7a, 0xdf, 0xa7, 0xa1, 0x2e, 0x80, 0x7e, 0xad, 0x00, 0xf3, 0x20, 0xa6, 0x94, 0x07, 0x87,
-	0x24, 0xf4, 0x03, 0xbe, 0x1d, 0xe0, 0xc4, 0x27, 0xfb, 0x38, 0xc3, 0x31, 0x83, 0xdf, 0x02, 0xc0,
-	0x38, 0xce, 0xb8, 0x27, 0xaa, 0x9a, 0xc6, 0x96, 0xf1, 0xaa, 0xfe, 0xa6, 0x69, 0x2b, 0x4a, 0x76,
-	0x41, 0xc9, 0xfe, 0xa6, 0xa0, 0xd4, 0xfd, 0xe4, 0x3a, 0xb7, 0x4a, 0x93, 0xdc, 0x5a, 0x3f, 0xc7,
-	0x71, 0xf4, 0x0e, 0xcd, 0x72, 0xd1, 0xc5, 0x1f, 0x96, 0xe1, 0xae, 0x49, 0x87, 0x08, 0x87, 0x01,
-	0x78, 0x56, 0x74, 0x6a, 0x96, 0x25, 0xee, 0xc6, 0xbf, 0x70, 0x77, 0x74, 0x40, 0xb7, 0x23, 0x60,
-	0xff, 0xce, 0x2d, 0x58, 0xa4, 0xbc, 0xa6, 0x71, 0xc8, 0x49, 0x9c, 0xf2, 0xf3, 0x49, 0x6e, 0x7d,
-	0xac, 0x8a, 0x15, 0x77, 0xe8, 0x52, 0x94, 0x9a, 0xa2, 0xc3, 0x31, 0x68, 0x84, 0x49, 0xc8, 0x43,
-	0x1c, 0x79, 0x62, 0xb7, 0xde, 0xa9, 0x6c, 0x93, 0x99, 0x95, 0xad, 0xca, 0xab, 0xfa, 0x1b, 0xcb,
-	0x5e, 0xb4, 0x47, 0x5b, 0x2c, 0xfa, 0x03, 0x63, 0x84, 0x77, 0x3f, 0xd5, 0x2d, 0x6d, 0xaa, 0x2a,
-	0x8b, 0xa0, 0x90, 0x0b, 0xb5, 0x5b, 0xa4, 0xa9, 0x31, 0x32, 0xc8, 0xc0, 0x4b, 0x8e, 0x33, 0x9f,
-	0xf0, 0xfb, 0x65, 0xab, 0x8f, 0x2b, 0x8b, 0x74, 0xd9, 0xa6, 0x2a, 0xbb, 0x00, 0x09, 0xb9, 0xeb,
-	0xca, 0x3b, 0x57, 0x14, 0xfd, 0x55, 0x06, 0x40, 0xd8, 0x7a, 0x7f, 0xc7, 0xe0, 0x19, 0x3b, 0xc5,
-	0xa9, 0x37, 0x24, 0x6a, 0x7b, 0x6b, 0xdd, 0x0f, 0x02, 0xf7, 0xf7, 0xdc, 0xfa, 0xcc, 0x0f, 0x79,
-	0x30, 0xea, 0xd9, 0x7d, 0x1a, 0x6b, 0x99, 0xea, 0x9f, 0x36, 0x1b, 0x9c, 0x38, 0xfc, 0x3c, 0x25,
-	0xcc, 0xde, 0x21, 0xfd, 0xd9, 0x78, 0x0b, 0x1c, 0xe4, 0xd6, 0xc4, 0xf1, 0x4b, 0x42, 0x04, 0x3a,
-	0x39, 0x0b, 0xb9, 0x44, 0x2f, 0xff, 0x3f, 0xf4, 0x02, 0x07, 0xb9, 0x35, 0x71, 0x14, 0xe8, 0x3f,
-	0x18, 0x60, 0x93, 0x49, 0x61, 0xea, 0x8e, 0xbd, 0xbe, 0x94, 0xa6, 0x97, 0xca, 0xde, 0xcc, 0x8a,
-	0x54, 0x8d, 0xbd, 0x78, 0x90, 0xcb, 0x14, 0xdd, 0xfd, 0xfc, 0x3a, 0xb7, 0x8c, 0x49, 0x6e, 0x21,
-	0xdd, 0xd5, 0xf2, 0x02, 0xc8, 0x35, 0xd9, 0x12, 0x14, 0xf4, 0xa3, 0x01, 0xd6, 0xa6, 0xbb, 0x82,
-	0xbb, 0x60, 0x85, 0xd3, 0x13, 0x92, 0xe8, 0x07, 0xb2, 0x61, 0xeb, 0x77, 0x2f, 0x9e, 0xdc, 0x94,
-	0xd1, 0x36, 0x0d, 0x93, 0x6e, 0x43, 0x6f, 0xf5, 0xb9, 0xde, 0xaa, 0xc8, 0x42, 0xae, 0xca, 0x86,
-	0x87, 0x60, 0x55, 0xf1, 0xd0, 0xc3, 0x7c, 0xff, 0x84, 0x61, 0xee, 0x25, 0x7c, 0x92, 0x5b, 0x1f,
-	0x29, 0x58, 0x85, 0x82, 0x5c, 0x0d, 0x87, 0x7e, 0xae, 0x82, 0xaa, 0x60, 0x0b, 0x5f, 0x83, 0x1a,
-	0x1e, 0x0c, 0x32, 0xc2, 0x98, 0x56, 0x03, 0x9c, 0xe4, 0xd6, 0x0b, 0x95, 0xa4, 0x2f, 0x90, 0x5b,
-	0x84, 0xc0, 0x17, 0xa0, 0x1c, 0x0e, 0x24, 0x97, 0xaa, 0x5b, 0x0e, 0x07, 0x70, 0x08, 0xea, 0x52,
-	0x7f, 0xf7, 0xe6, 0xbf, 0xb5, 0x5c, 0xc8, 0x7a, 0xe2, 0x0f, 0x1e, 0x50, 0xf1, 0x29, 0xf5, 0xe6,
-	0xb0, 0x90, 0x0b, 0xd2, 0x99, 0x68, 0xbf, 0x06, 0x8d, 0xe1, 0x88, 0x8f, 0x32, 0xa2, 0x42, 0x7c,
-	0x3a, 0x26, 0x59, 0x42, 0x33, 0xb3, 0x2a, 0x29, 0x5b, 0x33, 0xa8, 0x45, 0x51, 0xc8, 0x85, 0xca,
-	0x2d, 0x18, 0x7c, 0xa5, 0x9d, 0xf0, 0x08, 0x3c, 0xe7, 0x94, 0xe3, 0xc8, 0x63, 0x01, 0xce, 0x08,
-	0x33, 0x57, 0xfe, 0x6b, 0x51, 0x9b, 0x9a, 0xf4, 0xcb, 0x62, 0x51, 0xb3, 0x64, 0xe4, 0xd6, 0xa5,
-	0x79, 0x20, 0x2d, 0x78, 0xac, 0xa7, 0x82, 0x85, 0x14, 0x98, 0xb9, 0xfa, 0xb8, 0xe7, 0xdd, 0xd4,
-	0xf8, 0x50, 0xe1, 0xcf, 0x21, 0xe8, 0x59, 0xc8, 0x30, 0x06, 0x83, 0x82, 0xb8, 0x56, 0x46, 0x4d,
-	0xce, 0x60, 0xf7, 0xc9, 0xca, 0xb8, 0xd7, 0x47, 0xa1, 0x0f, 0xd5, 0x87, 0x92, 0xf7, 0xbb, 0xf5,
-	0xef, 0xaf, 0xac, 0xd2, 0xe5, 0x95, 0x55, 0xfa, 0xe5, 0xa7, 0xf6, 0x8a, 0x20, 0xba, 0xd7, 0x3d,
-	0xba, 0xbe, 0x6d, 0x19, 0x37, 0xb7, 0x2d, 0xe3, 0xcf, 0xdb, 0x96, 0x71, 0x71, 0xd7, 0x2a, 0xdd,
-	0xdc, 0xb5, 0x4a, 0xbf, 0xdd, 0xb5, 0x4a, 0xdf, 0xbd, 0x9f, 0x2b, 0xac, 0x3b, 0x6d, 0x47, 0xb8,
-	0xc7, 0x0a, 0xc3, 0x19, 0x77, 0xde, 0x3a, 0x67, 0xcb, 0xff, 0x50, 0x7b, 0xab, 0xf2, 0x23, 0xff,
-	0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9d, 0xba, 0x2b, 0xb9, 0x7c, 0x07, 0x00, 0x00,
+	// 851 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x8f, 0xdb, 0x44,
+	0x14, 0x8f, 0x93, 0x6c, 0x92, 0x9d, 0x94, 0xa2, 0x4c, 0x73, 0xf0, 0x66, 0x45, 0x1c, 0x0d, 0x12,
+	0x5a, 0x50, 0x63, 0x2b, 0x2d, 0xa7, 0x5e, 0xaa, 0xba, 0x2d, 0xa8, 0xb7, 0xe2, 0x22, 0x95, 0xa2,
+	0x4a, 0xd6, 0x24, 0x99, 0xd8, 0x56, 0x6d, 0x8f, 0xe5, 0x99, 0xa4, 0x5d, 0x3e, 0x01, 0xc7, 0x1e,
+	0xcb, 0xad, 0x77, 0xae, 0x7c, 0x88, 0x15, 0x5c, 0x7a, 0x44, 0x1c, 0xcc, 0x6a, 0x97, 0x13, 0xc7,
+	0x7c, 0x02, 0x34, 0x7f, 0x9c, 0x64, 0x97, 0x44, 0xec, 0x8a, 0x53, 0xe6, 0xbd, 0x79, 0xef, 0xf7,
+	0x7e, 0xef, 0xbd, 0xdf, 0xc4, 0xe0, 0x4b, 0xca, 0x12, 0xca, 0x22, 0xe6, 0x04, 0x38, 0x49, 0x9c,
+	0x8c, 0xd2, 0x78, 0x98, 0xd0, 0x29, 0x89, 0x99, 0x33, 0xc6, 0x31, 0x4e, 0x27, 0x24, 0x5f, 0x1d,
+	0x9e, 0x52, 0x1a, 0xdb, 0x59, 0x4e, 0x39, 0x85, 0x5d, 0x9d, 0x65, 0x8b, 0x2c, 0x7b, 0x31, 0x1a,
+	0x13, 0x8e, 0x47, 0xbd, 0x83, 0x89, 0x74, 0xfb, 0x32, 0xc6, 0x51, 0x86, 0x4a, 0xe8, 0x75, 0x03,
+	0x1a, 0x50, 0xe5, 0x17, 0x27, 0xed, 0xed, 0x07, 0x94, 0x06, 0x31, 0x71, 0xa4, 0x35, 0x9e, 0xcf,
+	0x9c, 0xe9, 0x3c, 0xc7, 0x3c, 0xa2, 0xa9, 0xbe, 0xb7, 0x2e, 0xdf, 0xf3, 0x28, 0x21, 0x8c, 0xe3,
+	0x24, 0x2b, 0x01, 0x54, 0x11, 0x07, 0xcf, 0x79, 0xe8, 0x68, 0x1a, 0xd2, 0xb8, 0x74, 0x3f, 0xc6,
+	0x8c, 0xac, 0xee, 0x27, 0x34, 0xd2, 0x05, 0xd0, 0x6f, 0x35, 0x60, 0x3e, 0x4b, 0x28, 0xe5, 0xe1,
+	0x73, 0x12, 0x05, 0x21, 0x7f, 0x18, 0xe2, 0x34, 0x20, 0x4f, 0x71, 0x8e, 0x13, 0x06, 0xbf, 0x03,
+	0x80, 0x71, 0x9c, 0x73, 0x5f, 0x54, 0x35, 0x8d, 0x81, 0x71, 0xd4, 0xbe, 0xd3, 0xb3, 0x15, 0x25,
+	0xbb, 0xa4, 0x64, 0x7f, 0x5b, 0x52, 0x72, 0x3f, 0x39, 0x29, 0xac, 0xca, 0xb2, 0xb0, 0x3a, 0xc7,
+	0x38, 0x89, 0xef, 0xa1, 0x75, 0x2e, 0x7a, 0xfb, 0xa7, 0x65, 0x78, 0xfb, 0xd2, 0x21, 0xc2, 0x61,
+	0x08, 0x5a, 0x65, 0xa7, 0x66, 0x55, 0xe2, 0x1e, 0xfc, 0x0b, 0xf7, 0x91, 0x0e, 0x70, 0x47, 0x02,
+	0xf6, 0xef, 0xc2, 0x82, 0x65, 0xca, 0x6d, 0x9a, 0x44, 0x9c, 0x24, 0x19, 0x3f, 0x5e, 0x16, 0xd6,
+	0xc7, 0xaa, 0x58, 0x79, 0x87, 0xde, 0x89, 0x52, 0x2b, 0x74, 0xb8, 0x00, 0xdd, 0x28, 0x8d, 0x78,
+	0x84, 0x63, 0x5f, 0xec, 0xd6, 0x7f, 0x2d, 0xdb, 0x64, 0x66, 0x6d, 0x50, 0x3b, 0x6a, 0xdf, 0xb1,
+	0xec, 0x6d, 0x7b, 0xb4, 0xc5, 0xa2, 0x1f, 0x30, 0x46, 0xb8, 0xfb, 0xa9, 0x6e, 0xe9, 0x50, 0x55,
+	0xd9, 0x06, 0x85, 0x3c, 0xa8, 0xdd, 0x22, 0x4d, 0x8d, 0x91, 0x41, 0x06, 0x6e, 0x71, 0x9c, 0x07,
+	0x84, 0x5f, 0x2c, 0x5b, 0xbf, 0x5a, 0x59, 0xa4, 0xcb, 0xf6, 0x54, 0xd9, 0x2d, 0x48, 0xc8, 0xeb,
+	0x28, 0xef, 0x46, 0x51, 0xf4, 0x57, 0x15, 0x00, 0x61, 0xeb, 0xfd, 0xbd, 0x04, 0x2d, 0xf6, 0x1a,
+	0x67, 0xfe, 0x8c, 0xa8, 0xed, 0xed, 0xbb, 0x0f, 0x04, 0xee, 0x1f, 0x85, 0xf5, 0x59, 0x10, 0xf1,
+	0x70, 0x3e, 0xb6, 0x27, 0x34, 0xd1, 0x32, 0xd5, 0x3f, 0x43, 0x36, 0x7d, 0xe5, 0xf0, 0xe3, 0x8c,
+	0x30, 0xfb, 0x11, 0x99, 0xac, 0xc7, 0x5b, 0xe2, 0x20, 0xaf, 0x29, 0x8e, 0x5f, 0x11, 0x22, 0xd0,
+	0xc9, 0x9b, 0x88, 0x4b, 0xf4, 0xea, 0xff, 0x43, 0x2f, 0x71, 0x90, 0xd7, 0x14, 0x47, 0x81, 0xfe,
+	0x93, 0x01, 0x0e, 0x99, 0x14, 0xa6, 0xee, 0xd8, 0x9f, 0x48, 0x69, 0xfa, 0x99, 0xec, 0xcd, 0xac,
+	0x49, 0xd5, 0xd8, 0xdb, 0x07, 0xb9, 0x4b, 0xd1, 0xee, 0x17, 0x27, 0x85, 0x65, 0x2c, 0x0b, 0x0b,
+	0xe9, 0xae, 0x76, 0x17, 0x40, 0x9e, 0xc9, 0x76, 0xa0, 0xa0, 0x9f, 0x0d, 0xb0, 0xbf, 0xda, 0x15,
+	0x7c, 0x0c, 0xf6, 0x38, 0x7d, 0x45, 0x52, 0xfd, 0x40, 0x0e, 0x6c, 0xfd, 0xee, 0xc5, 0x93, 0x5b,
+	0x31, 0x7a, 0x48, 0xa3, 0xd4, 0xed, 0xea, 0xad, 0xde, 0xd0, 0x5b, 0x15, 0x59, 0xc8, 0x53, 0xd9,
+	0xf0, 0x39, 0x68, 0x28, 0x1e, 0x7a, 0x98, 0xf7, 0xaf, 0x31, 0xcc, 0x27, 0x29, 0x5f, 0x16, 0xd6,
+	0x47, 0x0a, 0x56, 0xa1, 0x20, 0x4f, 0xc3, 0xa1, 0xd3, 0x3a, 0xa8, 0x0b, 0xb6, 0xf0, 0x36, 0x68,
+	0xe2, 0xe9, 0x34, 0x27, 0x8c, 0x69, 0x35, 0xc0, 0x65, 0x61, 0xdd, 0x54, 0x49, 0xfa, 0x02, 0x79,
+	0x65, 0x08, 0xbc, 0x09, 0xaa, 0xd1, 0x54, 0x72, 0xa9, 0x7b, 0xd5, 0x68, 0x0a, 0x67, 0xa0, 0x2d,
+	0xf5, 0x77, 0x61, 0xfe, 0x83, 0xdd, 0x42, 0xd6, 0x13, 0xbf, 0xf4, 0x80, 0xca, 0xbf, 0x52, 0x7f,
+	0x03, 0x0b, 0x79, 0x20, 0x5b, 0x8b, 0xf6, 0x1b, 0xd0, 0x9d, 0xcd, 0xf9, 0x3c, 0x27, 0x2a, 0x24,
+	0xa0, 0x0b, 0x92, 0xa7, 0x34, 0x37, 0xeb, 0x92, 0xb2, 0xb5, 0x86, 0xda, 0x16, 0x85, 0x3c, 0xa8,
+	0xdc, 0x82, 0xc1, 0xd7, 0xda, 0x09, 0x5f, 0x80, 0x1b, 0x9c, 0x72, 0x1c, 0xfb, 0x2c, 0xc4, 0x39,
+	0x61, 0xe6, 0xde, 0x7f, 0x2d, 0xea, 0x50, 0x93, 0xbe, 0x55, 0x2e, 0x6a, 0x9d, 0x8c, 0xbc, 0xb6,
+	0x34, 0x9f, 0x49, 0x0b, 0xbe, 0xd4, 0x53, 0xc1, 0x42, 0x0a, 0xcc, 0x6c, 0x5c, 0xed, 0x79, 0xf7,
+	0x34, 0x3e, 0x54, 0xf8, 0x1b, 0x08, 0x7a, 0x16, 0x32, 0x8c, 0xc1, 0xb0, 0x24, 0xae, 0x95, 0xd1,
+	0x94, 0x33, 0x78, 0x7c, 0x6d, 0x65, 0x5c, 0xe8, 0xa3, 0xd4, 0x87, 0xea, 0x43, 0xc9, 0x1b, 0x7e,
+	0x0e, 0x1a, 0xb3, 0x9c, 0xfe, 0x40, 0x52, 0xb3, 0x35, 0x30, 0x8e, 0x5a, 0x6e, 0x67, 0xad, 0x27,
+	0xe5, 0x47, 0x9e, 0x0e, 0xb8, 0xd7, 0xf9, 0xf1, 0xbd, 0x55, 0x79, 0xf7, 0xde, 0xaa, 0xfc, 0xfa,
+	0xcb, 0x70, 0x4f, 0xf4, 0xf4, 0xc4, 0x7d, 0x71, 0x72, 0xd6, 0x37, 0x3e, 0x9c, 0xf5, 0x8d, 0xd3,
+	0xb3, 0xbe, 0xf1, 0xf6, 0xbc, 0x5f, 0xf9, 0x70, 0xde, 0xaf, 0xfc, 0x7e, 0xde, 0xaf, 0x7c, 0x7f,
+	0x7f, 0x83, 0xa3, 0x1e, 0xca, 0x30, 0xc6, 0x63, 0x56, 0x1a, 0xce, 0x62, 0x74, 0xd7, 0x79, 0xb3,
+	0xfb, 0xdb, 0x3b, 0x6e, 0xc8, 0xef, 0xc1, 0xdd, 0x7f, 0x06, 0x00, 0xd3, 0x6f, 0x6f, 0x37, 0xa7,
+	0x07, 0x00, 0x00,
 }
 
 func (m *SmoothWeightChangeParams) Marshal() (dAtA []byte, err error) {
@@ -538,6 +542,16 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
 	{
 		size := m.TotalWeight.Size()
 		i -= size
@@ -699,6 +713,9 @@ func (m *Pool) Size() (n int) {
 	}
 	l = m.TotalWeight.Size()
 	n += 1 + l + sovBalancerPool(uint64(l))
+	if m.Frozen {
+		n += 2
+	}
 	return n
 }
 
@@ -1409,6 +1426,26 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBalancerPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipBalancerPool(dAtA[iNdEx:])
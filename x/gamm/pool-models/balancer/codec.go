@@ -16,6 +16,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&Pool{}, "osmosis/gamm/BalancerPool", nil)
 	cdc.RegisterConcrete(&MsgCreateBalancerPool{}, "osmosis/gamm/create-balancer-pool", nil)
+	cdc.RegisterConcrete(&MsgUpdatePoolParams{}, "osmosis/gamm/update-pool-params", nil)
+	cdc.RegisterConcrete(&MsgScheduleWeightChange{}, "osmosis/gamm/schedule-weight-change", nil)
 	cdc.RegisterConcrete(&PoolParams{}, "osmosis/gamm/BalancerPoolParams", nil)
 }
 
@@ -33,6 +35,8 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&MsgCreateBalancerPool{},
+		&MsgUpdatePoolParams{},
+		&MsgScheduleWeightChange{},
 	)
 	registry.RegisterImplementations(
 		(*proto.Message)(nil),
@@ -1,6 +1,8 @@
 package balancer
 
 import (
+	"errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -9,12 +11,16 @@ import (
 )
 
 const (
-	TypeMsgCreateBalancerPool = "create_balancer_pool"
+	TypeMsgCreateBalancerPool   = "create_balancer_pool"
+	TypeMsgUpdatePoolParams     = "update_pool_params"
+	TypeMsgScheduleWeightChange = "schedule_weight_change"
 )
 
 var (
 	_ sdk.Msg                       = &MsgCreateBalancerPool{}
 	_ swaproutertypes.CreatePoolMsg = &MsgCreateBalancerPool{}
+	_ sdk.Msg                       = &MsgUpdatePoolParams{}
+	_ sdk.Msg                       = &MsgScheduleWeightChange{}
 )
 
 func NewMsgCreateBalancerPool(
@@ -103,3 +109,110 @@ func (msg MsgCreateBalancerPool) CreatePool(ctx sdk.Context, poolID uint64) (swa
 func (msg MsgCreateBalancerPool) GetPoolType() swaproutertypes.PoolType {
 	return swaproutertypes.Balancer
 }
+
+func NewMsgUpdatePoolParams(
+	sender sdk.AccAddress,
+	poolID uint64,
+	swapFee, exitFee sdk.Dec,
+	smoothWeightChangeParams *SmoothWeightChangeParams,
+) MsgUpdatePoolParams {
+	return MsgUpdatePoolParams{
+		Sender:                   sender.String(),
+		PoolID:                   poolID,
+		SwapFee:                  swapFee,
+		ExitFee:                  exitFee,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
+	}
+}
+
+func (msg MsgUpdatePoolParams) Route() string { return types.RouterKey }
+func (msg MsgUpdatePoolParams) Type() string  { return TypeMsgUpdatePoolParams }
+func (msg MsgUpdatePoolParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.SwapFee.IsNil() || msg.SwapFee.IsNegative() {
+		return types.ErrNegativeSwapFee
+	}
+	if msg.SwapFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchSwapFee
+	}
+	if msg.ExitFee.IsNil() || msg.ExitFee.IsNegative() {
+		return types.ErrNegativeExitFee
+	}
+	if msg.ExitFee.GTE(sdk.OneDec()) {
+		return types.ErrTooMuchExitFee
+	}
+
+	if msg.SmoothWeightChangeParams != nil {
+		return validateWeightChange(*msg.SmoothWeightChangeParams)
+	}
+
+	return nil
+}
+
+func (msg MsgUpdatePoolParams) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgUpdatePoolParams) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// validateWeightChange does the stateless checks of a weight change.
+// The target weights are checked against the pool assets when the msg is executed.
+func validateWeightChange(params SmoothWeightChangeParams) error {
+	if len(params.TargetPoolWeights) == 0 {
+		return types.ErrPoolParamsInvalidNumDenoms
+	}
+	for _, v := range params.TargetPoolWeights {
+		if err := ValidateUserSpecifiedWeight(v.Weight); err != nil {
+			return err
+		}
+	}
+	if params.Duration <= 0 {
+		return errors.New("params.SmoothWeightChangeParams must have a positive duration")
+	}
+	return nil
+}
+
+func NewMsgScheduleWeightChange(
+	sender sdk.AccAddress,
+	poolID uint64,
+	smoothWeightChangeParams SmoothWeightChangeParams,
+) MsgScheduleWeightChange {
+	return MsgScheduleWeightChange{
+		Sender:                   sender.String(),
+		PoolID:                   poolID,
+		SmoothWeightChangeParams: smoothWeightChangeParams,
+	}
+}
+
+func (msg MsgScheduleWeightChange) Route() string { return types.RouterKey }
+func (msg MsgScheduleWeightChange) Type() string  { return TypeMsgScheduleWeightChange }
+func (msg MsgScheduleWeightChange) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return validateWeightChange(msg.SmoothWeightChangeParams)
+}
+
+func (msg MsgScheduleWeightChange) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgScheduleWeightChange) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
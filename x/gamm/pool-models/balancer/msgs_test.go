@@ -2,6 +2,7 @@ package balancer_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -240,3 +241,216 @@ func TestMsgCreateBalancerPool(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgUpdatePoolParams(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+		msg := balancer.NewMsgUpdatePoolParams(addr1, 1, sdk.NewDecWithPrec(1, 2), sdk.NewDecWithPrec(1, 2), &balancer.SmoothWeightChangeParams{
+			Duration: time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{
+					Weight: sdk.NewInt(200),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(50),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		})
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "update_pool_params")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgUpdatePoolParams
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "no weight change",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.SmoothWeightChangeParams = nil
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative swap fee",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.SwapFee = sdk.NewDecWithPrec(-1, 2)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "swap fee of one",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.SwapFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "nil exit fee",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.ExitFee = sdk.Dec{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "exit fee of one",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.ExitFee = sdk.OneDec()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero target weight",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.ZeroInt()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: createMsg(func(msg balancer.MsgUpdatePoolParams) balancer.MsgUpdatePoolParams {
+				msg.SmoothWeightChangeParams.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgScheduleWeightChange(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+		msg := balancer.NewMsgScheduleWeightChange(addr1, 1, balancer.SmoothWeightChangeParams{
+			StartTime: time.Now(),
+			Duration:  time.Hour,
+			TargetPoolWeights: []balancer.PoolAsset{
+				{
+					Weight: sdk.NewInt(200),
+					Token:  sdk.NewCoin("test", sdk.ZeroInt()),
+				},
+				{
+					Weight: sdk.NewInt(50),
+					Token:  sdk.NewCoin("test2", sdk.ZeroInt()),
+				},
+			},
+		})
+		return after(msg)
+	}
+
+	defaultMsg := createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, defaultMsg.Route(), types.RouterKey)
+	require.Equal(t, defaultMsg.Type(), "schedule_weight_change")
+	signers := defaultMsg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        balancer.MsgScheduleWeightChange
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "no target weights",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "too large of a target weight",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.TargetPoolWeights[0].Weight = sdk.NewInt(1 << 21)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative duration",
+			msg: createMsg(func(msg balancer.MsgScheduleWeightChange) balancer.MsgScheduleWeightChange {
+				msg.SmoothWeightChangeParams.Duration = -time.Hour
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -88,6 +88,10 @@ func (p Pool) GetId() uint64 {
 	return p.Id
 }
 
+func (p Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.Balancer
+}
+
 func (p Pool) GetSwapFee(_ sdk.Context) sdk.Dec {
 	return p.PoolParams.SwapFee
 }
@@ -210,6 +214,18 @@ func (p *Pool) setInitialPoolParams(params PoolParams, sortedAssets []PoolAsset,
 	return nil
 }
 
+// SetPoolParams replaces the pool's swap fee, exit fee and smooth weight change params.
+// A new weight change starts from the pool's current weights, and a nil weight change
+// freezes the pool at its current weights.
+// The pool should be poked before calling this, so that the current weights are up to date.
+func (p *Pool) SetPoolParams(params PoolParams, curBlockTime time.Time) error {
+	sortedAssets := p.GetAllPoolAssets()
+	if err := params.Validate(sortedAssets); err != nil {
+		return err
+	}
+	return p.setInitialPoolParams(params, sortedAssets, curBlockTime)
+}
+
 // GetPoolAssets returns the denom's PoolAsset, If the PoolAsset doesn't exist, will return error.
 // As above, it will search the denom's PoolAsset by using binary search.
 // So, it is important to make sure that the PoolAssets are sorted.
@@ -478,8 +494,18 @@ func (p Pool) NumAssets() int {
 	return len(p.PoolAssets)
 }
 
+// IsActive returns false if the pool is frozen, in which case it rejects
+// swaps and joins.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Frozen
+}
+
+func (p Pool) IsFrozen() bool {
+	return p.Frozen
+}
+
+func (p *Pool) SetFrozen(frozen bool) {
+	p.Frozen = frozen
 }
 
 // CalcOutAmtGivenIn calculates tokens to be swapped out given the provided
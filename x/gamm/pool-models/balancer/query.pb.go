@@ -0,0 +1,716 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/balancer/query/query.proto
+
+package balancer
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// =============================== WeightSchedule
+type QueryWeightScheduleRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryWeightScheduleRequest) Reset()         { *m = QueryWeightScheduleRequest{} }
+func (m *QueryWeightScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryWeightScheduleRequest) ProtoMessage()    {}
+func (*QueryWeightScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1717031689f658bc, []int{0}
+}
+func (m *QueryWeightScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWeightScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWeightScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWeightScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWeightScheduleRequest.Merge(m, src)
+}
+func (m *QueryWeightScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWeightScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWeightScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWeightScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryWeightScheduleRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryWeightScheduleResponse struct {
+	// The pool assets with their weights interpolated at the current block time.
+	CurrentWeights []PoolAsset `protobuf:"bytes,1,rep,name=current_weights,json=currentWeights,proto3" json:"current_weights" yaml:"current_weights"`
+	// The pending or in progress weight change. Unset if the weights are not
+	// scheduled to change.
+	SmoothWeightChangeParams *SmoothWeightChangeParams `protobuf:"bytes,2,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+	// Time left until the target weights are reached, counted from the current
+	// block time. Zero if the weights are not scheduled to change.
+	RemainingDuration time.Duration `protobuf:"bytes,3,opt,name=remaining_duration,json=remainingDuration,proto3,stdduration" json:"remaining_duration" yaml:"remaining_duration"`
+}
+
+func (m *QueryWeightScheduleResponse) Reset()         { *m = QueryWeightScheduleResponse{} }
+func (m *QueryWeightScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryWeightScheduleResponse) ProtoMessage()    {}
+func (*QueryWeightScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1717031689f658bc, []int{1}
+}
+func (m *QueryWeightScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryWeightScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryWeightScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryWeightScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryWeightScheduleResponse.Merge(m, src)
+}
+func (m *QueryWeightScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryWeightScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryWeightScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryWeightScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryWeightScheduleResponse) GetCurrentWeights() []PoolAsset {
+	if m != nil {
+		return m.CurrentWeights
+	}
+	return nil
+}
+
+func (m *QueryWeightScheduleResponse) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
+func (m *QueryWeightScheduleResponse) GetRemainingDuration() time.Duration {
+	if m != nil {
+		return m.RemainingDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryWeightScheduleRequest)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.QueryWeightScheduleRequest")
+	proto.RegisterType((*QueryWeightScheduleResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.QueryWeightScheduleResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/balancer/query/query.proto", fileDescriptor_1717031689f658bc)
+}
+
+var fileDescriptor_1717031689f658bc = []byte{
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0x41, 0x6b, 0xd4, 0x40,
+	0x14, 0xc7, 0x77, 0xba, 0x5a, 0x21, 0x85, 0x15, 0x07, 0x91, 0xed, 0x56, 0x92, 0x25, 0xa0, 0x2c,
+	0x48, 0x67, 0xd8, 0x5d, 0x45, 0x10, 0x44, 0x5c, 0xeb, 0xa1, 0xb7, 0xba, 0x3d, 0x88, 0x5e, 0x96,
+	0x49, 0x32, 0x26, 0x81, 0x24, 0x2f, 0xcd, 0x4c, 0xaa, 0x45, 0xbc, 0xf8, 0x09, 0x0a, 0x22, 0xf8,
+	0x91, 0x7a, 0x2c, 0x78, 0xf1, 0x20, 0xab, 0xec, 0x8a, 0x1f, 0x60, 0x3f, 0x81, 0x64, 0x66, 0x52,
+	0x58, 0xed, 0xaa, 0xd0, 0x4b, 0x98, 0xe4, 0xbd, 0xdf, 0xff, 0xbd, 0x79, 0xef, 0x1f, 0x6b, 0x08,
+	0x22, 0x05, 0x11, 0x0b, 0x1a, 0xb2, 0x34, 0xa5, 0x39, 0x40, 0xb2, 0x9d, 0x42, 0xc0, 0x13, 0x41,
+	0x3d, 0x96, 0xb0, 0xcc, 0xe7, 0x05, 0x3d, 0x28, 0x79, 0x71, 0xa4, 0x9f, 0x24, 0x2f, 0x40, 0x02,
+	0xee, 0x19, 0x88, 0x54, 0x10, 0xa9, 0x20, 0xcd, 0x90, 0x9a, 0x21, 0x87, 0x7d, 0x8f, 0x4b, 0xd6,
+	0xef, 0x5c, 0x0f, 0x21, 0x04, 0x05, 0xd1, 0xea, 0xa4, 0xf9, 0xce, 0xcd, 0x10, 0x20, 0x4c, 0x38,
+	0x65, 0x79, 0x4c, 0x59, 0x96, 0x81, 0x64, 0x32, 0x86, 0x4c, 0x98, 0xa8, 0x6d, 0xa2, 0xea, 0xcd,
+	0x2b, 0x5f, 0xd1, 0xa0, 0x2c, 0x54, 0x82, 0x89, 0xdf, 0xfd, 0x77, 0xcb, 0xf5, 0x61, 0x0f, 0x20,
+	0xd1, 0x94, 0xbb, 0x6b, 0x75, 0x9e, 0x55, 0x57, 0x78, 0xce, 0xe3, 0x30, 0x92, 0xfb, 0x7e, 0xc4,
+	0x83, 0x32, 0xe1, 0x63, 0x7e, 0x50, 0x72, 0x21, 0xf1, 0x1d, 0xeb, 0x4a, 0x25, 0x34, 0x89, 0x83,
+	0x36, 0xea, 0xa2, 0xde, 0xa5, 0x11, 0x5e, 0x4c, 0x9d, 0xd6, 0x11, 0x4b, 0x93, 0x07, 0xae, 0x09,
+	0xb8, 0xe3, 0xf5, 0xea, 0xb4, 0x1b, 0xb8, 0xc7, 0x4d, 0x6b, 0xeb, 0x5c, 0x2d, 0x91, 0x43, 0x26,
+	0x38, 0x8e, 0xac, 0xab, 0x7e, 0x59, 0x14, 0x3c, 0x93, 0x93, 0xd7, 0x2a, 0x43, 0xb4, 0x51, 0xb7,
+	0xd9, 0xdb, 0x18, 0x38, 0x64, 0x69, 0x70, 0x66, 0x48, 0xa4, 0xea, 0xf2, 0xb1, 0x10, 0x5c, 0x8e,
+	0xec, 0x93, 0xa9, 0xd3, 0x58, 0x4c, 0x9d, 0x1b, 0xba, 0xf2, 0x6f, 0x2a, 0xee, 0xb8, 0x65, 0xbe,
+	0xe8, 0xc2, 0x02, 0x7f, 0x44, 0xd6, 0x96, 0x48, 0x01, 0x64, 0x64, 0x72, 0x26, 0x7e, 0xc4, 0xb2,
+	0x90, 0x4f, 0x72, 0x56, 0xb0, 0x54, 0xb4, 0xd7, 0xba, 0xa8, 0xb7, 0x31, 0x20, 0xe7, 0x97, 0xdd,
+	0x57, 0xa0, 0x96, 0x7a, 0xa2, 0xb0, 0x3d, 0x45, 0x8d, 0x6e, 0x2f, 0xa6, 0x8e, 0xab, 0x3b, 0xf8,
+	0x8b, 0xb8, 0x3b, 0x6e, 0x8b, 0x15, 0x0a, 0x18, 0x2c, 0x5c, 0xf0, 0x94, 0xc5, 0x59, 0x9c, 0x85,
+	0x93, 0x7a, 0x7d, 0xed, 0xa6, 0xea, 0x66, 0x93, 0xe8, 0xfd, 0x92, 0x7a, 0xbf, 0x64, 0xc7, 0x24,
+	0x8c, 0x6e, 0x99, 0xeb, 0x6f, 0xea, 0xe2, 0x7f, 0x4a, 0xb8, 0x9f, 0xbe, 0x39, 0x68, 0x7c, 0xed,
+	0x2c, 0x50, 0x93, 0x83, 0x9f, 0xc8, 0xba, 0xac, 0x56, 0x82, 0xbf, 0x22, 0xab, 0xb5, 0xbc, 0x17,
+	0xbc, 0x43, 0xfe, 0xd7, 0xaf, 0x64, 0xb5, 0x45, 0x3a, 0x4f, 0x2f, 0xa8, 0xa2, 0xcd, 0xe1, 0x3e,
+	0x7c, 0xff, 0xf9, 0xc7, 0x87, 0xb5, 0xfb, 0xf8, 0x1e, 0x5d, 0xb2, 0xb1, 0x41, 0x95, 0x9d, 0x05,
+	0x7d, 0x6b, 0x3c, 0xf7, 0x8e, 0x9a, 0xc9, 0x0b, 0x23, 0x33, 0x7a, 0x71, 0x32, 0xb3, 0xd1, 0xe9,
+	0xcc, 0x46, 0xdf, 0x67, 0x36, 0x3a, 0x9e, 0xdb, 0x8d, 0xd3, 0xb9, 0xdd, 0xf8, 0x32, 0xb7, 0x1b,
+	0x2f, 0x1f, 0x85, 0xb1, 0x8c, 0x4a, 0x8f, 0xf8, 0x90, 0xd6, 0xd2, 0xdb, 0x09, 0xf3, 0xc4, 0x59,
+	0x9d, 0xc3, 0xfe, 0x90, 0xbe, 0x59, 0xfd, 0xd3, 0x78, 0xeb, 0x6a, 0x21, 0xc3, 0x5f, 0x03, 0x00,
+	0xb3, 0x50, 0x1f, 0xca, 0x13, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// WeightSchedule returns the current weights of a balancer pool and its
+	// scheduled weight change, if any.
+	WeightSchedule(ctx context.Context, in *QueryWeightScheduleRequest, opts ...grpc.CallOption) (*QueryWeightScheduleResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) WeightSchedule(ctx context.Context, in *QueryWeightScheduleRequest, opts ...grpc.CallOption) (*QueryWeightScheduleResponse, error) {
+	out := new(QueryWeightScheduleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Query/WeightSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// WeightSchedule returns the current weights of a balancer pool and its
+	// scheduled weight change, if any.
+	WeightSchedule(context.Context, *QueryWeightScheduleRequest) (*QueryWeightScheduleResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) WeightSchedule(ctx context.Context, req *QueryWeightScheduleRequest) (*QueryWeightScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WeightSchedule not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_WeightSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryWeightScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).WeightSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Query/WeightSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).WeightSchedule(ctx, req.(*QueryWeightScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "WeightSchedule",
+			Handler:    _Query_WeightSchedule_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/pool-models/balancer/query/query.proto",
+}
+
+func (m *QueryWeightScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWeightScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWeightScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryWeightScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryWeightScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryWeightScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RemainingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RemainingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentWeights) > 0 {
+		for iNdEx := len(m.CurrentWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CurrentWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryWeightScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryWeightScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentWeights) > 0 {
+		for _, e := range m.CurrentWeights {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RemainingDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryWeightScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWeightScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWeightScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryWeightScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryWeightScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryWeightScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentWeights = append(m.CurrentWeights, PoolAsset{})
+			if err := m.CurrentWeights[len(m.CurrentWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &SmoothWeightChangeParams{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RemainingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
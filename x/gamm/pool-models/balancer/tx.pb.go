@@ -6,6 +6,7 @@ package balancer
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
@@ -142,9 +143,219 @@ func (m *MsgCreateBalancerPoolResponse) GetPoolID() uint64 {
 	return 0
 }
 
+// ===================== MsgUpdatePoolParams
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Updates the swap and exit fees of a balancer or stableswap pool,
+// and schedules a weight change for balancer pools.
+type MsgUpdatePoolParams struct {
+	Sender  string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID  uint64                                 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	ExitFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=exit_fee,json=exitFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"exit_fee" yaml:"exit_fee"`
+	// Only allowed for balancer pools. If unset, any weight change in progress
+	// continues unchanged. It cannot start before the current block time.
+	SmoothWeightChangeParams *SmoothWeightChangeParams `protobuf:"bytes,5,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params,omitempty" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgUpdatePoolParams) Reset()         { *m = MsgUpdatePoolParams{} }
+func (m *MsgUpdatePoolParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolParams) ProtoMessage()    {}
+func (*MsgUpdatePoolParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{2}
+}
+func (m *MsgUpdatePoolParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolParams.Merge(m, src)
+}
+func (m *MsgUpdatePoolParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolParams proto.InternalMessageInfo
+
+func (m *MsgUpdatePoolParams) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgUpdatePoolParams) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgUpdatePoolParams) GetSmoothWeightChangeParams() *SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return nil
+}
+
+type MsgUpdatePoolParamsResponse struct {
+}
+
+func (m *MsgUpdatePoolParamsResponse) Reset()         { *m = MsgUpdatePoolParamsResponse{} }
+func (m *MsgUpdatePoolParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePoolParamsResponse) ProtoMessage()    {}
+func (*MsgUpdatePoolParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{3}
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePoolParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePoolParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePoolParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePoolParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePoolParamsResponse proto.InternalMessageInfo
+
+// ===================== MsgScheduleWeightChange
+// Sender must be the pool's future_pool_governor in order for the tx to
+// succeed. Replaces any weight change of a balancer pool with one that starts
+// from the pool's current weights.
+type MsgScheduleWeightChange struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	// If start_time is unset, the weight change starts at the current block
+	// time. initial_pool_weights is ignored and set from the pool.
+	SmoothWeightChangeParams SmoothWeightChangeParams `protobuf:"bytes,3,opt,name=smooth_weight_change_params,json=smoothWeightChangeParams,proto3" json:"smooth_weight_change_params" yaml:"smooth_weight_change_params"`
+}
+
+func (m *MsgScheduleWeightChange) Reset()         { *m = MsgScheduleWeightChange{} }
+func (m *MsgScheduleWeightChange) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChange) ProtoMessage()    {}
+func (*MsgScheduleWeightChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{4}
+}
+func (m *MsgScheduleWeightChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChange.Merge(m, src)
+}
+func (m *MsgScheduleWeightChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChange proto.InternalMessageInfo
+
+func (m *MsgScheduleWeightChange) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgScheduleWeightChange) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgScheduleWeightChange) GetSmoothWeightChangeParams() SmoothWeightChangeParams {
+	if m != nil {
+		return m.SmoothWeightChangeParams
+	}
+	return SmoothWeightChangeParams{}
+}
+
+type MsgScheduleWeightChangeResponse struct {
+}
+
+func (m *MsgScheduleWeightChangeResponse) Reset()         { *m = MsgScheduleWeightChangeResponse{} }
+func (m *MsgScheduleWeightChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgScheduleWeightChangeResponse) ProtoMessage()    {}
+func (*MsgScheduleWeightChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0647ee155de97433, []int{5}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgScheduleWeightChangeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.Merge(m, src)
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgScheduleWeightChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgScheduleWeightChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgScheduleWeightChangeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateBalancerPool)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPool")
 	proto.RegisterType((*MsgCreateBalancerPoolResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgCreateBalancerPoolResponse")
+	proto.RegisterType((*MsgUpdatePoolParams)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdatePoolParams")
+	proto.RegisterType((*MsgUpdatePoolParamsResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgUpdatePoolParamsResponse")
+	proto.RegisterType((*MsgScheduleWeightChange)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChange")
+	proto.RegisterType((*MsgScheduleWeightChangeResponse)(nil), "osmosis.gamm.poolmodels.balancer.v1beta1.MsgScheduleWeightChangeResponse")
 }
 
 func init() {
@@ -152,34 +363,49 @@ func init() {
 }
 
 var fileDescriptor_0647ee155de97433 = []byte{
-	// 427 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0x80, 0xeb, 0xb5, 0x0a, 0xc2, 0x15, 0x07, 0xac, 0x81, 0xa2, 0x22, 0x92, 0x28, 0x5c, 0xc2,
-	0xa1, 0xb6, 0xda, 0x71, 0xe2, 0x32, 0x11, 0x26, 0xa6, 0x1d, 0x26, 0x8d, 0xdc, 0xc6, 0x65, 0x72,
-	0x16, 0x13, 0x2a, 0x25, 0x71, 0x64, 0xbb, 0x55, 0x79, 0x0b, 0x9e, 0x80, 0x23, 0xcf, 0xc0, 0x23,
-	0xec, 0xb8, 0x23, 0xa7, 0x08, 0xa5, 0x6f, 0xd0, 0x27, 0x40, 0xb6, 0x13, 0x34, 0xa4, 0x4c, 0x20,
-	0x71, 0x73, 0x7e, 0x7f, 0xff, 0xf7, 0xff, 0xbf, 0xf3, 0xc3, 0x39, 0x97, 0x25, 0x97, 0x2b, 0x49,
-	0x72, 0x5a, 0x96, 0xa4, 0xe6, 0xbc, 0x98, 0x97, 0x3c, 0x63, 0x85, 0x24, 0x29, 0x2d, 0x68, 0x75,
-	0xcd, 0x04, 0x51, 0x5b, 0xa2, 0xb6, 0xb8, 0x16, 0x5c, 0x71, 0x14, 0x75, 0x38, 0xd6, 0x38, 0xd6,
-	0xb8, 0xa5, 0x71, 0x4f, 0xe3, 0xcd, 0x22, 0x65, 0x8a, 0x2e, 0x66, 0x87, 0x39, 0xcf, 0xb9, 0x49,
-	0x22, 0xfa, 0x64, 0xf3, 0x67, 0xaf, 0xfe, 0x5e, 0xae, 0x3f, 0x5c, 0x70, 0x5e, 0xd8, 0xac, 0xf0,
-	0xfb, 0x01, 0x7c, 0x72, 0x2e, 0xf3, 0xb7, 0x82, 0x51, 0xc5, 0xe2, 0x3b, 0xf7, 0xe8, 0x25, 0x74,
-	0x24, 0xab, 0x32, 0x26, 0x5c, 0x10, 0x80, 0xe8, 0x61, 0xfc, 0x78, 0xdf, 0xf8, 0x8f, 0x3e, 0xd3,
-	0xb2, 0x78, 0x1d, 0xda, 0x78, 0x98, 0x74, 0x00, 0xba, 0x84, 0x53, 0x5d, 0xef, 0xaa, 0xa6, 0x82,
-	0x96, 0xd2, 0x3d, 0x08, 0x40, 0x34, 0x5d, 0x06, 0xf8, 0x8f, 0x81, 0xba, 0xe6, 0xb1, 0x76, 0x5f,
-	0x18, 0x2e, 0x7e, 0xba, 0x6f, 0x7c, 0x64, 0x8d, 0x77, 0xd2, 0xc3, 0x04, 0xd6, 0xbf, 0x19, 0xf4,
-	0xae, 0x53, 0x53, 0x29, 0x99, 0x92, 0xee, 0x38, 0x18, 0x47, 0xd3, 0xa5, 0x7f, 0xbf, 0xfa, 0x8d,
-	0xe6, 0xe2, 0xc9, 0x4d, 0xe3, 0x8f, 0xac, 0xc7, 0x04, 0x24, 0x7a, 0x0f, 0x0f, 0x3f, 0xae, 0xd5,
-	0x5a, 0xb0, 0x2b, 0xa3, 0xcb, 0xf9, 0x86, 0x89, 0x8a, 0x0b, 0x77, 0x62, 0x66, 0xf3, 0xf7, 0x8d,
-	0xff, 0xcc, 0x76, 0x32, 0x44, 0x85, 0x09, 0xb2, 0x61, 0x5d, 0xe1, 0xb4, 0x0f, 0x9e, 0xc0, 0xe7,
-	0x83, 0x2f, 0x97, 0x30, 0x59, 0xf3, 0x4a, 0x32, 0xf4, 0x02, 0x3e, 0x30, 0x9a, 0x55, 0x66, 0x9e,
-	0x70, 0x12, 0xc3, 0xb6, 0xf1, 0x1d, 0x8d, 0x9c, 0x9d, 0x24, 0x8e, 0xbe, 0x3a, 0xcb, 0x96, 0xdf,
-	0x00, 0x1c, 0x9f, 0xcb, 0x1c, 0x7d, 0x05, 0x10, 0x0d, 0xfc, 0x85, 0x63, 0xfc, 0xaf, 0x6b, 0x81,
-	0x07, 0x9b, 0x99, 0x9d, 0xfe, 0xa7, 0xa0, 0x9f, 0x26, 0xbe, 0xbc, 0x69, 0x3d, 0x70, 0xdb, 0x7a,
-	0xe0, 0x67, 0xeb, 0x81, 0x2f, 0x3b, 0x6f, 0x74, 0xbb, 0xf3, 0x46, 0x3f, 0x76, 0xde, 0xe8, 0xc3,
-	0x71, 0xbe, 0x52, 0x9f, 0xd6, 0x29, 0xbe, 0xe6, 0x25, 0xe9, 0x8a, 0xcd, 0x0b, 0x9a, 0xca, 0xfe,
-	0x83, 0x6c, 0x16, 0x47, 0x64, 0x7b, 0xff, 0x5e, 0xa6, 0x8e, 0xd9, 0xc5, 0xa3, 0x5f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x09, 0x14, 0x1c, 0x57, 0x32, 0x03, 0x00, 0x00,
+	// 663 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xa6, 0x74, 0xe0, 0x09, 0x01, 0x61, 0x40, 0xd5, 0x69, 0x4d, 0x09, 0xd2, 0x54,
+	0x90, 0xea, 0x68, 0x1b, 0x27, 0x24, 0x34, 0xad, 0x1b, 0x9b, 0x76, 0xa8, 0x34, 0x32, 0x21, 0x34,
+	0x84, 0x54, 0xb9, 0xcd, 0xb7, 0xb4, 0x22, 0xa9, 0xa3, 0xd8, 0xdd, 0xba, 0x07, 0xe0, 0xce, 0x05,
+	0x09, 0x2e, 0x9c, 0x78, 0x08, 0x78, 0x83, 0x1d, 0x77, 0x44, 0x1c, 0x22, 0xd4, 0x5d, 0x38, 0xf7,
+	0x09, 0x90, 0x9d, 0x64, 0x14, 0x48, 0x34, 0x46, 0x39, 0xd5, 0x75, 0xfe, 0xff, 0xdf, 0xe7, 0xfc,
+	0xfd, 0xd9, 0x41, 0x75, 0xca, 0x3c, 0xca, 0x7a, 0xcc, 0x74, 0x88, 0xe7, 0x99, 0x3e, 0xa5, 0x6e,
+	0xdd, 0xa3, 0x36, 0xb8, 0xcc, 0x6c, 0x13, 0x97, 0xf4, 0x3b, 0x10, 0x98, 0x7c, 0x68, 0xf2, 0x21,
+	0xf6, 0x03, 0xca, 0xa9, 0x56, 0x8b, 0xe5, 0x58, 0xc8, 0xb1, 0x90, 0x47, 0x6a, 0x9c, 0xa8, 0xf1,
+	0xc1, 0x52, 0x1b, 0x38, 0x59, 0x2a, 0xcf, 0x39, 0xd4, 0xa1, 0xd2, 0x64, 0x8a, 0x51, 0xe4, 0x2f,
+	0x3f, 0x3c, 0xbf, 0x5c, 0x32, 0xd8, 0xa1, 0xd4, 0x8d, 0x5c, 0xc6, 0xa7, 0x3c, 0xba, 0xd5, 0x64,
+	0xce, 0x7a, 0x00, 0x84, 0x43, 0x63, 0xe2, 0xb9, 0x76, 0x1f, 0x15, 0x19, 0xf4, 0x6d, 0x08, 0x4a,
+	0x4a, 0x55, 0xa9, 0x5d, 0x69, 0xdc, 0x18, 0x87, 0xfa, 0xd5, 0x23, 0xe2, 0xb9, 0x8f, 0x8c, 0x68,
+	0xde, 0xb0, 0x62, 0x81, 0xb6, 0x87, 0x66, 0x45, 0xbd, 0x96, 0x4f, 0x02, 0xe2, 0xb1, 0x52, 0xbe,
+	0xaa, 0xd4, 0x66, 0x97, 0xab, 0xf8, 0x97, 0x17, 0x8a, 0x17, 0x8f, 0x05, 0x7b, 0x47, 0xea, 0x1a,
+	0xb7, 0xc7, 0xa1, 0xae, 0x45, 0xc4, 0x09, 0xbb, 0x61, 0x21, 0xff, 0x4c, 0xa3, 0x6d, 0xc6, 0x68,
+	0xc2, 0x18, 0x70, 0x56, 0x52, 0xab, 0x6a, 0x6d, 0x76, 0x59, 0xcf, 0x46, 0xaf, 0x09, 0x5d, 0xa3,
+	0x70, 0x1c, 0xea, 0xb9, 0x88, 0x23, 0x27, 0x98, 0xf6, 0x14, 0xcd, 0xed, 0x0f, 0xf8, 0x20, 0x80,
+	0x96, 0xc4, 0x39, 0xf4, 0x00, 0x82, 0x3e, 0x0d, 0x4a, 0x05, 0xf9, 0x6e, 0xfa, 0x38, 0xd4, 0xe7,
+	0xa3, 0x95, 0xa4, 0xa9, 0x0c, 0x4b, 0x8b, 0xa6, 0x45, 0x85, 0xad, 0x64, 0x72, 0x03, 0x2d, 0xa4,
+	0x26, 0x67, 0x01, 0xf3, 0x69, 0x9f, 0x81, 0x76, 0x0f, 0xcd, 0x48, 0x4c, 0xcf, 0x96, 0x11, 0x16,
+	0x1a, 0x68, 0x14, 0xea, 0x45, 0x21, 0xd9, 0xde, 0xb0, 0x8a, 0xe2, 0xd1, 0xb6, 0x6d, 0x7c, 0x56,
+	0xd1, 0xcd, 0x26, 0x73, 0x9e, 0xf9, 0x36, 0xe1, 0xf0, 0x33, 0x9c, 0x8b, 0xc4, 0x3f, 0x51, 0x27,
+	0x9f, 0x55, 0x47, 0x7b, 0x89, 0x2e, 0xb3, 0x43, 0xe2, 0xb7, 0xf6, 0x01, 0x4a, 0xaa, 0x24, 0xae,
+	0x89, 0x90, 0xbe, 0x86, 0xfa, 0xa2, 0xd3, 0xe3, 0xdd, 0x41, 0x1b, 0x77, 0xa8, 0x67, 0x76, 0x64,
+	0xb0, 0xf1, 0x4f, 0x9d, 0xd9, 0xaf, 0x4c, 0x7e, 0xe4, 0x03, 0xc3, 0x1b, 0xd0, 0x19, 0x87, 0xfa,
+	0xb5, 0xb8, 0x7e, 0xcc, 0x31, 0xac, 0x19, 0x31, 0xdc, 0x04, 0x10, 0x74, 0x18, 0xf6, 0xb8, 0xa4,
+	0x17, 0xa6, 0xa3, 0x27, 0x1c, 0xc3, 0x9a, 0x11, 0x43, 0x41, 0x7f, 0xab, 0xa0, 0x79, 0xe6, 0x51,
+	0xca, 0xbb, 0xad, 0x43, 0xe8, 0x39, 0x5d, 0xde, 0xea, 0x74, 0x49, 0xdf, 0x81, 0xa4, 0xe1, 0x2e,
+	0xc9, 0x86, 0xc3, 0xe9, 0x5d, 0xb1, 0x2b, 0x8d, 0xcf, 0xa5, 0x6f, 0x5d, 0xda, 0xe2, 0xf6, 0x5b,
+	0x1c, 0x87, 0xba, 0x11, 0xbf, 0x51, 0x36, 0xdc, 0xb0, 0x4a, 0x2c, 0x83, 0x60, 0x2c, 0xa0, 0xf9,
+	0x94, 0xad, 0x4b, 0xf6, 0xdf, 0x78, 0x9d, 0x47, 0x77, 0x9a, 0xcc, 0xd9, 0xed, 0x74, 0xc1, 0x1e,
+	0xb8, 0x30, 0x09, 0xf8, 0xef, 0xdb, 0xfb, 0xfe, 0x9c, 0x88, 0xd4, 0x7f, 0x8a, 0xe8, 0x81, 0xd8,
+	0xc4, 0xa9, 0x63, 0xba, 0x8b, 0xf4, 0x8c, 0x18, 0x92, 0xa8, 0x96, 0xbf, 0xab, 0x48, 0x6d, 0x32,
+	0x47, 0xfb, 0xa0, 0x20, 0x2d, 0xe5, 0x2e, 0x5a, 0xc5, 0x7f, 0x7b, 0x39, 0xe2, 0xd4, 0x23, 0x59,
+	0xde, 0x9a, 0x12, 0x70, 0x76, 0xa6, 0xdf, 0x29, 0xe8, 0xfa, 0x1f, 0x67, 0xf5, 0xf1, 0x85, 0xe8,
+	0xbf, 0xdb, 0xcb, 0x4f, 0xa6, 0xb2, 0x9f, 0x2d, 0xed, 0xa3, 0x82, 0xe6, 0x52, 0x7b, 0x6d, 0xed,
+	0x42, 0xfc, 0x34, 0x44, 0x79, 0x7b, 0x6a, 0x44, 0xb2, 0xcc, 0xc6, 0xde, 0xf1, 0xa8, 0xa2, 0x9c,
+	0x8c, 0x2a, 0xca, 0xb7, 0x51, 0x45, 0x79, 0x73, 0x5a, 0xc9, 0x9d, 0x9c, 0x56, 0x72, 0x5f, 0x4e,
+	0x2b, 0xb9, 0x17, 0xab, 0x13, 0x57, 0x45, 0x5c, 0xae, 0xee, 0x92, 0x36, 0x4b, 0xfe, 0x98, 0x07,
+	0x4b, 0x2b, 0xe6, 0x30, 0xfb, 0xfb, 0xd6, 0x2e, 0xca, 0x6f, 0xda, 0xca, 0x8f, 0x01, 0x00, 0x8c,
+	0x65, 0xc0, 0x9e, 0x7a, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -195,6 +421,8 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type MsgClient interface {
 	CreateBalancerPool(ctx context.Context, in *MsgCreateBalancerPool, opts ...grpc.CallOption) (*MsgCreateBalancerPoolResponse, error)
+	UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error)
+	ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error)
 }
 
 type msgClient struct {
@@ -214,9 +442,29 @@ func (c *msgClient) CreateBalancerPool(ctx context.Context, in *MsgCreateBalance
 	return out, nil
 }
 
+func (c *msgClient) UpdatePoolParams(ctx context.Context, in *MsgUpdatePoolParams, opts ...grpc.CallOption) (*MsgUpdatePoolParamsResponse, error) {
+	out := new(MsgUpdatePoolParamsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdatePoolParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleWeightChange(ctx context.Context, in *MsgScheduleWeightChange, opts ...grpc.CallOption) (*MsgScheduleWeightChangeResponse, error) {
+	out := new(MsgScheduleWeightChangeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateBalancerPool(context.Context, *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error)
+	UpdatePoolParams(context.Context, *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error)
+	ScheduleWeightChange(context.Context, *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -226,6 +474,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) CreateBalancerPool(ctx context.Context, req *MsgCreateBalancerPool) (*MsgCreateBalancerPoolResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateBalancerPool not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePoolParams(ctx context.Context, req *MsgUpdatePoolParams) (*MsgUpdatePoolParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePoolParams not implemented")
+}
+func (*UnimplementedMsgServer) ScheduleWeightChange(ctx context.Context, req *MsgScheduleWeightChange) (*MsgScheduleWeightChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleWeightChange not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -249,6 +503,42 @@ func _Msg_CreateBalancerPool_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePoolParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePoolParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePoolParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/UpdatePoolParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePoolParams(ctx, req.(*MsgUpdatePoolParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleWeightChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleWeightChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.balancer.v1beta1.Msg/ScheduleWeightChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleWeightChange(ctx, req.(*MsgScheduleWeightChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.balancer.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -257,6 +547,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateBalancerPool",
 			Handler:    _Msg_CreateBalancerPool_Handler,
 		},
+		{
+			MethodName: "UpdatePoolParams",
+			Handler:    _Msg_UpdatePoolParams_Handler,
+		},
+		{
+			MethodName: "ScheduleWeightChange",
+			Handler:    _Msg_ScheduleWeightChange_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/balancer/tx/tx.proto",
@@ -353,30 +651,188 @@ func (m *MsgCreateBalancerPoolResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePoolParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateBalancerPool) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MsgUpdatePoolParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SmoothWeightChangeParams != nil {
+		{
+			size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.ExitFee.Size()
+		i -= size
+		if _, err := m.ExitFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdatePoolParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePoolParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePoolParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.PoolParams != nil {
-		l = m.PoolParams.Size()
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.SmoothWeightChangeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScheduleWeightChangeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateBalancerPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolParams != nil {
+		l = m.PoolParams.Size()
+		n += 1 + l + sovTx(uint64(l))
 	}
 	if len(m.PoolAssets) > 0 {
 		for _, e := range m.PoolAssets {
@@ -384,32 +840,345 @@ func (m *MsgCreateBalancerPool) Size() (n int) {
 			n += 1 + l + sovTx(uint64(l))
 		}
 	}
-	l = len(m.FuturePoolGovernor)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
+	l = len(m.FuturePoolGovernor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ExitFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.SmoothWeightChangeParams != nil {
+		l = m.SmoothWeightChangeParams.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdatePoolParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScheduleWeightChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	l = m.SmoothWeightChangeParams.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgScheduleWeightChangeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PoolParams == nil {
+				m.PoolParams = &PoolParams{}
+			}
+			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
+			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgCreateBalancerPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.PoolID != 0 {
-		n += 1 + sovTx(uint64(m.PoolID))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -432,10 +1201,10 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -471,10 +1240,29 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolParams", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -484,33 +1272,31 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PoolParams == nil {
-				m.PoolParams = &PoolParams{}
-			}
-			if err := m.PoolParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolAssets", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExitFee", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -520,31 +1306,31 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PoolAssets = append(m.PoolAssets, PoolAsset{})
-			if err := m.PoolAssets[len(m.PoolAssets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExitFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FuturePoolGovernor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -554,23 +1340,27 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FuturePoolGovernor = string(dAtA[iNdEx:postIndex])
+			if m.SmoothWeightChangeParams == nil {
+				m.SmoothWeightChangeParams = &SmoothWeightChangeParams{}
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -593,7 +1383,7 @@ func (m *MsgCreateBalancerPool) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePoolParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -616,13 +1406,95 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePoolParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateBalancerPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePoolParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChange: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
 			}
@@ -641,6 +1513,89 @@ func (m *MsgCreateBalancerPoolResponse) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SmoothWeightChangeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SmoothWeightChangeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScheduleWeightChangeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScheduleWeightChangeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
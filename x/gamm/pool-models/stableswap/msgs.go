@@ -1,6 +1,9 @@
 package stableswap
 
 import (
+	"fmt"
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -11,6 +14,7 @@ import (
 const (
 	TypeMsgCreateStableswapPool           = "create_stableswap_pool"
 	TypeMsgStableSwapAdjustScalingFactors = "stable_swap_adjust_scaling_factors"
+	TypeMsgStableSwapRampScalingFactors   = "stable_swap_ramp_scaling_factors"
 )
 
 var (
@@ -168,3 +172,56 @@ func (msg MsgStableSwapAdjustScalingFactors) GetSigners() []sdk.AccAddress {
 
 	return []sdk.AccAddress{scalingFactorGovernor}
 }
+
+var _ sdk.Msg = &MsgStableSwapRampScalingFactors{}
+
+func NewMsgStableSwapRampScalingFactors(
+	sender string,
+	poolID uint64,
+	scalingFactors []uint64,
+	startTime time.Time,
+	duration time.Duration,
+) MsgStableSwapRampScalingFactors {
+	return MsgStableSwapRampScalingFactors{
+		Sender:         sender,
+		PoolID:         poolID,
+		ScalingFactors: scalingFactors,
+		StartTime:      startTime,
+		Duration:       duration,
+	}
+}
+
+func (msg MsgStableSwapRampScalingFactors) Route() string {
+	return types.RouterKey
+}
+
+func (msg MsgStableSwapRampScalingFactors) Type() string { return TypeMsgStableSwapRampScalingFactors }
+func (msg MsgStableSwapRampScalingFactors) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := validateScalingFactors(msg.ScalingFactors, len(msg.ScalingFactors)); err != nil {
+		return err
+	}
+
+	if msg.Duration.Milliseconds() <= 0 {
+		return fmt.Errorf("scaling factor ramp duration must be at least a millisecond, got %s", msg.Duration)
+	}
+
+	return nil
+}
+
+func (msg MsgStableSwapRampScalingFactors) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgStableSwapRampScalingFactors) GetSigners() []sdk.AccAddress {
+	scalingFactorGovernor, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+
+	return []sdk.AccAddress{scalingFactorGovernor}
+}
@@ -2,6 +2,7 @@ package stableswap_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -296,3 +297,76 @@ func TestMsgCreateStableswapPoolValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestMsgStableSwapRampScalingFactorsValidateBasic(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+	invalidAddr := sdk.AccAddress("invalid")
+
+	default_msg := stableswap.NewMsgStableSwapRampScalingFactors(addr1.String(), 1, []uint64{1, 2}, time.Time{}, time.Hour)
+	updateMsg := func(f func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+		return f(default_msg)
+	}
+
+	require.Equal(t, default_msg.Route(), types.RouterKey)
+	require.Equal(t, default_msg.Type(), "stable_swap_ramp_scaling_factors")
+	signers := default_msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+
+	tests := []struct {
+		name       string
+		msg        stableswap.MsgStableSwapRampScalingFactors
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero scaling factor",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+				msg.ScalingFactors = []uint64{0, 1}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero duration",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+				msg.Duration = 0
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "sub-millisecond duration",
+			msg: updateMsg(func(msg stableswap.MsgStableSwapRampScalingFactors) stableswap.MsgStableSwapRampScalingFactors {
+				msg.Duration = time.Microsecond
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -15,8 +16,9 @@ import (
 )
 
 var (
-	_ swaproutertypes.PoolI = &Pool{}
-	_ types.CFMMPoolI       = &Pool{}
+	_ swaproutertypes.PoolI      = &Pool{}
+	_ types.CFMMPoolI            = &Pool{}
+	_ types.PokablePoolExtension = &Pool{}
 )
 
 type unsortedPoolLiqError struct {
@@ -100,6 +102,10 @@ func (p Pool) GetId() uint64 {
 	return p.Id
 }
 
+func (p Pool) GetType() swaproutertypes.PoolType {
+	return swaproutertypes.StableSwap
+}
+
 func (p Pool) GetSwapFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.SwapFee
 }
@@ -108,8 +114,18 @@ func (p Pool) GetExitFee(ctx sdk.Context) sdk.Dec {
 	return p.PoolParams.ExitFee
 }
 
+// IsActive returns false if the pool is frozen, in which case it rejects
+// swaps and joins.
 func (p Pool) IsActive(ctx sdk.Context) bool {
-	return true
+	return !p.Frozen
+}
+
+func (p Pool) IsFrozen() bool {
+	return p.Frozen
+}
+
+func (p *Pool) SetFrozen(frozen bool) {
+	p.Frozen = frozen
 }
 
 // Returns the coins in the pool owned by all LP shareholders
@@ -429,6 +445,94 @@ func (p *Pool) SetScalingFactors(ctx sdk.Context, scalingFactors []uint64, sende
 	}
 
 	p.ScalingFactors = scalingFactors
+	// an instant change replaces any ramp in progress.
+	p.ScalingFactorRamp = nil
+	return nil
+}
+
+// RampScalingFactors schedules a linear change of the pool's scaling factors from their current values
+// to scalingFactors, between startTime and startTime + duration. It replaces any ramp in progress.
+// It should only be able to be successfully called by the pool's ScalingFactorGovernor.
+// The pool should be poked before calling this, so that the current scaling factors are up to date.
+func (p *Pool) RampScalingFactors(ctx sdk.Context, scalingFactors []uint64, startTime time.Time, duration time.Duration, sender string) error {
+	if sender != p.ScalingFactorController {
+		return types.ErrNotScalingFactorGovernor
+	}
+
+	if duration.Milliseconds() <= 0 {
+		return fmt.Errorf("scaling factor ramp duration must be at least a millisecond, got %s", duration)
+	}
+
+	if startTime.IsZero() {
+		startTime = ctx.BlockTime()
+	} else if startTime.Before(ctx.BlockTime()) {
+		return fmt.Errorf("scaling factor ramp start time %s is before the current block time %s", startTime, ctx.BlockTime())
+	}
+
+	scalingFactors = applyScalingFactorMultiplier(scalingFactors)
+
+	if err := validateScalingFactors(scalingFactors, p.PoolLiquidity.Len()); err != nil {
+		return err
+	}
+
+	if err := validatePoolLiquidity(p.PoolLiquidity, scalingFactors); err != nil {
+		return err
+	}
+
+	initialScalingFactors := make([]uint64, len(p.ScalingFactors))
+	copy(initialScalingFactors, p.ScalingFactors)
+	p.ScalingFactorRamp = &ScalingFactorRamp{
+		StartTime:             startTime,
+		Duration:              duration,
+		InitialScalingFactors: initialScalingFactors,
+		TargetScalingFactors:  scalingFactors,
+	}
+	return nil
+}
+
+// PokePool sets the pool's scaling factors to their values at blockTime
+// if a scaling factor ramp is scheduled, and clears the ramp once it is over.
+func (p *Pool) PokePool(blockTime time.Time) {
+	ramp := p.ScalingFactorRamp
+	if ramp == nil {
+		return
+	}
+
+	// The scaling factors s(t) for the pool at time `t` are
+	//
+	// 1. t <= start_time: s(t) = initial_scaling_factors
+	//
+	// 2. start_time < t < start_time + duration:
+	//     s(t) = initial_scaling_factors + (t - start_time) *
+	//       (target_scaling_factors - initial_scaling_factors) / (duration)
+	//
+	// 3. t >= start_time + duration: s(t) = target_scaling_factors
+	switch {
+	case !blockTime.After(ramp.StartTime):
+		return
+	case !blockTime.Before(ramp.StartTime.Add(ramp.Duration)):
+		p.ScalingFactors = ramp.TargetScalingFactors
+		p.ScalingFactorRamp = nil
+	default:
+		elapsed := sdk.NewInt(blockTime.Sub(ramp.StartTime).Milliseconds())
+		total := sdk.NewInt(ramp.Duration.Milliseconds())
+		scalingFactors := make([]uint64, len(ramp.TargetScalingFactors))
+		for i := range scalingFactors {
+			initial := sdk.NewIntFromUint64(ramp.InitialScalingFactors[i])
+			target := sdk.NewIntFromUint64(ramp.TargetScalingFactors[i])
+			scalingFactors[i] = initial.Add(target.Sub(initial).Mul(elapsed).Quo(total)).Uint64()
+		}
+		p.ScalingFactors = scalingFactors
+	}
+}
+
+// SetPoolParams replaces the pool's swap fee and exit fee.
+// Authorization is left to the caller.
+func (p *Pool) SetPoolParams(params PoolParams) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	p.PoolParams = params
 	return nil
 }
 
@@ -3,6 +3,7 @@ package stableswap
 
 import (
 	"testing"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
@@ -1188,6 +1189,152 @@ func TestSetScalingFactors(t *testing.T) {
 	}
 }
 
+func TestRampScalingFactors(t *testing.T) {
+	pk := ed25519.GenPrivKey().PubKey()
+	addr := sdk.AccAddress(pk.Address())
+
+	failPk := ed25519.GenPrivKey().PubKey()
+	failAddr := sdk.AccAddress(failPk.Address())
+
+	blockTime := time.Unix(1000, 0).UTC()
+
+	tests := map[string]struct {
+		scalingFactors []uint64
+		sender         string
+		startTime      time.Time
+		duration       time.Duration
+		expError       string
+	}{
+		"valid ramp from block time": {
+			scalingFactors: []uint64{2, 1},
+			sender:         addr.String(),
+			duration:       time.Hour,
+		},
+		"valid ramp from a later time": {
+			scalingFactors: []uint64{2, 1},
+			sender:         addr.String(),
+			startTime:      blockTime.Add(time.Hour),
+			duration:       time.Hour,
+		},
+		"sender is not scaling factor governor in pool": {
+			scalingFactors: []uint64{2, 1},
+			sender:         failAddr.String(),
+			duration:       time.Hour,
+			expError:       types.ErrNotScalingFactorGovernor.Error(),
+		},
+		"start time before block time": {
+			scalingFactors: []uint64{2, 1},
+			sender:         addr.String(),
+			startTime:      blockTime.Add(-time.Second),
+			duration:       time.Hour,
+			expError:       "is before the current block time",
+		},
+		"zero duration": {
+			scalingFactors: []uint64{2, 1},
+			sender:         addr.String(),
+			expError:       "must be at least a millisecond",
+		},
+		"invalid scaling factor's length": {
+			scalingFactors: defaultThreeAssetScalingFactors,
+			sender:         addr.String(),
+			duration:       time.Hour,
+			expError:       types.ErrInvalidScalingFactorLength.Error(),
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			ctx := sdk.Context{}.WithBlockTime(blockTime)
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, defaultTwoAssetScalingFactors)
+			pool.ScalingFactorController = addr.String()
+			err := pool.RampScalingFactors(ctx, tc.scalingFactors, tc.startTime, tc.duration, tc.sender)
+			if tc.expError != "" {
+				require.ErrorContains(t, err, tc.expError)
+				require.Nil(t, pool.ScalingFactorRamp)
+				return
+			}
+			require.NoError(t, err)
+
+			expectedStartTime := tc.startTime
+			if expectedStartTime.IsZero() {
+				expectedStartTime = blockTime
+			}
+			require.Equal(t, &ScalingFactorRamp{
+				StartTime:             expectedStartTime,
+				Duration:              tc.duration,
+				InitialScalingFactors: applyScalingFactorMultiplier(defaultTwoAssetScalingFactors),
+				TargetScalingFactors:  applyScalingFactorMultiplier(tc.scalingFactors),
+			}, pool.ScalingFactorRamp)
+			// the scaling factors don't change until the pool is poked.
+			require.Equal(t, applyScalingFactorMultiplier(defaultTwoAssetScalingFactors), pool.ScalingFactors)
+		})
+	}
+}
+
+func TestPokePool(t *testing.T) {
+	startTime := time.Unix(1000, 0).UTC()
+	ramp := ScalingFactorRamp{
+		StartTime:             startTime,
+		Duration:              100 * time.Second,
+		InitialScalingFactors: []uint64{100, 1000},
+		TargetScalingFactors:  []uint64{200, 500},
+	}
+
+	tests := map[string]struct {
+		blockTime              time.Time
+		expectedScalingFactors []uint64
+		expectRampCleared      bool
+	}{
+		"before start time": {
+			blockTime:              startTime.Add(-time.Second),
+			expectedScalingFactors: []uint64{100, 1000},
+		},
+		"at start time": {
+			blockTime:              startTime,
+			expectedScalingFactors: []uint64{100, 1000},
+		},
+		"a quarter of the way": {
+			blockTime:              startTime.Add(25 * time.Second),
+			expectedScalingFactors: []uint64{125, 875},
+		},
+		"rounds towards the initial factors": {
+			blockTime:              startTime.Add(12*time.Second + 345*time.Millisecond),
+			expectedScalingFactors: []uint64{112, 939},
+		},
+		"at end time": {
+			blockTime:              startTime.Add(100 * time.Second),
+			expectedScalingFactors: []uint64{200, 500},
+			expectRampCleared:      true,
+		},
+		"after end time": {
+			blockTime:              startTime.Add(time.Hour),
+			expectedScalingFactors: []uint64{200, 500},
+			expectRampCleared:      true,
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			pool := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{100, 1000})
+			rampCopy := ramp
+			pool.ScalingFactorRamp = &rampCopy
+
+			pool.PokePool(tc.blockTime)
+
+			require.Equal(t, tc.expectedScalingFactors, pool.ScalingFactors)
+			if tc.expectRampCleared {
+				require.Nil(t, pool.ScalingFactorRamp)
+			} else {
+				require.Equal(t, ramp, *pool.ScalingFactorRamp)
+			}
+		})
+	}
+
+	t.Run("no ramp", func(t *testing.T) {
+		pool := poolStructFromAssets(twoEvenStablePoolAssets, []uint64{100, 1000})
+		pool.PokePool(startTime.Add(time.Hour))
+		require.Equal(t, []uint64{100, 1000}, pool.ScalingFactors)
+	})
+}
+
 func TestStableswapSpotPrice(t *testing.T) {
 	type testcase struct {
 		baseDenom      string
@@ -0,0 +1,761 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/gamm/pool-models/stableswap/query.proto
+
+package stableswap
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	grpc1 "github.com/gogo/protobuf/grpc"
+	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// =============================== ScalingFactorRamp
+type QueryScalingFactorRampRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryScalingFactorRampRequest) Reset()         { *m = QueryScalingFactorRampRequest{} }
+func (m *QueryScalingFactorRampRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampRequest) ProtoMessage()    {}
+func (*QueryScalingFactorRampRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8af526162d2584, []int{0}
+}
+func (m *QueryScalingFactorRampRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRampRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRampRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRampRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampRequest.Merge(m, src)
+}
+func (m *QueryScalingFactorRampRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRampRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRampRequest proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRampRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryScalingFactorRampResponse struct {
+	// The scaling factors interpolated at the current block time.
+	CurrentScalingFactors []uint64 `protobuf:"varint,1,rep,packed,name=current_scaling_factors,json=currentScalingFactors,proto3" json:"current_scaling_factors,omitempty" yaml:"current_scaling_factors"`
+	// The pending or in progress ramp. Unset if the scaling factors are not
+	// scheduled to change.
+	ScalingFactorRamp *ScalingFactorRamp `protobuf:"bytes,2,opt,name=scaling_factor_ramp,json=scalingFactorRamp,proto3" json:"scaling_factor_ramp,omitempty" yaml:"scaling_factor_ramp"`
+	// Time left until the target scaling factors are reached, counted from the
+	// current block time. Zero if there is no ramp.
+	RemainingDuration time.Duration `protobuf:"bytes,3,opt,name=remaining_duration,json=remainingDuration,proto3,stdduration" json:"remaining_duration" yaml:"remaining_duration"`
+}
+
+func (m *QueryScalingFactorRampResponse) Reset()         { *m = QueryScalingFactorRampResponse{} }
+func (m *QueryScalingFactorRampResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScalingFactorRampResponse) ProtoMessage()    {}
+func (*QueryScalingFactorRampResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2d8af526162d2584, []int{1}
+}
+func (m *QueryScalingFactorRampResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScalingFactorRampResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScalingFactorRampResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScalingFactorRampResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScalingFactorRampResponse.Merge(m, src)
+}
+func (m *QueryScalingFactorRampResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScalingFactorRampResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScalingFactorRampResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScalingFactorRampResponse proto.InternalMessageInfo
+
+func (m *QueryScalingFactorRampResponse) GetCurrentScalingFactors() []uint64 {
+	if m != nil {
+		return m.CurrentScalingFactors
+	}
+	return nil
+}
+
+func (m *QueryScalingFactorRampResponse) GetScalingFactorRamp() *ScalingFactorRamp {
+	if m != nil {
+		return m.ScalingFactorRamp
+	}
+	return nil
+}
+
+func (m *QueryScalingFactorRampResponse) GetRemainingDuration() time.Duration {
+	if m != nil {
+		return m.RemainingDuration
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*QueryScalingFactorRampRequest)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.QueryScalingFactorRampRequest")
+	proto.RegisterType((*QueryScalingFactorRampResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.QueryScalingFactorRampResponse")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/gamm/pool-models/stableswap/query.proto", fileDescriptor_2d8af526162d2584)
+}
+
+var fileDescriptor_2d8af526162d2584 = []byte{
+	// 488 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x53, 0xcb, 0x6b, 0x13, 0x41,
+	0x18, 0xcf, 0xa4, 0xb5, 0xc2, 0x08, 0x42, 0x46, 0xc5, 0x34, 0xe8, 0x6c, 0x18, 0x10, 0x82, 0xd2,
+	0x19, 0xd2, 0x9e, 0xaa, 0x78, 0x68, 0x10, 0xa1, 0xe2, 0xc5, 0xf5, 0x56, 0x0f, 0xcb, 0x6c, 0x32,
+	0x5d, 0x17, 0x76, 0x76, 0xb6, 0x3b, 0xb3, 0xd5, 0x22, 0x5e, 0xbc, 0x0b, 0x82, 0x17, 0xff, 0xa4,
+	0x1e, 0x0b, 0x5e, 0x3c, 0xad, 0x92, 0x78, 0xf5, 0x92, 0x8b, 0x27, 0x41, 0x76, 0x67, 0xd2, 0x12,
+	0xd3, 0x96, 0x0a, 0xbd, 0x7d, 0xc3, 0xef, 0xf1, 0x3d, 0x07, 0x32, 0xa5, 0xa5, 0xd2, 0xb1, 0x66,
+	0x11, 0x97, 0x92, 0x65, 0x4a, 0x25, 0x6b, 0x52, 0x8d, 0x44, 0xa2, 0x99, 0x36, 0x3c, 0x4c, 0x84,
+	0x7e, 0xc3, 0x33, 0xb6, 0x57, 0x88, 0xfc, 0x80, 0x66, 0xb9, 0x32, 0x0a, 0xdd, 0x77, 0x02, 0x5a,
+	0x09, 0x68, 0x25, 0xb0, 0x7c, 0x7a, 0xc2, 0xa7, 0xfb, 0xfd, 0x50, 0x18, 0xde, 0xef, 0xdc, 0x8c,
+	0x54, 0xa4, 0x6a, 0x19, 0xab, 0x22, 0xeb, 0xd0, 0xb9, 0x13, 0x29, 0x15, 0x25, 0x82, 0xf1, 0x2c,
+	0x66, 0x3c, 0x4d, 0x95, 0xe1, 0x26, 0x56, 0xa9, 0x76, 0x28, 0x76, 0x68, 0xfd, 0x0a, 0x8b, 0x5d,
+	0x36, 0x2a, 0xf2, 0x9a, 0xe0, 0xf0, 0xcd, 0x8b, 0x14, 0x7c, 0x12, 0x06, 0x15, 0xc3, 0x4a, 0xc9,
+	0x73, 0x78, 0xf7, 0x45, 0xd5, 0xc9, 0xcb, 0x21, 0x4f, 0xe2, 0x34, 0x7a, 0xca, 0x87, 0x46, 0xe5,
+	0x3e, 0x97, 0x99, 0x2f, 0xf6, 0x0a, 0xa1, 0x0d, 0x7a, 0x00, 0xaf, 0x56, 0xf4, 0x20, 0x1e, 0xb5,
+	0x41, 0x17, 0xf4, 0x96, 0x07, 0x68, 0x5a, 0x7a, 0xd7, 0x0f, 0xb8, 0x4c, 0x1e, 0x12, 0x07, 0x10,
+	0x7f, 0xa5, 0x8a, 0xb6, 0x47, 0xe4, 0x4f, 0x13, 0xe2, 0xb3, 0xec, 0x74, 0xa6, 0x52, 0x2d, 0xd0,
+	0x0e, 0xbc, 0x3d, 0x2c, 0xf2, 0x5c, 0xa4, 0x26, 0xd0, 0x96, 0x14, 0xec, 0xd6, 0x2c, 0xdd, 0x06,
+	0xdd, 0xa5, 0xde, 0xf2, 0x80, 0x4c, 0x4b, 0x0f, 0x5b, 0xff, 0x33, 0x88, 0xc4, 0xbf, 0xe5, 0x90,
+	0xb9, 0x34, 0x1a, 0x7d, 0x04, 0xf0, 0xc6, 0x3c, 0x37, 0xc8, 0xb9, 0xcc, 0xda, 0xcd, 0x2e, 0xe8,
+	0x5d, 0x5b, 0x7f, 0x4c, 0x2f, 0xbe, 0x26, 0xba, 0xd0, 0xc0, 0x00, 0x4f, 0x4b, 0xaf, 0x63, 0xeb,
+	0x3a, 0x25, 0x07, 0xf1, 0x5b, 0xfa, 0x5f, 0x09, 0x52, 0x10, 0xe5, 0x42, 0xf2, 0x38, 0xad, 0xc8,
+	0xb3, 0x9d, 0xb5, 0x97, 0xea, 0x6a, 0x56, 0xa9, 0x5d, 0x2a, 0x9d, 0x2d, 0x95, 0x3e, 0x71, 0x84,
+	0xc1, 0xbd, 0xc3, 0xd2, 0x6b, 0x4c, 0x4b, 0x6f, 0xd5, 0x66, 0x5b, 0xb4, 0x20, 0x5f, 0xbe, 0x7b,
+	0xc0, 0x6f, 0x1d, 0x03, 0x33, 0xe5, 0xfa, 0x6f, 0x00, 0xaf, 0xd4, 0xf3, 0x47, 0xbf, 0x00, 0x6c,
+	0x2d, 0xf4, 0x80, 0xb6, 0xff, 0x67, 0x04, 0xe7, 0xde, 0x45, 0xe7, 0xd9, 0x65, 0x58, 0xd9, 0x9b,
+	0x20, 0x5b, 0x1f, 0xbe, 0xfe, 0xfc, 0xdc, 0x7c, 0x84, 0x36, 0xe7, 0x7f, 0x9e, 0x53, 0xd7, 0x07,
+	0xad, 0xd9, 0x3b, 0x77, 0x6d, 0xef, 0xd9, 0x29, 0xe3, 0x1f, 0xbc, 0x3a, 0x1c, 0x63, 0x70, 0x34,
+	0xc6, 0xe0, 0xc7, 0x18, 0x83, 0x4f, 0x13, 0xdc, 0x38, 0x9a, 0xe0, 0xc6, 0xb7, 0x09, 0x6e, 0xec,
+	0x6c, 0x45, 0xb1, 0x79, 0x5d, 0x84, 0x74, 0xa8, 0xe4, 0xcc, 0x7e, 0x2d, 0xe1, 0xa1, 0x3e, 0xce,
+	0xb5, 0xdf, 0xdf, 0x60, 0x6f, 0xcf, 0xfb, 0x3a, 0xe1, 0x4a, 0xbd, 0xa3, 0x8d, 0xbf, 0x03, 0x00,
+	0xba, 0x52, 0x29, 0xa9, 0x19, 0x04, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// ScalingFactorRamp returns the current scaling factors of a stableswap pool
+	// and its in-flight scaling factor ramp, if any.
+	ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) ScalingFactorRamp(ctx context.Context, in *QueryScalingFactorRampRequest, opts ...grpc.CallOption) (*QueryScalingFactorRampResponse, error) {
+	out := new(QueryScalingFactorRampResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Query/ScalingFactorRamp", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// ScalingFactorRamp returns the current scaling factors of a stableswap pool
+	// and its in-flight scaling factor ramp, if any.
+	ScalingFactorRamp(context.Context, *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) ScalingFactorRamp(ctx context.Context, req *QueryScalingFactorRampRequest) (*QueryScalingFactorRampResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScalingFactorRamp not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_ScalingFactorRamp_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScalingFactorRampRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Query/ScalingFactorRamp",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScalingFactorRamp(ctx, req.(*QueryScalingFactorRampRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ScalingFactorRamp",
+			Handler:    _Query_ScalingFactorRamp_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "osmosis/gamm/pool-models/stableswap/query.proto",
+}
+
+func (m *QueryScalingFactorRampRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRampRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRampRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryScalingFactorRampResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScalingFactorRampResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScalingFactorRampResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.RemainingDuration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.RemainingDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintQuery(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	if m.ScalingFactorRamp != nil {
+		{
+			size, err := m.ScalingFactorRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.CurrentScalingFactors) > 0 {
+		dAtA4 := make([]byte, len(m.CurrentScalingFactors)*10)
+		var j3 int
+		for _, num := range m.CurrentScalingFactors {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryScalingFactorRampRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryScalingFactorRampResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.CurrentScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.CurrentScalingFactors {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.ScalingFactorRamp != nil {
+		l = m.ScalingFactorRamp.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.RemainingDuration)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryScalingFactorRampRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRampRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScalingFactorRampResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScalingFactorRampResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.CurrentScalingFactors = append(m.CurrentScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.CurrentScalingFactors) == 0 {
+					m.CurrentScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.CurrentScalingFactors = append(m.CurrentScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentScalingFactors", wireType)
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRamp == nil {
+				m.ScalingFactorRamp = &ScalingFactorRamp{}
+			}
+			if err := m.ScalingFactorRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.RemainingDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
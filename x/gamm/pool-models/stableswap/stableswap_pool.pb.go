@@ -12,15 +12,18 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -92,6 +95,11 @@ type Pool struct {
 	ScalingFactors []uint64 `protobuf:"varint,7,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factors"`
 	// scaling_factor_controller is the address can adjust pool scaling factors
 	ScalingFactorController string `protobuf:"bytes,8,opt,name=scaling_factor_controller,json=scalingFactorController,proto3" json:"scaling_factor_controller,omitempty" yaml:"scaling_factor_controller"`
+	// scaling_factor_ramp is the in-flight change of the scaling factors, if
+	// any. scaling_factors is kept up to date with it at each block time.
+	ScalingFactorRamp *ScalingFactorRamp `protobuf:"bytes,9,opt,name=scaling_factor_ramp,json=scalingFactorRamp,proto3" json:"scaling_factor_ramp,omitempty" yaml:"scaling_factor_ramp"`
+	// frozen pools reject swaps and joins, but can still be exited.
+	Frozen bool `protobuf:"varint,10,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
 }
 
 func (m *Pool) Reset()      { *m = Pool{} }
@@ -126,9 +134,82 @@ func (m *Pool) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Pool proto.InternalMessageInfo
 
+// ScalingFactorRamp linearly changes a pool's scaling factors from
+// initial_scaling_factors to target_scaling_factors between start_time and
+// start_time + duration.
+type ScalingFactorRamp struct {
+	StartTime time.Time     `protobuf:"bytes,1,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,2,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+	// The scaling factors of the pool when the ramp was scheduled.
+	InitialScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=initial_scaling_factors,json=initialScalingFactors,proto3" json:"initial_scaling_factors,omitempty" yaml:"initial_scaling_factors"`
+	TargetScalingFactors  []uint64 `protobuf:"varint,4,rep,packed,name=target_scaling_factors,json=targetScalingFactors,proto3" json:"target_scaling_factors,omitempty" yaml:"target_scaling_factors"`
+}
+
+func (m *ScalingFactorRamp) Reset()         { *m = ScalingFactorRamp{} }
+func (m *ScalingFactorRamp) String() string { return proto.CompactTextString(m) }
+func (*ScalingFactorRamp) ProtoMessage()    {}
+func (*ScalingFactorRamp) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ae0f054436f9999a, []int{2}
+}
+func (m *ScalingFactorRamp) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScalingFactorRamp) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScalingFactorRamp.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScalingFactorRamp) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScalingFactorRamp.Merge(m, src)
+}
+func (m *ScalingFactorRamp) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScalingFactorRamp) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScalingFactorRamp.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScalingFactorRamp proto.InternalMessageInfo
+
+func (m *ScalingFactorRamp) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *ScalingFactorRamp) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+func (m *ScalingFactorRamp) GetInitialScalingFactors() []uint64 {
+	if m != nil {
+		return m.InitialScalingFactors
+	}
+	return nil
+}
+
+func (m *ScalingFactorRamp) GetTargetScalingFactors() []uint64 {
+	if m != nil {
+		return m.TargetScalingFactors
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*PoolParams)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.PoolParams")
 	proto.RegisterType((*Pool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.Pool")
+	proto.RegisterType((*ScalingFactorRamp)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.ScalingFactorRamp")
 }
 
 func init() {
@@ -136,48 +217,61 @@ func init() {
 }
 
 var fileDescriptor_ae0f054436f9999a = []byte{
-	// 642 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
-	0x10, 0x8e, 0xdb, 0xb4, 0x29, 0x5b, 0x48, 0x85, 0xa9, 0x84, 0xdb, 0x0a, 0x6f, 0xb0, 0x28, 0x8a,
-	0x10, 0xb1, 0x29, 0x95, 0x90, 0xe8, 0xad, 0x29, 0x2a, 0x42, 0x42, 0xa8, 0x98, 0x13, 0x3f, 0x52,
-	0x58, 0xdb, 0x1b, 0x77, 0x85, 0x9d, 0x35, 0xbb, 0xeb, 0xd2, 0x5e, 0x38, 0x73, 0xe4, 0xc8, 0xb1,
-	0x67, 0xb8, 0xf2, 0x10, 0x15, 0xa7, 0x1e, 0x11, 0x07, 0x83, 0xda, 0x37, 0xc8, 0x13, 0xa0, 0x5d,
-	0xaf, 0xfb, 0x13, 0xa0, 0xaa, 0xc4, 0x29, 0x3b, 0x33, 0xdf, 0x7c, 0x33, 0xdf, 0xcc, 0xc4, 0xe0,
-	0x3e, 0xe5, 0x29, 0xe5, 0x84, 0x7b, 0x31, 0x4a, 0x53, 0x2f, 0xa3, 0x34, 0xe9, 0xa4, 0x34, 0xc2,
-	0x09, 0xf7, 0xb8, 0x40, 0x41, 0x82, 0xf9, 0x3b, 0x94, 0x9d, 0x78, 0xf6, 0x24, 0xc2, 0xcd, 0x18,
-	0x15, 0xd4, 0xbc, 0xa5, 0x53, 0x5d, 0x99, 0xea, 0xca, 0x40, 0x99, 0xe9, 0x1e, 0xc3, 0xdd, 0xad,
-	0xa5, 0x00, 0x0b, 0xb4, 0x34, 0x3f, 0x17, 0x2a, 0x70, 0x4f, 0x65, 0x7a, 0xa5, 0x51, 0xd2, 0xcc,
-	0xcf, 0xc6, 0x34, 0xa6, 0xa5, 0x5f, 0xbe, 0xb4, 0xd7, 0x8e, 0x29, 0x8d, 0x13, 0xec, 0x29, 0x2b,
-	0xc8, 0xfb, 0x5e, 0x94, 0x33, 0x24, 0x08, 0x1d, 0xe8, 0x38, 0x1c, 0x8d, 0x0b, 0x92, 0x62, 0x2e,
-	0x50, 0x9a, 0x55, 0x04, 0x65, 0x11, 0x0f, 0xe5, 0x62, 0xd3, 0xd3, 0x6d, 0x28, 0x63, 0x24, 0x1e,
-	0x20, 0x8e, 0x8f, 0xe2, 0x21, 0x25, 0xba, 0x80, 0xb3, 0x67, 0x00, 0xb0, 0x41, 0x69, 0xb2, 0x81,
-	0x18, 0x4a, 0xb9, 0xf9, 0x0a, 0x4c, 0x29, 0xfd, 0x7d, 0x8c, 0x2d, 0xa3, 0x65, 0xb4, 0x2f, 0x74,
-	0x57, 0xf7, 0x0a, 0x58, 0xfb, 0x51, 0xc0, 0x9b, 0x31, 0x11, 0x9b, 0x79, 0xe0, 0x86, 0x34, 0xd5,
-	0xc2, 0xf4, 0x4f, 0x87, 0x47, 0x6f, 0x3c, 0xb1, 0x93, 0x61, 0xee, 0x3e, 0xc0, 0xe1, 0xb0, 0x80,
-	0x33, 0x3b, 0x28, 0x4d, 0x56, 0x9c, 0x8a, 0xc7, 0xf1, 0x1b, 0xf2, 0xb9, 0x8e, 0xb1, 0x64, 0xc7,
-	0xdb, 0x44, 0x28, 0xf6, 0xb1, 0xff, 0x63, 0xaf, 0x78, 0x1c, 0xbf, 0x21, 0x9f, 0xeb, 0x18, 0x3b,
-	0x5f, 0x26, 0x40, 0x5d, 0x4a, 0x31, 0x6f, 0x83, 0x06, 0x8a, 0x22, 0x86, 0x39, 0xd7, 0x1a, 0xcc,
-	0x61, 0x01, 0x9b, 0x65, 0x9e, 0x0e, 0x38, 0x7e, 0x05, 0x31, 0x9b, 0x60, 0x8c, 0x44, 0xaa, 0x9d,
-	0xba, 0x3f, 0x46, 0x22, 0xf3, 0x3d, 0x98, 0x96, 0x4b, 0xee, 0x65, 0x6a, 0x22, 0xd6, 0x78, 0xcb,
-	0x68, 0x4f, 0xdf, 0xbd, 0xe7, 0x9e, 0xff, 0x0a, 0xdc, 0xe3, 0x79, 0x76, 0x17, 0xa5, 0xbe, 0x61,
-	0x01, 0xaf, 0xe9, 0x99, 0x9c, 0xbe, 0x30, 0x5d, 0xc3, 0xf1, 0x41, 0x76, 0xbc, 0x82, 0xa7, 0x60,
-	0xb6, 0x9f, 0x8b, 0x9c, 0xe1, 0x12, 0x12, 0xd3, 0x2d, 0xcc, 0x06, 0x94, 0x59, 0x75, 0x25, 0x05,
-	0x0e, 0x0b, 0xb8, 0x50, 0x92, 0xfd, 0x0d, 0xe5, 0xf8, 0x66, 0xe9, 0x96, 0x3d, 0x3c, 0xd4, 0x4e,
-	0xf3, 0x39, 0xb8, 0x28, 0xa8, 0x40, 0x49, 0x8f, 0x6f, 0x22, 0x86, 0xb9, 0x35, 0xa1, 0x34, 0xcd,
-	0xb9, 0xfa, 0x40, 0xe5, 0x6d, 0x1c, 0x35, 0xbf, 0x46, 0xc9, 0xa0, 0xbb, 0xa0, 0xdb, 0xbe, 0x52,
-	0x56, 0x3a, 0x99, 0xec, 0xf8, 0xd3, 0xca, 0x7c, 0xa6, 0x2c, 0x93, 0x81, 0xa6, 0x6a, 0x20, 0x21,
-	0x6f, 0x73, 0x12, 0x11, 0xb1, 0x63, 0x4d, 0xb6, 0xc6, 0xcf, 0x26, 0xbf, 0x23, 0xc9, 0x3f, 0xff,
-	0x84, 0xed, 0x73, 0xec, 0x5c, 0x26, 0x70, 0xff, 0x92, 0x2c, 0xf1, 0xb8, 0xaa, 0x60, 0x3e, 0x01,
-	0x33, 0x3c, 0x44, 0x09, 0x19, 0xc4, 0xbd, 0x3e, 0x0a, 0x05, 0x65, 0xdc, 0x6a, 0xb4, 0xc6, 0xdb,
-	0xf5, 0xee, 0xe2, 0xb0, 0x80, 0xd7, 0xff, 0x98, 0xf4, 0x08, 0xd6, 0xf1, 0x9b, 0xda, 0xb3, 0x5e,
-	0x3a, 0xcc, 0xd7, 0x60, 0xee, 0x34, 0xa6, 0x17, 0xd2, 0x81, 0x60, 0x34, 0x49, 0x30, 0xb3, 0xa6,
-	0xd4, 0xd8, 0x6f, 0x0c, 0x0b, 0xd8, 0xd2, 0xcc, 0xff, 0x82, 0x3a, 0xfe, 0xd5, 0x53, 0xc4, 0x6b,
-	0x47, 0x91, 0x95, 0xcb, 0x1f, 0x76, 0x61, 0xed, 0xd3, 0x2e, 0xac, 0x7d, 0xfb, 0xda, 0x99, 0x90,
-	0xab, 0x79, 0xd4, 0x7d, 0xb9, 0x77, 0x60, 0x1b, 0xfb, 0x07, 0xb6, 0xf1, 0xeb, 0xc0, 0x36, 0x3e,
-	0x1e, 0xda, 0xb5, 0xfd, 0x43, 0xbb, 0xf6, 0xfd, 0xd0, 0xae, 0xbd, 0x58, 0x3d, 0x31, 0x17, 0x7d,
-	0x75, 0x9d, 0x04, 0x05, 0xbc, 0x32, 0xbc, 0xad, 0xa5, 0x65, 0x6f, 0xfb, 0xac, 0x2f, 0x59, 0x30,
-	0xa9, 0xfe, 0xdc, 0xcb, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xeb, 0xf7, 0x52, 0xb8, 0xf7, 0x04,
-	0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xcd, 0x6f, 0xdc, 0x44,
+	0x14, 0x5f, 0x67, 0xb7, 0xf9, 0x98, 0xd0, 0x54, 0x3b, 0x0d, 0xd4, 0x49, 0x55, 0xcf, 0x76, 0x44,
+	0xd1, 0x82, 0x1a, 0x9b, 0xb4, 0x12, 0x12, 0x95, 0x38, 0xd4, 0xad, 0x82, 0x90, 0x10, 0x2a, 0x0e,
+	0x12, 0x50, 0x90, 0x96, 0xd9, 0xf5, 0xac, 0x33, 0xc2, 0xde, 0x31, 0x33, 0xb3, 0xa1, 0xe1, 0xc0,
+	0x99, 0x03, 0x48, 0x3d, 0xf6, 0xd8, 0x33, 0x67, 0xfe, 0x88, 0x88, 0x53, 0x8f, 0x88, 0x83, 0x8b,
+	0x12, 0x89, 0x03, 0x47, 0xff, 0x05, 0x68, 0xc6, 0xe3, 0xfd, 0x4a, 0x5a, 0x15, 0x71, 0xda, 0x99,
+	0xf7, 0x7e, 0xef, 0xf7, 0x3e, 0xe6, 0xe7, 0xb7, 0xe0, 0x7d, 0x2e, 0x33, 0x2e, 0x99, 0x0c, 0x12,
+	0x92, 0x65, 0x41, 0xce, 0x79, 0xba, 0x93, 0xf1, 0x98, 0xa6, 0x32, 0x90, 0x8a, 0xf4, 0x53, 0x2a,
+	0xbf, 0x27, 0xf9, 0xcc, 0xb1, 0xa7, 0x11, 0x7e, 0x2e, 0xb8, 0xe2, 0xf0, 0x1d, 0x1b, 0xea, 0xeb,
+	0x50, 0x5f, 0x3b, 0xaa, 0x48, 0x7f, 0x0a, 0xf7, 0x0f, 0x77, 0xfb, 0x54, 0x91, 0xdd, 0xed, 0xad,
+	0x81, 0x01, 0xf7, 0x4c, 0x64, 0x50, 0x5d, 0x2a, 0x9a, 0xed, 0xcd, 0x84, 0x27, 0xbc, 0xb2, 0xeb,
+	0x93, 0xb5, 0x7a, 0x09, 0xe7, 0x49, 0x4a, 0x03, 0x73, 0xeb, 0x8f, 0x87, 0x41, 0x3c, 0x16, 0x44,
+	0x31, 0x3e, 0xb2, 0x7e, 0xb4, 0xe8, 0x57, 0x2c, 0xa3, 0x52, 0x91, 0x2c, 0xaf, 0x09, 0xaa, 0x24,
+	0x01, 0x19, 0xab, 0x83, 0xc0, 0x96, 0x61, 0x2e, 0x0b, 0xfe, 0x3e, 0x91, 0x74, 0xe2, 0x1f, 0x70,
+	0x66, 0x13, 0xe0, 0x63, 0x07, 0x80, 0x07, 0x9c, 0xa7, 0x0f, 0x88, 0x20, 0x99, 0x84, 0x5f, 0x83,
+	0x55, 0xd3, 0xff, 0x90, 0x52, 0xd7, 0xe9, 0x38, 0xdd, 0xb5, 0xf0, 0xee, 0x71, 0x81, 0x1a, 0x7f,
+	0x16, 0xe8, 0xad, 0x84, 0xa9, 0x83, 0x71, 0xdf, 0x1f, 0xf0, 0xcc, 0x36, 0x66, 0x7f, 0x76, 0x64,
+	0xfc, 0x6d, 0xa0, 0x8e, 0x72, 0x2a, 0xfd, 0xfb, 0x74, 0x50, 0x16, 0xe8, 0xd2, 0x11, 0xc9, 0xd2,
+	0x3b, 0xb8, 0xe6, 0xc1, 0xd1, 0x8a, 0x3e, 0xee, 0x51, 0xaa, 0xd9, 0xe9, 0x23, 0xa6, 0x0c, 0xfb,
+	0xd2, 0xff, 0x63, 0xaf, 0x79, 0x70, 0xb4, 0xa2, 0x8f, 0x7b, 0x94, 0xe2, 0xbf, 0x97, 0x41, 0x4b,
+	0xb7, 0x02, 0x6f, 0x82, 0x15, 0x12, 0xc7, 0x82, 0x4a, 0x69, 0x7b, 0x80, 0x65, 0x81, 0x36, 0xaa,
+	0x38, 0xeb, 0xc0, 0x51, 0x0d, 0x81, 0x1b, 0x60, 0x89, 0xc5, 0xa6, 0x9c, 0x56, 0xb4, 0xc4, 0x62,
+	0xf8, 0x23, 0x58, 0xd7, 0x8f, 0xdc, 0xcb, 0xcd, 0x44, 0xdc, 0x66, 0xc7, 0xe9, 0xae, 0xdf, 0x7a,
+	0xcf, 0x7f, 0x75, 0x15, 0xf8, 0xd3, 0x79, 0x86, 0x37, 0x74, 0x7f, 0x65, 0x81, 0xae, 0xd9, 0x99,
+	0xcc, 0x2b, 0xcc, 0xe6, 0xc0, 0x11, 0xc8, 0xa7, 0x4f, 0xf0, 0x29, 0xd8, 0x1c, 0x8e, 0xd5, 0x58,
+	0xd0, 0x0a, 0x92, 0xf0, 0x43, 0x2a, 0x46, 0x5c, 0xb8, 0x2d, 0xd3, 0x0a, 0x2a, 0x0b, 0x74, 0xb5,
+	0x22, 0x3b, 0x0f, 0x85, 0x23, 0x58, 0x99, 0x75, 0x0d, 0x1f, 0x5a, 0x23, 0xfc, 0x12, 0xbc, 0xa6,
+	0xb8, 0x22, 0x69, 0x4f, 0x1e, 0x10, 0x41, 0xa5, 0x7b, 0xc1, 0xf4, 0xb4, 0xe5, 0x5b, 0x81, 0x6a,
+	0x6d, 0x4c, 0x8a, 0xbf, 0xc7, 0xd9, 0x28, 0xbc, 0x6a, 0xcb, 0xbe, 0x5c, 0x65, 0x9a, 0x0d, 0xc6,
+	0xd1, 0xba, 0xb9, 0xee, 0x9b, 0x1b, 0x14, 0x60, 0xc3, 0x14, 0x90, 0xb2, 0xef, 0xc6, 0x2c, 0x66,
+	0xea, 0xc8, 0x5d, 0xee, 0x34, 0x5f, 0x4e, 0xfe, 0xae, 0x26, 0xff, 0xf5, 0x39, 0xea, 0xbe, 0xc2,
+	0x9b, 0xeb, 0x00, 0x19, 0x5d, 0xd4, 0x29, 0x3e, 0xae, 0x33, 0xc0, 0x4f, 0xc0, 0x25, 0x39, 0x20,
+	0x29, 0x1b, 0x25, 0xbd, 0x21, 0x19, 0x28, 0x2e, 0xa4, 0xbb, 0xd2, 0x69, 0x76, 0x5b, 0xe1, 0x8d,
+	0xb2, 0x40, 0xd7, 0xcf, 0x4c, 0x7a, 0x01, 0x8b, 0xa3, 0x0d, 0x6b, 0xd9, 0xab, 0x0c, 0xf0, 0x1b,
+	0xb0, 0x35, 0x8f, 0xe9, 0x0d, 0xf8, 0x48, 0x09, 0x9e, 0xa6, 0x54, 0xb8, 0xab, 0x66, 0xec, 0x6f,
+	0x96, 0x05, 0xea, 0x58, 0xe6, 0x17, 0x41, 0x71, 0x74, 0x65, 0x8e, 0xf8, 0xde, 0xc4, 0x03, 0x7f,
+	0x71, 0xc0, 0xe5, 0x85, 0x38, 0x41, 0xb2, 0xdc, 0x5d, 0x33, 0x0f, 0xf1, 0xc1, 0x7f, 0x11, 0xd7,
+	0xfe, 0x6c, 0x8a, 0x88, 0x64, 0x79, 0xe8, 0x95, 0x05, 0xda, 0x3e, 0xb7, 0x36, 0x9d, 0x03, 0x47,
+	0x6d, 0xb9, 0x18, 0x02, 0xdf, 0x06, 0xcb, 0x43, 0xc1, 0x7f, 0xa0, 0x23, 0x17, 0x74, 0x9c, 0xee,
+	0x6a, 0xd8, 0x2e, 0x0b, 0x74, 0xd1, 0xaa, 0xca, 0xd8, 0x71, 0x64, 0x01, 0x77, 0xda, 0x3f, 0x3d,
+	0x45, 0x8d, 0x27, 0x4f, 0x51, 0xe3, 0xf7, 0xdf, 0x76, 0x2e, 0x68, 0x55, 0x7d, 0x84, 0x7f, 0x6e,
+	0x82, 0xf6, 0x99, 0x32, 0xe0, 0x17, 0x00, 0x48, 0x45, 0x84, 0xea, 0xe9, 0x15, 0x65, 0x3e, 0xbc,
+	0xf5, 0x5b, 0xdb, 0x7e, 0xb5, 0xbf, 0xfc, 0x7a, 0x7f, 0xf9, 0x9f, 0xd5, 0xfb, 0x2b, 0xbc, 0x66,
+	0x35, 0xd6, 0x9e, 0x3c, 0x98, 0x8d, 0xc5, 0x8f, 0x9f, 0x23, 0x27, 0x5a, 0x33, 0x06, 0x0d, 0x87,
+	0x07, 0x60, 0xb5, 0x5e, 0x8b, 0xe6, 0x3b, 0xd5, 0xea, 0x5a, 0xe4, 0xbd, 0x6f, 0x01, 0xe1, 0xae,
+	0xa6, 0xfd, 0xa7, 0x40, 0xb0, 0x0e, 0xb9, 0xc9, 0x33, 0xa6, 0x68, 0x96, 0xab, 0xa3, 0xe9, 0xf6,
+	0xa8, 0x7d, 0xf8, 0x89, 0x4e, 0x35, 0x61, 0x87, 0x0f, 0xc1, 0x15, 0x36, 0x62, 0x8a, 0x69, 0xb5,
+	0x2f, 0x28, 0xac, 0x69, 0x14, 0x86, 0xcb, 0x02, 0x79, 0x15, 0xc7, 0x0b, 0x80, 0x38, 0x7a, 0xdd,
+	0x7a, 0xf6, 0xe7, 0x55, 0xf6, 0x39, 0x78, 0x43, 0x11, 0x91, 0x50, 0x75, 0x86, 0xba, 0x65, 0xa8,
+	0xaf, 0x4f, 0xd7, 0xc4, 0xf9, 0x38, 0x1c, 0x6d, 0x56, 0x8e, 0x79, 0xe2, 0xf0, 0xab, 0xe3, 0x13,
+	0xcf, 0x79, 0x76, 0xe2, 0x39, 0x7f, 0x9d, 0x78, 0xce, 0xe3, 0x53, 0xaf, 0xf1, 0xec, 0xd4, 0x6b,
+	0xfc, 0x71, 0xea, 0x35, 0x1e, 0xde, 0x9d, 0xf9, 0xc2, 0xac, 0xc4, 0x76, 0x52, 0xd2, 0x97, 0xf5,
+	0x25, 0x38, 0xdc, 0xbd, 0x1d, 0x3c, 0x7a, 0xd9, 0x7f, 0x62, 0x7f, 0xd9, 0x4c, 0xf8, 0xf6, 0xbf,
+	0x03, 0x00, 0xbd, 0x3f, 0x17, 0x2d, 0x41, 0x07, 0x00, 0x00,
 }
 
 func (m *PoolParams) Marshal() (dAtA []byte, err error) {
@@ -243,6 +337,28 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.ScalingFactorRamp != nil {
+		{
+			size, err := m.ScalingFactorRamp.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStableswapPool(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.ScalingFactorController) > 0 {
 		i -= len(m.ScalingFactorController)
 		copy(dAtA[i:], m.ScalingFactorController)
@@ -251,20 +367,20 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x42
 	}
 	if len(m.ScalingFactors) > 0 {
-		dAtA2 := make([]byte, len(m.ScalingFactors)*10)
-		var j1 int
+		dAtA3 := make([]byte, len(m.ScalingFactors)*10)
+		var j2 int
 		for _, num := range m.ScalingFactors {
 			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA3[j2] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j1++
+				j2++
 			}
-			dAtA2[j1] = uint8(num)
-			j1++
+			dAtA3[j2] = uint8(num)
+			j2++
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintStableswapPool(dAtA, i, uint64(j1))
+		i -= j2
+		copy(dAtA[i:], dAtA3[:j2])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j2))
 		i--
 		dAtA[i] = 0x3a
 	}
@@ -324,6 +440,81 @@ func (m *Pool) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ScalingFactorRamp) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScalingFactorRamp) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScalingFactorRamp) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TargetScalingFactors) > 0 {
+		dAtA7 := make([]byte, len(m.TargetScalingFactors)*10)
+		var j6 int
+		for _, num := range m.TargetScalingFactors {
+			for num >= 1<<7 {
+				dAtA7[j6] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j6++
+			}
+			dAtA7[j6] = uint8(num)
+			j6++
+		}
+		i -= j6
+		copy(dAtA[i:], dAtA7[:j6])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j6))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.InitialScalingFactors) > 0 {
+		dAtA9 := make([]byte, len(m.InitialScalingFactors)*10)
+		var j8 int
+		for _, num := range m.InitialScalingFactors {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintStableswapPool(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	n10, err10 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err10 != nil {
+		return 0, err10
+	}
+	i -= n10
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n10))
+	i--
+	dAtA[i] = 0x12
+	n11, err11 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err11 != nil {
+		return 0, err11
+	}
+	i -= n11
+	i = encodeVarintStableswapPool(dAtA, i, uint64(n11))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintStableswapPool(dAtA []byte, offset int, v uint64) int {
 	offset -= sovStableswapPool(v)
 	base := offset
@@ -386,6 +577,40 @@ func (m *Pool) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovStableswapPool(uint64(l))
 	}
+	if m.ScalingFactorRamp != nil {
+		l = m.ScalingFactorRamp.Size()
+		n += 1 + l + sovStableswapPool(uint64(l))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *ScalingFactorRamp) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovStableswapPool(uint64(l))
+	if len(m.InitialScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.InitialScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
+	if len(m.TargetScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.TargetScalingFactors {
+			l += sovStableswapPool(uint64(e))
+		}
+		n += 1 + sovStableswapPool(uint64(l)) + l
+	}
 	return n
 }
 
@@ -833,6 +1058,330 @@ func (m *Pool) Unmarshal(dAtA []byte) error {
 			}
 			m.ScalingFactorController = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactorRamp", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ScalingFactorRamp == nil {
+				m.ScalingFactorRamp = &ScalingFactorRamp{}
+			}
+			if err := m.ScalingFactorRamp.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Frozen = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStableswapPool(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScalingFactorRamp) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStableswapPool
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScalingFactorRamp: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScalingFactorRamp: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStableswapPool
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStableswapPool
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.InitialScalingFactors) == 0 {
+					m.InitialScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.InitialScalingFactors = append(m.InitialScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialScalingFactors", wireType)
+			}
+		case 4:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowStableswapPool
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthStableswapPool
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.TargetScalingFactors) == 0 {
+					m.TargetScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowStableswapPool
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.TargetScalingFactors = append(m.TargetScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetScalingFactors", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStableswapPool(dAtA[iNdEx:])
@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -262,11 +266,131 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgStableSwapAdjustScalingFactorsResponse proto.InternalMessageInfo
 
+// Sender must be the pool's scaling_factor_governor in order for the tx to
+// succeed. Linearly moves the stableswap scaling factors from their current
+// values to scaling_factors over duration, replacing any ramp in progress.
+type MsgStableSwapRampScalingFactors struct {
+	Sender         string   `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolID         uint64   `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	ScalingFactors []uint64 `protobuf:"varint,3,rep,packed,name=scaling_factors,json=scalingFactors,proto3" json:"scaling_factors,omitempty" yaml:"stableswap_scaling_factor"`
+	// If unset, the ramp starts at the current block time.
+	StartTime time.Time     `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time" yaml:"start_time"`
+	Duration  time.Duration `protobuf:"bytes,5,opt,name=duration,proto3,stdduration" json:"duration,omitempty" yaml:"duration"`
+}
+
+func (m *MsgStableSwapRampScalingFactors) Reset()         { *m = MsgStableSwapRampScalingFactors{} }
+func (m *MsgStableSwapRampScalingFactors) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactors) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactors) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{4}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactors.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactors) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactors.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactors proto.InternalMessageInfo
+
+func (m *MsgStableSwapRampScalingFactors) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetPoolID() uint64 {
+	if m != nil {
+		return m.PoolID
+	}
+	return 0
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetScalingFactors() []uint64 {
+	if m != nil {
+		return m.ScalingFactors
+	}
+	return nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetStartTime() time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return time.Time{}
+}
+
+func (m *MsgStableSwapRampScalingFactors) GetDuration() time.Duration {
+	if m != nil {
+		return m.Duration
+	}
+	return 0
+}
+
+type MsgStableSwapRampScalingFactorsResponse struct {
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Reset() {
+	*m = MsgStableSwapRampScalingFactorsResponse{}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgStableSwapRampScalingFactorsResponse) ProtoMessage()    {}
+func (*MsgStableSwapRampScalingFactorsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_46b7c8a0f24de97c, []int{5}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.Merge(m, src)
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgStableSwapRampScalingFactorsResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateStableswapPool)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPool")
 	proto.RegisterType((*MsgCreateStableswapPoolResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgCreateStableswapPoolResponse")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactors")
 	proto.RegisterType((*MsgStableSwapAdjustScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapAdjustScalingFactorsResponse")
+	proto.RegisterType((*MsgStableSwapRampScalingFactors)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactors")
+	proto.RegisterType((*MsgStableSwapRampScalingFactorsResponse)(nil), "osmosis.gamm.poolmodels.stableswap.v1beta1.MsgStableSwapRampScalingFactorsResponse")
 }
 
 func init() {
@@ -274,46 +398,57 @@ func init() {
 }
 
 var fileDescriptor_46b7c8a0f24de97c = []byte{
-	// 622 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4f, 0x6f, 0xd3, 0x30,
-	0x14, 0x6f, 0xd6, 0x52, 0x84, 0x27, 0x40, 0x44, 0xd5, 0x96, 0x15, 0x29, 0x29, 0x81, 0x43, 0x07,
-	0x2c, 0x66, 0x9b, 0x84, 0x04, 0xb7, 0xa5, 0x68, 0x68, 0x82, 0x4a, 0x23, 0x13, 0x17, 0x38, 0x14,
-	0x27, 0xf1, 0x82, 0x21, 0x89, 0x43, 0xec, 0x76, 0xeb, 0x91, 0x6f, 0xc0, 0xc7, 0x40, 0x7c, 0x07,
-	0x2e, 0x1c, 0xd0, 0x8e, 0x3b, 0x72, 0x0a, 0xa8, 0xfd, 0x06, 0xbd, 0x71, 0x43, 0x89, 0xd3, 0x7f,
-	0x52, 0xbb, 0xad, 0x68, 0xa7, 0x38, 0xcf, 0xbf, 0xf7, 0xfb, 0xbd, 0xf7, 0x7b, 0xb6, 0xc1, 0x43,
-	0xca, 0x02, 0xca, 0x08, 0x83, 0x1e, 0x0a, 0x02, 0x18, 0x51, 0xea, 0x6f, 0x04, 0xd4, 0xc5, 0x3e,
-	0x83, 0x8c, 0x23, 0xdb, 0xc7, 0xec, 0x08, 0x45, 0x90, 0x1f, 0x1b, 0x51, 0x4c, 0x39, 0x95, 0xef,
-	0xe7, 0x68, 0x23, 0x45, 0x1b, 0x29, 0x5a, 0x80, 0x8d, 0x31, 0xd8, 0xe8, 0x6c, 0xda, 0x98, 0xa3,
-	0xcd, 0xaa, 0xea, 0x64, 0x60, 0x68, 0x23, 0x86, 0x61, 0x1e, 0x84, 0x0e, 0x25, 0xa1, 0xe0, 0xaa,
-	0x56, 0x3c, 0xea, 0xd1, 0x6c, 0x09, 0xd3, 0x55, 0x1e, 0x7d, 0x72, 0x91, 0x7a, 0xc6, 0xcb, 0x56,
-	0x8a, 0x10, 0xa9, 0xfa, 0xf7, 0x12, 0x58, 0x6d, 0x32, 0xaf, 0x11, 0x63, 0xc4, 0xf1, 0xc1, 0x08,
-	0xb2, 0x4f, 0xa9, 0x2f, 0xaf, 0x83, 0x32, 0xc3, 0xa1, 0x8b, 0x63, 0x45, 0xaa, 0x49, 0xf5, 0x6b,
-	0xe6, 0xad, 0x41, 0xa2, 0x5d, 0xef, 0xa2, 0xc0, 0x7f, 0xaa, 0x8b, 0xb8, 0x6e, 0xe5, 0x00, 0x99,
-	0x82, 0xe5, 0x94, 0xb4, 0x15, 0xa1, 0x18, 0x05, 0x4c, 0x59, 0xaa, 0x49, 0xf5, 0xe5, 0xad, 0xc7,
-	0xc6, 0xc5, 0x3b, 0x37, 0x52, 0xc5, 0xfd, 0x2c, 0xdb, 0x5c, 0x19, 0x24, 0x9a, 0x2c, 0x74, 0x26,
-	0x48, 0x75, 0x0b, 0x44, 0x23, 0x8c, 0xfc, 0x59, 0x02, 0x2b, 0x24, 0x24, 0x9c, 0x20, 0x3f, 0x6b,
-	0xa7, 0xe5, 0x93, 0x4f, 0x6d, 0xe2, 0x12, 0xde, 0x55, 0x8a, 0xb5, 0x62, 0x7d, 0x79, 0x6b, 0xcd,
-	0x10, 0x56, 0x1a, 0xa9, 0x95, 0x23, 0x95, 0x06, 0x25, 0xa1, 0xf9, 0xe8, 0x24, 0xd1, 0x0a, 0xdf,
-	0x7e, 0x6b, 0x75, 0x8f, 0xf0, 0xf7, 0x6d, 0xdb, 0x70, 0x68, 0x00, 0x73, 0xdf, 0xc5, 0x67, 0x83,
-	0xb9, 0x1f, 0x21, 0xef, 0x46, 0x98, 0x65, 0x09, 0xcc, 0xaa, 0xe4, 0x52, 0x69, 0x91, 0x2f, 0x87,
-	0x42, 0x72, 0x13, 0xdc, 0x64, 0x0e, 0xf2, 0x49, 0xe8, 0xb5, 0x0e, 0x91, 0xc3, 0x69, 0xcc, 0x94,
-	0x52, 0xad, 0x58, 0x2f, 0x99, 0xf7, 0x06, 0x89, 0x56, 0xcb, 0x8d, 0x1a, 0xbb, 0x3e, 0x8d, 0xd5,
-	0xad, 0x1b, 0x79, 0x60, 0x57, 0xe4, 0xca, 0xaf, 0x40, 0xe5, 0xb0, 0xcd, 0xdb, 0x31, 0x16, 0x0d,
-	0x79, 0xb4, 0x83, 0xe3, 0x90, 0xc6, 0xca, 0x95, 0xcc, 0x7c, 0x6d, 0x90, 0x68, 0xb7, 0x05, 0xe7,
-	0x2c, 0x94, 0x6e, 0xc9, 0x22, 0x9c, 0x96, 0xf8, 0x3c, 0x0f, 0xca, 0xef, 0xc0, 0xda, 0xb4, 0x6a,
-	0xcb, 0xa1, 0x21, 0x8f, 0xa9, 0xef, 0xe3, 0x58, 0x29, 0x67, 0xbc, 0x93, 0xb5, 0xce, 0x83, 0xea,
-	0xd6, 0xea, 0x54, 0xad, 0x8d, 0xf1, 0xce, 0x2e, 0xd0, 0xe6, 0x1c, 0x1f, 0x0b, 0xb3, 0x88, 0x86,
-	0x0c, 0xcb, 0x77, 0xc1, 0xd5, 0xac, 0x54, 0xe2, 0x66, 0xe7, 0xa8, 0x64, 0x82, 0x5e, 0xa2, 0x95,
-	0x53, 0xc8, 0xde, 0x33, 0xab, 0x9c, 0x6e, 0xed, 0xb9, 0xfa, 0x0f, 0x09, 0xdc, 0x69, 0x32, 0x4f,
-	0x50, 0x1c, 0x1c, 0xa1, 0x68, 0xc7, 0xfd, 0xd0, 0x66, 0xfc, 0x60, 0xda, 0xa2, 0x05, 0x4e, 0xe4,
-	0x84, 0xea, 0xd2, 0x3c, 0xd5, 0x59, 0x13, 0x2c, 0xfe, 0xff, 0x04, 0xf5, 0x07, 0x60, 0xfd, 0xdc,
-	0x1e, 0x86, 0xb6, 0x6c, 0xfd, 0x5d, 0x02, 0xc5, 0x26, 0xf3, 0xe4, 0xaf, 0x12, 0xa8, 0xcc, 0xbc,
-	0x7e, 0x8d, 0x45, 0xae, 0xcf, 0x9c, 0x21, 0x54, 0x5f, 0x5c, 0x02, 0xc9, 0x68, 0x92, 0x3f, 0x25,
-	0xa0, 0x9e, 0x33, 0xa1, 0xe6, 0x82, 0x7a, 0x67, 0xd3, 0x55, 0x5f, 0x5f, 0x2a, 0xdd, 0xb0, 0x11,
-	0xf3, 0xed, 0x49, 0x4f, 0x95, 0x4e, 0x7b, 0xaa, 0xf4, 0xa7, 0xa7, 0x4a, 0x5f, 0xfa, 0x6a, 0xe1,
-	0xb4, 0xaf, 0x16, 0x7e, 0xf5, 0xd5, 0xc2, 0x9b, 0x9d, 0x89, 0x37, 0x21, 0x97, 0xde, 0xf0, 0x91,
-	0xcd, 0x86, 0x3f, 0xb0, 0xb3, 0xb9, 0x0d, 0x8f, 0xcf, 0x7a, 0x68, 0xed, 0x72, 0xf6, 0xb2, 0x6e,
-	0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xc6, 0x5b, 0x3f, 0xf5, 0x26, 0x06, 0x00, 0x00,
+	// 787 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x55, 0xcd, 0x6e, 0xd3, 0x4a,
+	0x14, 0x8e, 0x9b, 0xdc, 0xdc, 0xdb, 0xa9, 0xee, 0xad, 0x6a, 0x45, 0x6d, 0x9a, 0x7b, 0xaf, 0x1d,
+	0x0c, 0x12, 0x29, 0xb4, 0x36, 0x69, 0x25, 0x24, 0xd8, 0xd5, 0xa9, 0x8a, 0xaa, 0x12, 0xa9, 0x38,
+	0x20, 0x21, 0x58, 0x84, 0x49, 0x3c, 0x75, 0x07, 0x6c, 0x8f, 0xf1, 0x4c, 0xda, 0x66, 0x89, 0xc4,
+	0x03, 0x74, 0xc9, 0x23, 0x20, 0x9e, 0x01, 0x24, 0xc4, 0x02, 0x75, 0xd9, 0x25, 0x2b, 0x17, 0xa5,
+	0x3b, 0x96, 0x79, 0x02, 0x34, 0xfe, 0xc9, 0x4f, 0x69, 0xfa, 0xa7, 0x6e, 0x58, 0x65, 0x72, 0xe6,
+	0x3b, 0xdf, 0x77, 0x7e, 0xe6, 0x1c, 0x83, 0x79, 0x42, 0x1d, 0x42, 0x31, 0xd5, 0x2c, 0xe8, 0x38,
+	0x9a, 0x47, 0x88, 0xbd, 0xe0, 0x10, 0x13, 0xd9, 0x54, 0xa3, 0x0c, 0x36, 0x6c, 0x44, 0x77, 0xa0,
+	0xa7, 0xb1, 0x5d, 0xd5, 0xf3, 0x09, 0x23, 0xe2, 0xad, 0x18, 0xad, 0x72, 0xb4, 0xca, 0xd1, 0x11,
+	0x58, 0xed, 0x83, 0xd5, 0xed, 0x72, 0x03, 0x31, 0x58, 0x2e, 0x48, 0xcd, 0x10, 0xac, 0x35, 0x20,
+	0x45, 0x5a, 0x6c, 0xd4, 0x9a, 0x04, 0xbb, 0x11, 0x57, 0x21, 0x67, 0x11, 0x8b, 0x84, 0x47, 0x8d,
+	0x9f, 0x62, 0xab, 0x64, 0x11, 0x62, 0xd9, 0x48, 0x0b, 0xff, 0x35, 0x5a, 0x9b, 0x9a, 0xd9, 0xf2,
+	0x21, 0xc3, 0x24, 0xf1, 0x92, 0x8f, 0xdf, 0x33, 0xec, 0x20, 0xca, 0xa0, 0xe3, 0xc5, 0x80, 0x7b,
+	0xe7, 0x49, 0xa8, 0x7f, 0xac, 0x73, 0x44, 0xe4, 0xaa, 0x7c, 0xca, 0x80, 0x99, 0x2a, 0xb5, 0x2a,
+	0x3e, 0x82, 0x0c, 0xd5, 0x7a, 0x90, 0x0d, 0x42, 0x6c, 0x71, 0x0e, 0x64, 0x29, 0x72, 0x4d, 0xe4,
+	0xe7, 0x85, 0xa2, 0x50, 0x1a, 0xd7, 0xa7, 0xba, 0x81, 0xfc, 0x77, 0x1b, 0x3a, 0xf6, 0x7d, 0x25,
+	0xb2, 0x2b, 0x46, 0x0c, 0x10, 0x09, 0x98, 0xe0, 0xa4, 0x75, 0x0f, 0xfa, 0xd0, 0xa1, 0xf9, 0xb1,
+	0xa2, 0x50, 0x9a, 0x58, 0xbc, 0xab, 0x9e, 0xbf, 0x74, 0x2a, 0x57, 0xdc, 0x08, 0xbd, 0xf5, 0xe9,
+	0x6e, 0x20, 0x8b, 0x91, 0xce, 0x00, 0xa9, 0x62, 0x00, 0xaf, 0x87, 0x11, 0xdf, 0x08, 0x60, 0x1a,
+	0xbb, 0x98, 0x61, 0x68, 0x87, 0xe9, 0xd4, 0x6d, 0xfc, 0xba, 0x85, 0x4d, 0xcc, 0xda, 0xf9, 0x74,
+	0x31, 0x5d, 0x9a, 0x58, 0x9c, 0x55, 0xa3, 0x5e, 0xa8, 0xbc, 0x17, 0x3d, 0x95, 0x0a, 0xc1, 0xae,
+	0x7e, 0x67, 0x3f, 0x90, 0x53, 0x1f, 0x0e, 0xe5, 0x92, 0x85, 0xd9, 0x56, 0xab, 0xa1, 0x36, 0x89,
+	0xa3, 0xc5, 0x8d, 0x8b, 0x7e, 0x16, 0xa8, 0xf9, 0x4a, 0x63, 0x6d, 0x0f, 0xd1, 0xd0, 0x81, 0x1a,
+	0xb9, 0x58, 0x8a, 0x07, 0xf9, 0x30, 0x11, 0x12, 0xab, 0x60, 0x92, 0x36, 0xa1, 0x8d, 0x5d, 0xab,
+	0xbe, 0x09, 0x9b, 0x8c, 0xf8, 0x34, 0x9f, 0x29, 0xa6, 0x4b, 0x19, 0xfd, 0x46, 0x37, 0x90, 0x8b,
+	0x71, 0xa1, 0xfa, 0x55, 0x1f, 0xc6, 0x2a, 0xc6, 0x3f, 0xb1, 0x61, 0x35, 0xf2, 0x15, 0x1f, 0x81,
+	0xdc, 0x66, 0x8b, 0xb5, 0x7c, 0x14, 0x25, 0x64, 0x91, 0x6d, 0xe4, 0xbb, 0xc4, 0xcf, 0xff, 0x11,
+	0x16, 0x5f, 0xee, 0x06, 0xf2, 0xbf, 0x11, 0xe7, 0x49, 0x28, 0xc5, 0x10, 0x23, 0x33, 0x0f, 0xf1,
+	0x41, 0x6c, 0x14, 0x5f, 0x80, 0xd9, 0x61, 0xd5, 0x7a, 0x93, 0xb8, 0xcc, 0x27, 0xb6, 0x8d, 0xfc,
+	0x7c, 0x36, 0xe4, 0x1d, 0x8c, 0x75, 0x14, 0x54, 0x31, 0x66, 0x86, 0x62, 0xad, 0xf4, 0x6f, 0x56,
+	0x81, 0x3c, 0xe2, 0xf9, 0x18, 0x88, 0x7a, 0xc4, 0xa5, 0x48, 0xbc, 0x0e, 0xfe, 0x0c, 0x43, 0xc5,
+	0x66, 0xf8, 0x8e, 0x32, 0x3a, 0xe8, 0x04, 0x72, 0x96, 0x43, 0xd6, 0x56, 0x8c, 0x2c, 0xbf, 0x5a,
+	0x33, 0x95, 0x2f, 0x02, 0xb8, 0x56, 0xa5, 0x56, 0x44, 0x51, 0xdb, 0x81, 0xde, 0xb2, 0xf9, 0xb2,
+	0x45, 0x59, 0x6d, 0xb8, 0x44, 0x17, 0x78, 0x91, 0x03, 0xaa, 0x63, 0xa3, 0x54, 0x4f, 0xea, 0x60,
+	0xfa, 0xf2, 0x1d, 0x54, 0x6e, 0x83, 0xb9, 0x33, 0x73, 0x48, 0xca, 0xa2, 0xbc, 0x4d, 0x03, 0x79,
+	0x08, 0x6d, 0x40, 0xc7, 0xfb, 0xad, 0xf2, 0x15, 0x9f, 0x02, 0x40, 0x19, 0xf4, 0x59, 0x9d, 0x2f,
+	0xa4, 0x7c, 0x26, 0x1c, 0xfa, 0x82, 0x1a, 0x6d, 0x2b, 0x35, 0xd9, 0x56, 0xea, 0xe3, 0x64, 0x5b,
+	0xe9, 0xff, 0xf3, 0xc1, 0xeb, 0x06, 0xf2, 0x54, 0x4f, 0x29, 0xf6, 0x55, 0xf6, 0x0e, 0x65, 0xc1,
+	0x18, 0x0f, 0x0d, 0x1c, 0x2e, 0x6e, 0x81, 0xbf, 0x92, 0x25, 0x18, 0xbe, 0x7f, 0x3e, 0xcf, 0xc7,
+	0x79, 0x57, 0x62, 0x80, 0x5e, 0xe6, 0xb4, 0x3f, 0x02, 0x59, 0x4c, 0x5c, 0xe6, 0x89, 0x83, 0x19,
+	0x72, 0x3c, 0xd6, 0xee, 0x06, 0xf2, 0x64, 0x24, 0x96, 0xdc, 0x29, 0xef, 0xb8, 0x54, 0x8f, 0x5d,
+	0x99, 0x03, 0x37, 0xcf, 0xe8, 0x42, 0xd2, 0xb1, 0xc5, 0x8f, 0x19, 0x90, 0xae, 0x52, 0x4b, 0x7c,
+	0x2f, 0x80, 0xdc, 0x89, 0x0b, 0xb3, 0x72, 0x91, 0x85, 0x37, 0x62, 0x6c, 0x0a, 0xeb, 0x57, 0x40,
+	0xd2, 0x9b, 0xbd, 0xaf, 0x02, 0x90, 0xce, 0x98, 0xa9, 0xea, 0x05, 0xf5, 0x4e, 0xa7, 0x2b, 0x3c,
+	0xb9, 0x52, 0xba, 0x5e, 0x22, 0x9f, 0x05, 0xf0, 0xdf, 0xa9, 0xa3, 0xb2, 0x7e, 0x69, 0xdd, 0x5f,
+	0xc9, 0x0a, 0xb5, 0x2b, 0x24, 0x4b, 0x52, 0xd0, 0x9f, 0xef, 0x77, 0x24, 0xe1, 0xa0, 0x23, 0x09,
+	0xdf, 0x3b, 0x92, 0xb0, 0x77, 0x24, 0xa5, 0x0e, 0x8e, 0xa4, 0xd4, 0xb7, 0x23, 0x29, 0xf5, 0x6c,
+	0x79, 0xe0, 0x43, 0x14, 0x0b, 0x2f, 0xd8, 0xb0, 0x41, 0x93, 0x3f, 0xda, 0x76, 0x79, 0x49, 0xdb,
+	0x3d, 0xed, 0xeb, 0xde, 0xc8, 0x86, 0x63, 0xb1, 0xf4, 0x73, 0x00, 0x6e, 0x9f, 0x77, 0x4e, 0xdc,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -330,6 +465,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateStableswapPool(ctx context.Context, in *MsgCreateStableswapPool, opts ...grpc.CallOption) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(ctx context.Context, in *MsgStableSwapAdjustScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error)
 }
 
 type msgClient struct {
@@ -358,10 +494,20 @@ func (c *msgClient) StableSwapAdjustScalingFactors(ctx context.Context, in *MsgS
 	return out, nil
 }
 
+func (c *msgClient) StableSwapRampScalingFactors(ctx context.Context, in *MsgStableSwapRampScalingFactors, opts ...grpc.CallOption) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	out := new(MsgStableSwapRampScalingFactorsResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateStableswapPool(context.Context, *MsgCreateStableswapPool) (*MsgCreateStableswapPoolResponse, error)
 	StableSwapAdjustScalingFactors(context.Context, *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error)
+	StableSwapRampScalingFactors(context.Context, *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -374,6 +520,9 @@ func (*UnimplementedMsgServer) CreateStableswapPool(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) StableSwapAdjustScalingFactors(ctx context.Context, req *MsgStableSwapAdjustScalingFactors) (*MsgStableSwapAdjustScalingFactorsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method StableSwapAdjustScalingFactors not implemented")
 }
+func (*UnimplementedMsgServer) StableSwapRampScalingFactors(ctx context.Context, req *MsgStableSwapRampScalingFactors) (*MsgStableSwapRampScalingFactorsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StableSwapRampScalingFactors not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -415,6 +564,24 @@ func _Msg_StableSwapAdjustScalingFactors_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_StableSwapRampScalingFactors_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgStableSwapRampScalingFactors)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.poolmodels.stableswap.v1beta1.Msg/StableSwapRampScalingFactors",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).StableSwapRampScalingFactors(ctx, req.(*MsgStableSwapRampScalingFactors))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.gamm.poolmodels.stableswap.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -427,6 +594,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "StableSwapAdjustScalingFactors",
 			Handler:    _Msg_StableSwapAdjustScalingFactors_Handler,
 		},
+		{
+			MethodName: "StableSwapRampScalingFactors",
+			Handler:    _Msg_StableSwapRampScalingFactors_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/pool-models/stableswap/tx.proto",
@@ -624,6 +795,98 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) MarshalToSizedBuffer(dAtA []
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgStableSwapRampScalingFactors) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n6, err6 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Duration, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintTx(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0x2a
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintTx(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0x22
+	if len(m.ScalingFactors) > 0 {
+		dAtA9 := make([]byte, len(m.ScalingFactors)*10)
+		var j8 int
+		for _, num := range m.ScalingFactors {
+			for num >= 1<<7 {
+				dAtA9[j8] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j8++
+			}
+			dAtA9[j8] = uint8(num)
+			j8++
+		}
+		i -= j8
+		copy(dAtA[i:], dAtA9[:j8])
+		i = encodeVarintTx(dAtA, i, uint64(j8))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.PoolID != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolID))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -717,6 +980,42 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgStableSwapRampScalingFactors) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolID != 0 {
+		n += 1 + sovTx(uint64(m.PoolID))
+	}
+	if len(m.ScalingFactors) > 0 {
+		l = 0
+		for _, e := range m.ScalingFactors {
+			l += sovTx(uint64(e))
+		}
+		n += 1 + sovTx(uint64(l)) + l
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime)
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Duration)
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgStableSwapRampScalingFactorsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1311,6 +1610,299 @@ func (m *MsgStableSwapAdjustScalingFactorsResponse) Unmarshal(dAtA []byte) error
 	}
 	return nil
 }
+func (m *MsgStableSwapRampScalingFactors) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactors: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolID", wireType)
+			}
+			m.PoolID = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolID |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.ScalingFactors = append(m.ScalingFactors, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowTx
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthTx
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthTx
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.ScalingFactors) == 0 {
+					m.ScalingFactors = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowTx
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.ScalingFactors = append(m.ScalingFactors, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScalingFactors", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Duration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Duration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgStableSwapRampScalingFactorsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgStableSwapRampScalingFactorsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
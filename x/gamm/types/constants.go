@@ -14,6 +14,12 @@ const (
 	StableswapMinScaledAmtPerAsset = 1
 	// We keep this multiplier at 1, but can increase if needed in the unlikely scenario where default scaling factors of 1 cannot accommodate enough assets
 	ScalingFactorMultiplier = 1
+
+	// MaxProtocolRevenueSwapsPerEpoch is the maximum number of protocol revenue denoms swapped to OSMO at an epoch end.
+	MaxProtocolRevenueSwapsPerEpoch = 20
+
+	// MaxDynamicSwapFeeUpdatesPerBlock is the maximum number of pools whose effective swap fee is updated in a block.
+	MaxDynamicSwapFeeUpdatesPerBlock = 50
 )
 
 var (
@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxDynamicSwapFeeWindow is the longest window the volatility of a pool with
+// a dynamic swap fee may be measured over, as twap records are only kept for
+// 48 hours.
+const MaxDynamicSwapFeeWindow = 48 * time.Hour
+
+// MaxVolatilityMultiplier is the largest volatility multiplier of a dynamic swap fee,
+// such that multiplying it by the volatility of any pool's prices can't overflow.
+var MaxVolatilityMultiplier = sdk.NewDec(10_000)
+
+// Validate returns an error unless params bound the swap fee between
+// 0 and 1, measure volatility over a window of at most MaxDynamicSwapFeeWindow,
+// and multiply it by at most MaxVolatilityMultiplier.
+func (params DynamicSwapFeeParams) Validate() error {
+	if params.MinSwapFee.IsNil() || params.MinSwapFee.IsNegative() {
+		return ErrNegativeSwapFee
+	}
+	if params.MaxSwapFee.IsNil() || params.MaxSwapFee.GTE(sdk.OneDec()) {
+		return ErrTooMuchSwapFee
+	}
+	if params.MinSwapFee.GT(params.MaxSwapFee) {
+		return fmt.Errorf("min swap fee %s is greater than max swap fee %s", params.MinSwapFee, params.MaxSwapFee)
+	}
+	if params.Window <= 0 || params.Window > MaxDynamicSwapFeeWindow {
+		return fmt.Errorf("window %s must be positive and at most %s", params.Window, MaxDynamicSwapFeeWindow)
+	}
+	if params.VolatilityMultiplier.IsNil() || params.VolatilityMultiplier.IsNegative() || params.VolatilityMultiplier.GT(MaxVolatilityMultiplier) {
+		return fmt.Errorf("volatility multiplier must be between 0 and %s", MaxVolatilityMultiplier)
+	}
+	return nil
+}
+
+// EffectiveSwapFee returns the swap fee of a pool of the given swap fee, the
+// prices of which had the given volatility over the window.
+func (params DynamicSwapFeeParams) EffectiveSwapFee(swapFee sdk.Dec, volatility sdk.Dec) sdk.Dec {
+	effectiveSwapFee := swapFee.Add(volatility.Mul(params.VolatilityMultiplier))
+	if effectiveSwapFee.LT(params.MinSwapFee) {
+		return params.MinSwapFee
+	}
+	if effectiveSwapFee.GT(params.MaxSwapFee) {
+		return params.MaxSwapFee
+	}
+	return effectiveSwapFee
+}
@@ -54,4 +54,13 @@ var (
 	ErrInvalidScalingFactors      = sdkerrors.Register(ModuleName, 64, "scaling factors cannot be 0 or use more than 63 bits")
 	ErrHitMaxScaledAssets         = sdkerrors.Register(ModuleName, 65, "post-scaled pool assets can not exceed 10^34")
 	ErrHitMinScaledAssets         = sdkerrors.Register(ModuleName, 66, "post-scaled pool assets can not be less than 1")
+
+	ErrNotFutureGovernor = sdkerrors.Register(ModuleName, 67, "not the pool's future governor")
+
+	ErrSamePoolMigration       = sdkerrors.Register(ModuleName, 68, "cannot migrate shares to the pool they are of")
+	ErrMigrationDenomsMismatch = sdkerrors.Register(ModuleName, 69, "pools migrated between must hold the same denoms")
+	ErrMigrationLockNotAllowed = sdkerrors.Register(ModuleName, 70, "lock cannot be migrated")
+
+	ErrNotPoolFreezeAdmin = sdkerrors.Register(ModuleName, 71, "not the pool freeze admin")
+	ErrPoolNotFreezable   = sdkerrors.Register(ModuleName, 72, "pool cannot be frozen")
 )
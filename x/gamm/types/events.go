@@ -6,9 +6,19 @@ const (
 	TypeEvtPoolCreated  = "pool_created"
 	TypeEvtTokenSwapped = "token_swapped"
 
+	TypeEvtProtocolRevenueCollected = "protocol_revenue_collected"
+
+	TypeEvtPoolParamsUpdated     = "pool_params_updated"
+	TypeEvtWeightChangeScheduled = "weight_change_scheduled"
+	TypeEvtDynamicSwapFeeSet     = "dynamic_swap_fee_set"
+	TypeEvtPoolFrozenSet         = "pool_frozen_set"
+
 	AttributeValueCategory = ModuleName
 	AttributeKeyPoolId     = "pool_id"
 	AttributeKeySwapFee    = "swap_fee"
+	AttributeKeyExitFee    = "exit_fee"
 	AttributeKeyTokensIn   = "tokens_in"
 	AttributeKeyTokensOut  = "tokens_out"
+	AttributeKeyRevenue    = "revenue"
+	AttributeKeyFrozen     = "frozen"
 )
@@ -1,9 +1,14 @@
 package types
 
 import (
+	"time"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // AccountKeeper defines the account contract that must be fulfilled when
@@ -35,6 +40,8 @@ type BankKeeper interface {
 	// TODO: Look into golang syntax to make this "Everything in stakingtypes.bankkeeper + extra funcs"
 	// I think it has to do with listing another interface as the first line here?
 	GetAllBalances(ctx sdk.Context, addr sdk.AccAddress) sdk.Coins
+	GetBalance(ctx sdk.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	GetSupply(ctx sdk.Context, denom string) sdk.Coin
 }
 
 // CommunityPoolKeeper defines the contract needed to be fulfilled for distribution keeper.
@@ -45,3 +52,29 @@ type CommunityPoolKeeper interface {
 type PoolIncentivesKeeper interface {
 	IsPoolIncentivized(ctx sdk.Context, poolId uint64) bool
 }
+
+// LockupKeeper defines the lockup contract needed to check lock-based future pool governors,
+// and to migrate locked shares.
+type LockupKeeper interface {
+	GetAccountLockedLongerDurationDenom(ctx sdk.Context, addr sdk.AccAddress, denom string, duration time.Duration) []lockuptypes.PeriodLock
+	GetPeriodLocksAccumulation(ctx sdk.Context, query lockuptypes.QueryCondition) sdk.Int
+	GetLockByID(ctx sdk.Context, lockID uint64) (*lockuptypes.PeriodLock, error)
+	HasAnySyntheticLockups(ctx sdk.Context, lockID uint64) bool
+	PartialForceUnlock(ctx sdk.Context, lock lockuptypes.PeriodLock, coins sdk.Coins) error
+	CreateLock(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, duration time.Duration) (lockuptypes.PeriodLock, error)
+	BeginUnlock(ctx sdk.Context, lockID uint64, coins sdk.Coins) error
+}
+
+// TwapKeeper defines the twap contract needed to measure the volatility of
+// pools with a dynamic swap fee.
+type TwapKeeper interface {
+	GetArithmeticTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+	GetGeometricTwapToNow(ctx sdk.Context, poolId uint64, baseAssetDenom string, quoteAssetDenom string, startTime time.Time) (sdk.Dec, error)
+}
+
+// SwapRouter defines the swaprouter contract needed to estimate swaps along
+// routes through pools of any pool module.
+type SwapRouter interface {
+	MultihopEstimateOutGivenExactAmountIn(ctx sdk.Context, routes []swaproutertypes.SwapAmountInRoute, tokenIn sdk.Coin) (sdk.Int, error)
+	MultihopEstimateInGivenExactAmountOut(ctx sdk.Context, routes []swaproutertypes.SwapAmountOutRoute, tokenOut sdk.Coin) (sdk.Int, error)
+}
@@ -1,6 +1,8 @@
 package types
 
 import (
+	"fmt"
+
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 )
 
@@ -19,5 +21,15 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+	for _, poolRevenue := range gs.PoolProtocolRevenues {
+		if err := poolRevenue.Revenue.Validate(); err != nil {
+			return fmt.Errorf("invalid protocol revenue of pool %d: %w", poolRevenue.PoolId, err)
+		}
+	}
+	for _, poolDynamicSwapFee := range gs.PoolDynamicSwapFees {
+		if err := poolDynamicSwapFee.Params.Validate(); err != nil {
+			return fmt.Errorf("invalid dynamic swap fee params of pool %d: %w", poolDynamicSwapFee.PoolId, err)
+		}
+	}
 	return nil
 }
@@ -30,6 +30,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params holds parameters for the incentives module
 type Params struct {
 	PoolCreationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=pool_creation_fee,json=poolCreationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"pool_creation_fee" yaml:"pool_creation_fee"`
+	// take_rate is the fraction of every swap fee diverted from the pool's
+	// liquidity providers to the protocol revenue module account. Only swaps
+	// through x/gamm pools are charged it.
+	TakeRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=take_rate,json=takeRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"take_rate" yaml:"take_rate"`
+	// pool_freeze_admin is the address, typically a multisig, allowed to freeze
+	// and unfreeze pools in an emergency. Pools can't be frozen by hand if unset.
+	PoolFreezeAdmin string `protobuf:"bytes,3,opt,name=pool_freeze_admin,json=poolFreezeAdmin,proto3" json:"pool_freeze_admin,omitempty" yaml:"pool_freeze_admin"`
+	// protocol_revenue_epoch_identifier is the identifier of the epochs at the
+	// end of which the protocol revenue is swapped to OSMO.
+	ProtocolRevenueEpochIdentifier string `protobuf:"bytes,4,opt,name=protocol_revenue_epoch_identifier,json=protocolRevenueEpochIdentifier,proto3" json:"protocol_revenue_epoch_identifier,omitempty" yaml:"protocol_revenue_epoch_identifier"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -72,12 +82,32 @@ func (m *Params) GetPoolCreationFee() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Params) GetPoolFreezeAdmin() string {
+	if m != nil {
+		return m.PoolFreezeAdmin
+	}
+	return ""
+}
+
+func (m *Params) GetProtocolRevenueEpochIdentifier() string {
+	if m != nil {
+		return m.ProtocolRevenueEpochIdentifier
+	}
+	return ""
+}
+
 // GenesisState defines the gamm module's genesis state.
 type GenesisState struct {
 	Pools []*types1.Any `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
 	// will be renamed to next_pool_id in an upcoming version
 	NextPoolNumber uint64 `protobuf:"varint,2,opt,name=next_pool_number,json=nextPoolNumber,proto3" json:"next_pool_number,omitempty"`
 	Params         Params `protobuf:"bytes,3,opt,name=params,proto3" json:"params"`
+	// pool_protocol_revenues are the swap fees each pool has diverted to the
+	// protocol.
+	PoolProtocolRevenues []PoolProtocolRevenue `protobuf:"bytes,4,rep,name=pool_protocol_revenues,json=poolProtocolRevenues,proto3" json:"pool_protocol_revenues"`
+	// pool_dynamic_swap_fees are the dynamic swap fee params of the pools opted
+	// into one.
+	PoolDynamicSwapFees []PoolDynamicSwapFee `protobuf:"bytes,5,rep,name=pool_dynamic_swap_fees,json=poolDynamicSwapFees,proto3" json:"pool_dynamic_swap_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,9 +164,132 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPoolProtocolRevenues() []PoolProtocolRevenue {
+	if m != nil {
+		return m.PoolProtocolRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPoolDynamicSwapFees() []PoolDynamicSwapFee {
+	if m != nil {
+		return m.PoolDynamicSwapFees
+	}
+	return nil
+}
+
+// PoolProtocolRevenue is the total of the swap fees a pool has diverted to the
+// protocol, in the denoms they were charged in.
+type PoolProtocolRevenue struct {
+	PoolId  uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Revenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=revenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenue" yaml:"revenue"`
+}
+
+func (m *PoolProtocolRevenue) Reset()         { *m = PoolProtocolRevenue{} }
+func (m *PoolProtocolRevenue) String() string { return proto.CompactTextString(m) }
+func (*PoolProtocolRevenue) ProtoMessage()    {}
+func (*PoolProtocolRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{2}
+}
+func (m *PoolProtocolRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolProtocolRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolProtocolRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolProtocolRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolProtocolRevenue.Merge(m, src)
+}
+func (m *PoolProtocolRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolProtocolRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolProtocolRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolProtocolRevenue proto.InternalMessageInfo
+
+func (m *PoolProtocolRevenue) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolProtocolRevenue) GetRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+// PoolDynamicSwapFee is the dynamic swap fee params of a pool.
+type PoolDynamicSwapFee struct {
+	PoolId uint64               `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Params DynamicSwapFeeParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params" yaml:"params"`
+}
+
+func (m *PoolDynamicSwapFee) Reset()         { *m = PoolDynamicSwapFee{} }
+func (m *PoolDynamicSwapFee) String() string { return proto.CompactTextString(m) }
+func (*PoolDynamicSwapFee) ProtoMessage()    {}
+func (*PoolDynamicSwapFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a324eb7f1dd793e, []int{3}
+}
+func (m *PoolDynamicSwapFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PoolDynamicSwapFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PoolDynamicSwapFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PoolDynamicSwapFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PoolDynamicSwapFee.Merge(m, src)
+}
+func (m *PoolDynamicSwapFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *PoolDynamicSwapFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_PoolDynamicSwapFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PoolDynamicSwapFee proto.InternalMessageInfo
+
+func (m *PoolDynamicSwapFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *PoolDynamicSwapFee) GetParams() DynamicSwapFeeParams {
+	if m != nil {
+		return m.Params
+	}
+	return DynamicSwapFeeParams{}
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.gamm.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.gamm.v1beta1.GenesisState")
+	proto.RegisterType((*PoolProtocolRevenue)(nil), "osmosis.gamm.v1beta1.PoolProtocolRevenue")
+	proto.RegisterType((*PoolDynamicSwapFee)(nil), "osmosis.gamm.v1beta1.PoolDynamicSwapFee")
 }
 
 func init() {
@@ -144,33 +297,50 @@ func init() {
 }
 
 var fileDescriptor_5a324eb7f1dd793e = []byte{
-	// 401 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0xaa, 0xd3, 0x40,
-	0x18, 0xc5, 0x33, 0xde, 0x7b, 0x0b, 0xe6, 0x8a, 0x7f, 0x42, 0x17, 0xb9, 0x17, 0x49, 0x4b, 0x56,
-	0xd9, 0x74, 0xc6, 0xde, 0xe2, 0xa6, 0x3b, 0x53, 0x50, 0x14, 0x91, 0x12, 0x77, 0x6e, 0xc2, 0x24,
-	0x4e, 0x63, 0x30, 0x99, 0x2f, 0x64, 0xa6, 0xa5, 0x79, 0x0b, 0xc1, 0xbd, 0x0f, 0xa0, 0x5b, 0x1f,
-	0xa2, 0xb8, 0xea, 0xd2, 0x55, 0x95, 0xf6, 0x0d, 0x7c, 0x02, 0x99, 0x3f, 0x11, 0x41, 0x57, 0xc9,
-	0x37, 0xdf, 0xef, 0x1c, 0xce, 0x9c, 0xc4, 0x0d, 0x41, 0xd4, 0x20, 0x4a, 0x41, 0x0a, 0x5a, 0xd7,
-	0x64, 0x33, 0xcd, 0x98, 0xa4, 0x53, 0x52, 0x30, 0xce, 0x44, 0x29, 0x70, 0xd3, 0x82, 0x04, 0x6f,
-	0x68, 0x19, 0xac, 0x18, 0x6c, 0x99, 0xeb, 0x61, 0x01, 0x05, 0x68, 0x80, 0xa8, 0x37, 0xc3, 0x5e,
-	0x5f, 0x15, 0x00, 0x45, 0xc5, 0x88, 0x9e, 0xb2, 0xf5, 0x8a, 0x50, 0xde, 0xf5, 0xab, 0x5c, 0xfb,
-	0xa4, 0x46, 0x63, 0x06, 0xbb, 0x0a, 0xcc, 0x44, 0x32, 0x2a, 0xd8, 0x9f, 0x10, 0x39, 0x94, 0xdc,
-	0xec, 0xc3, 0x4f, 0xc8, 0x1d, 0x2c, 0x69, 0x4b, 0x6b, 0xe1, 0x7d, 0x44, 0xee, 0x83, 0x06, 0xa0,
-	0x4a, 0xf3, 0x96, 0x51, 0x59, 0x02, 0x4f, 0x57, 0x8c, 0xf9, 0x68, 0x7c, 0x16, 0x5d, 0xde, 0x5c,
-	0x61, 0xeb, 0xaa, 0x7c, 0xfa, 0xa0, 0x78, 0x01, 0x25, 0x8f, 0x5f, 0xee, 0x0e, 0x23, 0xe7, 0xd7,
-	0x61, 0xe4, 0x77, 0xb4, 0xae, 0xe6, 0xe1, 0x3f, 0x0e, 0xe1, 0xe7, 0x1f, 0xa3, 0xa8, 0x28, 0xe5,
-	0xbb, 0x75, 0x86, 0x73, 0xa8, 0x6d, 0x3c, 0xfb, 0x98, 0x88, 0xb7, 0xef, 0x89, 0xec, 0x1a, 0x26,
-	0xb4, 0x99, 0x48, 0xee, 0x29, 0xfd, 0xc2, 0xca, 0x9f, 0x32, 0x16, 0x7e, 0x41, 0xee, 0x9d, 0x67,
-	0xa6, 0xb4, 0xd7, 0x92, 0x4a, 0xe6, 0x3d, 0x76, 0x2f, 0x14, 0x23, 0x6c, 0xb2, 0x21, 0x36, 0xbd,
-	0xe0, 0xbe, 0x17, 0xfc, 0x84, 0x77, 0xf1, 0xed, 0x6f, 0x5f, 0x27, 0x17, 0x4b, 0x80, 0xea, 0x79,
-	0x62, 0x68, 0x2f, 0x72, 0xef, 0x73, 0xb6, 0x95, 0xa9, 0xce, 0xc7, 0xd7, 0x75, 0xc6, 0x5a, 0xff,
-	0xd6, 0x18, 0x45, 0xe7, 0xc9, 0x5d, 0x75, 0xae, 0xd8, 0x57, 0xfa, 0xd4, 0x9b, 0xbb, 0x83, 0x46,
-	0x37, 0xe2, 0x9f, 0x8d, 0x51, 0x74, 0x79, 0xf3, 0x10, 0xff, 0xef, 0x2b, 0x61, 0xd3, 0x5a, 0x7c,
-	0xae, 0xae, 0x9f, 0x58, 0x45, 0xfc, 0x62, 0x77, 0x0c, 0xd0, 0xfe, 0x18, 0xa0, 0x9f, 0xc7, 0x00,
-	0x7d, 0x38, 0x05, 0xce, 0xfe, 0x14, 0x38, 0xdf, 0x4f, 0x81, 0xf3, 0xe6, 0xd1, 0x5f, 0x15, 0x58,
-	0xbf, 0x49, 0x45, 0x33, 0xd1, 0x0f, 0x64, 0x33, 0x9d, 0x91, 0xad, 0xf9, 0x59, 0x74, 0x21, 0xd9,
-	0x40, 0xdf, 0x68, 0xf6, 0x3b, 0x00, 0x00, 0xff, 0xff, 0x43, 0x18, 0x92, 0x53, 0x49, 0x02, 0x00,
-	0x00,
+	// 685 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x43, 0x08, 0x8f, 0xe1, 0x3d, 0x1e, 0xcf, 0xe4, 0x55, 0x06, 0x51, 0x87, 0x7a, 0x51,
+	0xa5, 0x1f, 0xd8, 0x05, 0xd4, 0x0d, 0x3b, 0x0c, 0xa5, 0xa5, 0xaa, 0x2a, 0x64, 0x56, 0xed, 0xc6,
+	0x1a, 0xdb, 0x37, 0xc6, 0xc2, 0xf6, 0x58, 0x1e, 0x87, 0x90, 0xfe, 0x8a, 0x4a, 0xdd, 0xf6, 0x17,
+	0x74, 0xdd, 0x5d, 0x77, 0x5d, 0xa1, 0xae, 0x58, 0x56, 0x5d, 0xa4, 0x15, 0xfc, 0x83, 0x6c, 0xba,
+	0xad, 0xe6, 0xc3, 0x14, 0x48, 0x10, 0x65, 0x95, 0x99, 0x7b, 0xcf, 0x3d, 0xe7, 0xde, 0xeb, 0x33,
+	0x41, 0x06, 0xa1, 0x09, 0xa1, 0x11, 0xb5, 0x42, 0x9c, 0x24, 0xd6, 0xc1, 0xb2, 0x07, 0x05, 0x5e,
+	0xb6, 0x42, 0x48, 0x81, 0x46, 0xd4, 0xcc, 0x72, 0x52, 0x10, 0xb5, 0x21, 0x31, 0x26, 0xc3, 0x98,
+	0x12, 0x33, 0xdf, 0x08, 0x49, 0x48, 0x38, 0xc0, 0x62, 0x27, 0x81, 0x9d, 0x9f, 0x0b, 0x09, 0x09,
+	0x63, 0xb0, 0xf8, 0xcd, 0xeb, 0xb4, 0x2d, 0x9c, 0xf6, 0xca, 0x94, 0xcf, 0x79, 0x5c, 0x51, 0x23,
+	0x2e, 0x32, 0xa5, 0x8b, 0x9b, 0xe5, 0x61, 0x0a, 0x67, 0x4d, 0xf8, 0x24, 0x4a, 0x65, 0xfe, 0xf6,
+	0xc8, 0x2e, 0x8b, 0x43, 0x91, 0x36, 0x3e, 0x8f, 0xa1, 0xfa, 0x0e, 0xce, 0x71, 0x42, 0xd5, 0x77,
+	0x0a, 0xfa, 0x2f, 0x23, 0x24, 0x76, 0xfd, 0x1c, 0x70, 0x11, 0x91, 0xd4, 0x6d, 0x03, 0x68, 0xca,
+	0xe2, 0x58, 0x6b, 0x6a, 0x65, 0xce, 0x94, 0xa2, 0x4c, 0xa6, 0x9c, 0xc3, 0xdc, 0x20, 0x51, 0x6a,
+	0xbf, 0x38, 0xea, 0x37, 0x2b, 0x83, 0x7e, 0x53, 0xeb, 0xe1, 0x24, 0x5e, 0x33, 0x86, 0x18, 0x8c,
+	0x0f, 0xdf, 0x9b, 0xad, 0x30, 0x2a, 0xf6, 0x3a, 0x9e, 0xe9, 0x93, 0x44, 0x76, 0x2f, 0x7f, 0x96,
+	0x68, 0xb0, 0x6f, 0x15, 0xbd, 0x0c, 0x28, 0x27, 0xa3, 0xce, 0xbf, 0xac, 0x7e, 0x43, 0x96, 0x6f,
+	0x01, 0xa8, 0x2e, 0x9a, 0x2c, 0xf0, 0x3e, 0xb8, 0x39, 0x2e, 0x40, 0xab, 0x2e, 0x2a, 0xad, 0x49,
+	0xdb, 0x66, 0x8a, 0xdf, 0xfa, 0xcd, 0xbb, 0x7f, 0xc0, 0xba, 0x09, 0xfe, 0xa0, 0xdf, 0x9c, 0x11,
+	0xbd, 0x9d, 0x11, 0x19, 0xce, 0x5f, 0xec, 0xec, 0xe0, 0x02, 0xd4, 0x67, 0x72, 0xea, 0x76, 0x0e,
+	0xf0, 0x06, 0x5c, 0x1c, 0x24, 0x51, 0xaa, 0x8d, 0x71, 0xa1, 0x85, 0x4b, 0x63, 0x9d, 0x87, 0x18,
+	0xa2, 0xd5, 0x2d, 0x1e, 0x5a, 0x67, 0x11, 0xb5, 0x8b, 0xee, 0xf0, 0xa5, 0xfa, 0x24, 0x76, 0x73,
+	0x38, 0x80, 0xb4, 0x03, 0x2e, 0x64, 0xc4, 0xdf, 0x73, 0xa3, 0x00, 0xd2, 0x22, 0x6a, 0x47, 0x90,
+	0x6b, 0x35, 0xce, 0xfc, 0x70, 0xd0, 0x6f, 0xb6, 0x24, 0xf3, 0x75, 0x25, 0x86, 0xa3, 0x97, 0x18,
+	0x47, 0x40, 0x9e, 0x30, 0xc4, 0xf6, 0x6f, 0xc0, 0xcf, 0x2a, 0xfa, 0xfb, 0xa9, 0xf0, 0xdd, 0x6e,
+	0xc1, 0x66, 0x7a, 0x8c, 0xc6, 0x59, 0x73, 0x54, 0x7e, 0xbd, 0x86, 0x29, 0xac, 0x65, 0x96, 0xd6,
+	0x32, 0xd7, 0xd3, 0x9e, 0x3d, 0xf9, 0xe5, 0xe3, 0xd2, 0xf8, 0x0e, 0x21, 0xf1, 0xb6, 0x23, 0xd0,
+	0x6a, 0x0b, 0xcd, 0xa4, 0x70, 0x58, 0xb8, 0x7c, 0xd8, 0xb4, 0x93, 0x78, 0x90, 0xf3, 0x95, 0xd7,
+	0x9c, 0x69, 0x16, 0x67, 0xd8, 0x97, 0x3c, 0xaa, 0xae, 0xa1, 0x7a, 0xc6, 0x5d, 0xc3, 0x37, 0x35,
+	0xb5, 0xb2, 0x60, 0x8e, 0x32, 0xba, 0x29, 0x9c, 0x65, 0xd7, 0xd8, 0x07, 0x73, 0x64, 0x85, 0x0a,
+	0xe8, 0x16, 0x17, 0xb8, 0x3c, 0x38, 0xd5, 0x6a, 0xbc, 0xdb, 0x7b, 0x57, 0x70, 0x11, 0x12, 0xef,
+	0x5c, 0xdc, 0x83, 0x24, 0x6e, 0x64, 0xc3, 0x29, 0xaa, 0xfa, 0x52, 0x26, 0xe8, 0xa5, 0x38, 0x89,
+	0x7c, 0x97, 0x76, 0x71, 0xc6, 0xfc, 0x48, 0xb5, 0x71, 0x2e, 0xd3, 0xba, 0x5a, 0x66, 0x53, 0x94,
+	0xec, 0x76, 0x71, 0xb6, 0x05, 0xa5, 0xca, 0x6c, 0x36, 0x94, 0xa1, 0xc6, 0x27, 0x05, 0xcd, 0x8e,
+	0x68, 0x4c, 0x7d, 0x80, 0x26, 0xb8, 0x78, 0x14, 0x68, 0x0a, 0x5b, 0xa0, 0xad, 0x0e, 0xfa, 0xcd,
+	0xe9, 0x73, 0x56, 0x8a, 0x02, 0xc3, 0xa9, 0xb3, 0xd3, 0x76, 0xa0, 0x76, 0xd1, 0x84, 0x5c, 0x81,
+	0x56, 0xbd, 0xee, 0xb5, 0xd9, 0xf2, 0xb5, 0x49, 0x2e, 0x59, 0x77, 0xb3, 0x37, 0x56, 0xaa, 0x19,
+	0xef, 0x15, 0xa4, 0x0e, 0xcf, 0x7b, 0xb3, 0xe6, 0x5f, 0x9d, 0x39, 0xa1, 0xca, 0x9d, 0x70, 0x7f,
+	0xf4, 0x5a, 0x2f, 0x4a, 0x48, 0x5f, 0xfc, 0x2f, 0x87, 0xf9, 0x47, 0x72, 0xf3, 0xa8, 0x51, 0x1a,
+	0xc5, 0x7e, 0x7e, 0x74, 0xa2, 0x2b, 0xc7, 0x27, 0xba, 0xf2, 0xe3, 0x44, 0x57, 0xde, 0x9e, 0xea,
+	0x95, 0xe3, 0x53, 0xbd, 0xf2, 0xf5, 0x54, 0xaf, 0xbc, 0x7e, 0x74, 0x6e, 0x56, 0x29, 0xb7, 0x14,
+	0x63, 0x8f, 0x96, 0x17, 0xeb, 0x60, 0x79, 0xd5, 0x3a, 0x14, 0x7f, 0x79, 0x7c, 0x72, 0xaf, 0xce,
+	0xdd, 0xb6, 0xfa, 0x6b, 0x00, 0x27, 0xb1, 0x5f, 0x91, 0xb5, 0x05, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -193,6 +363,30 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ProtocolRevenueEpochIdentifier) > 0 {
+		i -= len(m.ProtocolRevenueEpochIdentifier)
+		copy(dAtA[i:], m.ProtocolRevenueEpochIdentifier)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProtocolRevenueEpochIdentifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PoolFreezeAdmin) > 0 {
+		i -= len(m.PoolFreezeAdmin)
+		copy(dAtA[i:], m.PoolFreezeAdmin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PoolFreezeAdmin)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size := m.TakeRate.Size()
+		i -= size
+		if _, err := m.TakeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	if len(m.PoolCreationFee) > 0 {
 		for iNdEx := len(m.PoolCreationFee) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -230,6 +424,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolDynamicSwapFees) > 0 {
+		for iNdEx := len(m.PoolDynamicSwapFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolDynamicSwapFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.PoolProtocolRevenues) > 0 {
+		for iNdEx := len(m.PoolProtocolRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolProtocolRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -262,6 +484,86 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PoolProtocolRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolProtocolRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolProtocolRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PoolDynamicSwapFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PoolDynamicSwapFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PoolDynamicSwapFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.PoolId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -285,6 +587,16 @@ func (m *Params) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	l = m.TakeRate.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = len(m.PoolFreezeAdmin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProtocolRevenueEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
 	return n
 }
 
@@ -305,6 +617,50 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolProtocolRevenues) > 0 {
+		for _, e := range m.PoolProtocolRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PoolDynamicSwapFees) > 0 {
+		for _, e := range m.PoolDynamicSwapFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolProtocolRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PoolDynamicSwapFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovGenesis(uint64(m.PoolId))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -377,41 +733,139 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TakeRate", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenesis
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GenesisState) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenesis
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
+			if err := m.TakeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolFreezeAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolFreezeAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolRevenueEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolRevenueEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
 			iNdEx++
 			wire |= uint64(b&0x7F) << shift
 			if b < 0x80 {
@@ -513,6 +967,279 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolProtocolRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolProtocolRevenues = append(m.PoolProtocolRevenues, PoolProtocolRevenue{})
+			if err := m.PoolProtocolRevenues[len(m.PoolProtocolRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolDynamicSwapFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolDynamicSwapFees = append(m.PoolDynamicSwapFees, PoolDynamicSwapFee{})
+			if err := m.PoolDynamicSwapFees[len(m.PoolDynamicSwapFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolProtocolRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolProtocolRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolProtocolRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PoolDynamicSwapFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PoolDynamicSwapFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PoolDynamicSwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
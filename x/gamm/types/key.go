@@ -16,6 +16,10 @@ const (
 	RouterKey = ModuleName
 
 	QuerierRoute = ModuleName
+
+	// ProtocolRevenueCollectorName is the module account collecting the
+	// protocol's take of swap fees.
+	ProtocolRevenueCollectorName = "protocol_revenue"
 )
 
 var (
@@ -25,6 +29,14 @@ var (
 	KeyPrefixPools = []byte{0x02}
 	// KeyTotalLiquidity defines key to store total liquidity.
 	KeyTotalLiquidity = []byte{0x03}
+	// KeyPrefixProtocolRevenue defines prefix to store the protocol revenue accrued per pool and denom.
+	KeyPrefixProtocolRevenue = []byte{0x04}
+	// KeyPrefixDynamicSwapFeeParams defines prefix to store the dynamic swap fee params of pools.
+	KeyPrefixDynamicSwapFeeParams = []byte{0x05}
+	// KeyPrefixEffectiveSwapFee defines prefix to store the swap fee of pools with a dynamic swap fee.
+	KeyPrefixEffectiveSwapFee = []byte{0x06}
+	// KeyDynamicSwapFeeCursor defines key to store the id of the next pool whose effective swap fee to update.
+	KeyDynamicSwapFeeCursor = []byte{0x07}
 )
 
 func MustGetPoolIdFromShareDenom(denom string) uint64 {
@@ -56,3 +68,19 @@ func GetPoolShareDenom(poolId uint64) string {
 func GetKeyPrefixPools(poolId uint64) []byte {
 	return append(KeyPrefixPools, sdk.Uint64ToBigEndian(poolId)...)
 }
+
+func GetKeyPrefixPoolProtocolRevenue(poolId uint64) []byte {
+	return append(KeyPrefixProtocolRevenue, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyPoolProtocolRevenue(poolId uint64, denom string) []byte {
+	return append(GetKeyPrefixPoolProtocolRevenue(poolId), []byte(denom)...)
+}
+
+func GetKeyDynamicSwapFeeParams(poolId uint64) []byte {
+	return append(KeyPrefixDynamicSwapFeeParams, sdk.Uint64ToBigEndian(poolId)...)
+}
+
+func GetKeyEffectiveSwapFee(poolId uint64) []byte {
+	return append(KeyPrefixEffectiveSwapFee, sdk.Uint64ToBigEndian(poolId)...)
+}
@@ -19,6 +19,9 @@ const (
 	TypeMsgJoinSwapShareAmountOut  = "join_swap_share_amount_out"
 	TypeMsgExitSwapExternAmountOut = "exit_swap_extern_amount_out"
 	TypeMsgExitSwapShareAmountIn   = "exit_swap_share_amount_in"
+	TypeMsgSetDynamicSwapFee       = "set_dynamic_swap_fee"
+	TypeMsgMigrateShares           = "migrate_shares"
+	TypeMsgSetPoolFrozen           = "set_pool_frozen"
 )
 
 func ValidateFutureGovernor(governor string) error {
@@ -27,25 +30,51 @@ func ValidateFutureGovernor(governor string) error {
 		return nil
 	}
 
+	_, err := ParseFutureGovernor(governor, "")
+	return err
+}
+
+// FutureGovernor is a parsed pool FuturePoolGovernor.
+// It is either an address, or whoever holds the majority of LockDenom
+// locked for at least LockDuration.
+type FutureGovernor struct {
+	Address      sdk.AccAddress
+	LockDenom    string
+	LockDuration time.Duration
+}
+
+// IsAddress returns true if the governor is an address rather than a lock.
+func (g FutureGovernor) IsAddress() bool {
+	return g.Address != nil
+}
+
+// ParseFutureGovernor parses a non-empty FuturePoolGovernor.
+// The governor is one of
+// * an address: "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
+// * a lock denom and duration: "token,100h"
+// * a lock duration: "100h", in which case the lock denom is poolShareDenom.
+func ParseFutureGovernor(governor string, poolShareDenom string) (FutureGovernor, error) {
 	// validation for future owner
 	// "osmo1fqlr98d45v5ysqgp6h56kpujcj4cvsjnjq9nck"
-	_, err := sdk.AccAddressFromBech32(governor)
+	addr, err := sdk.AccAddressFromBech32(governor)
 	if err == nil {
-		return nil
+		return FutureGovernor{Address: addr}, nil
 	}
 
+	lockDenom := poolShareDenom
 	lockTimeStr := ""
 	splits := strings.Split(governor, ",")
 	if len(splits) > 2 {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
 
 	// token,100h
 	if len(splits) == 2 {
 		lpTokenStr := splits[0]
 		if sdk.ValidateDenom(lpTokenStr) != nil {
-			return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+			return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 		}
+		lockDenom = lpTokenStr
 		lockTimeStr = splits[1]
 	}
 
@@ -55,11 +84,11 @@ func ValidateFutureGovernor(governor string) error {
 	}
 
 	// Note that a duration of 0 is allowed
-	_, err = time.ParseDuration(lockTimeStr)
+	lockDuration, err := time.ParseDuration(lockTimeStr)
 	if err != nil {
-		return sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
+		return FutureGovernor{}, sdkerrors.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid future governor: %s", governor))
 	}
-	return nil
+	return FutureGovernor{LockDenom: lockDenom, LockDuration: lockDuration}, nil
 }
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -347,3 +376,94 @@ func (msg MsgExitSwapShareAmountIn) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSetDynamicSwapFee{}
+
+func (msg MsgSetDynamicSwapFee) Route() string { return RouterKey }
+func (msg MsgSetDynamicSwapFee) Type() string  { return TypeMsgSetDynamicSwapFee }
+func (msg MsgSetDynamicSwapFee) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.Params != nil {
+		return msg.Params.Validate()
+	}
+
+	return nil
+}
+
+func (msg MsgSetDynamicSwapFee) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetDynamicSwapFee) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgMigrateShares{}
+
+func (msg MsgMigrateShares) Route() string { return RouterKey }
+func (msg MsgMigrateShares) Type() string  { return TypeMsgMigrateShares }
+func (msg MsgMigrateShares) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if msg.PoolIdLeaving == msg.PoolIdEntering {
+		return ErrSamePoolMigration
+	}
+
+	if msg.ShareInAmount.IsNil() || !msg.ShareInAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveRequireAmount, msg.ShareInAmount.String())
+	}
+
+	if !msg.ShareOutMinAmount.IsPositive() {
+		return sdkerrors.Wrap(ErrNotPositiveCriteria, msg.ShareOutMinAmount.String())
+	}
+
+	return nil
+}
+
+func (msg MsgMigrateShares) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgMigrateShares) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSetPoolFrozen{}
+
+func (msg MsgSetPoolFrozen) Route() string { return RouterKey }
+func (msg MsgSetPoolFrozen) Type() string  { return TypeMsgSetPoolFrozen }
+func (msg MsgSetPoolFrozen) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (msg MsgSetPoolFrozen) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSetPoolFrozen) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
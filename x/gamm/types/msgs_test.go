@@ -871,6 +871,132 @@ func TestMsgExitSwapShareAmountIn(t *testing.T) {
 	}
 }
 
+func TestMsgMigrateShares(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+		properMsg := gammtypes.MsgMigrateShares{
+			Sender:            addr1,
+			PoolIdLeaving:     1,
+			PoolIdEntering:    2,
+			ShareInAmount:     sdk.NewInt(100),
+			ShareOutMinAmount: sdk.NewInt(100),
+			LockId:            1,
+		}
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "migrate_shares")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        gammtypes.MsgMigrateShares
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "unlocked shares",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.LockId = 0
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "same pool",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.PoolIdEntering = msg.PoolIdLeaving
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.ShareInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative amount",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.ShareInAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero criteria",
+			msg: createMsg(func(msg gammtypes.MsgMigrateShares) gammtypes.MsgMigrateShares {
+				msg.ShareOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSetPoolFrozen(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	msg := gammtypes.MsgSetPoolFrozen{
+		Sender: addr1,
+		PoolId: 1,
+		Frozen: true,
+	}
+
+	require.Equal(t, msg.Route(), gammtypes.RouterKey)
+	require.Equal(t, msg.Type(), "set_pool_frozen")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	require.NoError(t, msg.ValidateBasic())
+	msg.Frozen = false
+	require.NoError(t, msg.ValidateBasic())
+	msg.Sender = invalidAddr.String()
+	require.Error(t, msg.ValidateBasic())
+}
+
 // Test authz serialize and de-serializes for gamm msg.
 func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
@@ -900,6 +1026,25 @@ func TestAuthzMsg(t *testing.T) {
 				ShareInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgMigrateShares",
+			gammMsg: &gammtypes.MsgMigrateShares{
+				Sender:            addr1,
+				PoolIdLeaving:     1,
+				PoolIdEntering:    2,
+				ShareInAmount:     sdk.NewInt(100),
+				ShareOutMinAmount: sdk.NewInt(1),
+				LockId:            1,
+			},
+		},
+		{
+			name: "MsgSetPoolFrozen",
+			gammMsg: &gammtypes.MsgSetPoolFrozen{
+				Sender: addr1,
+				PoolId: 1,
+				Frozen: true,
+			},
+		},
 		{
 			name: "MsgExitPool",
 			gammMsg: &gammtypes.MsgExitPool{
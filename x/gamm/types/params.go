@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
@@ -12,6 +13,10 @@ import (
 // Parameter store keys.
 var (
 	KeyPoolCreationFee = []byte("PoolCreationFee")
+	KeyTakeRate        = []byte("TakeRate")
+	KeyPoolFreezeAdmin = []byte("PoolFreezeAdmin")
+
+	KeyProtocolRevenueEpochIdentifier = []byte("ProtocolRevenueEpochIdentifier")
 )
 
 // ParamTable for gamm module.
@@ -19,9 +24,10 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(poolCreationFee sdk.Coins) Params {
+func NewParams(poolCreationFee sdk.Coins, takeRate sdk.Dec) Params {
 	return Params{
 		PoolCreationFee: poolCreationFee,
+		TakeRate:        takeRate,
 	}
 }
 
@@ -29,6 +35,9 @@ func NewParams(poolCreationFee sdk.Coins) Params {
 func DefaultParams() Params {
 	return Params{
 		PoolCreationFee: sdk.Coins{sdk.NewInt64Coin(appparams.BaseCoinUnit, 1000_000_000)}, // 1000 OSMO
+		TakeRate:        sdk.ZeroDec(),
+
+		ProtocolRevenueEpochIdentifier: "day",
 	}
 }
 
@@ -37,6 +46,15 @@ func (p Params) Validate() error {
 	if err := validatePoolCreationFee(p.PoolCreationFee); err != nil {
 		return err
 	}
+	if err := validateTakeRate(p.TakeRate); err != nil {
+		return err
+	}
+	if err := validatePoolFreezeAdmin(p.PoolFreezeAdmin); err != nil {
+		return err
+	}
+	if err := epochtypes.ValidateEpochIdentifierInterface(p.ProtocolRevenueEpochIdentifier); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -45,6 +63,9 @@ func (p Params) Validate() error {
 func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 	return paramtypes.ParamSetPairs{
 		paramtypes.NewParamSetPair(KeyPoolCreationFee, &p.PoolCreationFee, validatePoolCreationFee),
+		paramtypes.NewParamSetPair(KeyTakeRate, &p.TakeRate, validateTakeRate),
+		paramtypes.NewParamSetPair(KeyPoolFreezeAdmin, &p.PoolFreezeAdmin, validatePoolFreezeAdmin),
+		paramtypes.NewParamSetPair(KeyProtocolRevenueEpochIdentifier, &p.ProtocolRevenueEpochIdentifier, epochtypes.ValidateEpochIdentifierInterface),
 	}
 }
 
@@ -60,3 +81,34 @@ func validatePoolCreationFee(i interface{}) error {
 
 	return nil
 }
+
+func validateTakeRate(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() || v.GT(sdk.OneDec()) {
+		return fmt.Errorf("take rate must be between 0 and 1, was: %s", v)
+	}
+
+	return nil
+}
+
+func validatePoolFreezeAdmin(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// an empty admin disables freezing pools by hand.
+	if v == "" {
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid pool freeze admin address (%s): %w", v, err)
+	}
+
+	return nil
+}
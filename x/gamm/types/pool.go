@@ -95,6 +95,27 @@ type PoolAmountOutExtension interface {
 	IncreaseLiquidity(sharesOut sdk.Int, coinsIn sdk.Coins)
 }
 
+// PokablePoolExtension is an extension of the PoolI interface
+// for pools whose parameters change over time, and so must be
+// brought up to date with the block time before being used.
+type PokablePoolExtension interface {
+	CFMMPoolI
+
+	// PokePool updates the pool's time dependent parameters to their
+	// values at blockTime.
+	PokePool(blockTime time.Time)
+}
+
+// FreezablePoolExtension is an extension of the PoolI interface
+// for pools that can be frozen in an emergency. A frozen pool is
+// inactive, rejecting swaps and joins, but can still be exited.
+type FreezablePoolExtension interface {
+	CFMMPoolI
+
+	IsFrozen() bool
+	SetFrozen(frozen bool)
+}
+
 // WeightedPoolExtension is an extension of the PoolI interface
 // That defines an additional API for handling the pool's weights.
 type WeightedPoolExtension interface {
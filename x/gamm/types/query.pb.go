@@ -851,6 +851,267 @@ func (m *QueryTotalSharesResponse) GetTotalShares() types1.Coin {
 	return types1.Coin{}
 }
 
+// =============================== ProtocolRevenue
+type QueryProtocolRevenueRequest struct {
+}
+
+func (m *QueryProtocolRevenueRequest) Reset()         { *m = QueryProtocolRevenueRequest{} }
+func (m *QueryProtocolRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolRevenueRequest) ProtoMessage()    {}
+func (*QueryProtocolRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{18}
+}
+func (m *QueryProtocolRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolRevenueRequest.Merge(m, src)
+}
+func (m *QueryProtocolRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolRevenueRequest proto.InternalMessageInfo
+
+type QueryProtocolRevenueResponse struct {
+	Revenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=revenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenue" yaml:"revenue"`
+}
+
+func (m *QueryProtocolRevenueResponse) Reset()         { *m = QueryProtocolRevenueResponse{} }
+func (m *QueryProtocolRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProtocolRevenueResponse) ProtoMessage()    {}
+func (*QueryProtocolRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{19}
+}
+func (m *QueryProtocolRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProtocolRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProtocolRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProtocolRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProtocolRevenueResponse.Merge(m, src)
+}
+func (m *QueryProtocolRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProtocolRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProtocolRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProtocolRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryProtocolRevenueResponse) GetRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+// =============================== PoolProtocolRevenue
+type QueryPoolProtocolRevenueRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryPoolProtocolRevenueRequest) Reset()         { *m = QueryPoolProtocolRevenueRequest{} }
+func (m *QueryPoolProtocolRevenueRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolProtocolRevenueRequest) ProtoMessage()    {}
+func (*QueryPoolProtocolRevenueRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{20}
+}
+func (m *QueryPoolProtocolRevenueRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolProtocolRevenueRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolProtocolRevenueRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolProtocolRevenueRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolProtocolRevenueRequest.Merge(m, src)
+}
+func (m *QueryPoolProtocolRevenueRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolProtocolRevenueRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolProtocolRevenueRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolProtocolRevenueRequest proto.InternalMessageInfo
+
+func (m *QueryPoolProtocolRevenueRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryPoolProtocolRevenueResponse struct {
+	Revenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=revenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"revenue" yaml:"revenue"`
+}
+
+func (m *QueryPoolProtocolRevenueResponse) Reset()         { *m = QueryPoolProtocolRevenueResponse{} }
+func (m *QueryPoolProtocolRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPoolProtocolRevenueResponse) ProtoMessage()    {}
+func (*QueryPoolProtocolRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{21}
+}
+func (m *QueryPoolProtocolRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPoolProtocolRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPoolProtocolRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPoolProtocolRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPoolProtocolRevenueResponse.Merge(m, src)
+}
+func (m *QueryPoolProtocolRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPoolProtocolRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPoolProtocolRevenueResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPoolProtocolRevenueResponse proto.InternalMessageInfo
+
+func (m *QueryPoolProtocolRevenueResponse) GetRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+// =============================== EffectiveSwapFee
+type QueryEffectiveSwapFeeRequest struct {
+	PoolId uint64 `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+}
+
+func (m *QueryEffectiveSwapFeeRequest) Reset()         { *m = QueryEffectiveSwapFeeRequest{} }
+func (m *QueryEffectiveSwapFeeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveSwapFeeRequest) ProtoMessage()    {}
+func (*QueryEffectiveSwapFeeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{22}
+}
+func (m *QueryEffectiveSwapFeeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveSwapFeeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveSwapFeeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveSwapFeeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveSwapFeeRequest.Merge(m, src)
+}
+func (m *QueryEffectiveSwapFeeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveSwapFeeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveSwapFeeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveSwapFeeRequest proto.InternalMessageInfo
+
+func (m *QueryEffectiveSwapFeeRequest) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+type QueryEffectiveSwapFeeResponse struct {
+	SwapFee github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=swap_fee,json=swapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"swap_fee" yaml:"swap_fee"`
+	// dynamic_swap_fee_params are unset if the pool has no dynamic swap fee.
+	DynamicSwapFeeParams *DynamicSwapFeeParams `protobuf:"bytes,2,opt,name=dynamic_swap_fee_params,json=dynamicSwapFeeParams,proto3" json:"dynamic_swap_fee_params,omitempty" yaml:"dynamic_swap_fee_params"`
+}
+
+func (m *QueryEffectiveSwapFeeResponse) Reset()         { *m = QueryEffectiveSwapFeeResponse{} }
+func (m *QueryEffectiveSwapFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryEffectiveSwapFeeResponse) ProtoMessage()    {}
+func (*QueryEffectiveSwapFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d9a717df9ca609ef, []int{23}
+}
+func (m *QueryEffectiveSwapFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryEffectiveSwapFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryEffectiveSwapFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryEffectiveSwapFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryEffectiveSwapFeeResponse.Merge(m, src)
+}
+func (m *QueryEffectiveSwapFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryEffectiveSwapFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryEffectiveSwapFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryEffectiveSwapFeeResponse proto.InternalMessageInfo
+
+func (m *QueryEffectiveSwapFeeResponse) GetDynamicSwapFeeParams() *DynamicSwapFeeParams {
+	if m != nil {
+		return m.DynamicSwapFeeParams
+	}
+	return nil
+}
+
 // =============================== CalcJoinPoolNoSwapShares
 type QueryCalcJoinPoolNoSwapSharesRequest struct {
 	PoolId   uint64                                   `protobuf:"varint,1,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
@@ -861,7 +1122,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Reset()         { *m = QueryCalcJ
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesRequest) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{18}
+	return fileDescriptor_d9a717df9ca609ef, []int{24}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -913,7 +1174,7 @@ func (m *QueryCalcJoinPoolNoSwapSharesResponse) Reset()         { *m = QueryCalc
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryCalcJoinPoolNoSwapSharesResponse) ProtoMessage()    {}
 func (*QueryCalcJoinPoolNoSwapSharesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{19}
+	return fileDescriptor_d9a717df9ca609ef, []int{25}
 }
 func (m *QueryCalcJoinPoolNoSwapSharesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -963,7 +1224,7 @@ func (m *QuerySpotPriceRequest) Reset()         { *m = QuerySpotPriceRequest{} }
 func (m *QuerySpotPriceRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceRequest) ProtoMessage()    {}
 func (*QuerySpotPriceRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{20}
+	return fileDescriptor_d9a717df9ca609ef, []int{26}
 }
 func (m *QuerySpotPriceRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1023,7 +1284,7 @@ func (m *QueryPoolsWithFilterRequest) Reset()         { *m = QueryPoolsWithFilte
 func (m *QueryPoolsWithFilterRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterRequest) ProtoMessage()    {}
 func (*QueryPoolsWithFilterRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{21}
+	return fileDescriptor_d9a717df9ca609ef, []int{27}
 }
 func (m *QueryPoolsWithFilterRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1083,7 +1344,7 @@ func (m *QueryPoolsWithFilterResponse) Reset()         { *m = QueryPoolsWithFilt
 func (m *QueryPoolsWithFilterResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPoolsWithFilterResponse) ProtoMessage()    {}
 func (*QueryPoolsWithFilterResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{22}
+	return fileDescriptor_d9a717df9ca609ef, []int{28}
 }
 func (m *QueryPoolsWithFilterResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1139,7 +1400,7 @@ func (m *QuerySpotPriceResponse) Reset()         { *m = QuerySpotPriceResponse{}
 func (m *QuerySpotPriceResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySpotPriceResponse) ProtoMessage()    {}
 func (*QuerySpotPriceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{23}
+	return fileDescriptor_d9a717df9ca609ef, []int{29}
 }
 func (m *QuerySpotPriceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1188,7 +1449,7 @@ func (m *QuerySwapExactAmountInRequest) Reset()         { *m = QuerySwapExactAmo
 func (m *QuerySwapExactAmountInRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountInRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{24}
+	return fileDescriptor_d9a717df9ca609ef, []int{30}
 }
 func (m *QuerySwapExactAmountInRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1253,7 +1514,7 @@ func (m *QuerySwapExactAmountInResponse) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountInResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountInResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountInResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{25}
+	return fileDescriptor_d9a717df9ca609ef, []int{31}
 }
 func (m *QuerySwapExactAmountInResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1295,7 +1556,7 @@ func (m *QuerySwapExactAmountOutRequest) Reset()         { *m = QuerySwapExactAm
 func (m *QuerySwapExactAmountOutRequest) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutRequest) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{26}
+	return fileDescriptor_d9a717df9ca609ef, []int{32}
 }
 func (m *QuerySwapExactAmountOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1360,7 +1621,7 @@ func (m *QuerySwapExactAmountOutResponse) Reset()         { *m = QuerySwapExactA
 func (m *QuerySwapExactAmountOutResponse) String() string { return proto.CompactTextString(m) }
 func (*QuerySwapExactAmountOutResponse) ProtoMessage()    {}
 func (*QuerySwapExactAmountOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{27}
+	return fileDescriptor_d9a717df9ca609ef, []int{33}
 }
 func (m *QuerySwapExactAmountOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1396,7 +1657,7 @@ func (m *QueryTotalLiquidityRequest) Reset()         { *m = QueryTotalLiquidityR
 func (m *QueryTotalLiquidityRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityRequest) ProtoMessage()    {}
 func (*QueryTotalLiquidityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{28}
+	return fileDescriptor_d9a717df9ca609ef, []int{34}
 }
 func (m *QueryTotalLiquidityRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1694,7 @@ func (m *QueryTotalLiquidityResponse) Reset()         { *m = QueryTotalLiquidity
 func (m *QueryTotalLiquidityResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTotalLiquidityResponse) ProtoMessage()    {}
 func (*QueryTotalLiquidityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d9a717df9ca609ef, []int{29}
+	return fileDescriptor_d9a717df9ca609ef, []int{35}
 }
 func (m *QueryTotalLiquidityResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1488,6 +1749,12 @@ func init() {
 	proto.RegisterType((*QueryTotalPoolLiquidityResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalPoolLiquidityResponse")
 	proto.RegisterType((*QueryTotalSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesRequest")
 	proto.RegisterType((*QueryTotalSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryTotalSharesResponse")
+	proto.RegisterType((*QueryProtocolRevenueRequest)(nil), "osmosis.gamm.v1beta1.QueryProtocolRevenueRequest")
+	proto.RegisterType((*QueryProtocolRevenueResponse)(nil), "osmosis.gamm.v1beta1.QueryProtocolRevenueResponse")
+	proto.RegisterType((*QueryPoolProtocolRevenueRequest)(nil), "osmosis.gamm.v1beta1.QueryPoolProtocolRevenueRequest")
+	proto.RegisterType((*QueryPoolProtocolRevenueResponse)(nil), "osmosis.gamm.v1beta1.QueryPoolProtocolRevenueResponse")
+	proto.RegisterType((*QueryEffectiveSwapFeeRequest)(nil), "osmosis.gamm.v1beta1.QueryEffectiveSwapFeeRequest")
+	proto.RegisterType((*QueryEffectiveSwapFeeResponse)(nil), "osmosis.gamm.v1beta1.QueryEffectiveSwapFeeResponse")
 	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesRequest)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesRequest")
 	proto.RegisterType((*QueryCalcJoinPoolNoSwapSharesResponse)(nil), "osmosis.gamm.v1beta1.QueryCalcJoinPoolNoSwapSharesResponse")
 	proto.RegisterType((*QuerySpotPriceRequest)(nil), "osmosis.gamm.v1beta1.QuerySpotPriceRequest")
@@ -1505,120 +1772,134 @@ func init() {
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/query.proto", fileDescriptor_d9a717df9ca609ef) }
 
 var fileDescriptor_d9a717df9ca609ef = []byte{
-	// 1795 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x4b, 0x6c, 0x1c, 0x49,
-	0x19, 0x76, 0x8d, 0x1f, 0xeb, 0x29, 0xaf, 0x5f, 0xb5, 0x4e, 0x32, 0x19, 0x3b, 0x33, 0xa1, 0xd8,
-	0xb5, 0xbd, 0x1b, 0xbb, 0x27, 0x7e, 0xac, 0x40, 0x86, 0xdd, 0xac, 0x9d, 0xb5, 0xe3, 0xb1, 0xd8,
-	0xb5, 0xe9, 0x44, 0x89, 0x80, 0xc3, 0xa8, 0x6d, 0x77, 0xc6, 0x9d, 0xcc, 0x74, 0xb5, 0xa7, 0xab,
-	0x63, 0x5b, 0x28, 0x8a, 0x14, 0x21, 0x94, 0x03, 0x48, 0x48, 0x21, 0x39, 0x20, 0x04, 0x1c, 0x10,
-	0x42, 0x39, 0x23, 0x71, 0xe2, 0x84, 0x90, 0x22, 0x24, 0xa4, 0x20, 0x2e, 0x88, 0xc3, 0x80, 0x12,
-	0xb8, 0x71, 0xf2, 0x85, 0x23, 0xa8, 0xaa, 0xfe, 0x7e, 0xcc, 0xfb, 0x01, 0x91, 0xc2, 0xc9, 0x9e,
-	0xfa, 0x1f, 0xf5, 0xfd, 0xdf, 0xff, 0xd7, 0xdf, 0x7f, 0x15, 0xbe, 0xc8, 0xdc, 0x22, 0x73, 0x2d,
-	0x37, 0x93, 0x37, 0x8a, 0xc5, 0xcc, 0xbd, 0x85, 0x5d, 0x93, 0x1b, 0x0b, 0x99, 0x43, 0xcf, 0x2c,
-	0x9d, 0x68, 0x4e, 0x89, 0x71, 0x46, 0x26, 0x40, 0x43, 0x13, 0x1a, 0x1a, 0x68, 0x24, 0x27, 0xf2,
-	0x2c, 0xcf, 0xa4, 0x42, 0x46, 0xfc, 0xa7, 0x74, 0x93, 0x17, 0xea, 0x7a, 0xe3, 0xc7, 0x20, 0x4e,
-	0xed, 0x49, 0x79, 0x66, 0xd7, 0x70, 0xcd, 0x40, 0xba, 0xc7, 0x2c, 0x1b, 0xe4, 0x1f, 0x44, 0xe5,
-	0x12, 0x43, 0xa0, 0xe5, 0x18, 0x79, 0xcb, 0x36, 0xb8, 0xc5, 0x7c, 0xdd, 0xa9, 0x3c, 0x63, 0xf9,
-	0x82, 0x99, 0x31, 0x1c, 0x2b, 0x63, 0xd8, 0x36, 0xe3, 0x52, 0xe8, 0x82, 0xf4, 0x3c, 0x48, 0xe5,
-	0xaf, 0x5d, 0xef, 0x76, 0xc6, 0xb0, 0x4f, 0x7c, 0x91, 0xda, 0x24, 0xa7, 0xc0, 0xab, 0x1f, 0x4a,
-	0x44, 0xaf, 0xe0, 0xb1, 0xaf, 0x8b, 0x5d, 0x77, 0x18, 0x2b, 0xe8, 0xe6, 0xa1, 0x67, 0xba, 0x9c,
-	0x5c, 0xc2, 0x6f, 0x39, 0x8c, 0x15, 0x72, 0xd6, 0x7e, 0x02, 0x5d, 0x44, 0xb3, 0x7d, 0x6b, 0xe4,
-	0xb4, 0x9c, 0x1e, 0x39, 0x31, 0x8a, 0x85, 0x15, 0x0a, 0x02, 0xaa, 0x0f, 0x88, 0xff, 0xb2, 0xfb,
-	0x74, 0x13, 0x8f, 0x47, 0x1c, 0xb8, 0x0e, 0xb3, 0x5d, 0x93, 0x2c, 0xe1, 0x3e, 0x21, 0x96, 0xe6,
-	0x43, 0x8b, 0x13, 0x9a, 0x82, 0xa6, 0xf9, 0xd0, 0xb4, 0x55, 0xfb, 0x64, 0x2d, 0xfe, 0xfb, 0x5f,
-	0xcd, 0xf7, 0x0b, 0xab, 0xac, 0x2e, 0x95, 0xe9, 0xb7, 0x22, 0x9e, 0x5c, 0x1f, 0xcb, 0x06, 0xc6,
-	0x21, 0x0f, 0x89, 0x98, 0xf4, 0x37, 0xad, 0x41, 0x08, 0x82, 0x34, 0x4d, 0x25, 0x0e, 0x48, 0xd3,
-	0x76, 0x8c, 0xbc, 0x09, 0xb6, 0x7a, 0xc4, 0x92, 0xfe, 0x10, 0x61, 0x12, 0xf5, 0x0e, 0x40, 0x3f,
-	0xc4, 0xfd, 0x62, 0x6f, 0x37, 0x81, 0x2e, 0xf6, 0xb6, 0x83, 0x54, 0x69, 0x93, 0x6b, 0x75, 0x50,
-	0xcd, 0xb4, 0x44, 0xa5, 0xf6, 0xac, 0x80, 0x75, 0x16, 0x4f, 0x48, 0x54, 0x9f, 0x7b, 0xc5, 0x68,
-	0xd8, 0x74, 0x0b, 0x9f, 0xa9, 0x5a, 0x07, 0xc0, 0x0b, 0x38, 0x6e, 0x7b, 0xc5, 0x9c, 0x0f, 0x5a,
-	0x64, 0x67, 0xe2, 0xb4, 0x9c, 0x1e, 0x53, 0xd9, 0x09, 0x44, 0x54, 0x1f, 0xb4, 0xc1, 0x94, 0x5e,
-	0x85, 0x3d, 0xc4, 0xaf, 0x1b, 0x27, 0x8e, 0xd9, 0x55, 0x9a, 0x7d, 0x40, 0xa1, 0x93, 0x10, 0x90,
-	0x54, 0xe6, 0x27, 0x8e, 0x29, 0xfd, 0xc4, 0xa3, 0x80, 0x02, 0x11, 0xd5, 0x07, 0x1d, 0x30, 0xa5,
-	0xbf, 0x46, 0x38, 0x25, 0x9d, 0x5d, 0x35, 0x0a, 0x7b, 0x5b, 0xcc, 0xb2, 0x85, 0xd3, 0xeb, 0x07,
-	0x46, 0xc9, 0x74, 0xbb, 0xc1, 0x46, 0x0e, 0x70, 0x9c, 0xb3, 0xbb, 0xa6, 0xed, 0xe6, 0x2c, 0x91,
-	0x0c, 0x91, 0xc8, 0xf3, 0x15, 0xc9, 0xf0, 0xd3, 0x70, 0x95, 0x59, 0xf6, 0xda, 0xe5, 0xe7, 0xe5,
-	0x74, 0xcf, 0xb3, 0xbf, 0xa6, 0x67, 0xf3, 0x16, 0x3f, 0xf0, 0x76, 0xb5, 0x3d, 0x56, 0x84, 0x23,
-	0x01, 0x7f, 0xe6, 0xdd, 0xfd, 0xbb, 0x19, 0x81, 0xd9, 0x95, 0x06, 0xae, 0x3e, 0xa8, 0xbc, 0x67,
-	0x6d, 0xfa, 0x30, 0x86, 0xd3, 0x0d, 0x91, 0x03, 0x21, 0x2e, 0x1e, 0x73, 0xc5, 0x4a, 0x8e, 0x79,
-	0x3c, 0x67, 0x14, 0x99, 0x67, 0x73, 0xe0, 0x25, 0x2b, 0x76, 0xfe, 0x4b, 0x39, 0x3d, 0xdd, 0xc6,
-	0xce, 0x59, 0x9b, 0x9f, 0x96, 0xd3, 0xe7, 0x54, 0xc4, 0xd5, 0xfe, 0xa8, 0x3e, 0x22, 0x97, 0xb6,
-	0x3d, 0xbe, 0x2a, 0x17, 0xc8, 0x1d, 0x8c, 0x81, 0x02, 0xe6, 0xf1, 0xd7, 0xc1, 0x01, 0x30, 0xbc,
-	0xed, 0x71, 0xfa, 0x23, 0x84, 0x67, 0x02, 0x12, 0xd6, 0x8f, 0x2d, 0x2e, 0x48, 0x90, 0x5a, 0x1b,
-	0x25, 0x56, 0xac, 0xcc, 0xe3, 0xb9, 0xaa, 0x3c, 0x06, 0x39, 0xbb, 0x89, 0x47, 0x55, 0x54, 0x96,
-	0xed, 0x93, 0x14, 0x93, 0x24, 0x69, 0x9d, 0x91, 0xa4, 0x0f, 0x4b, 0x37, 0x59, 0x5b, 0x11, 0x41,
-	0x9f, 0x22, 0x3c, 0xdb, 0x1a, 0x1c, 0xa4, 0xaa, 0x92, 0x35, 0xf4, 0x5a, 0x59, 0x5b, 0xc7, 0x67,
-	0x83, 0x03, 0xb4, 0x63, 0x94, 0x8c, 0x62, 0x57, 0xb5, 0x4e, 0xaf, 0xe1, 0x73, 0x35, 0x6e, 0x20,
-	0x9a, 0x39, 0x3c, 0xe0, 0xc8, 0x95, 0x66, 0x6d, 0x57, 0x07, 0x1d, 0xfa, 0x19, 0x9c, 0xc1, 0x1b,
-	0x8c, 0x1b, 0x05, 0xe1, 0xed, 0x6b, 0xd6, 0xa1, 0x67, 0xed, 0x5b, 0xfc, 0xa4, 0x2b, 0x5c, 0x3f,
-	0x43, 0x70, 0x32, 0xea, 0xf9, 0x03, 0x80, 0xf7, 0x71, 0xbc, 0xe0, 0x2f, 0xb6, 0x66, 0xfb, 0x53,
-	0xc1, 0x76, 0xd8, 0x49, 0x02, 0x4b, 0xda, 0x59, 0x06, 0x42, 0xbb, 0x0d, 0xa0, 0x4e, 0x22, 0xec,
-	0xbe, 0xdd, 0x50, 0x0f, 0x27, 0x6a, 0xfd, 0x40, 0x88, 0xdf, 0xc0, 0x6f, 0x73, 0xb1, 0x9c, 0x93,
-	0x55, 0xe9, 0x67, 0xa2, 0x49, 0x94, 0x93, 0x10, 0xe5, 0x3b, 0x6a, 0xb3, 0xa8, 0x31, 0xd5, 0x87,
-	0x78, 0xb8, 0x05, 0xfd, 0x0d, 0xc2, 0xef, 0xd6, 0xf4, 0x9e, 0xcf, 0xd9, 0xf5, 0x23, 0xc3, 0xf9,
-	0xbf, 0xe8, 0x9d, 0xff, 0x42, 0xf8, 0xbd, 0x16, 0xf8, 0x81, 0xc4, 0x07, 0x9d, 0x1d, 0xcb, 0x75,
-	0xa0, 0x70, 0xdc, 0xa7, 0xd0, 0x37, 0xa5, 0x5d, 0x9e, 0x55, 0xf2, 0x19, 0xc6, 0x2a, 0x05, 0xd0,
-	0x4d, 0xbb, 0xe9, 0x4b, 0x71, 0xe5, 0x41, 0x1c, 0xfd, 0x7f, 0x22, 0xf8, 0x78, 0x5e, 0x77, 0x18,
-	0xdf, 0x29, 0x59, 0x7b, 0x5d, 0x7d, 0x82, 0xc9, 0x3a, 0x1e, 0x13, 0xc1, 0xe7, 0x0c, 0xd7, 0x35,
-	0x79, 0x6e, 0xdf, 0xb4, 0x59, 0x11, 0xb0, 0x4d, 0x86, 0x9f, 0x8a, 0x6a, 0x0d, 0xaa, 0x8f, 0x88,
-	0xa5, 0x55, 0xb1, 0xf2, 0xa9, 0x58, 0x20, 0x9b, 0x78, 0xfc, 0xd0, 0x63, 0xbc, 0xd2, 0x4f, 0xaf,
-	0xf4, 0x33, 0x75, 0x5a, 0x4e, 0x27, 0x94, 0x9f, 0x1a, 0x15, 0xaa, 0x8f, 0xca, 0xb5, 0xd0, 0xd3,
-	0x4a, 0x2c, 0x81, 0xb6, 0xfa, 0x06, 0xfb, 0xc6, 0xfa, 0xf5, 0xa1, 0x23, 0x8b, 0x1f, 0x88, 0x4c,
-	0x6e, 0x98, 0x26, 0xfd, 0x7e, 0x0c, 0x4f, 0x86, 0xa3, 0xd6, 0x2d, 0x8b, 0x1f, 0x6c, 0x58, 0x05,
-	0x6e, 0x96, 0xfc, 0xa0, 0x1f, 0x21, 0x3c, 0x5c, 0xb4, 0xec, 0x5c, 0x07, 0xbd, 0x60, 0x13, 0x52,
-	0x3c, 0xa1, 0xc0, 0x55, 0x58, 0x77, 0x96, 0xe5, 0xb7, 0x8b, 0x96, 0x1d, 0x74, 0x26, 0x32, 0x19,
-	0x1d, 0x5e, 0x24, 0x97, 0xe1, 0x98, 0x52, 0x35, 0x7a, 0xf6, 0x76, 0x3d, 0x7a, 0xfe, 0x04, 0xe1,
-	0xa9, 0xfa, 0x7c, 0xbc, 0x21, 0x43, 0xa8, 0x0e, 0x9f, 0xa6, 0x48, 0x79, 0x02, 0xb2, 0x65, 0x8c,
-	0x5d, 0x87, 0xf1, 0x9c, 0x23, 0x56, 0x61, 0x8a, 0x39, 0x13, 0x1e, 0xb5, 0x50, 0x46, 0xf5, 0xb8,
-	0xeb, 0x5b, 0x8b, 0xba, 0xa0, 0xff, 0x46, 0xf8, 0x82, 0x72, 0x7a, 0x64, 0x38, 0xeb, 0xc7, 0xc6,
-	0x1e, 0x4c, 0x2a, 0x59, 0xdb, 0x2f, 0x83, 0xf7, 0xf1, 0x80, 0x6b, 0xda, 0xfb, 0x66, 0x09, 0xfc,
-	0x8e, 0x9f, 0x96, 0xd3, 0xc3, 0xe0, 0x57, 0xae, 0x53, 0x1d, 0x14, 0xa2, 0xc7, 0x24, 0xd6, 0xf2,
-	0x98, 0x68, 0x58, 0xf5, 0x1c, 0xd1, 0xd0, 0x54, 0x59, 0xbf, 0x73, 0x5a, 0x4e, 0x8f, 0x46, 0x9a,
-	0x43, 0xce, 0xb2, 0xa9, 0xfe, 0x96, 0xfc, 0x37, 0x6b, 0x93, 0x9b, 0x78, 0xa0, 0xc4, 0x3c, 0x6e,
-	0xba, 0x89, 0x3e, 0x49, 0xff, 0x8c, 0x56, 0xef, 0xf6, 0xa7, 0x89, 0x38, 0x82, 0x10, 0x84, 0xfe,
-	0xda, 0x19, 0x28, 0x4a, 0x00, 0xad, 0x9c, 0x50, 0x1d, 0xbc, 0xd1, 0x27, 0xfe, 0x94, 0x5b, 0x87,
-	0x81, 0x70, 0x54, 0x54, 0x80, 0xfe, 0x77, 0xa3, 0x62, 0xb5, 0x3f, 0xaa, 0x8f, 0xc8, 0xa5, 0x60,
-	0x54, 0xa4, 0xdf, 0x89, 0xd5, 0xc7, 0xb5, 0xed, 0xf1, 0xd7, 0x9d, 0x9a, 0x5b, 0x01, 0xd5, 0xbd,
-	0x92, 0xea, 0xd9, 0x56, 0x54, 0x0b, 0x4c, 0x6d, 0x70, 0x2d, 0x2e, 0x21, 0x41, 0xe0, 0x89, 0xbe,
-	0xea, 0x4b, 0x48, 0x20, 0xa2, 0xf0, 0x39, 0x12, 0x4d, 0xf9, 0xb1, 0x3f, 0xb0, 0xd4, 0xa3, 0x01,
-	0xf2, 0xe3, 0xe0, 0x51, 0xbf, 0x60, 0x2a, 0xd3, 0xb3, 0xd9, 0x71, 0x7a, 0xce, 0x56, 0xd6, 0x5f,
-	0x90, 0x9d, 0x61, 0x28, 0x43, 0x48, 0xce, 0x14, 0x4e, 0x86, 0xb3, 0x45, 0xf5, 0x44, 0x46, 0x7f,
-	0x8c, 0xa0, 0xb3, 0x56, 0x8b, 0xdf, 0x88, 0x01, 0x6b, 0xf1, 0xd9, 0x04, 0xee, 0x97, 0xf0, 0xc8,
-	0x03, 0x2c, 0x5b, 0x95, 0x4b, 0x1a, 0x1c, 0xa6, 0x9a, 0x7b, 0x7e, 0x72, 0xb6, 0xb5, 0xa2, 0x0a,
-	0x92, 0x7e, 0xf1, 0xe1, 0x9f, 0xfe, 0xfe, 0x38, 0x76, 0x81, 0x4c, 0x66, 0xea, 0xbe, 0xbc, 0xa8,
-	0xde, 0xf8, 0x3d, 0x84, 0x07, 0xfd, 0xbb, 0x33, 0xf9, 0xa0, 0x89, 0xef, 0xaa, 0x8b, 0x77, 0xf2,
-	0x52, 0x5b, 0xba, 0x00, 0x65, 0x46, 0x42, 0xf9, 0x02, 0x49, 0xd7, 0x87, 0x12, 0xdc, 0xc6, 0xc9,
-	0xcf, 0x11, 0x1e, 0xa9, 0xcc, 0x19, 0xb9, 0xdc, 0x64, 0xa3, 0xba, 0xd9, 0x4f, 0x2e, 0x74, 0x60,
-	0x01, 0x00, 0xe7, 0x25, 0xc0, 0x19, 0xf2, 0x5e, 0x7d, 0x80, 0x6a, 0xda, 0x0c, 0x12, 0x48, 0x7e,
-	0x81, 0xf0, 0x68, 0xd5, 0x47, 0x8a, 0x2c, 0xb4, 0x4a, 0x4c, 0xcd, 0x07, 0x3e, 0xb9, 0xd8, 0x89,
-	0x09, 0x20, 0x9d, 0x93, 0x48, 0xa7, 0xc9, 0xbb, 0xf5, 0x91, 0xde, 0x96, 0xda, 0xe6, 0x3e, 0xf0,
-	0xf9, 0x5d, 0x84, 0xfb, 0x84, 0x27, 0x32, 0xdd, 0x62, 0x2b, 0x1f, 0xd2, 0x4c, 0x4b, 0xbd, 0xf6,
-	0x18, 0x93, 0xdb, 0x67, 0xbe, 0x0d, 0x9d, 0xed, 0x3e, 0x79, 0x8a, 0xf0, 0xa0, 0xff, 0x24, 0xd2,
-	0xb4, 0xce, 0xaa, 0x1e, 0x5f, 0x9a, 0xd6, 0x59, 0xf5, 0x1b, 0x0b, 0x5d, 0x90, 0xa0, 0x2e, 0x91,
-	0xf7, 0x1b, 0x83, 0x92, 0x23, 0x4c, 0x04, 0xd8, 0x13, 0x84, 0x13, 0x8d, 0x06, 0x6d, 0xb2, 0xd2,
-	0x64, 0xf3, 0x16, 0xb7, 0x8b, 0xe4, 0x57, 0xba, 0xb2, 0x85, 0x40, 0x7a, 0xc8, 0x6f, 0x11, 0x26,
-	0xb5, 0x8f, 0x27, 0x64, 0xb9, 0x4d, 0xaf, 0x95, 0x58, 0x3e, 0xec, 0xd0, 0x0a, 0x50, 0x7c, 0x22,
-	0xe9, 0x5c, 0x21, 0x5f, 0x6e, 0x2b, 0xc7, 0x99, 0x3b, 0xcc, 0xb2, 0x73, 0xee, 0x91, 0xe1, 0xe4,
-	0x4c, 0xf1, 0x99, 0xc8, 0x59, 0x36, 0xf9, 0x07, 0xc2, 0x93, 0x4d, 0x1e, 0x18, 0xc8, 0x47, 0x2d,
-	0x80, 0x35, 0x7f, 0x35, 0x49, 0x7e, 0xdc, 0xad, 0x39, 0x04, 0x78, 0x4d, 0x06, 0xb8, 0x4a, 0xae,
-	0xb4, 0x17, 0xa0, 0x79, 0x6c, 0x71, 0x15, 0xa0, 0x7a, 0x92, 0x51, 0xdf, 0x26, 0x11, 0xe7, 0x4f,
-	0x11, 0xc6, 0xe1, 0x4b, 0x03, 0x99, 0x6b, 0x51, 0xb4, 0x15, 0xef, 0x1a, 0xc9, 0xf9, 0x36, 0xb5,
-	0x01, 0xf4, 0xb2, 0x04, 0xad, 0x91, 0xb9, 0xf6, 0x40, 0xab, 0x67, 0x0c, 0xf2, 0x3b, 0x84, 0x49,
-	0xed, 0x93, 0x43, 0xd3, 0x7a, 0x6a, 0xf8, 0xe2, 0xd1, 0xb4, 0x9e, 0x1a, 0xbf, 0x6b, 0xd0, 0x35,
-	0x89, 0xfc, 0xab, 0x64, 0xa5, 0x3d, 0xe4, 0xaa, 0xeb, 0xca, 0x9f, 0x61, 0xeb, 0xfd, 0x25, 0xc2,
-	0x43, 0x91, 0x07, 0x05, 0x32, 0xdf, 0x0a, 0x4a, 0x65, 0xc5, 0x68, 0xed, 0xaa, 0x03, 0xe4, 0x15,
-	0x09, 0x79, 0x99, 0x2c, 0x76, 0x02, 0x59, 0xdd, 0x68, 0x45, 0x51, 0xc4, 0x83, 0xab, 0x02, 0x69,
-	0xd6, 0xc8, 0xaa, 0xef, 0xbb, 0xc9, 0xb9, 0xf6, 0x94, 0x01, 0xe4, 0x97, 0x3a, 0xac, 0x08, 0x61,
-	0xec, 0x3e, 0x8a, 0x21, 0xf2, 0x07, 0x84, 0xcf, 0xaf, 0xbb, 0xdc, 0x2a, 0x1a, 0xdc, 0xac, 0x99,
-	0xbe, 0xc9, 0x52, 0x33, 0x10, 0x0d, 0x6e, 0x2b, 0xc9, 0xe5, 0xce, 0x8c, 0x20, 0x82, 0x75, 0x19,
-	0xc1, 0x15, 0xf2, 0x51, 0xfd, 0x08, 0x22, 0x47, 0x10, 0xd0, 0x66, 0x22, 0x7d, 0x26, 0x3c, 0x86,
-	0x7f, 0x44, 0x38, 0xd9, 0x20, 0x9e, 0x6d, 0x8f, 0x93, 0x0e, 0xb0, 0x85, 0x43, 0x7e, 0xd3, 0x62,
-	0x6f, 0x3c, 0x13, 0xd3, 0x0d, 0x19, 0xd2, 0x27, 0xe4, 0xe3, 0xff, 0x22, 0x24, 0xe6, 0xf1, 0xb5,
-	0xad, 0xe7, 0x2f, 0x53, 0xe8, 0xc5, 0xcb, 0x14, 0xfa, 0xdb, 0xcb, 0x14, 0xfa, 0xc1, 0xab, 0x54,
-	0xcf, 0x8b, 0x57, 0xa9, 0x9e, 0x3f, 0xbf, 0x4a, 0xf5, 0x7c, 0xf3, 0x72, 0x64, 0xf6, 0x84, 0x3d,
-	0xe6, 0x0b, 0xc6, 0xae, 0x1b, 0x6c, 0x78, 0x6f, 0x61, 0x29, 0x73, 0xac, 0xb6, 0x95, 0x93, 0xe8,
-	0xee, 0x80, 0xbc, 0x29, 0x2f, 0xfd, 0x27, 0x00, 0x00, 0xff, 0xff, 0xbf, 0xce, 0x32, 0x25, 0xda,
-	0x1b, 0x00, 0x00,
+	// 2030 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0x5d, 0x6c, 0x1c, 0x57,
+	0x15, 0xf6, 0xdd, 0x38, 0x8e, 0xf7, 0xa4, 0xb1, 0x9d, 0x1b, 0x27, 0xde, 0x8c, 0x93, 0xdd, 0x70,
+	0x69, 0x6d, 0x37, 0xb1, 0x77, 0x63, 0xc7, 0x2e, 0x95, 0xa1, 0x49, 0xbd, 0x89, 0x9d, 0x38, 0xd0,
+	0x24, 0x4c, 0xaa, 0x56, 0xfc, 0x48, 0xab, 0xf1, 0xee, 0xd8, 0x9e, 0x76, 0x77, 0xee, 0x66, 0x67,
+	0x26, 0xb6, 0x85, 0xaa, 0x4a, 0x15, 0x45, 0x7d, 0x00, 0x09, 0xa9, 0xb4, 0x48, 0x80, 0x80, 0x07,
+	0x84, 0x10, 0xcf, 0x48, 0x3c, 0xf5, 0x09, 0x90, 0x2a, 0x24, 0xa4, 0x22, 0x5e, 0x10, 0x42, 0x0b,
+	0x4a, 0xe0, 0x8d, 0x27, 0xbf, 0xf0, 0x08, 0xba, 0xf7, 0x9e, 0x99, 0xd9, 0x9f, 0xd9, 0x9f, 0x59,
+	0x88, 0x08, 0x4f, 0xf6, 0xde, 0xf3, 0xf7, 0x9d, 0x9f, 0x39, 0xf7, 0x9e, 0x03, 0x17, 0xb8, 0x53,
+	0xe1, 0x8e, 0xe5, 0xe4, 0x76, 0x8c, 0x4a, 0x25, 0xf7, 0x70, 0x71, 0xcb, 0x74, 0x8d, 0xc5, 0xdc,
+	0x03, 0xcf, 0xac, 0x1d, 0x64, 0xab, 0x35, 0xee, 0x72, 0x3a, 0x89, 0x1c, 0x59, 0xc1, 0x91, 0x45,
+	0x0e, 0x6d, 0x72, 0x87, 0xef, 0x70, 0xc9, 0x90, 0x13, 0xff, 0x29, 0x5e, 0xed, 0x7c, 0xa4, 0x36,
+	0x77, 0x1f, 0xc9, 0xe9, 0xa2, 0xa4, 0xe7, 0xb6, 0x0c, 0xc7, 0x0c, 0xa8, 0x45, 0x6e, 0xd9, 0x48,
+	0xbf, 0xd8, 0x48, 0x97, 0x18, 0x02, 0xae, 0xaa, 0xb1, 0x63, 0xd9, 0x86, 0x6b, 0x71, 0x9f, 0xf7,
+	0xdc, 0x0e, 0xe7, 0x3b, 0x65, 0x33, 0x67, 0x54, 0xad, 0x9c, 0x61, 0xdb, 0xdc, 0x95, 0x44, 0x07,
+	0xa9, 0x67, 0x91, 0x2a, 0x7f, 0x6d, 0x79, 0xdb, 0x39, 0xc3, 0x3e, 0xf0, 0x49, 0xca, 0x48, 0x41,
+	0x81, 0x57, 0x3f, 0x14, 0x89, 0x5d, 0x83, 0x89, 0x2f, 0x0a, 0xab, 0xf7, 0x38, 0x2f, 0xeb, 0xe6,
+	0x03, 0xcf, 0x74, 0x5c, 0x7a, 0x09, 0x8e, 0x55, 0x39, 0x2f, 0x17, 0xac, 0x52, 0x8a, 0x5c, 0x20,
+	0x73, 0xc3, 0x79, 0x7a, 0x58, 0xcf, 0x8c, 0x1d, 0x18, 0x95, 0xf2, 0x2a, 0x43, 0x02, 0xd3, 0x47,
+	0xc4, 0x7f, 0x9b, 0x25, 0x76, 0x0b, 0x4e, 0x36, 0x28, 0x70, 0xaa, 0xdc, 0x76, 0x4c, 0x7a, 0x05,
+	0x86, 0x05, 0x59, 0x8a, 0x1f, 0x5f, 0x9a, 0xcc, 0x2a, 0x68, 0x59, 0x1f, 0x5a, 0x76, 0xcd, 0x3e,
+	0xc8, 0x27, 0x7f, 0xfb, 0x8b, 0x85, 0xa3, 0x42, 0x6a, 0x53, 0x97, 0xcc, 0xec, 0x2b, 0x0d, 0x9a,
+	0x1c, 0x1f, 0xcb, 0x06, 0x40, 0x18, 0x87, 0x54, 0x42, 0xea, 0x9b, 0xc9, 0xa2, 0x0b, 0x22, 0x68,
+	0x59, 0x95, 0x38, 0x0c, 0x5a, 0xf6, 0x9e, 0xb1, 0x63, 0xa2, 0xac, 0xde, 0x20, 0xc9, 0xbe, 0x43,
+	0x80, 0x36, 0x6a, 0x47, 0xa0, 0x2b, 0x70, 0x54, 0xd8, 0x76, 0x52, 0xe4, 0xc2, 0x91, 0x7e, 0x90,
+	0x2a, 0x6e, 0x7a, 0x33, 0x02, 0xd5, 0x6c, 0x4f, 0x54, 0xca, 0x66, 0x13, 0xac, 0x33, 0x30, 0x29,
+	0x51, 0xdd, 0xf1, 0x2a, 0x8d, 0x6e, 0xb3, 0xdb, 0x70, 0xba, 0xe5, 0x1c, 0x01, 0x2f, 0x42, 0xd2,
+	0xf6, 0x2a, 0x05, 0x1f, 0xb4, 0xc8, 0xce, 0xe4, 0x61, 0x3d, 0x33, 0xa1, 0xb2, 0x13, 0x90, 0x98,
+	0x3e, 0x6a, 0xa3, 0x28, 0xbb, 0x8e, 0x36, 0xc4, 0xaf, 0x57, 0x0f, 0xaa, 0xe6, 0x40, 0x69, 0xf6,
+	0x01, 0x85, 0x4a, 0x42, 0x40, 0x92, 0xd9, 0x3d, 0xa8, 0x9a, 0x52, 0x4f, 0xb2, 0x11, 0x50, 0x40,
+	0x62, 0xfa, 0x68, 0x15, 0x45, 0xd9, 0x2f, 0x09, 0xa4, 0xa5, 0xb2, 0xeb, 0x46, 0xb9, 0x78, 0x9b,
+	0x5b, 0xb6, 0x50, 0x7a, 0x7f, 0xd7, 0xa8, 0x99, 0xce, 0x20, 0xd8, 0xe8, 0x2e, 0x24, 0x5d, 0xfe,
+	0xa6, 0x69, 0x3b, 0x05, 0x4b, 0x24, 0x43, 0x24, 0xf2, 0x6c, 0x53, 0x32, 0xfc, 0x34, 0x5c, 0xe7,
+	0x96, 0x9d, 0xbf, 0xfc, 0x71, 0x3d, 0x33, 0xf4, 0xf3, 0xbf, 0x64, 0xe6, 0x76, 0x2c, 0x77, 0xd7,
+	0xdb, 0xca, 0x16, 0x79, 0x05, 0x3f, 0x09, 0xfc, 0xb3, 0xe0, 0x94, 0xde, 0xcc, 0x09, 0xcc, 0x8e,
+	0x14, 0x70, 0xf4, 0x51, 0xa5, 0x7d, 0xd3, 0x66, 0xef, 0x24, 0x20, 0xd3, 0x11, 0x39, 0x06, 0xc4,
+	0x81, 0x09, 0x47, 0x9c, 0x14, 0xb8, 0xe7, 0x16, 0x8c, 0x0a, 0xf7, 0x6c, 0x17, 0xe3, 0xb2, 0x29,
+	0x2c, 0xff, 0xa9, 0x9e, 0x99, 0xe9, 0xc3, 0xf2, 0xa6, 0xed, 0x1e, 0xd6, 0x33, 0x53, 0xca, 0xe3,
+	0x56, 0x7d, 0x4c, 0x1f, 0x93, 0x47, 0x77, 0x3d, 0x77, 0x4d, 0x1e, 0xd0, 0x37, 0x00, 0x30, 0x04,
+	0xdc, 0x73, 0x9f, 0x44, 0x0c, 0x30, 0xc2, 0x77, 0x3d, 0x97, 0x7d, 0x8f, 0xc0, 0x6c, 0x10, 0x84,
+	0xf5, 0x7d, 0xcb, 0x15, 0x41, 0x90, 0x5c, 0x1b, 0x35, 0x5e, 0x69, 0xce, 0xe3, 0x54, 0x4b, 0x1e,
+	0x83, 0x9c, 0xbd, 0x06, 0xe3, 0xca, 0x2b, 0xcb, 0xf6, 0x83, 0x94, 0x90, 0x41, 0xca, 0xc6, 0x0b,
+	0x92, 0x7e, 0x42, 0xaa, 0xd9, 0xb4, 0x55, 0x20, 0xd8, 0x87, 0x04, 0xe6, 0x7a, 0x83, 0xc3, 0x54,
+	0x35, 0x47, 0x8d, 0x3c, 0xd1, 0xa8, 0xad, 0xc3, 0x99, 0xe0, 0x03, 0xba, 0x67, 0xd4, 0x8c, 0xca,
+	0x40, 0xb5, 0xce, 0x6e, 0xc2, 0x54, 0x9b, 0x1a, 0xf4, 0x66, 0x1e, 0x46, 0xaa, 0xf2, 0xa4, 0x5b,
+	0xdb, 0xd5, 0x91, 0x87, 0xbd, 0x82, 0xdf, 0xe0, 0xab, 0xdc, 0x35, 0xca, 0x42, 0xdb, 0x17, 0xac,
+	0x07, 0x9e, 0x55, 0xb2, 0xdc, 0x83, 0x81, 0x70, 0xfd, 0x98, 0x40, 0xa6, 0xa3, 0x3e, 0x04, 0xf8,
+	0x16, 0x24, 0xcb, 0xfe, 0x61, 0xef, 0x68, 0xdf, 0x10, 0xd1, 0x0e, 0x3b, 0x49, 0x20, 0xc9, 0xe2,
+	0x65, 0x20, 0x94, 0xdb, 0x80, 0xa9, 0x10, 0xe1, 0xe0, 0xed, 0x86, 0x79, 0x90, 0x6a, 0xd7, 0x83,
+	0x2e, 0x7e, 0x09, 0x9e, 0x71, 0xc5, 0x71, 0x41, 0x56, 0xa5, 0x9f, 0x89, 0x2e, 0x5e, 0x4e, 0xa3,
+	0x97, 0xa7, 0x94, 0xb1, 0x46, 0x61, 0xa6, 0x1f, 0x77, 0x43, 0x13, 0xec, 0x3c, 0x4c, 0xab, 0xcc,
+	0x8b, 0x6c, 0x16, 0xc5, 0x65, 0xfb, 0xd0, 0xb4, 0x3d, 0xbf, 0x9b, 0xb3, 0xef, 0x12, 0x38, 0x17,
+	0x4d, 0x47, 0x68, 0x7b, 0x70, 0xac, 0xa6, 0x8e, 0x7a, 0xc7, 0x3e, 0x8f, 0xa8, 0x30, 0x04, 0x28,
+	0x17, 0x2f, 0xf2, 0xbe, 0x35, 0x76, 0x07, 0x2b, 0x43, 0x96, 0x6c, 0x24, 0xf8, 0x78, 0xf1, 0xff,
+	0x3e, 0x81, 0x0b, 0x9d, 0x15, 0xfe, 0xaf, 0xbd, 0xfd, 0x3c, 0xa6, 0x61, 0x7d, 0x7b, 0xdb, 0x2c,
+	0xba, 0xd6, 0x43, 0xf3, 0xfe, 0x9e, 0x51, 0xdd, 0x30, 0x07, 0x73, 0xf5, 0xdd, 0x04, 0x9c, 0xef,
+	0xa0, 0x0d, 0xfd, 0xfc, 0x2a, 0x8c, 0x3a, 0x7b, 0x46, 0xb5, 0xb0, 0x6d, 0xfa, 0xb7, 0xef, 0x5a,
+	0x8c, 0x06, 0x7a, 0xc3, 0x2c, 0x1e, 0xd6, 0x33, 0xe3, 0xca, 0xba, 0xaf, 0x87, 0xe9, 0xc7, 0x1c,
+	0x65, 0x85, 0xbe, 0x4b, 0x60, 0xaa, 0x74, 0x60, 0x1b, 0x15, 0xab, 0x58, 0xf0, 0xc9, 0x05, 0x6c,
+	0x32, 0xea, 0xd5, 0x73, 0x31, 0x1b, 0xf5, 0x56, 0xce, 0xde, 0x50, 0x42, 0x88, 0x56, 0x35, 0xaa,
+	0x3c, 0x3b, 0xac, 0x67, 0xd2, 0xca, 0x56, 0x07, 0xa5, 0x4c, 0x9f, 0x2c, 0x45, 0x48, 0xb2, 0x8f,
+	0x08, 0x3c, 0xdb, 0x76, 0xef, 0xde, 0xe1, 0x82, 0xe9, 0xff, 0xe2, 0xdd, 0xf0, 0x4f, 0x02, 0xcf,
+	0xf5, 0xc0, 0x8f, 0xf9, 0x7c, 0x3b, 0xde, 0x95, 0xb4, 0x8e, 0xa5, 0x7b, 0xd2, 0x6f, 0x1f, 0xbe,
+	0x28, 0x1b, 0xf0, 0x9e, 0xa2, 0xaf, 0x00, 0xa8, 0xf6, 0x83, 0x2f, 0x89, 0x41, 0xee, 0xe4, 0xa4,
+	0xd2, 0x20, 0xae, 0xbd, 0x7f, 0x10, 0x7c, 0x38, 0xde, 0xaf, 0x72, 0xf7, 0x5e, 0xcd, 0x2a, 0x0e,
+	0xf4, 0x21, 0xd0, 0x75, 0x98, 0x10, 0xce, 0x17, 0x0c, 0xc7, 0x31, 0xdd, 0x42, 0xc9, 0xb4, 0x79,
+	0x05, 0xb1, 0x4d, 0x87, 0xcf, 0xa4, 0x56, 0x0e, 0xa6, 0x8f, 0x89, 0xa3, 0x35, 0x71, 0x72, 0x43,
+	0x1c, 0xd0, 0x5b, 0x70, 0xf2, 0x81, 0xc7, 0xdd, 0x66, 0x3d, 0x47, 0xa4, 0x9e, 0x73, 0x87, 0xf5,
+	0x4c, 0x4a, 0xe9, 0x69, 0x63, 0x61, 0xfa, 0xb8, 0x3c, 0x0b, 0x35, 0xad, 0x26, 0x52, 0xe4, 0xf6,
+	0xf0, 0xe8, 0xf0, 0xc4, 0x51, 0xfd, 0xf8, 0x9e, 0xe5, 0xee, 0x62, 0xb9, 0xb2, 0x6f, 0x25, 0xfc,
+	0x2e, 0xcd, 0x79, 0xd9, 0x79, 0xdd, 0x72, 0x77, 0x37, 0xac, 0xb2, 0x6b, 0xd6, 0x7c, 0xa7, 0xdf,
+	0x23, 0x70, 0xa2, 0x62, 0xd9, 0x85, 0x18, 0xf7, 0xe0, 0x2d, 0x4c, 0xf1, 0xa4, 0x02, 0xd7, 0x24,
+	0x1d, 0x2f, 0xcb, 0xcf, 0x54, 0x2c, 0x3b, 0xb8, 0x95, 0xe9, 0x74, 0xe3, 0xc3, 0x5d, 0xc6, 0x32,
+	0x7c, 0xa2, 0xb7, 0x8c, 0x5d, 0x47, 0x06, 0x1e, 0xbb, 0x7e, 0x18, 0xdc, 0x4a, 0xad, 0xf1, 0x78,
+	0x4a, 0x06, 0x30, 0x1d, 0xce, 0xb4, 0x96, 0x27, 0x22, 0x5b, 0x06, 0x70, 0xaa, 0xdc, 0x2d, 0x54,
+	0xc5, 0x29, 0xf6, 0xd6, 0xd3, 0xe1, 0xa7, 0x16, 0xd2, 0x98, 0x9e, 0x74, 0x7c, 0x69, 0x51, 0x17,
+	0xec, 0x5f, 0x04, 0xbb, 0xb6, 0xa8, 0x8a, 0xf5, 0x7d, 0xa3, 0x88, 0xaf, 0xf4, 0x4d, 0xdb, 0x2f,
+	0x83, 0xe7, 0x61, 0xc4, 0x31, 0xed, 0x92, 0x59, 0x43, 0xbd, 0x27, 0x0f, 0xeb, 0x99, 0x13, 0xa8,
+	0x57, 0x9e, 0x33, 0x1d, 0x19, 0x1a, 0x3f, 0x93, 0x44, 0xcf, 0xcf, 0x24, 0x0b, 0xaa, 0xe7, 0x14,
+	0x2c, 0x95, 0xb4, 0x64, 0xfe, 0x54, 0xd8, 0xdf, 0x7d, 0x0a, 0xd3, 0x8f, 0xc9, 0x7f, 0x37, 0x6d,
+	0xfa, 0x1a, 0x8c, 0xd4, 0xb8, 0xe7, 0x9a, 0x4e, 0x6a, 0x58, 0x86, 0x7f, 0x36, 0xba, 0x9b, 0x0b,
+	0x3f, 0x02, 0x17, 0x04, 0x7f, 0xfe, 0x34, 0x16, 0x25, 0x82, 0x56, 0x4a, 0x98, 0x8e, 0xda, 0xd8,
+	0x07, 0xfe, 0x84, 0x17, 0x11, 0x81, 0x70, 0x4c, 0x52, 0x80, 0xfe, 0x7b, 0x63, 0x52, 0xab, 0x3e,
+	0xa6, 0x8f, 0xc9, 0xa3, 0x60, 0x4c, 0x62, 0x5f, 0x4f, 0x44, 0xe3, 0xba, 0xeb, 0xb9, 0x4f, 0x3a,
+	0x35, 0xaf, 0x07, 0xa1, 0x3e, 0x22, 0x43, 0x3d, 0xd7, 0x2b, 0xd4, 0x02, 0x53, 0x1f, 0xb1, 0x16,
+	0x03, 0x78, 0xe0, 0x78, 0x6a, 0xb8, 0x75, 0x00, 0x0f, 0x48, 0x0c, 0xaf, 0x23, 0xd1, 0x94, 0xdf,
+	0xf7, 0x1f, 0xeb, 0x51, 0x61, 0xc0, 0xfc, 0x54, 0x61, 0xdc, 0x2f, 0x98, 0xe6, 0xf4, 0xdc, 0x8a,
+	0x9d, 0x9e, 0x33, 0xcd, 0xf5, 0x17, 0x64, 0xe7, 0x04, 0x96, 0x21, 0x26, 0xe7, 0x1c, 0x68, 0xe1,
+	0xbb, 0xba, 0x75, 0x1a, 0x61, 0x3f, 0x20, 0x30, 0x1d, 0x49, 0x7e, 0x2a, 0x86, 0x8b, 0xa5, 0x3f,
+	0xa7, 0xe0, 0xa8, 0x84, 0x47, 0xdf, 0x06, 0xd9, 0xaa, 0x1c, 0xda, 0xe1, 0x63, 0x6a, 0xdb, 0x71,
+	0x69, 0x73, 0xbd, 0x19, 0x95, 0x93, 0xec, 0xd3, 0xef, 0xfc, 0xe1, 0x6f, 0xef, 0x27, 0xce, 0xd3,
+	0xe9, 0x5c, 0xe4, 0xd6, 0x51, 0xf5, 0xc6, 0x6f, 0x12, 0x18, 0xf5, 0xf7, 0x46, 0xf4, 0x62, 0x17,
+	0xdd, 0x2d, 0x4b, 0x27, 0xed, 0x52, 0x5f, 0xbc, 0x08, 0x65, 0x56, 0x42, 0xf9, 0x14, 0xcd, 0x44,
+	0x43, 0x09, 0x36, 0x51, 0xf4, 0x27, 0x04, 0xc6, 0x9a, 0x73, 0x46, 0x2f, 0x77, 0x31, 0x14, 0x99,
+	0x7d, 0x6d, 0x31, 0x86, 0x04, 0x02, 0x5c, 0x90, 0x00, 0x67, 0xe9, 0x73, 0xd1, 0x00, 0xd5, 0xa4,
+	0x15, 0x24, 0x90, 0xfe, 0x94, 0xc0, 0x78, 0xcb, 0x25, 0x45, 0x17, 0x7b, 0x25, 0xa6, 0xed, 0x82,
+	0xd7, 0x96, 0xe2, 0x88, 0x20, 0xd2, 0x79, 0x89, 0x74, 0x86, 0x3e, 0x1b, 0x8d, 0x74, 0x5b, 0x72,
+	0x9b, 0x25, 0x8c, 0xe7, 0x37, 0x08, 0x0c, 0x0b, 0x4d, 0x74, 0xa6, 0x87, 0x29, 0x1f, 0xd2, 0x6c,
+	0x4f, 0xbe, 0xfe, 0x22, 0x26, 0xcd, 0xe7, 0xbe, 0x86, 0x9d, 0xed, 0x2d, 0xfa, 0x21, 0x81, 0x51,
+	0x7f, 0x1d, 0xd8, 0xb5, 0xce, 0x5a, 0x16, 0x8f, 0xda, 0xa5, 0xbe, 0x78, 0x11, 0xd4, 0xa2, 0x04,
+	0x75, 0x89, 0x3e, 0xdf, 0x19, 0x94, 0x7c, 0xc2, 0x34, 0x00, 0xfb, 0x80, 0x40, 0xaa, 0xd3, 0x43,
+	0x9b, 0xae, 0x76, 0x31, 0xde, 0x63, 0xba, 0xd0, 0x3e, 0x3b, 0x90, 0x2c, 0x3a, 0x32, 0x44, 0x7f,
+	0x45, 0x80, 0xb6, 0x2f, 0x0e, 0xe9, 0x72, 0x9f, 0x5a, 0x9b, 0xb1, 0xac, 0xc4, 0x94, 0x42, 0x14,
+	0x2f, 0xcb, 0x70, 0xae, 0xd2, 0x17, 0xfb, 0xca, 0x71, 0xee, 0x0d, 0x6e, 0xd9, 0x6a, 0x48, 0x33,
+	0xc5, 0x35, 0x51, 0xb0, 0x6c, 0xfa, 0x77, 0x02, 0xd3, 0x5d, 0x96, 0x6b, 0xf4, 0xa5, 0x1e, 0xc0,
+	0xba, 0x6f, 0x0c, 0xb5, 0xab, 0x83, 0x8a, 0xa3, 0x83, 0x37, 0xa5, 0x83, 0x6b, 0xf4, 0x5a, 0x7f,
+	0x0e, 0x9a, 0xfb, 0x96, 0xab, 0x1c, 0x54, 0xeb, 0x48, 0x75, 0x37, 0x09, 0x3f, 0x7f, 0x44, 0x00,
+	0xc2, 0x2d, 0x1b, 0x9d, 0xef, 0x51, 0xb4, 0x4d, 0x3b, 0x3d, 0x6d, 0xa1, 0x4f, 0x6e, 0x04, 0xbd,
+	0x2c, 0x41, 0x67, 0xe9, 0x7c, 0x7f, 0xa0, 0xd5, 0xb0, 0x4c, 0x7f, 0x43, 0x80, 0xb6, 0xaf, 0xdb,
+	0xba, 0xd6, 0x53, 0xc7, 0x6d, 0x9f, 0xb6, 0x12, 0x53, 0x0a, 0x91, 0xe7, 0x25, 0xf2, 0xcf, 0xd1,
+	0xd5, 0xfe, 0x90, 0xab, 0xae, 0x2b, 0x7f, 0x86, 0xad, 0xf7, 0x67, 0x04, 0x8e, 0x37, 0x2c, 0xd3,
+	0xe8, 0x42, 0x2f, 0x28, 0xcd, 0x15, 0x93, 0xed, 0x97, 0x1d, 0x21, 0xaf, 0x4a, 0xc8, 0xcb, 0x74,
+	0x29, 0x0e, 0x64, 0x35, 0xd1, 0x0a, 0xa8, 0xe3, 0x2d, 0x2b, 0xa7, 0xee, 0xb7, 0x44, 0xe4, 0xbe,
+	0x4b, 0x5b, 0x8a, 0x23, 0x82, 0xb0, 0xb3, 0x12, 0xf6, 0x1c, 0x9d, 0xe9, 0x00, 0x1b, 0xc5, 0x0a,
+	0xb8, 0x88, 0xa2, 0xbf, 0x26, 0x70, 0x2a, 0x62, 0x43, 0x46, 0x57, 0x7a, 0x95, 0x66, 0x34, 0xe4,
+	0x17, 0xe2, 0x8a, 0x21, 0xec, 0xab, 0x12, 0xf6, 0x8b, 0xf4, 0x85, 0x3e, 0x4b, 0xbb, 0xd5, 0x8d,
+	0x8f, 0x08, 0x4c, 0xb4, 0x6e, 0xbf, 0x68, 0xb7, 0xf8, 0x75, 0x58, 0xbc, 0x69, 0x57, 0x62, 0xc9,
+	0x0c, 0xd6, 0x2e, 0x4d, 0x5f, 0x4f, 0xb0, 0xd8, 0x12, 0x6d, 0x24, 0x19, 0x0c, 0x97, 0xb4, 0xdb,
+	0xd5, 0xd7, 0xba, 0x21, 0xd1, 0xe6, 0xfb, 0x63, 0x46, 0xa8, 0x9f, 0x89, 0xd9, 0x43, 0x84, 0xb0,
+	0xf3, 0x5e, 0x82, 0xd0, 0xdf, 0x11, 0x38, 0xbb, 0xee, 0xb8, 0x56, 0xc5, 0x70, 0xcd, 0xb6, 0x79,
+	0x8d, 0x76, 0x0b, 0x5b, 0xa7, 0xf9, 0x56, 0x5b, 0x8e, 0x27, 0x84, 0x1e, 0xac, 0x4b, 0x0f, 0xae,
+	0xd1, 0x97, 0xa2, 0x3d, 0x68, 0x08, 0x33, 0xa2, 0xcd, 0x35, 0xdc, 0x4c, 0x61, 0xe3, 0xfe, 0x3d,
+	0x01, 0xad, 0x83, 0x3f, 0x62, 0xc1, 0x15, 0x03, 0x5b, 0x38, 0x16, 0x6a, 0x2b, 0x31, 0xa5, 0xd0,
+	0xa5, 0x0d, 0xe9, 0xd2, 0xcb, 0xf4, 0xea, 0x7f, 0xe0, 0x12, 0xf7, 0xdc, 0xfc, 0xed, 0x8f, 0x1f,
+	0xa5, 0xc9, 0x27, 0x8f, 0xd2, 0xe4, 0xaf, 0x8f, 0xd2, 0xe4, 0xdb, 0x8f, 0xd3, 0x43, 0x9f, 0x3c,
+	0x4e, 0x0f, 0xfd, 0xf1, 0x71, 0x7a, 0xe8, 0xcb, 0x97, 0x1b, 0xa6, 0x15, 0xb4, 0xb1, 0x50, 0x36,
+	0xb6, 0x9c, 0xc0, 0xe0, 0xc3, 0xc5, 0x2b, 0xb9, 0x7d, 0x65, 0x56, 0xce, 0x2e, 0x5b, 0x23, 0xf2,
+	0x1b, 0xbb, 0xf2, 0xef, 0x01, 0x00, 0x6b, 0x91, 0x04, 0xb7, 0x08, 0x21, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1653,10 +1934,20 @@ type QueryClient interface {
 	PoolParams(ctx context.Context, in *QueryPoolParamsRequest, opts ...grpc.CallOption) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(ctx context.Context, in *QueryTotalPoolLiquidityRequest, opts ...grpc.CallOption) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(ctx context.Context, in *QueryTotalSharesRequest, opts ...grpc.CallOption) (*QueryTotalSharesResponse, error)
+	// ProtocolRevenue returns the swap fees all pools have diverted to the
+	// protocol, per denom.
+	ProtocolRevenue(ctx context.Context, in *QueryProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryProtocolRevenueResponse, error)
+	// PoolProtocolRevenue returns the swap fees a pool has diverted to the
+	// protocol, per denom.
+	PoolProtocolRevenue(ctx context.Context, in *QueryPoolProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryPoolProtocolRevenueResponse, error)
+	// EffectiveSwapFee returns the swap fee currently charged by a pool, which
+	// differs from its swap fee if it has a dynamic swap fee.
+	EffectiveSwapFee(ctx context.Context, in *QueryEffectiveSwapFeeRequest, opts ...grpc.CallOption) (*QueryEffectiveSwapFeeResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error)
-	// Estimate the swap.
+	// Estimate the swap. The routes may go through pools of any pool module, as
+	// they are estimated by the swaprouter.
 	EstimateSwapExactAmountIn(ctx context.Context, in *QuerySwapExactAmountInRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(ctx context.Context, in *QuerySwapExactAmountOutRequest, opts ...grpc.CallOption) (*QuerySwapExactAmountOutResponse, error)
 }
@@ -1777,6 +2068,33 @@ func (c *queryClient) TotalShares(ctx context.Context, in *QueryTotalSharesReque
 	return out, nil
 }
 
+func (c *queryClient) ProtocolRevenue(ctx context.Context, in *QueryProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryProtocolRevenueResponse, error) {
+	out := new(QueryProtocolRevenueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/ProtocolRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PoolProtocolRevenue(ctx context.Context, in *QueryPoolProtocolRevenueRequest, opts ...grpc.CallOption) (*QueryPoolProtocolRevenueResponse, error) {
+	out := new(QueryPoolProtocolRevenueResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/PoolProtocolRevenue", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EffectiveSwapFee(ctx context.Context, in *QueryEffectiveSwapFeeRequest, opts ...grpc.CallOption) (*QueryEffectiveSwapFeeResponse, error) {
+	out := new(QueryEffectiveSwapFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Query/EffectiveSwapFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) SpotPrice(ctx context.Context, in *QuerySpotPriceRequest, opts ...grpc.CallOption) (*QuerySpotPriceResponse, error) {
 	out := new(QuerySpotPriceResponse)
@@ -1827,10 +2145,20 @@ type QueryServer interface {
 	PoolParams(context.Context, *QueryPoolParamsRequest) (*QueryPoolParamsResponse, error)
 	TotalPoolLiquidity(context.Context, *QueryTotalPoolLiquidityRequest) (*QueryTotalPoolLiquidityResponse, error)
 	TotalShares(context.Context, *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error)
+	// ProtocolRevenue returns the swap fees all pools have diverted to the
+	// protocol, per denom.
+	ProtocolRevenue(context.Context, *QueryProtocolRevenueRequest) (*QueryProtocolRevenueResponse, error)
+	// PoolProtocolRevenue returns the swap fees a pool has diverted to the
+	// protocol, per denom.
+	PoolProtocolRevenue(context.Context, *QueryPoolProtocolRevenueRequest) (*QueryPoolProtocolRevenueResponse, error)
+	// EffectiveSwapFee returns the swap fee currently charged by a pool, which
+	// differs from its swap fee if it has a dynamic swap fee.
+	EffectiveSwapFee(context.Context, *QueryEffectiveSwapFeeRequest) (*QueryEffectiveSwapFeeResponse, error)
 	// SpotPrice defines a gRPC query handler that returns the spot price given
 	// a base denomination and a quote denomination.
 	SpotPrice(context.Context, *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error)
-	// Estimate the swap.
+	// Estimate the swap. The routes may go through pools of any pool module, as
+	// they are estimated by the swaprouter.
 	EstimateSwapExactAmountIn(context.Context, *QuerySwapExactAmountInRequest) (*QuerySwapExactAmountInResponse, error)
 	EstimateSwapExactAmountOut(context.Context, *QuerySwapExactAmountOutRequest) (*QuerySwapExactAmountOutResponse, error)
 }
@@ -1875,6 +2203,15 @@ func (*UnimplementedQueryServer) TotalPoolLiquidity(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) TotalShares(ctx context.Context, req *QueryTotalSharesRequest) (*QueryTotalSharesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TotalShares not implemented")
 }
+func (*UnimplementedQueryServer) ProtocolRevenue(ctx context.Context, req *QueryProtocolRevenueRequest) (*QueryProtocolRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProtocolRevenue not implemented")
+}
+func (*UnimplementedQueryServer) PoolProtocolRevenue(ctx context.Context, req *QueryPoolProtocolRevenueRequest) (*QueryPoolProtocolRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PoolProtocolRevenue not implemented")
+}
+func (*UnimplementedQueryServer) EffectiveSwapFee(ctx context.Context, req *QueryEffectiveSwapFeeRequest) (*QueryEffectiveSwapFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EffectiveSwapFee not implemented")
+}
 func (*UnimplementedQueryServer) SpotPrice(ctx context.Context, req *QuerySpotPriceRequest) (*QuerySpotPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SpotPrice not implemented")
 }
@@ -2105,6 +2442,60 @@ func _Query_TotalShares_Handler(srv interface{}, ctx context.Context, dec func(i
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProtocolRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProtocolRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/ProtocolRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProtocolRevenue(ctx, req.(*QueryProtocolRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PoolProtocolRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPoolProtocolRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PoolProtocolRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/PoolProtocolRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PoolProtocolRevenue(ctx, req.(*QueryPoolProtocolRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EffectiveSwapFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEffectiveSwapFeeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EffectiveSwapFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Query/EffectiveSwapFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EffectiveSwapFee(ctx, req.(*QueryEffectiveSwapFeeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_SpotPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QuerySpotPriceRequest)
 	if err := dec(in); err != nil {
@@ -2211,6 +2602,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "TotalShares",
 			Handler:    _Query_TotalShares_Handler,
 		},
+		{
+			MethodName: "ProtocolRevenue",
+			Handler:    _Query_ProtocolRevenue_Handler,
+		},
+		{
+			MethodName: "PoolProtocolRevenue",
+			Handler:    _Query_PoolProtocolRevenue_Handler,
+		},
+		{
+			MethodName: "EffectiveSwapFee",
+			Handler:    _Query_EffectiveSwapFee_Handler,
+		},
 		{
 			MethodName: "SpotPrice",
 			Handler:    _Query_SpotPrice_Handler,
@@ -2837,7 +3240,7 @@ func (m *QueryTotalSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2847,24 +3250,222 @@ func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokensIn) > 0 {
-		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolProtocolRevenueRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolProtocolRevenueRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolProtocolRevenueRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPoolProtocolRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPoolProtocolRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPoolProtocolRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveSwapFeeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveSwapFeeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveSwapFeeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEffectiveSwapFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEffectiveSwapFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEffectiveSwapFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DynamicSwapFeeParams != nil {
+		{
+			size, err := m.DynamicSwapFeeParams.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.SwapFee.Size()
+		i -= size
+		if _, err := m.SwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCalcJoinPoolNoSwapSharesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokensIn) > 0 {
+		for iNdEx := len(m.TokensIn) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokensIn[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
 				i = encodeVarintQuery(dAtA, i, uint64(size))
 			}
 			i--
@@ -3594,6 +4195,84 @@ func (m *QueryTotalSharesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryProtocolRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryProtocolRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPoolProtocolRevenueRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryPoolProtocolRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryEffectiveSwapFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovQuery(uint64(m.PoolId))
+	}
+	return n
+}
+
+func (m *QueryEffectiveSwapFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.SwapFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.DynamicSwapFeeParams != nil {
+		l = m.DynamicSwapFeeParams.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5307,6 +5986,482 @@ func (m *QueryTotalSharesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProtocolRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProtocolRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types1.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolProtocolRevenueRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolProtocolRevenueRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolProtocolRevenueRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPoolProtocolRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPoolProtocolRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPoolProtocolRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, types1.Coin{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveSwapFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveSwapFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveSwapFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEffectiveSwapFeeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEffectiveSwapFeeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEffectiveSwapFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DynamicSwapFeeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.DynamicSwapFeeParams == nil {
+				m.DynamicSwapFeeParams = &DynamicSwapFeeParams{}
+			}
+			if err := m.DynamicSwapFeeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryCalcJoinPoolNoSwapSharesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
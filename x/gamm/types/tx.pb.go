@@ -11,18 +11,22 @@ import (
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	_ "github.com/gogo/protobuf/types"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -945,6 +949,379 @@ func (m *MsgExitSwapExternAmountOutResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgExitSwapExternAmountOutResponse proto.InternalMessageInfo
 
+// ===================== MsgSetDynamicSwapFee
+// DynamicSwapFeeParams opts a pool into a swap fee that rises with the
+// volatility of its prices. Every block, the volatility over the window is
+// measured as the ratio of the arithmetic to the geometric twap of the pool's
+// prices, minus one. The effective swap fee is the pool's swap fee plus the
+// volatility times the volatility multiplier, bounded by the min and max swap
+// fees.
+type DynamicSwapFeeParams struct {
+	MinSwapFee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,opt,name=min_swap_fee,json=minSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"min_swap_fee" yaml:"min_swap_fee"`
+	MaxSwapFee           github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=max_swap_fee,json=maxSwapFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"max_swap_fee" yaml:"max_swap_fee"`
+	Window               time.Duration                          `protobuf:"bytes,3,opt,name=window,proto3,stdduration" json:"window" yaml:"window"`
+	VolatilityMultiplier github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=volatility_multiplier,json=volatilityMultiplier,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"volatility_multiplier" yaml:"volatility_multiplier"`
+}
+
+func (m *DynamicSwapFeeParams) Reset()         { *m = DynamicSwapFeeParams{} }
+func (m *DynamicSwapFeeParams) String() string { return proto.CompactTextString(m) }
+func (*DynamicSwapFeeParams) ProtoMessage()    {}
+func (*DynamicSwapFeeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{18}
+}
+func (m *DynamicSwapFeeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DynamicSwapFeeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DynamicSwapFeeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DynamicSwapFeeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DynamicSwapFeeParams.Merge(m, src)
+}
+func (m *DynamicSwapFeeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *DynamicSwapFeeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_DynamicSwapFeeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DynamicSwapFeeParams proto.InternalMessageInfo
+
+func (m *DynamicSwapFeeParams) GetWindow() time.Duration {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// MsgSetDynamicSwapFee sets the dynamic swap fee params of a pool, or opts it
+// out of a dynamic swap fee if params is unset. It may only be sent by the
+// pool's future governor.
+type MsgSetDynamicSwapFee struct {
+	Sender string                `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64                `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Params *DynamicSwapFeeParams `protobuf:"bytes,3,opt,name=params,proto3" json:"params,omitempty" yaml:"params"`
+}
+
+func (m *MsgSetDynamicSwapFee) Reset()         { *m = MsgSetDynamicSwapFee{} }
+func (m *MsgSetDynamicSwapFee) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDynamicSwapFee) ProtoMessage()    {}
+func (*MsgSetDynamicSwapFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{19}
+}
+func (m *MsgSetDynamicSwapFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDynamicSwapFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDynamicSwapFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDynamicSwapFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDynamicSwapFee.Merge(m, src)
+}
+func (m *MsgSetDynamicSwapFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDynamicSwapFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDynamicSwapFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDynamicSwapFee proto.InternalMessageInfo
+
+func (m *MsgSetDynamicSwapFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetDynamicSwapFee) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSetDynamicSwapFee) GetParams() *DynamicSwapFeeParams {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type MsgSetDynamicSwapFeeResponse struct {
+}
+
+func (m *MsgSetDynamicSwapFeeResponse) Reset()         { *m = MsgSetDynamicSwapFeeResponse{} }
+func (m *MsgSetDynamicSwapFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDynamicSwapFeeResponse) ProtoMessage()    {}
+func (*MsgSetDynamicSwapFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{20}
+}
+func (m *MsgSetDynamicSwapFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDynamicSwapFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDynamicSwapFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDynamicSwapFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDynamicSwapFeeResponse.Merge(m, src)
+}
+func (m *MsgSetDynamicSwapFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDynamicSwapFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDynamicSwapFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDynamicSwapFeeResponse proto.InternalMessageInfo
+
+// ===================== MsgMigrateShares
+// MsgMigrateShares exits share_in_amount shares of pool_id_leaving, and joins
+// pool_id_entering, which must hold the same denoms, with all the tokens
+// exited. If lock_id is set, the shares are taken out of that lock, and the
+// shares joined are locked for the lock's remaining duration.
+type MsgMigrateShares struct {
+	Sender            string                                 `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolIdLeaving     uint64                                 `protobuf:"varint,2,opt,name=pool_id_leaving,json=poolIdLeaving,proto3" json:"pool_id_leaving,omitempty" yaml:"pool_id_leaving"`
+	PoolIdEntering    uint64                                 `protobuf:"varint,3,opt,name=pool_id_entering,json=poolIdEntering,proto3" json:"pool_id_entering,omitempty" yaml:"pool_id_entering"`
+	ShareInAmount     github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=share_in_amount,json=shareInAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_in_amount" yaml:"share_in_amount"`
+	ShareOutMinAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,5,opt,name=share_out_min_amount,json=shareOutMinAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_min_amount" yaml:"share_out_min_amount"`
+	LockId            uint64                                 `protobuf:"varint,6,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgMigrateShares) Reset()         { *m = MsgMigrateShares{} }
+func (m *MsgMigrateShares) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateShares) ProtoMessage()    {}
+func (*MsgMigrateShares) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{21}
+}
+func (m *MsgMigrateShares) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateShares) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateShares.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateShares) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateShares.Merge(m, src)
+}
+func (m *MsgMigrateShares) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateShares) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateShares.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateShares proto.InternalMessageInfo
+
+func (m *MsgMigrateShares) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgMigrateShares) GetPoolIdLeaving() uint64 {
+	if m != nil {
+		return m.PoolIdLeaving
+	}
+	return 0
+}
+
+func (m *MsgMigrateShares) GetPoolIdEntering() uint64 {
+	if m != nil {
+		return m.PoolIdEntering
+	}
+	return 0
+}
+
+func (m *MsgMigrateShares) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+type MsgMigrateSharesResponse struct {
+	ShareOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,1,opt,name=share_out_amount,json=shareOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"share_out_amount" yaml:"share_out_amount"`
+	// lock_id is the id of the lock of the shares joined, if they were locked.
+	LockId uint64 `protobuf:"varint,2,opt,name=lock_id,json=lockId,proto3" json:"lock_id,omitempty" yaml:"lock_id"`
+}
+
+func (m *MsgMigrateSharesResponse) Reset()         { *m = MsgMigrateSharesResponse{} }
+func (m *MsgMigrateSharesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgMigrateSharesResponse) ProtoMessage()    {}
+func (*MsgMigrateSharesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{22}
+}
+func (m *MsgMigrateSharesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgMigrateSharesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgMigrateSharesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgMigrateSharesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgMigrateSharesResponse.Merge(m, src)
+}
+func (m *MsgMigrateSharesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgMigrateSharesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgMigrateSharesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgMigrateSharesResponse proto.InternalMessageInfo
+
+func (m *MsgMigrateSharesResponse) GetLockId() uint64 {
+	if m != nil {
+		return m.LockId
+	}
+	return 0
+}
+
+// ===================== MsgSetPoolFrozen
+// MsgSetPoolFrozen freezes or unfreezes a pool. It must be signed by the
+// pool freeze admin.
+type MsgSetPoolFrozen struct {
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
+	Frozen bool   `protobuf:"varint,3,opt,name=frozen,proto3" json:"frozen,omitempty" yaml:"frozen"`
+}
+
+func (m *MsgSetPoolFrozen) Reset()         { *m = MsgSetPoolFrozen{} }
+func (m *MsgSetPoolFrozen) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFrozen) ProtoMessage()    {}
+func (*MsgSetPoolFrozen) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{23}
+}
+func (m *MsgSetPoolFrozen) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFrozen) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFrozen.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFrozen) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFrozen.Merge(m, src)
+}
+func (m *MsgSetPoolFrozen) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFrozen) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFrozen.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFrozen proto.InternalMessageInfo
+
+func (m *MsgSetPoolFrozen) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetPoolFrozen) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
+func (m *MsgSetPoolFrozen) GetFrozen() bool {
+	if m != nil {
+		return m.Frozen
+	}
+	return false
+}
+
+type MsgSetPoolFrozenResponse struct {
+}
+
+func (m *MsgSetPoolFrozenResponse) Reset()         { *m = MsgSetPoolFrozenResponse{} }
+func (m *MsgSetPoolFrozenResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPoolFrozenResponse) ProtoMessage()    {}
+func (*MsgSetPoolFrozenResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cfc8fd3ac7df3247, []int{24}
+}
+func (m *MsgSetPoolFrozenResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPoolFrozenResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPoolFrozenResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetPoolFrozenResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPoolFrozenResponse.Merge(m, src)
+}
+func (m *MsgSetPoolFrozenResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPoolFrozenResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPoolFrozenResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetPoolFrozenResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgJoinPool)(nil), "osmosis.gamm.v1beta1.MsgJoinPool")
 	proto.RegisterType((*MsgJoinPoolResponse)(nil), "osmosis.gamm.v1beta1.MsgJoinPoolResponse")
@@ -964,83 +1341,114 @@ func init() {
 	proto.RegisterType((*MsgExitSwapShareAmountInResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapShareAmountInResponse")
 	proto.RegisterType((*MsgExitSwapExternAmountOut)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOut")
 	proto.RegisterType((*MsgExitSwapExternAmountOutResponse)(nil), "osmosis.gamm.v1beta1.MsgExitSwapExternAmountOutResponse")
+	proto.RegisterType((*DynamicSwapFeeParams)(nil), "osmosis.gamm.v1beta1.DynamicSwapFeeParams")
+	proto.RegisterType((*MsgSetDynamicSwapFee)(nil), "osmosis.gamm.v1beta1.MsgSetDynamicSwapFee")
+	proto.RegisterType((*MsgSetDynamicSwapFeeResponse)(nil), "osmosis.gamm.v1beta1.MsgSetDynamicSwapFeeResponse")
+	proto.RegisterType((*MsgMigrateShares)(nil), "osmosis.gamm.v1beta1.MsgMigrateShares")
+	proto.RegisterType((*MsgMigrateSharesResponse)(nil), "osmosis.gamm.v1beta1.MsgMigrateSharesResponse")
+	proto.RegisterType((*MsgSetPoolFrozen)(nil), "osmosis.gamm.v1beta1.MsgSetPoolFrozen")
+	proto.RegisterType((*MsgSetPoolFrozenResponse)(nil), "osmosis.gamm.v1beta1.MsgSetPoolFrozenResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/gamm/v1beta1/tx.proto", fileDescriptor_cfc8fd3ac7df3247) }
 
 var fileDescriptor_cfc8fd3ac7df3247 = []byte{
-	// 1121 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0x4b, 0x6f, 0x1b, 0x45,
-	0x1c, 0xcf, 0xd8, 0x6e, 0x1e, 0x93, 0xe6, 0xb5, 0x4d, 0x1a, 0x67, 0xdb, 0xda, 0xe9, 0x80, 0x20,
-	0xa5, 0xea, 0x6e, 0x93, 0x48, 0x80, 0xb8, 0x00, 0x86, 0x20, 0x5c, 0x61, 0xa5, 0xda, 0x5e, 0x2a,
-	0x2e, 0xd6, 0x3a, 0x5e, 0xb9, 0xab, 0x66, 0x67, 0x2c, 0xcf, 0x6c, 0x70, 0x85, 0x04, 0x12, 0x8f,
-	0x3b, 0x08, 0xf1, 0xf8, 0x04, 0x88, 0xaf, 0xc0, 0x01, 0x0e, 0x70, 0xe9, 0xb1, 0x37, 0x28, 0x07,
-	0x0b, 0x25, 0xdf, 0xc0, 0x9f, 0xa0, 0xda, 0x9d, 0xd9, 0xa7, 0x77, 0x63, 0x6f, 0x62, 0x37, 0xa7,
-	0xc4, 0x3b, 0xff, 0xf7, 0xff, 0x37, 0xbf, 0xff, 0x7f, 0x17, 0xde, 0x20, 0xd4, 0x22, 0xd4, 0xa4,
-	0x6a, 0x4b, 0xb7, 0x2c, 0xf5, 0x68, 0xbb, 0x61, 0x30, 0x7d, 0x5b, 0x65, 0x5d, 0xa5, 0xdd, 0x21,
-	0x8c, 0x48, 0xab, 0xe2, 0x58, 0x71, 0x8e, 0x15, 0x71, 0x2c, 0xaf, 0xb6, 0x48, 0x8b, 0xb8, 0x02,
-	0xaa, 0xf3, 0x1f, 0x97, 0x95, 0x4b, 0x07, 0xae, 0xb0, 0xda, 0xd0, 0xa9, 0xe1, 0x5b, 0x3a, 0x20,
-	0x26, 0xe6, 0xe7, 0xe8, 0x8f, 0x1c, 0x9c, 0xaf, 0xd1, 0xd6, 0x3d, 0x62, 0xe2, 0xfb, 0x84, 0x1c,
-	0x4a, 0xb7, 0xe0, 0x34, 0x35, 0x70, 0xd3, 0xe8, 0x14, 0xc1, 0x26, 0xd8, 0x9a, 0xab, 0xac, 0xf4,
-	0x7b, 0xe5, 0x85, 0x27, 0xba, 0x75, 0xf8, 0x0e, 0xe2, 0xcf, 0x91, 0x26, 0x04, 0xa4, 0xdb, 0x70,
-	0xa6, 0x4d, 0xc8, 0x61, 0xdd, 0x6c, 0x16, 0x73, 0x9b, 0x60, 0xab, 0x50, 0x91, 0xfa, 0xbd, 0xf2,
-	0x22, 0x97, 0x15, 0x07, 0x48, 0x9b, 0x76, 0xfe, 0xab, 0x36, 0xa5, 0x0e, 0x5c, 0xa6, 0x8f, 0xf4,
-	0x8e, 0x51, 0x27, 0x36, 0xab, 0xeb, 0x16, 0xb1, 0x31, 0x2b, 0xe6, 0x5d, 0x0f, 0x1f, 0x3f, 0xed,
-	0x95, 0xa7, 0xfe, 0xeb, 0x95, 0x5f, 0x6b, 0x99, 0xec, 0x91, 0xdd, 0x50, 0x0e, 0x88, 0xa5, 0x8a,
-	0xa0, 0xf9, 0x9f, 0x3b, 0xb4, 0xf9, 0x58, 0x65, 0x4f, 0xda, 0x06, 0x55, 0xaa, 0x98, 0xf5, 0x7b,
-	0xe5, 0xab, 0x21, 0x1f, 0xdc, 0x94, 0x63, 0x15, 0x69, 0x8b, 0xae, 0x87, 0x7d, 0x9b, 0xbd, 0xef,
-	0x3e, 0x94, 0x1a, 0x70, 0x81, 0x91, 0xc7, 0x06, 0xae, 0x9b, 0xb8, 0x6e, 0xe9, 0x5d, 0x5a, 0x2c,
-	0x6c, 0xe6, 0xb7, 0xe6, 0x77, 0x36, 0x14, 0x6e, 0x57, 0x71, 0x6a, 0xe2, 0x95, 0x4f, 0xf9, 0x80,
-	0x98, 0xb8, 0xf2, 0x8a, 0x13, 0x4b, 0xbf, 0x57, 0xbe, 0xc6, 0x3d, 0x84, 0xb5, 0x85, 0x27, 0x8a,
-	0xb4, 0x79, 0xf7, 0x71, 0x15, 0xd7, 0xf4, 0x2e, 0x45, 0xcf, 0x01, 0xbc, 0x12, 0xaa, 0x9f, 0x66,
-	0xd0, 0x36, 0xc1, 0xd4, 0x90, 0x68, 0x42, 0xbe, 0xbc, 0xa2, 0xd5, 0xcc, 0xf9, 0xae, 0x8b, 0xfa,
-	0xc7, 0xec, 0x0d, 0x26, 0x5c, 0x83, 0xb3, 0x5e, 0xc8, 0xc5, 0xdc, 0xb0, 0x5c, 0xd7, 0x45, 0xae,
-	0x4b, 0xd1, 0x5c, 0x91, 0x36, 0x23, 0xf2, 0x43, 0x7f, 0x72, 0x6c, 0xec, 0x75, 0x4d, 0x36, 0x51,
-	0x6c, 0xb4, 0xe1, 0x12, 0xcf, 0xcd, 0xc4, 0x63, 0x82, 0x46, 0xcc, 0x1c, 0xd2, 0x16, 0xdc, 0x27,
-	0x55, 0x2c, 0x0a, 0x65, 0xc0, 0x45, 0x9e, 0xaf, 0x53, 0x4d, 0xcb, 0xc4, 0x23, 0x40, 0xe3, 0x55,
-	0x51, 0xae, 0xeb, 0xe1, 0x72, 0x09, 0xf5, 0x00, 0x1b, 0x97, 0xdd, 0xe7, 0xfb, 0x36, 0xab, 0x99,
-	0x98, 0xa2, 0x96, 0x8b, 0x0d, 0xaf, 0x7e, 0x3e, 0x36, 0xee, 0xc3, 0x39, 0x5f, 0xbd, 0x08, 0x86,
-	0x39, 0x2e, 0x0a, 0xc7, 0xcb, 0x31, 0xc7, 0x48, 0x9b, 0xf5, 0x9c, 0xa1, 0x6f, 0x00, 0x5c, 0x79,
-	0xf0, 0x99, 0xde, 0xe6, 0xe9, 0x55, 0xb1, 0x46, 0x6c, 0x66, 0x84, 0x9b, 0x00, 0x86, 0x36, 0xa1,
-	0x02, 0x97, 0x82, 0x9c, 0x9a, 0x06, 0x26, 0x96, 0xdb, 0xb9, 0xb9, 0x8a, 0x1c, 0x94, 0x35, 0x26,
-	0x80, 0xb4, 0x05, 0x2f, 0x82, 0x0f, 0xdd, 0xdf, 0xff, 0xe4, 0xe0, 0x6a, 0x8d, 0xb6, 0x9c, 0x48,
-	0xf6, 0xba, 0xfa, 0x01, 0xf3, 0xc2, 0xc9, 0x82, 0x9c, 0x3d, 0x38, 0xdd, 0x71, 0xa2, 0xa7, 0x02,
-	0xc1, 0xaf, 0x2b, 0x49, 0x6c, 0xa7, 0x0c, 0x64, 0x5b, 0x29, 0x38, 0x75, 0xd2, 0x84, 0x72, 0xe4,
-	0x2a, 0x38, 0x60, 0x3a, 0xdf, 0x55, 0x90, 0xbe, 0x80, 0xab, 0x49, 0x1d, 0x2f, 0x16, 0xdc, 0x74,
-	0x6a, 0x99, 0x71, 0x7a, 0x2d, 0x1d, 0x45, 0x48, 0x5b, 0x09, 0x81, 0x88, 0xe7, 0x88, 0x7e, 0x00,
-	0xf0, 0x7a, 0x52, 0x65, 0xc3, 0x7c, 0x13, 0x18, 0x1b, 0x0f, 0xdf, 0xc4, 0xed, 0x21, 0x6d, 0xd1,
-	0x0b, 0x4c, 0x44, 0xf5, 0x35, 0x80, 0x52, 0xd0, 0x88, 0x7d, 0x9b, 0x9d, 0x01, 0x77, 0xef, 0x79,
-	0x57, 0xd1, 0xc4, 0x23, 0xc3, 0xee, 0xb2, 0x68, 0x0b, 0x47, 0xdd, 0xf3, 0x1c, 0x5c, 0x1b, 0xac,
-	0xcd, 0xbe, 0xcd, 0xb2, 0xc0, 0xee, 0xa3, 0x18, 0xec, 0xb6, 0x86, 0xc1, 0xce, 0xcb, 0x36, 0x86,
-	0xbb, 0xcf, 0xe1, 0x95, 0x84, 0xa9, 0x21, 0xf8, 0xec, 0x93, 0xcc, 0xad, 0x90, 0x53, 0x07, 0x11,
-	0xd2, 0x96, 0x83, 0x39, 0x24, 0x68, 0x2d, 0x42, 0x2c, 0x85, 0x61, 0xa8, 0x1f, 0x85, 0x58, 0xbe,
-	0x07, 0xf0, 0x46, 0x62, 0x6d, 0x7d, 0xe0, 0xb5, 0x3d, 0xde, 0x08, 0x2e, 0x05, 0x38, 0x1f, 0x79,
-	0xc7, 0xcc, 0x79, 0x2c, 0xe3, 0x91, 0x37, 0xfa, 0x2b, 0x07, 0x37, 0xc4, 0xc8, 0xe5, 0x71, 0x31,
-	0xa3, 0x83, 0xcf, 0x42, 0x35, 0x99, 0x86, 0xd4, 0xf8, 0x09, 0x25, 0x98, 0xe7, 0xe3, 0x23, 0x94,
-	0x24, 0x9b, 0x48, 0x5b, 0xf1, 0xf6, 0x84, 0x80, 0x50, 0x7e, 0x01, 0xf0, 0x66, 0x6a, 0x11, 0x2f,
-	0x74, 0x8b, 0x41, 0xbf, 0xe6, 0x23, 0xfd, 0x7d, 0xe0, 0x9c, 0x9e, 0xe9, 0x4e, 0x67, 0xea, 0xef,
-	0xbb, 0x03, 0x3c, 0xc4, 0xef, 0xec, 0x46, 0xbf, 0x57, 0x5e, 0x8b, 0x01, 0x33, 0x89, 0x86, 0x12,
-	0x6b, 0x55, 0x98, 0xf4, 0xc6, 0x97, 0x42, 0x37, 0x97, 0x5e, 0x06, 0xdd, 0xa0, 0x1f, 0xa3, 0x18,
-	0x8a, 0x36, 0xea, 0x02, 0x09, 0xe2, 0xb7, 0x3c, 0x2c, 0x8a, 0xbd, 0x2b, 0x16, 0xd7, 0x04, 0xf9,
-	0x21, 0x61, 0x7f, 0xca, 0x67, 0xdc, 0x9f, 0x92, 0x16, 0xe1, 0xc2, 0x64, 0x17, 0xe1, 0xb4, 0xbd,
-	0xe6, 0xd2, 0x4b, 0xda, 0x6b, 0x7e, 0x06, 0x70, 0x33, 0xad, 0x55, 0x17, 0xbb, 0xdb, 0xfc, 0x9d,
-	0x83, 0x72, 0x28, 0xb2, 0x30, 0x41, 0x4e, 0x92, 0x86, 0x22, 0x23, 0x3c, 0x3f, 0x86, 0x11, 0xee,
-	0x50, 0x84, 0x8f, 0x82, 0x10, 0x45, 0x14, 0xce, 0x47, 0x11, 0x09, 0x26, 0x91, 0xb6, 0x2c, 0xc0,
-	0x15, 0x50, 0xc4, 0x4f, 0x00, 0xa2, 0xf4, 0x2a, 0x86, 0x39, 0x22, 0x0e, 0x7c, 0x30, 0x51, 0xe0,
-	0xef, 0xfc, 0x3e, 0x03, 0xf3, 0x35, 0xda, 0x92, 0x1e, 0xc2, 0x59, 0xff, 0xdb, 0xc7, 0xcd, 0xe4,
-	0x9d, 0x2f, 0xf4, 0x7a, 0x2f, 0xdf, 0x1a, 0x2a, 0xe2, 0xe7, 0xf4, 0x10, 0xce, 0xfa, 0x6f, 0xce,
-	0xe9, 0x96, 0x3d, 0x91, 0x53, 0x2c, 0x0f, 0xbc, 0x3f, 0x52, 0xfe, 0xb2, 0x17, 0x7d, 0xc5, 0x7a,
-	0x23, 0x55, 0x7f, 0x40, 0x56, 0xde, 0x19, 0x5d, 0xd6, 0x77, 0x7a, 0xc4, 0x57, 0xfd, 0xd8, 0x86,
-	0x7d, 0x7b, 0x54, 0x4b, 0xfb, 0x36, 0x93, 0x77, 0x33, 0x08, 0xfb, 0x7e, 0xbf, 0x02, 0xf0, 0x6a,
-	0xca, 0xaa, 0xa7, 0x9e, 0xda, 0x8c, 0x41, 0x05, 0xf9, 0xad, 0x8c, 0x0a, 0x89, 0x41, 0xc4, 0xf6,
-	0x91, 0xe1, 0x41, 0x44, 0x15, 0x46, 0x08, 0x22, 0x65, 0x90, 0x7e, 0x0b, 0xe0, 0x7a, 0x1a, 0x1d,
-	0xdd, 0x3d, 0x15, 0x3d, 0x09, 0x1a, 0xf2, 0xdb, 0x59, 0x35, 0xfc, 0x38, 0xbe, 0x84, 0x6b, 0xc9,
-	0xa3, 0x55, 0x19, 0x6a, 0x32, 0x22, 0x2f, 0xbf, 0x99, 0x4d, 0xde, 0x0b, 0xa0, 0x72, 0xef, 0xe9,
-	0x71, 0x09, 0x3c, 0x3b, 0x2e, 0x81, 0xff, 0x8f, 0x4b, 0xe0, 0xbb, 0x93, 0xd2, 0xd4, 0xb3, 0x93,
-	0xd2, 0xd4, 0xbf, 0x27, 0xa5, 0xa9, 0x4f, 0xef, 0x86, 0x68, 0x42, 0xd8, 0xbe, 0x73, 0xa8, 0x37,
-	0xa8, 0xf7, 0x43, 0x3d, 0xda, 0xde, 0x55, 0xbb, 0xfc, 0xb3, 0xaa, 0x4b, 0x1a, 0x8d, 0x69, 0xf7,
-	0x33, 0xe8, 0xee, 0x8b, 0x00, 0x00, 0x00, 0xff, 0xff, 0x66, 0x91, 0x23, 0xe6, 0x73, 0x15, 0x00,
-	0x00,
+	// 1518 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x4d, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xcf, 0xd8, 0xae, 0x9b, 0x3e, 0x6d, 0xde, 0x36, 0x49, 0xeb, 0x6c, 0x5b, 0x3b, 0x9d, 0xff,
+	0x5f, 0x25, 0x69, 0xd5, 0x75, 0x9b, 0x4a, 0x14, 0x71, 0x01, 0x4c, 0x12, 0xe1, 0xaa, 0x26, 0xd5,
+	0x56, 0x48, 0x15, 0x17, 0x6b, 0x6d, 0x4f, 0xb7, 0xab, 0x7a, 0x77, 0x2c, 0xef, 0x3a, 0x71, 0x40,
+	0x02, 0x44, 0xe1, 0x0e, 0x42, 0x85, 0x7e, 0x02, 0xc4, 0x87, 0x28, 0x1c, 0x40, 0x48, 0x3d, 0xf6,
+	0x06, 0xe5, 0x60, 0x50, 0x7b, 0xe0, 0x9e, 0x4f, 0x80, 0x76, 0x67, 0xf6, 0xd5, 0xeb, 0xd8, 0xdb,
+	0xc4, 0xcd, 0x29, 0xde, 0x99, 0x67, 0x9e, 0xd7, 0xdf, 0xfc, 0xe6, 0x99, 0x09, 0x9c, 0xa7, 0xa6,
+	0x4e, 0x4d, 0xcd, 0x2c, 0xaa, 0x8a, 0xae, 0x17, 0xb7, 0xaf, 0xd5, 0x88, 0xa5, 0x5c, 0x2b, 0x5a,
+	0x5d, 0xa9, 0xd5, 0xa6, 0x16, 0x15, 0x16, 0xf8, 0xb4, 0x64, 0x4f, 0x4b, 0x7c, 0x5a, 0x5c, 0x50,
+	0xa9, 0x4a, 0x1d, 0x81, 0xa2, 0xfd, 0x8b, 0xc9, 0x8a, 0xf9, 0xba, 0x23, 0x5c, 0xac, 0x29, 0x26,
+	0xf1, 0x34, 0xd5, 0xa9, 0x66, 0xb8, 0xf3, 0x2a, 0xa5, 0x6a, 0x93, 0x14, 0x9d, 0xaf, 0x5a, 0xe7,
+	0x5e, 0xb1, 0xd1, 0x69, 0x2b, 0x96, 0x46, 0xf9, 0x3c, 0xfe, 0x39, 0x05, 0x27, 0x2b, 0xa6, 0x7a,
+	0x93, 0x6a, 0xc6, 0x6d, 0x4a, 0x9b, 0xc2, 0x2a, 0x64, 0x4d, 0x62, 0x34, 0x48, 0x3b, 0x87, 0x96,
+	0xd1, 0xca, 0x89, 0xd2, 0xdc, 0x5e, 0xaf, 0x30, 0xb5, 0xab, 0xe8, 0xcd, 0xb7, 0x31, 0x1b, 0xc7,
+	0x32, 0x17, 0x10, 0x2e, 0xc3, 0xf1, 0x16, 0xa5, 0xcd, 0xaa, 0xd6, 0xc8, 0xa5, 0x96, 0xd1, 0x4a,
+	0xa6, 0x24, 0xec, 0xf5, 0x0a, 0xd3, 0x4c, 0x96, 0x4f, 0x60, 0x39, 0x6b, 0xff, 0x2a, 0x37, 0x84,
+	0x36, 0xcc, 0x9a, 0xf7, 0x95, 0x36, 0xa9, 0xd2, 0x8e, 0x55, 0x55, 0x74, 0xda, 0x31, 0xac, 0x5c,
+	0xda, 0xb1, 0xf0, 0xc1, 0xd3, 0x5e, 0x61, 0xe2, 0xaf, 0x5e, 0xe1, 0xa2, 0xaa, 0x59, 0xf7, 0x3b,
+	0x35, 0xa9, 0x4e, 0xf5, 0x22, 0x0f, 0x8a, 0xfd, 0xb9, 0x62, 0x36, 0x1e, 0x14, 0xad, 0xdd, 0x16,
+	0x31, 0xa5, 0xb2, 0x61, 0xed, 0xf5, 0x0a, 0xa7, 0x03, 0x36, 0x98, 0x2a, 0x5b, 0x2b, 0x96, 0xa7,
+	0x1d, 0x0b, 0x5b, 0x1d, 0xeb, 0x3d, 0x67, 0x50, 0xa8, 0xc1, 0x94, 0x45, 0x1f, 0x10, 0xa3, 0xaa,
+	0x19, 0x55, 0x5d, 0xe9, 0x9a, 0xb9, 0xcc, 0x72, 0x7a, 0xe5, 0xe4, 0xda, 0x92, 0xc4, 0xf4, 0x4a,
+	0x76, 0xce, 0xdc, 0xf4, 0x4a, 0xef, 0x53, 0xcd, 0x28, 0xfd, 0xcf, 0xf6, 0x65, 0xaf, 0x57, 0x38,
+	0xcb, 0x2c, 0x04, 0x57, 0x73, 0x4b, 0x26, 0x96, 0x4f, 0x3a, 0xc3, 0x65, 0xa3, 0xa2, 0x74, 0x4d,
+	0xfc, 0x1c, 0xc1, 0x7c, 0x20, 0x7f, 0x32, 0x31, 0x5b, 0xd4, 0x30, 0x89, 0x60, 0xc6, 0xc4, 0xcb,
+	0x32, 0x5a, 0x4e, 0x1c, 0xef, 0x19, 0x9e, 0xff, 0x88, 0xbe, 0xfe, 0x80, 0x2b, 0x30, 0xe9, 0xba,
+	0x9c, 0x4b, 0x0d, 0x8b, 0xf5, 0x0c, 0x8f, 0x75, 0x26, 0x1c, 0x2b, 0x96, 0x8f, 0xf3, 0xf8, 0xf0,
+	0x2f, 0x0c, 0x1b, 0x1b, 0x5d, 0xcd, 0x1a, 0x2b, 0x36, 0x5a, 0x30, 0xc3, 0x62, 0xd3, 0x8c, 0x43,
+	0x82, 0x46, 0x44, 0x1d, 0x96, 0xa7, 0x9c, 0x91, 0xb2, 0xc1, 0x13, 0x45, 0x60, 0x9a, 0xc5, 0x6b,
+	0x67, 0x53, 0xd7, 0x8c, 0x11, 0xa0, 0xf1, 0x7f, 0x9e, 0xae, 0x73, 0xc1, 0x74, 0xf1, 0xe5, 0x3e,
+	0x36, 0x4e, 0x39, 0xe3, 0x5b, 0x1d, 0xab, 0xa2, 0x19, 0x26, 0x56, 0x61, 0x3e, 0x90, 0x3f, 0x0f,
+	0x1b, 0xb7, 0xe1, 0x84, 0xb7, 0x3c, 0x87, 0x86, 0x19, 0xce, 0x71, 0xc3, 0xb3, 0x11, 0xc3, 0x58,
+	0x9e, 0x74, 0x8d, 0xe1, 0xaf, 0x10, 0xcc, 0xdd, 0xd9, 0x51, 0x5a, 0x2c, 0xbc, 0xb2, 0x21, 0xd3,
+	0x8e, 0x45, 0x82, 0x45, 0x40, 0x43, 0x8b, 0x50, 0x82, 0x19, 0x3f, 0xa6, 0x06, 0x31, 0xa8, 0xee,
+	0x54, 0xee, 0x44, 0x49, 0xf4, 0xd3, 0x1a, 0x11, 0xc0, 0xf2, 0x94, 0xeb, 0xc1, 0xba, 0xf3, 0xfd,
+	0x47, 0x0a, 0x16, 0x2a, 0xa6, 0x6a, 0x7b, 0xb2, 0xd1, 0x55, 0xea, 0x96, 0xeb, 0x4e, 0x12, 0xe4,
+	0x6c, 0x40, 0xb6, 0x6d, 0x7b, 0x6f, 0x72, 0x04, 0xbf, 0x21, 0xc5, 0xb1, 0xa1, 0xd4, 0x17, 0x6d,
+	0x29, 0x63, 0xe7, 0x49, 0xe6, 0x8b, 0x43, 0x5b, 0xc1, 0x06, 0xd3, 0xc1, 0xb6, 0x82, 0xf0, 0x19,
+	0x2c, 0xc4, 0x55, 0x3c, 0x97, 0x71, 0xc2, 0xa9, 0x24, 0xc6, 0xe9, 0xd9, 0xc1, 0x28, 0xc2, 0xf2,
+	0x5c, 0x00, 0x44, 0x2c, 0x46, 0xfc, 0x1d, 0x82, 0x73, 0x71, 0x99, 0x0d, 0xf2, 0x8d, 0xaf, 0xec,
+	0x70, 0xf8, 0x26, 0xaa, 0x0f, 0xcb, 0xd3, 0xae, 0x63, 0xdc, 0xab, 0x87, 0x08, 0x04, 0xbf, 0x10,
+	0x5b, 0x1d, 0xeb, 0x15, 0x70, 0xf7, 0xae, 0xbb, 0x15, 0x35, 0x63, 0x64, 0xd8, 0x9d, 0xe2, 0x65,
+	0x61, 0xa8, 0x7b, 0x9e, 0x82, 0xc5, 0xfe, 0xdc, 0x6c, 0x75, 0xac, 0x24, 0xb0, 0xdb, 0x8c, 0xc0,
+	0x6e, 0x65, 0x18, 0xec, 0xdc, 0x68, 0x23, 0xb8, 0xfb, 0x14, 0xe6, 0x63, 0x4e, 0x0d, 0xce, 0x67,
+	0xb7, 0x12, 0x97, 0x42, 0x1c, 0x78, 0x10, 0x61, 0x79, 0xd6, 0x3f, 0x87, 0x38, 0xad, 0x85, 0x88,
+	0x25, 0xb3, 0x8c, 0x0e, 0x4e, 0x2c, 0xdf, 0x22, 0x38, 0x1f, 0x9b, 0x5b, 0x0f, 0x78, 0x2d, 0x97,
+	0x37, 0xfc, 0x4d, 0x81, 0x0e, 0x46, 0xde, 0x11, 0x75, 0x2e, 0xcb, 0xb8, 0xe4, 0x8d, 0x7f, 0x4d,
+	0xc1, 0x12, 0x3f, 0x72, 0x99, 0x5f, 0x16, 0x69, 0x1b, 0xaf, 0x42, 0x35, 0x89, 0x0e, 0xa9, 0xc3,
+	0x27, 0x14, 0xff, 0x3c, 0x3f, 0x3c, 0x42, 0x89, 0xd3, 0x89, 0xe5, 0x39, 0xb7, 0x4f, 0xf0, 0x09,
+	0xe5, 0x31, 0x82, 0x0b, 0x03, 0x93, 0x78, 0xa4, 0x5d, 0x0c, 0xfe, 0x31, 0x1d, 0xaa, 0xef, 0x1d,
+	0x7b, 0xf6, 0x95, 0xf6, 0x74, 0xa2, 0xfa, 0xbe, 0xd3, 0xc7, 0x43, 0x6c, 0xcf, 0x2e, 0xed, 0xf5,
+	0x0a, 0x8b, 0x11, 0x60, 0xc6, 0xd1, 0x50, 0x6c, 0xae, 0x32, 0xe3, 0xee, 0xf8, 0x06, 0xd0, 0xcd,
+	0xb1, 0xd7, 0x41, 0x37, 0xf8, 0x51, 0x18, 0x43, 0xe1, 0x42, 0x1d, 0x21, 0x41, 0xfc, 0x94, 0x86,
+	0x1c, 0xef, 0xbb, 0x22, 0x7e, 0x8d, 0x91, 0x1f, 0x62, 0xfa, 0xa7, 0x74, 0xc2, 0xfe, 0x29, 0xae,
+	0x11, 0xce, 0x8c, 0xb7, 0x11, 0x1e, 0xd4, 0xd7, 0x1c, 0x7b, 0x4d, 0x7d, 0xcd, 0x0f, 0x08, 0x96,
+	0x07, 0x95, 0xea, 0x68, 0x7b, 0x9b, 0xdf, 0x52, 0x20, 0x06, 0x3c, 0x0b, 0x12, 0xe4, 0x38, 0x69,
+	0x28, 0x74, 0x84, 0xa7, 0x0f, 0xe1, 0x08, 0xb7, 0x29, 0xc2, 0x43, 0x41, 0x80, 0x22, 0x32, 0x07,
+	0xa3, 0x88, 0x18, 0x95, 0x58, 0x9e, 0xe5, 0xe0, 0xf2, 0x29, 0xe2, 0x7b, 0x04, 0x78, 0x70, 0x16,
+	0x83, 0x1c, 0x11, 0x05, 0x3e, 0x1a, 0x2b, 0xf0, 0xf1, 0x93, 0x34, 0x2c, 0xac, 0xef, 0x1a, 0x8a,
+	0xae, 0xd5, 0x6d, 0xc7, 0x36, 0x09, 0xb9, 0xad, 0xb4, 0x15, 0xdd, 0x14, 0x54, 0x38, 0x65, 0x63,
+	0xd6, 0xdc, 0x51, 0x5a, 0xd5, 0x7b, 0x84, 0x70, 0x3f, 0x36, 0x12, 0xf8, 0xb1, 0x4e, 0xea, 0x7b,
+	0xbd, 0xc2, 0x3c, 0xf3, 0x23, 0xa8, 0x0b, 0xcb, 0xa0, 0x33, 0xaa, 0xdc, 0x24, 0xc4, 0x31, 0xa4,
+	0x74, 0x7d, 0x43, 0xa9, 0x03, 0x1a, 0x52, 0xba, 0x21, 0x43, 0x4a, 0xd7, 0x35, 0x74, 0x0b, 0xb2,
+	0x3b, 0x9a, 0xd1, 0xa0, 0x3b, 0x1e, 0x9e, 0xd8, 0x9b, 0x90, 0xe4, 0xbe, 0x09, 0x49, 0xeb, 0xfc,
+	0x4d, 0xa8, 0xb4, 0xc4, 0xf1, 0xc4, 0x91, 0xcc, 0x96, 0xe1, 0xc7, 0x7f, 0x17, 0x90, 0xcc, 0x75,
+	0x08, 0x0f, 0x11, 0x2c, 0x6e, 0xd3, 0xa6, 0x62, 0x69, 0x4d, 0xcd, 0xda, 0xad, 0xea, 0x9d, 0xa6,
+	0xa5, 0xb5, 0x9a, 0x1a, 0x69, 0x73, 0x44, 0x7d, 0x98, 0x38, 0x00, 0x7e, 0xa3, 0x8e, 0x55, 0x8a,
+	0xe5, 0x05, 0x7f, 0xbc, 0xe2, 0x0f, 0xff, 0x8e, 0xd8, 0x4d, 0x93, 0x58, 0xe1, 0x22, 0x8e, 0x6d,
+	0x5f, 0x7e, 0x04, 0xd9, 0x96, 0x03, 0x10, 0x9e, 0xc4, 0x4b, 0xf1, 0xf7, 0x83, 0x38, 0x48, 0x05,
+	0x7d, 0x60, 0x3a, 0x6c, 0xb5, 0xec, 0x47, 0x1e, 0xce, 0xc5, 0x85, 0xe1, 0x6e, 0x0c, 0xfc, 0x6f,
+	0x1a, 0x66, 0x2b, 0xa6, 0x5a, 0xd1, 0xd4, 0xb6, 0x62, 0x11, 0x87, 0x1e, 0xcd, 0x24, 0x31, 0x96,
+	0x60, 0x86, 0x87, 0x52, 0x6d, 0x12, 0x65, 0x5b, 0x33, 0x54, 0x1e, 0xab, 0x18, 0x79, 0x47, 0xf3,
+	0x05, 0xb0, 0x3c, 0xc5, 0x62, 0xbe, 0xc5, 0xbe, 0x85, 0x0d, 0x98, 0x75, 0x45, 0x88, 0x61, 0x91,
+	0xb6, 0xad, 0x24, 0xed, 0x28, 0x39, 0xeb, 0x13, 0x6a, 0x54, 0x02, 0xcb, 0xd3, 0x4c, 0xcb, 0x06,
+	0x1f, 0x38, 0x9a, 0xc3, 0x2d, 0xb6, 0xc7, 0x3e, 0xf6, 0x7a, 0x7a, 0x6c, 0x1b, 0x60, 0x4d, 0x5a,
+	0x7f, 0x60, 0x03, 0x2c, 0x1b, 0x05, 0x18, 0x9f, 0xc0, 0x72, 0xd6, 0xfe, 0x55, 0x6e, 0xe0, 0x27,
+	0x08, 0x72, 0xd1, 0x4a, 0x1f, 0xed, 0x6b, 0x62, 0xc0, 0xfd, 0xd4, 0x50, 0xf7, 0x1f, 0x21, 0x07,
+	0xa8, 0x77, 0x88, 0xf3, 0xd4, 0xb5, 0xd9, 0xa6, 0x9f, 0x90, 0xf1, 0xf5, 0x5a, 0xab, 0x90, 0xbd,
+	0xe7, 0x58, 0x70, 0x70, 0x38, 0x19, 0xd4, 0xcb, 0xc6, 0xb1, 0xcc, 0x05, 0xb0, 0x08, 0xb9, 0xa8,
+	0x5b, 0x6e, 0x56, 0xd7, 0xbe, 0x00, 0x48, 0x57, 0x4c, 0x55, 0xb8, 0x0b, 0x93, 0xde, 0xfb, 0xf7,
+	0x85, 0xf8, 0x7d, 0x1d, 0x78, 0xe2, 0x15, 0x57, 0x87, 0x8a, 0x78, 0x75, 0xbb, 0x0b, 0x93, 0xde,
+	0xeb, 0xe9, 0x60, 0xcd, 0xae, 0x88, 0xb8, 0x3a, 0x54, 0x24, 0x80, 0x88, 0xb9, 0xfe, 0x67, 0xb6,
+	0x4b, 0x03, 0xd7, 0xf7, 0xc9, 0x8a, 0x6b, 0xa3, 0xcb, 0x7a, 0x46, 0xb7, 0x41, 0x88, 0x4c, 0xda,
+	0x0d, 0xc6, 0xe5, 0x51, 0x35, 0x6d, 0x75, 0x2c, 0xf1, 0x7a, 0x02, 0x61, 0xcf, 0xee, 0x97, 0x08,
+	0x4e, 0x0f, 0xb8, 0xee, 0x17, 0xf7, 0x2d, 0x46, 0xff, 0x02, 0xf1, 0x46, 0xc2, 0x05, 0xb1, 0x4e,
+	0x44, 0xee, 0xa4, 0xc3, 0x9d, 0x08, 0x2f, 0x10, 0x6f, 0x24, 0x5c, 0xe0, 0x39, 0xf1, 0x35, 0x82,
+	0x33, 0x83, 0x5a, 0xd2, 0xab, 0xfb, 0xa2, 0x27, 0x66, 0x85, 0xf8, 0x56, 0xd2, 0x15, 0x9e, 0x1f,
+	0x9f, 0xc3, 0x62, 0xfc, 0xf5, 0x4a, 0x1a, 0xaa, 0x32, 0x24, 0x2f, 0xbe, 0x99, 0x4c, 0x3e, 0x84,
+	0xff, 0xbe, 0xc3, 0x7f, 0x1f, 0xfc, 0x47, 0x65, 0xc5, 0xb5, 0xd1, 0x65, 0x3d, 0xa3, 0x2a, 0x4c,
+	0x85, 0x4f, 0xe2, 0x8b, 0x03, 0x95, 0x84, 0xe4, 0x44, 0x69, 0x34, 0xb9, 0xa0, 0xa1, 0x30, 0x93,
+	0x5e, 0xdc, 0xcf, 0x5b, 0x5f, 0x4e, 0x94, 0x46, 0x93, 0x73, 0x0d, 0x95, 0x6e, 0x3e, 0x7d, 0x91,
+	0x47, 0xcf, 0x5e, 0xe4, 0xd1, 0x3f, 0x2f, 0xf2, 0xe8, 0x9b, 0x97, 0xf9, 0x89, 0x67, 0x2f, 0xf3,
+	0x13, 0x7f, 0xbe, 0xcc, 0x4f, 0x7c, 0x7c, 0x35, 0x70, 0xa0, 0x70, 0x9d, 0x57, 0x9a, 0x4a, 0xcd,
+	0x74, 0x3f, 0x8a, 0xdb, 0xd7, 0xae, 0x17, 0xbb, 0xec, 0x3f, 0x98, 0xce, 0xf1, 0x52, 0xcb, 0x3a,
+	0xfd, 0xe4, 0xf5, 0xff, 0x06, 0x00, 0x3a, 0x7a, 0xe6, 0xfe, 0xde, 0x1c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1063,6 +1471,9 @@ type MsgClient interface {
 	JoinSwapShareAmountOut(ctx context.Context, in *MsgJoinSwapShareAmountOut, opts ...grpc.CallOption) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(ctx context.Context, in *MsgExitSwapExternAmountOut, opts ...grpc.CallOption) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapShareAmountIn, opts ...grpc.CallOption) (*MsgExitSwapShareAmountInResponse, error)
+	SetDynamicSwapFee(ctx context.Context, in *MsgSetDynamicSwapFee, opts ...grpc.CallOption) (*MsgSetDynamicSwapFeeResponse, error)
+	MigrateShares(ctx context.Context, in *MsgMigrateShares, opts ...grpc.CallOption) (*MsgMigrateSharesResponse, error)
+	SetPoolFrozen(ctx context.Context, in *MsgSetPoolFrozen, opts ...grpc.CallOption) (*MsgSetPoolFrozenResponse, error)
 }
 
 type msgClient struct {
@@ -1145,6 +1556,33 @@ func (c *msgClient) ExitSwapShareAmountIn(ctx context.Context, in *MsgExitSwapSh
 	return out, nil
 }
 
+func (c *msgClient) SetDynamicSwapFee(ctx context.Context, in *MsgSetDynamicSwapFee, opts ...grpc.CallOption) (*MsgSetDynamicSwapFeeResponse, error) {
+	out := new(MsgSetDynamicSwapFeeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SetDynamicSwapFee", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) MigrateShares(ctx context.Context, in *MsgMigrateShares, opts ...grpc.CallOption) (*MsgMigrateSharesResponse, error) {
+	out := new(MsgMigrateSharesResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/MigrateShares", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPoolFrozen(ctx context.Context, in *MsgSetPoolFrozen, opts ...grpc.CallOption) (*MsgSetPoolFrozenResponse, error) {
+	out := new(MsgSetPoolFrozenResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.gamm.v1beta1.Msg/SetPoolFrozen", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	JoinPool(context.Context, *MsgJoinPool) (*MsgJoinPoolResponse, error)
@@ -1155,6 +1593,9 @@ type MsgServer interface {
 	JoinSwapShareAmountOut(context.Context, *MsgJoinSwapShareAmountOut) (*MsgJoinSwapShareAmountOutResponse, error)
 	ExitSwapExternAmountOut(context.Context, *MsgExitSwapExternAmountOut) (*MsgExitSwapExternAmountOutResponse, error)
 	ExitSwapShareAmountIn(context.Context, *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error)
+	SetDynamicSwapFee(context.Context, *MsgSetDynamicSwapFee) (*MsgSetDynamicSwapFeeResponse, error)
+	MigrateShares(context.Context, *MsgMigrateShares) (*MsgMigrateSharesResponse, error)
+	SetPoolFrozen(context.Context, *MsgSetPoolFrozen) (*MsgSetPoolFrozenResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1185,6 +1626,15 @@ func (*UnimplementedMsgServer) ExitSwapExternAmountOut(ctx context.Context, req
 func (*UnimplementedMsgServer) ExitSwapShareAmountIn(ctx context.Context, req *MsgExitSwapShareAmountIn) (*MsgExitSwapShareAmountInResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ExitSwapShareAmountIn not implemented")
 }
+func (*UnimplementedMsgServer) SetDynamicSwapFee(ctx context.Context, req *MsgSetDynamicSwapFee) (*MsgSetDynamicSwapFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDynamicSwapFee not implemented")
+}
+func (*UnimplementedMsgServer) MigrateShares(ctx context.Context, req *MsgMigrateShares) (*MsgMigrateSharesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MigrateShares not implemented")
+}
+func (*UnimplementedMsgServer) SetPoolFrozen(ctx context.Context, req *MsgSetPoolFrozen) (*MsgSetPoolFrozenResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPoolFrozen not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1334,30 +1784,84 @@ func _Msg_ExitSwapShareAmountIn_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "osmosis.gamm.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "JoinPool",
-			Handler:    _Msg_JoinPool_Handler,
-		},
-		{
-			MethodName: "ExitPool",
-			Handler:    _Msg_ExitPool_Handler,
-		},
-		{
-			MethodName: "SwapExactAmountIn",
-			Handler:    _Msg_SwapExactAmountIn_Handler,
-		},
-		{
-			MethodName: "SwapExactAmountOut",
-			Handler:    _Msg_SwapExactAmountOut_Handler,
-		},
-		{
-			MethodName: "JoinSwapExternAmountIn",
-			Handler:    _Msg_JoinSwapExternAmountIn_Handler,
-		},
+func _Msg_SetDynamicSwapFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDynamicSwapFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDynamicSwapFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SetDynamicSwapFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDynamicSwapFee(ctx, req.(*MsgSetDynamicSwapFee))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_MigrateShares_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgMigrateShares)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).MigrateShares(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/MigrateShares",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).MigrateShares(ctx, req.(*MsgMigrateShares))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPoolFrozen_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPoolFrozen)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPoolFrozen(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.gamm.v1beta1.Msg/SetPoolFrozen",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPoolFrozen(ctx, req.(*MsgSetPoolFrozen))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "osmosis.gamm.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "JoinPool",
+			Handler:    _Msg_JoinPool_Handler,
+		},
+		{
+			MethodName: "ExitPool",
+			Handler:    _Msg_ExitPool_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountIn",
+			Handler:    _Msg_SwapExactAmountIn_Handler,
+		},
+		{
+			MethodName: "SwapExactAmountOut",
+			Handler:    _Msg_SwapExactAmountOut_Handler,
+		},
+		{
+			MethodName: "JoinSwapExternAmountIn",
+			Handler:    _Msg_JoinSwapExternAmountIn_Handler,
+		},
 		{
 			MethodName: "JoinSwapShareAmountOut",
 			Handler:    _Msg_JoinSwapShareAmountOut_Handler,
@@ -1370,6 +1874,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "ExitSwapShareAmountIn",
 			Handler:    _Msg_ExitSwapShareAmountIn_Handler,
 		},
+		{
+			MethodName: "SetDynamicSwapFee",
+			Handler:    _Msg_SetDynamicSwapFee_Handler,
+		},
+		{
+			MethodName: "MigrateShares",
+			Handler:    _Msg_MigrateShares_Handler,
+		},
+		{
+			MethodName: "SetPoolFrozen",
+			Handler:    _Msg_SetPoolFrozen_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/gamm/v1beta1/tx.proto",
@@ -2207,127 +2723,429 @@ func (m *MsgExitSwapExternAmountOutResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *DynamicSwapFeeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgJoinPool) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *DynamicSwapFeeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DynamicSwapFeeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.VolatilityMultiplier.Size()
+		i -= size
+		if _, err := m.VolatilityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+	i--
+	dAtA[i] = 0x22
+	n5, err5 := github_com_gogo_protobuf_types.StdDurationMarshalTo(m.Window, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window):])
+	if err5 != nil {
+		return 0, err5
 	}
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenInMaxs) > 0 {
-		for _, e := range m.TokenInMaxs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i -= n5
+	i = encodeVarintTx(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSwapFee.Size()
+		i -= size
+		if _, err := m.MaxSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
-}
-
-func (m *MsgJoinPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ShareOutAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenIn) > 0 {
-		for _, e := range m.TokenIn {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSwapFee.Size()
+		i -= size
+		if _, err := m.MinSwapFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExitPool) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetDynamicSwapFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDynamicSwapFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDynamicSwapFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.ShareInAmount.Size()
-	n += 1 + l + sovTx(uint64(l))
-	if len(m.TokenOutMins) > 0 {
-		for _, e := range m.TokenOutMins {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExitPoolResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.TokenOut) > 0 {
-		for _, e := range m.TokenOut {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
-		}
+func (m *MsgSetDynamicSwapFeeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *SwapAmountInRoute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetDynamicSwapFeeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDynamicSwapFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.PoolId != 0 {
-		n += 1 + sovTx(uint64(m.PoolId))
-	}
-	l = len(m.TokenOutDenom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSwapExactAmountIn) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgMigrateShares) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateShares) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateShares) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x30
 	}
-	if len(m.Routes) > 0 {
-		for _, e := range m.Routes {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	{
+		size := m.ShareOutMinAmount.Size()
+		i -= size
+		if _, err := m.ShareOutMinAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.ShareInAmount.Size()
+		i -= size
+		if _, err := m.ShareInAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.PoolIdEntering != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdEntering))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolIdLeaving != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolIdLeaving))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgMigrateSharesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgMigrateSharesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgMigrateSharesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LockId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LockId))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.ShareOutAmount.Size()
+		i -= size
+		if _, err := m.ShareOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolFrozen) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFrozen) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFrozen) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Frozen {
+		i--
+		if m.Frozen {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PoolId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPoolFrozenResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPoolFrozenResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPoolFrozenResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgJoinPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenInMaxs) > 0 {
+		for _, e := range m.TokenInMaxs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgJoinPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenIn) > 0 {
+		for _, e := range m.TokenIn {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPool) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.TokenOutMins) > 0 {
+		for _, e := range m.TokenOutMins {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExitPoolResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenOut) > 0 {
+		for _, e := range m.TokenOut {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SwapAmountInRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSwapExactAmountIn) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
 		}
 	}
 	l = m.TokenIn.Size()
@@ -2530,14 +3348,128 @@ func (m *MsgExitSwapExternAmountOutResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *DynamicSwapFeeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.MaxSwapFee.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = github_com_gogo_protobuf_types.SizeOfStdDuration(m.Window)
+	n += 1 + l + sovTx(uint64(l))
+	l = m.VolatilityMultiplier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
 }
-func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *MsgSetDynamicSwapFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Params != nil {
+		l = m.Params.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetDynamicSwapFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgMigrateShares) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolIdLeaving != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdLeaving))
+	}
+	if m.PoolIdEntering != 0 {
+		n += 1 + sovTx(uint64(m.PoolIdEntering))
+	}
+	l = m.ShareInAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.ShareOutMinAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgMigrateSharesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ShareOutAmount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.LockId != 0 {
+		n += 1 + sovTx(uint64(m.LockId))
+	}
+	return n
+}
+
+func (m *MsgSetPoolFrozen) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PoolId != 0 {
+		n += 1 + sovTx(uint64(m.PoolId))
+	}
+	if m.Frozen {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetPoolFrozenResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgJoinPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -2770,9 +3702,998 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = append(m.TokenIn, types.Coin{})
+			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
+			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOut = append(m.TokenOut, types.Coin{})
+			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountInRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountInRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwapAmountOutRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwapAmountOutRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, SwapAmountOutRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2782,23 +4703,23 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenIn = append(m.TokenIn, types.Coin{})
-			if err := m.TokenIn[len(m.TokenIn)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2823,7 +4744,7 @@ func (m *MsgJoinPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2846,10 +4767,10 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPool: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPool: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2905,41 +4826,7 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMins", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2966,66 +4853,15 @@ func (m *MsgExitPool) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutMins = append(m.TokenOutMins, types.Coin{})
-			if err := m.TokenOutMins[len(m.TokenOutMins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitPoolResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3035,23 +4871,23 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOut = append(m.TokenOut, types.Coin{})
-			if err := m.TokenOut[len(m.TokenOut)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3076,7 +4912,7 @@ func (m *MsgExitPoolResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3099,34 +4935,15 @@ func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapAmountInRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapAmountInRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3154,7 +4971,9 @@ func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3177,7 +4996,7 @@ func (m *SwapAmountInRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3200,10 +5019,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3239,10 +5058,10 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var msglen int
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3252,31 +5071,16 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Routes = append(m.Routes, SwapAmountInRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3286,28 +5090,27 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3335,63 +5138,13 @@ func (m *MsgSwapExactAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTx(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTx
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTx
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3419,7 +5172,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3444,7 +5197,7 @@ func (m *MsgSwapExactAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
+func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3467,34 +5220,15 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SwapAmountOutRoute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SwapAmountOutRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
-			}
-			m.PoolId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3522,7 +5256,9 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3545,7 +5281,7 @@ func (m *SwapAmountOutRoute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3568,10 +5304,10 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3607,10 +5343,29 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3620,29 +5375,27 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Routes = append(m.Routes, SwapAmountOutRoute{})
-			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3670,15 +5423,15 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3688,22 +5441,23 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3728,7 +5482,7 @@ func (m *MsgSwapExactAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3751,15 +5505,15 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSwapExactAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3787,7 +5541,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3812,7 +5566,7 @@ func (m *MsgSwapExactAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3835,10 +5589,10 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3894,7 +5648,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -3921,13 +5675,13 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInMaxAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3955,7 +5709,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -3980,7 +5734,7 @@ func (m *MsgJoinSwapExternAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4003,15 +5757,15 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapExternAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4039,7 +5793,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4064,7 +5818,7 @@ func (m *MsgJoinSwapExternAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
+func (m *DynamicSwapFeeParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4087,15 +5841,15 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: DynamicSwapFeeParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: DynamicSwapFeeParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSwapFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4123,13 +5877,15 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.MinSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapFee", wireType)
 			}
-			m.PoolId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4139,14 +5895,146 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_gogo_protobuf_types.StdDurationUnmarshal(&m.Window, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VolatilityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VolatilityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetDynamicSwapFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetDynamicSwapFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetDynamicSwapFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4174,13 +6062,13 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
 			}
-			var stringLen uint64
+			m.PoolId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4190,31 +6078,16 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInMaxAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4224,23 +6097,25 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Params == nil {
+				m.Params = &DynamicSwapFeeParams{}
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4265,7 +6140,7 @@ func (m *MsgJoinSwapShareAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDynamicSwapFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4288,46 +6163,12 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDynamicSwapFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgJoinSwapShareAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDynamicSwapFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4349,7 +6190,7 @@ func (m *MsgJoinSwapShareAmountOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateShares) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4372,10 +6213,10 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateShares: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountIn: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateShares: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4412,9 +6253,9 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdLeaving", wireType)
 			}
-			m.PoolId = 0
+			m.PoolIdLeaving = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4424,16 +6265,16 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PoolId |= uint64(b&0x7F) << shift
+				m.PoolIdLeaving |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolIdEntering", wireType)
 			}
-			var stringLen uint64
+			m.PoolIdEntering = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4443,24 +6284,11 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.PoolIdEntering |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
@@ -4497,7 +6325,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutMinAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutMinAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4525,10 +6353,29 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutMinAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4550,7 +6397,7 @@ func (m *MsgExitSwapShareAmountIn) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgMigrateSharesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4573,15 +6420,15 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgMigrateSharesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapShareAmountInResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgMigrateSharesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareOutAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4609,10 +6456,29 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ShareOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LockId", wireType)
+			}
+			m.LockId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LockId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4634,7 +6500,7 @@ func (m *MsgExitSwapShareAmountInResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolFrozen) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4657,10 +6523,10 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolFrozen: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOut: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolFrozen: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4715,43 +6581,10 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenOut", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.TokenOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInMaxAmount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Frozen", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4761,26 +6594,12 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareInMaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.Frozen = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4802,7 +6621,7 @@ func (m *MsgExitSwapExternAmountOut) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPoolFrozenResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4825,46 +6644,12 @@ func (m *MsgExitSwapExternAmountOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPoolFrozenResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExitSwapExternAmountOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPoolFrozenResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ShareInAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ShareInAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -190,6 +190,41 @@ func (s *IntegrationTestSuite) TestGetCmdGaugeByID() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdGaugePayoutSchedule() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name      string
+		args      []string
+		expectErr bool
+		respType  proto.Message
+	}{
+		{
+			"query gauge payout schedule",
+			[]string{"1", fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			false,
+			&types.GaugePayoutScheduleResponse{},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdGaugePayoutSchedule()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			if tc.expectErr {
+				s.Require().Error(err)
+			} else {
+				s.Require().NoError(err, out.String())
+				s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), tc.respType), out.String())
+			}
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdActiveGauges() {
 	val := s.network.Validators[0]
 
@@ -8,14 +8,16 @@ import (
 
 // Flags for incentives module tx commands.
 const (
-	FlagDuration  = "duration"
-	FlagStartTime = "start-time"
-	FlagEpochs    = "epochs"
-	FlagPerpetual = "perpetual"
-	FlagTimestamp = "timestamp"
-	FlagOwner     = "owner"
-	FlagLockIds   = "lock-ids"
-	FlagEndEpoch  = "end-epoch"
+	FlagDuration     = "duration"
+	FlagStartTime    = "start-time"
+	FlagEpochs       = "epochs"
+	FlagPerpetual    = "perpetual"
+	FlagTimestamp    = "timestamp"
+	FlagEpochWeights = "epoch-weights"
+	FlagDecayFactor  = "decay-factor"
+	FlagOwner        = "owner"
+	FlagLockIds      = "lock-ids"
+	FlagEndEpoch     = "end-epoch"
 )
 
 // FlagSetCreateGauge returns flags for creating gauges.
@@ -27,5 +29,8 @@ func FlagSetCreateGauge() *flag.FlagSet {
 	fs.String(FlagStartTime, "", "Timestamp to begin distribution")
 	fs.Uint64(FlagEpochs, 0, "Total epochs to distribute tokens")
 	fs.Bool(FlagPerpetual, false, "Perpetual distribution")
+	fs.String(FlagTimestamp, "", "Distribute to locks unlocking after this time, rather than to locks of the duration")
+	fs.String(FlagEpochWeights, "", "Comma separated relative amounts to pay out each epoch, e.g. 3,2,1. Total epochs default to the number of weights")
+	fs.String(FlagDecayFactor, "", "Ratio of each epoch's payout to the previous epoch's payout, in (0, 1]")
 	return fs
 }
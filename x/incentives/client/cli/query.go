@@ -31,6 +31,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdUpcomingGauges(),
 		GetCmdUpcomingGaugesPerDenom(),
 		GetCmdRewardsEst(),
+		GetCmdGaugePayoutSchedule(),
 	)
 
 	return cmd
@@ -66,6 +67,16 @@ func GetCmdGaugeByID() *cobra.Command {
 `, types.ModuleName, types.NewQueryClient)
 }
 
+// GetCmdGaugePayoutSchedule returns the coins a gauge is expected to pay out in each of its remaining epochs.
+func GetCmdGaugePayoutSchedule() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*types.GaugePayoutScheduleRequest](
+		"gauge-payout-schedule [id]",
+		"Query the coins a gauge is expected to pay out in each of its remaining epochs.",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} gauge-payout-schedule 1
+`, types.ModuleName, types.NewQueryClient)
+}
+
 // GetCmdActiveGauges returns active gauges.
 func GetCmdActiveGauges() *cobra.Command {
 	return osmocli.SimpleQueryCmd[*types.ActiveGaugesRequest](
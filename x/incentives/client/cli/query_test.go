@@ -55,6 +55,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&types.GaugeByIDRequest{Id: 1},
 			&types.GaugeByIDResponse{},
 		},
+		{
+			"Query gauge payout schedule",
+			"/osmosis.incentives.Query/GaugePayoutSchedule",
+			&types.GaugePayoutScheduleRequest{Id: 1},
+			&types.GaugePayoutScheduleResponse{},
+		},
 		{
 			"Query all gauges",
 			"/osmosis.incentives.Query/Gauges",
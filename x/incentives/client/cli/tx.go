@@ -2,7 +2,9 @@ package cli
 
 import (
 	"errors"
+	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/spf13/cobra"
@@ -23,6 +25,7 @@ func GetTxCmd() *cobra.Command {
 	cmd.AddCommand(
 		NewCreateGaugeCmd(),
 		NewAddToGaugeCmd(),
+		NewCancelGaugeCmd(),
 	)
 
 	return cmd
@@ -48,18 +51,12 @@ func NewCreateGaugeCmd() *cobra.Command {
 				return err
 			}
 
-			var startTime time.Time
 			timeStr, err := cmd.Flags().GetString(FlagStartTime)
 			if err != nil {
 				return err
 			}
-			if timeStr == "" { // empty start time
-				startTime = time.Unix(0, 0)
-			} else if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil { // unix time
-				startTime = time.Unix(timeUnix, 0)
-			} else if timeRFC, err := time.Parse(time.RFC3339, timeStr); err == nil { // RFC time
-				startTime = timeRFC
-			} else { // invalid input
+			startTime, err := parseGaugeTime(timeStr)
+			if err != nil {
 				return errors.New("invalid start time format")
 			}
 
@@ -77,16 +74,36 @@ func NewCreateGaugeCmd() *cobra.Command {
 				epochs = 1
 			}
 
+			payoutSchedule, err := parsePayoutSchedule(cmd)
+			if err != nil {
+				return err
+			}
+			if payoutSchedule != nil && epochs == 0 {
+				epochs = uint64(len(payoutSchedule.EpochWeights))
+			}
+
 			duration, err := cmd.Flags().GetDuration(FlagDuration)
 			if err != nil {
 				return err
 			}
 
+			timestampStr, err := cmd.Flags().GetString(FlagTimestamp)
+			if err != nil {
+				return err
+			}
+			timestamp, err := parseGaugeTime(timestampStr)
+			if err != nil {
+				return errors.New("invalid timestamp format")
+			}
+
 			distributeTo := lockuptypes.QueryCondition{
 				LockQueryType: lockuptypes.ByDuration,
 				Denom:         denom,
 				Duration:      duration,
-				Timestamp:     time.Unix(0, 0), // XXX check
+				Timestamp:     timestamp,
+			}
+			if timestampStr != "" {
+				distributeTo.LockQueryType = lockuptypes.ByTime
 			}
 
 			msg := types.NewMsgCreateGauge(
@@ -97,6 +114,7 @@ func NewCreateGaugeCmd() *cobra.Command {
 				startTime,
 				epochs,
 			)
+			msg.PayoutSchedule = payoutSchedule
 
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
@@ -107,9 +125,60 @@ func NewCreateGaugeCmd() *cobra.Command {
 	return cmd
 }
 
+// parseGaugeTime parses a unix or RFC3339 time, or returns the unix epoch if timeStr is empty.
+func parseGaugeTime(timeStr string) (time.Time, error) {
+	if timeStr == "" {
+		return time.Unix(0, 0), nil
+	}
+	if timeUnix, err := strconv.ParseInt(timeStr, 10, 64); err == nil {
+		return time.Unix(timeUnix, 0), nil
+	}
+	return time.Parse(time.RFC3339, timeStr)
+}
+
+// parsePayoutSchedule parses the payout schedule flags, or returns nil if neither is set.
+func parsePayoutSchedule(cmd *cobra.Command) (*types.PayoutSchedule, error) {
+	weightsStr, err := cmd.Flags().GetString(FlagEpochWeights)
+	if err != nil {
+		return nil, err
+	}
+	decayFactorStr, err := cmd.Flags().GetString(FlagDecayFactor)
+	if err != nil {
+		return nil, err
+	}
+	if weightsStr == "" && decayFactorStr == "" {
+		return nil, nil
+	}
+
+	payoutSchedule := types.PayoutSchedule{}
+	if weightsStr != "" {
+		for _, weightStr := range strings.Split(weightsStr, ",") {
+			weight, err := sdk.NewDecFromStr(strings.TrimSpace(weightStr))
+			if err != nil {
+				return nil, fmt.Errorf("invalid epoch weight %s: %w", weightStr, err)
+			}
+			payoutSchedule.EpochWeights = append(payoutSchedule.EpochWeights, weight)
+		}
+	}
+	if decayFactorStr != "" {
+		payoutSchedule.DecayFactor, err = sdk.NewDecFromStr(decayFactorStr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid decay factor %s: %w", decayFactorStr, err)
+		}
+	}
+	return &payoutSchedule, nil
+}
+
 func NewAddToGaugeCmd() *cobra.Command {
 	return osmocli.BuildTxCli[*types.MsgAddToGauge](&osmocli.TxCliDesc{
 		Use:   "add-to-gauge [gauge_id] [rewards] [flags]",
 		Short: "add coins to gauge to distribute more rewards to users",
 	})
 }
+
+func NewCancelGaugeCmd() *cobra.Command {
+	return osmocli.BuildTxCli[*types.MsgCancelGauge](&osmocli.TxCliDesc{
+		Use:   "cancel-gauge [gauge_id] [flags]",
+		Short: "cancel a non-perpetual gauge and refund its undistributed coins to the owner",
+	})
+}
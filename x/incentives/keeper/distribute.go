@@ -80,6 +80,8 @@ func (k Keeper) moveActiveGaugeToFinishedGauge(ctx sdk.Context, gauge types.Gaug
 
 // getLocksToDistributionWithMaxDuration returns locks that match the provided lockuptypes QueryCondition,
 // are greater than the provided minDuration, AND have yet to be distributed to.
+// For ByTime conditions, minDuration is ignored and the locks returned are those unlocking after
+// the condition's timestamp, counting locks not yet unlocking as if they began unlocking now.
 func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo lockuptypes.QueryCondition, minDuration time.Duration) []lockuptypes.PeriodLock {
 	switch distrTo.LockQueryType {
 	case lockuptypes.ByDuration:
@@ -89,17 +91,39 @@ func (k Keeper) getLocksToDistributionWithMaxDuration(ctx sdk.Context, distrTo l
 		}
 		return k.lk.GetLocksLongerThanDurationDenom(ctx, distrTo.Denom, distrTo.Duration)
 	case lockuptypes.ByTime:
-		panic("Gauge by time is present, however is no longer supported. This should have been blocked in ValidateBasic")
+		return k.lk.GetLocksPastTimeDenom(ctx, distrTo.Denom, distrTo.Timestamp)
 	default:
 	}
 	return []lockuptypes.PeriodLock{}
 }
 
+// getLocksPastTimeDenomCached returns the locks that the provided ByTime QueryCondition distributes to.
+// They are loaded from the lockup store at most once per (denom, timestamp) pair, and kept in the provided cache.
+func (k Keeper) getLocksPastTimeDenomCached(ctx sdk.Context, distrTo lockuptypes.QueryCondition, cache map[string][]lockuptypes.PeriodLock) []lockuptypes.PeriodLock {
+	cacheKey := string(combineKeys([]byte(distrTo.Denom), getTimeKey(distrTo.Timestamp)))
+	if _, ok := cache[cacheKey]; !ok {
+		cache[cacheKey] = k.getLocksToDistributionWithMaxDuration(ctx, distrTo, time.Millisecond)
+	}
+	return cache[cacheKey]
+}
+
+// getTotalLockedForDistribution returns the total amount of tokens locked in locks that match
+// the provided lockuptypes QueryCondition. The totals of ByTime conditions are summed from their
+// locks, which are kept in the provided cache.
+func (k Keeper) getTotalLockedForDistribution(ctx sdk.Context, distrTo lockuptypes.QueryCondition, cache map[string][]lockuptypes.PeriodLock) sdk.Int {
+	if distrTo.LockQueryType == lockuptypes.ByTime {
+		locks := k.getLocksPastTimeDenomCached(ctx, distrTo, cache)
+		return lockuptypes.SumLocksByDenom(locks, distrTo.Denom)
+	}
+	return k.lk.GetPeriodLocksAccumulation(ctx, distrTo)
+}
+
 // FilteredLocksDistributionEst estimates distribution amount of coins from gauge.
 // It also applies an update for the gauge, handling the sending of the rewards.
 // (Note this update is in-memory, it does not change state.)
-func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge, filteredLocks []lockuptypes.PeriodLock) (types.Gauge, sdk.Coins, bool, error) {
-	TotalAmtLocked := k.lk.GetPeriodLocksAccumulation(ctx, gauge.DistributeTo)
+// The locks of ByTime gauges are kept in the provided cache, so that estimating several epochs loads them once.
+func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge, filteredLocks []lockuptypes.PeriodLock, cache map[string][]lockuptypes.PeriodLock) (types.Gauge, sdk.Coins, bool, error) {
+	TotalAmtLocked := k.getTotalLockedForDistribution(ctx, gauge.DistributeTo, cache)
 	if TotalAmtLocked.IsZero() {
 		return types.Gauge{}, nil, false, nil
 	}
@@ -107,24 +131,10 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		return types.Gauge{}, nil, true, nil
 	}
 
-	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
-	// remainEpochs is the number of remaining epochs that the gauge will pay out its rewards.
-	// for a perpetual gauge, it will pay out everything in the next epoch, and we don't make
-	// an assumption of the rate at which it will get refilled at.
-	remainEpochs := uint64(1)
-	if !gauge.IsPerpetual {
-		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
-	}
-	if remainEpochs == 0 {
+	if !gauge.IsPerpetual && gauge.FilledEpochs >= gauge.NumEpochsPaidOver {
 		return gauge, sdk.Coins{}, false, nil
 	}
-
-	remainCoinsPerEpoch := sdk.Coins{}
-	for _, coin := range remainCoins {
-		// distribution amount per epoch = gauge_size / (remain_epochs)
-		amt := coin.Amount.QuoRaw(int64(remainEpochs))
-		remainCoinsPerEpoch = remainCoinsPerEpoch.Add(sdk.NewCoin(coin.Denom, amt))
-	}
+	remainCoinsPerEpoch := getEpochPayout(gauge)
 
 	// now we compute the filtered coins
 	filteredDistrCoins := sdk.Coins{}
@@ -134,6 +144,10 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 		// distribution in next epoch = gauge_size  / (remain_epochs)
 		filteredDistrCoins = remainCoinsPerEpoch
 	}
+	// locks unlocking before the timestamp of a ByTime gauge are not distributed to.
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		filteredLocks = FilterLocksByMinEndTime(filteredLocks, ctx.BlockTime(), gauge.DistributeTo.Timestamp)
+	}
 	for _, lock := range filteredLocks {
 		denomLockAmt := lock.Coins.AmountOf(gauge.DistributeTo.Denom)
 
@@ -152,6 +166,40 @@ func (k Keeper) FilteredLocksDistributionEst(ctx sdk.Context, gauge types.Gauge,
 	return gauge, filteredDistrCoins, false, nil
 }
 
+// getEpochPayout returns the coins the gauge pays out in total in its next epoch.
+// A perpetual gauge pays out everything in the next epoch, and we don't make an assumption
+// of the rate at which it will get refilled at. A gauge with a payout schedule pays out
+// the schedule's share of its remaining coins, and any other gauge pays out its remaining
+// coins evenly over its remaining epochs.
+func getEpochPayout(gauge types.Gauge) sdk.Coins {
+	remainCoins := gauge.Coins.Sub(gauge.DistributedCoins)
+	if gauge.IsPerpetual {
+		return remainCoins
+	}
+	remainEpochs := gauge.NumEpochsPaidOver - gauge.FilledEpochs
+	if remainEpochs == 0 {
+		return sdk.Coins{}
+	}
+	weight, remainWeight := sdk.OneDec(), sdk.OneDec()
+	if gauge.PayoutSchedule != nil {
+		weight, remainWeight = gauge.PayoutSchedule.NextPayoutWeight(gauge.FilledEpochs, gauge.NumEpochsPaidOver)
+	}
+
+	payout := sdk.Coins{}
+	for _, coin := range remainCoins {
+		var amt sdk.Int
+		if gauge.PayoutSchedule != nil {
+			// distribution amount per epoch = gauge_size * epoch_weight / remain_weight
+			amt = coin.Amount.ToDec().Mul(weight).Quo(remainWeight).TruncateInt()
+		} else {
+			// distribution amount per epoch = gauge_size / (remain_epochs)
+			amt = coin.Amount.QuoRaw(int64(remainEpochs))
+		}
+		payout = payout.Add(sdk.NewCoin(coin.Denom, amt))
+	}
+	return payout
+}
+
 // distributionInfo stores all of the information for pent up sends for rewards distributions.
 // This enables us to lower the number of events and calls to back.
 type distributionInfo struct {
@@ -279,6 +327,11 @@ func (k Keeper) distributeInternal(
 	if !gauge.IsPerpetual {
 		remainEpochs = gauge.NumEpochsPaidOver - gauge.FilledEpochs
 	}
+	// if it has a payout schedule, the gauge only pays out the schedule's share of its remaining coins this epoch.
+	if gauge.PayoutSchedule != nil && !gauge.IsPerpetual {
+		remainCoins = getEpochPayout(gauge)
+		remainEpochs = 1
+	}
 
 	for _, lock := range locks {
 		distrCoins := sdk.Coins{}
@@ -310,6 +363,7 @@ func (k Keeper) distributeInternal(
 
 // updateGaugePostDistribute increments the gauge's filled epochs field.
 // Also adds the coins that were just distributed to the gauge's distributed coins field.
+// Filled epochs index the gauge's payout schedule, if it has one, so this moves the gauge on to its next scheduled payout.
 func (k Keeper) updateGaugePostDistribute(ctx sdk.Context, gauge types.Gauge, newlyDistributedCoins sdk.Coins) error {
 	gauge.FilledEpochs += 1
 	gauge.DistributedCoins = gauge.DistributedCoins.Add(newlyDistributedCoins...)
@@ -325,8 +379,13 @@ func (k Keeper) getDistributeToBaseLocks(ctx sdk.Context, gauge types.Gauge, cac
 	if gauge.Coins.Empty() {
 		return []lockuptypes.PeriodLock{}
 	}
+	// ByTime gauges are cached by their denom and timestamp, as their locks depend on the gauge's
+	// timestamp rather than on its denom alone. They can't distribute to synthetic lockups.
+	if gauge.DistributeTo.LockQueryType == lockuptypes.ByTime {
+		return k.getLocksPastTimeDenomCached(ctx, gauge.DistributeTo, cache)
+	}
 	// Confusingly, there is no way to get all synthetic lockups. Thus we use a separate method `distributeSyntheticInternal` to separately get lockSum for synthetic lockups.
-	// All gauges have a precondition of being ByDuration.
+	// All other gauges are ByDuration.
 	distributeBaseDenom := lockuptypes.NativeDenom(gauge.DistributeTo.Denom)
 	if _, ok := cache[distributeBaseDenom]; !ok {
 		cache[distributeBaseDenom] = k.getLocksToDistributionWithMaxDuration(
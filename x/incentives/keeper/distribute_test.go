@@ -278,6 +278,7 @@ func (suite *KeeperTestSuite) TestNoLockPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -325,6 +326,7 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 
@@ -345,3 +347,175 @@ func (suite *KeeperTestSuite) TestNoLockNonPerpetualGaugeDistribution() {
 	suite.Require().Len(gauges, 1)
 	suite.Require().Equal(gauges[0].String(), expectedGauge.String())
 }
+
+// TestByTimeDistribute tests that a gauge distributing by time rewards the locks unlocking after
+// its timestamp, counting locks that are not unlocking as if they began unlocking now,
+// and that its rewards are estimated accordingly. A gauge of the same denom with an earlier
+// timestamp is distributed in the same epoch, and must not reuse the first gauge's locks.
+func (suite *KeeperTestSuite) TestByTimeDistribute() {
+	suite.SetupTest()
+	timestamp := suite.Ctx.BlockTime().Add(90 * time.Second)
+	earlierTimestamp := suite.Ctx.BlockTime().Add(30 * time.Second)
+	locks := []struct {
+		duration          time.Duration
+		isUnlocking       bool
+		isRewarded        bool
+		isRewardedEarlier bool
+	}{
+		{duration: time.Hour, isRewarded: true, isRewardedEarlier: true},
+		{duration: time.Second},
+		{duration: time.Hour, isUnlocking: true, isRewarded: true, isRewardedEarlier: true},
+		{duration: time.Minute, isUnlocking: true, isRewardedEarlier: true},
+	}
+	addrs := make([]sdk.AccAddress, len(locks))
+	for i, l := range locks {
+		addrs[i] = suite.setupAddr(i, "", defaultLPTokens)
+		lock, err := suite.App.LockupKeeper.CreateLock(suite.Ctx, addrs[i], defaultLPTokens, l.duration)
+		suite.Require().NoError(err)
+		if l.isUnlocking {
+			err = suite.App.LockupKeeper.BeginUnlock(suite.Ctx, lock.ID, nil)
+			suite.Require().NoError(err)
+		}
+	}
+
+	rewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 2000)}
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         defaultLPDenom,
+		Timestamp:     timestamp,
+	}
+	_, gauge := suite.CreateGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), rewards, distrTo, suite.Ctx.BlockTime(), 1)
+
+	// the two rewarded locks split the rewards.
+	expectedRewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 1000)}
+	for i, l := range locks {
+		rewardsEst := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, addrs[i], []lockuptypes.PeriodLock{}, 100)
+		if l.isRewarded {
+			suite.Require().Equal(expectedRewards.String(), rewardsEst.String(), "lock %d", i)
+		} else {
+			suite.Require().True(rewardsEst.Empty(), "lock %d", i)
+		}
+	}
+
+	// the three locks rewarded by the earlier gauge split its rewards.
+	earlierRewards := sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 3000)}
+	distrTo.Timestamp = earlierTimestamp
+	_, earlierGauge := suite.CreateGauge(true, sdk.AccAddress([]byte("Gauge_Creation_Addr_")), earlierRewards, distrTo, suite.Ctx.BlockTime(), 1)
+
+	// System under test.
+	distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge, *earlierGauge})
+
+	suite.Require().NoError(err)
+	suite.Require().Equal(rewards.Add(earlierRewards...), distributed)
+	for i, l := range locks {
+		expectedBal := sdk.ZeroInt()
+		if l.isRewarded {
+			expectedBal = expectedBal.AddRaw(1000)
+		}
+		if l.isRewardedEarlier {
+			expectedBal = expectedBal.AddRaw(1000)
+		}
+		bal := suite.App.BankKeeper.GetBalance(suite.Ctx, addrs[i], defaultRewardDenom)
+		suite.Require().Equal(expectedBal.String(), bal.Amount.String(), "lock %d", i)
+	}
+}
+
+// TestScheduledGaugeDistribute tests that a gauge with a payout schedule pays out the schedule's
+// amount each epoch, and that its payout schedule and rewards are estimated accordingly.
+func (suite *KeeperTestSuite) TestScheduledGaugeDistribute() {
+	tests := map[string]struct {
+		payoutSchedule  types.PayoutSchedule
+		rewards         sdk.Coins
+		expectedPayouts []sdk.Coins
+	}{
+		"epoch weights": {
+			payoutSchedule: types.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.NewDec(3), sdk.NewDec(2), sdk.NewDec(1)}},
+			rewards:        sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 600)},
+			expectedPayouts: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 300)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 200)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+			},
+		},
+		"epoch weights with a zero weight epoch": {
+			payoutSchedule: types.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.ZeroDec(), sdk.NewDec(1)}},
+			rewards:        sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+			expectedPayouts: []sdk.Coins{
+				{},
+				{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+			},
+		},
+		"decay factor": {
+			payoutSchedule: types.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(5, 1)},
+			rewards:        sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 700), sdk.NewInt64Coin("stake", 70)},
+			expectedPayouts: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 400), sdk.NewInt64Coin("stake", 40)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 200), sdk.NewInt64Coin("stake", 20)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 100), sdk.NewInt64Coin("stake", 10)},
+			},
+		},
+		"decay factor of one pays out evenly": {
+			payoutSchedule: types.PayoutSchedule{DecayFactor: sdk.OneDec()},
+			rewards:        sdk.Coins{sdk.NewInt64Coin(defaultRewardDenom, 300)},
+			expectedPayouts: []sdk.Coins{
+				{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+				{sdk.NewInt64Coin(defaultRewardDenom, 100)},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			lockOwner := sdk.AccAddress([]byte("addr1---------------"))
+			suite.LockTokens(lockOwner, defaultLPTokens, defaultLockDuration)
+			distrTo := lockuptypes.QueryCondition{
+				LockQueryType: lockuptypes.ByDuration,
+				Denom:         defaultLPDenom,
+				Duration:      defaultLockDuration,
+			}
+			suite.FundAcc(defaultGaugeCreator, tc.rewards)
+			numEpochs := uint64(len(tc.expectedPayouts))
+			gaugeID, err := suite.App.IncentivesKeeper.CreateScheduledGauge(suite.Ctx, defaultGaugeCreator, tc.rewards, distrTo, suite.Ctx.BlockTime(), numEpochs, tc.payoutSchedule)
+			suite.Require().NoError(err)
+
+			// the payout schedule query returns the expected payouts, in consecutive epochs.
+			res, err := suite.querier.GaugePayoutSchedule(sdk.WrapSDKContext(suite.Ctx), &types.GaugePayoutScheduleRequest{Id: gaugeID})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Payouts, len(tc.expectedPayouts))
+			for i, payout := range res.Payouts {
+				suite.Require().Equal(res.Payouts[0].Epoch+int64(i), payout.Epoch)
+				suite.Require().Equal(tc.expectedPayouts[i].String(), payout.Coins.String(), "epoch %d", i)
+			}
+
+			// the only lock is estimated to get all the rewards.
+			rewardsEst := suite.App.IncentivesKeeper.GetRewardsEst(suite.Ctx, lockOwner, []lockuptypes.PeriodLock{}, 100)
+			suite.Require().Equal(tc.rewards.String(), rewardsEst.String())
+
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+			suite.Require().NoError(err)
+			for i, expectedPayout := range tc.expectedPayouts {
+				gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lockOwner)
+
+				// System under test.
+				distributed, err := suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+
+				suite.Require().NoError(err)
+				suite.Require().Equal(expectedPayout.String(), distributed.String(), "epoch %d", i)
+				balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, lockOwner)
+				suite.Require().Equal(balanceBefore.Add(expectedPayout...).String(), balanceAfter.String(), "epoch %d", i)
+			}
+
+			// the gauge has finished and has no payouts left.
+			suite.Require().Len(suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx), 1)
+			res, err = suite.querier.GaugePayoutSchedule(sdk.WrapSDKContext(suite.Ctx), &types.GaugePayoutScheduleRequest{Id: gaugeID})
+			suite.Require().NoError(err)
+			suite.Require().Len(res.Payouts, 0)
+		})
+	}
+}
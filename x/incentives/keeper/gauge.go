@@ -92,6 +92,20 @@ func (k Keeper) SetGaugeWithRefKey(ctx sdk.Context, gauge *types.Gauge) error {
 
 // CreateGauge creates a gauge and sends coins to the gauge.
 func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64) (uint64, error) {
+	return k.createGauge(ctx, isPerpetual, owner, coins, distrTo, startTime, numEpochsPaidOver, nil)
+}
+
+// CreateScheduledGauge creates a non-perpetual gauge that pays out its coins over the provided payout schedule,
+// and sends coins to the gauge.
+func (k Keeper) CreateScheduledGauge(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, payoutSchedule types.PayoutSchedule) (uint64, error) {
+	if err := payoutSchedule.Validate(numEpochsPaidOver); err != nil {
+		return 0, err
+	}
+	return k.createGauge(ctx, false, owner, coins, distrTo, startTime, numEpochsPaidOver, &payoutSchedule)
+}
+
+// createGauge creates a gauge with an optional payout schedule and sends coins to the gauge.
+func (k Keeper) createGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddress, coins sdk.Coins, distrTo lockuptypes.QueryCondition, startTime time.Time, numEpochsPaidOver uint64, payoutSchedule *types.PayoutSchedule) (uint64, error) {
 	// Ensure that this gauge's duration is one of the allowed durations on chain
 	durations := k.GetLockableDurations(ctx)
 	if distrTo.LockQueryType == lockuptypes.ByDuration {
@@ -119,6 +133,8 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 		Coins:             coins,
 		StartTime:         startTime,
 		NumEpochsPaidOver: numEpochsPaidOver,
+		Owner:             owner.String(),
+		PayoutSchedule:    payoutSchedule,
 	}
 
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, gauge.Coins); err != nil {
@@ -142,12 +158,20 @@ func (k Keeper) CreateGauge(ctx sdk.Context, isPerpetual bool, owner sdk.AccAddr
 	return gauge.Id, nil
 }
 
-// AddToGaugeRewards adds coins to gauge.
+// AddToGaugeRewards adds coins to an upcoming or active gauge, as a finished gauge would never distribute them.
+// Only the owner of a gauge that can be cancelled can add to it, as cancelling refunds all of its
+// undistributed coins to the owner.
 func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins sdk.Coins, gaugeID uint64) error {
 	gauge, err := k.GetGaugeByID(ctx, gaugeID)
 	if err != nil {
 		return err
 	}
+	if _, found := k.getNotFinishedGaugeRefKey(ctx, *gauge); !found {
+		return fmt.Errorf("gauge %d has already finished", gaugeID)
+	}
+	if isCancellable(*gauge) && gauge.Owner != owner.String() {
+		return fmt.Errorf("only the owner of gauge %d can add to it, as it can be cancelled", gaugeID)
+	}
 	if err := k.bk.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, coins); err != nil {
 		return err
 	}
@@ -161,6 +185,72 @@ func (k Keeper) AddToGaugeRewards(ctx sdk.Context, owner sdk.AccAddress, coins s
 	return nil
 }
 
+// CancelGauge moves an upcoming or active non-perpetual gauge to the finished gauges and refunds
+// the coins it has not distributed yet to its owner. Only the gauge owner can cancel a gauge.
+// Returns the refunded coins.
+func (k Keeper) CancelGauge(ctx sdk.Context, owner sdk.AccAddress, gaugeID uint64) (sdk.Coins, error) {
+	gauge, err := k.GetGaugeByID(ctx, gaugeID)
+	if err != nil {
+		return nil, err
+	}
+	if gauge.IsPerpetual {
+		return nil, fmt.Errorf("perpetual gauge %d can't be cancelled", gaugeID)
+	}
+	if !isCancellable(*gauge) || gauge.Owner != owner.String() {
+		return nil, fmt.Errorf("gauge %d can only be cancelled by its owner", gaugeID)
+	}
+
+	refKey, found := k.getNotFinishedGaugeRefKey(ctx, *gauge)
+	if !found {
+		return nil, fmt.Errorf("gauge %d has already finished", gaugeID)
+	}
+	if err := k.deleteGaugeRefByKey(ctx, refKey, gaugeID); err != nil {
+		return nil, err
+	}
+	if err := k.addGaugeRefByKey(ctx, combineKeys(types.KeyPrefixFinishedGauges, getTimeKey(gauge.StartTime)), gaugeID); err != nil {
+		return nil, err
+	}
+	if err := k.deleteGaugeIDForDenom(ctx, gaugeID, gauge.DistributeTo.Denom); err != nil {
+		return nil, err
+	}
+
+	refund := gauge.Coins.Sub(gauge.DistributedCoins)
+	if !refund.Empty() {
+		if err := k.bk.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, refund); err != nil {
+			return nil, err
+		}
+	}
+
+	// the finished gauge only keeps record of what it actually paid out, so it no longer has a payout schedule.
+	gauge.Coins = gauge.DistributedCoins
+	gauge.NumEpochsPaidOver = gauge.FilledEpochs
+	gauge.PayoutSchedule = nil
+	if err := k.setGauge(ctx, gauge); err != nil {
+		return nil, err
+	}
+	k.hooks.AfterFinishDistribution(ctx, gaugeID)
+	return refund, nil
+}
+
+// getNotFinishedGaugeRefKey returns the key of the upcoming or active gauges that reference the gauge,
+// and false if neither does, as the gauge has finished.
+func (k Keeper) getNotFinishedGaugeRefKey(ctx sdk.Context, gauge types.Gauge) ([]byte, bool) {
+	timeKey := getTimeKey(gauge.StartTime)
+	for _, prefix := range [][]byte{types.KeyPrefixUpcomingGauges, types.KeyPrefixActiveGauges} {
+		key := combineKeys(prefix, timeKey)
+		if findIndex(k.getGaugeRefs(ctx, key), gauge.Id) > -1 {
+			return key, true
+		}
+	}
+	return nil, false
+}
+
+// isCancellable returns true if the gauge can be cancelled by its owner, that is if it is not
+// perpetual and has an owner.
+func isCancellable(gauge types.Gauge) bool {
+	return !gauge.IsPerpetual && gauge.Owner != ""
+}
+
 // GetGaugeByID returns gauge from gauge ID.
 func (k Keeper) GetGaugeByID(ctx sdk.Context, gaugeID uint64) (*types.Gauge, error) {
 	gauge := types.Gauge{}
@@ -251,6 +341,7 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 
 	// no need to change storage while doing estimation as we use cached context
 	cacheCtx, _ := ctx.CacheContext()
+	locksCache := make(map[string][]lockuptypes.PeriodLock)
 	for _, gauge := range gauges {
 		distrBeginEpoch := epochInfo.CurrentEpoch
 		blockTime := ctx.BlockTime()
@@ -259,7 +350,7 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 		}
 
 		for epoch := distrBeginEpoch; epoch <= endEpoch; epoch++ {
-			newGauge, distrCoins, isBuggedGauge, err := k.FilteredLocksDistributionEst(cacheCtx, gauge, locks)
+			newGauge, distrCoins, isBuggedGauge, err := k.FilteredLocksDistributionEst(cacheCtx, gauge, locks, locksCache)
 			if err != nil {
 				continue
 			}
@@ -274,6 +365,33 @@ func (k Keeper) GetRewardsEst(ctx sdk.Context, addr sdk.AccAddress, locks []lock
 	return estimatedRewards
 }
 
+// GetGaugePayoutSchedule returns the coins the gauge is expected to pay out at the end of each of its
+// remaining epochs, assuming no coins are added to it. Perpetual gauges are expected to pay out all of
+// their coins in their next epoch. At most maxEpochs payouts are returned.
+func (k Keeper) GetGaugePayoutSchedule(ctx sdk.Context, gauge types.Gauge, maxEpochs int) []types.EpochPayout {
+	epochInfo := k.GetEpochInfo(ctx)
+	epoch := epochInfo.CurrentEpoch
+	blockTime := ctx.BlockTime()
+	if gauge.StartTime.After(blockTime) {
+		epoch = epochInfo.CurrentEpoch + 1 + int64(gauge.StartTime.Sub(blockTime)/epochInfo.Duration)
+	}
+
+	payouts := []types.EpochPayout{}
+	if gauge.IsPerpetual {
+		if coins := getEpochPayout(gauge); !coins.Empty() {
+			payouts = append(payouts, types.EpochPayout{Epoch: epoch, Coins: coins})
+		}
+		return payouts
+	}
+	for ; gauge.FilledEpochs < gauge.NumEpochsPaidOver && len(payouts) < maxEpochs; epoch++ {
+		coins := getEpochPayout(gauge)
+		payouts = append(payouts, types.EpochPayout{Epoch: epoch, Coins: coins})
+		gauge.FilledEpochs += 1
+		gauge.DistributedCoins = gauge.DistributedCoins.Add(coins...)
+	}
+	return payouts
+}
+
 // GetEpochInfo returns EpochInfo struct given context.
 func (k Keeper) GetEpochInfo(ctx sdk.Context) epochtypes.EpochInfo {
 	params := k.GetParams(ctx)
@@ -111,6 +111,7 @@ func (suite *KeeperTestSuite) TestGaugeOperations() {
 			FilledEpochs:      0,
 			DistributedCoins:  sdk.Coins{},
 			StartTime:         startTime,
+			Owner:             defaultGaugeCreator.String(),
 		}
 		suite.Require().Equal(expectedGauge.String(), gauges[0].String())
 
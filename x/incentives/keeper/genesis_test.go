@@ -63,6 +63,7 @@ func TestIncentivesExportGenesis(t *testing.T) {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins(nil),
 		StartTime:         startTime.UTC(),
+		Owner:             addr.String(),
 	})
 }
 
@@ -113,3 +114,126 @@ func TestIncentivesInitGenesis(t *testing.T) {
 	require.Len(t, gauges, 1)
 	require.Equal(t, gauges[0], gauge)
 }
+
+// TestIncentivesByTimeGaugeGenesis tests that a gauge distributing by time is exported, validated
+// and initialized, and that one without a timestamp fails validation.
+func TestIncentivesByTimeGaugeGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10000)}
+	err := simapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(sdk.NewInt64Coin("lptoken", 200)))
+	require.NoError(t, err)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByTime,
+		Denom:         "lptoken",
+		Timestamp:     ctx.BlockTime().Add(time.Hour).UTC(),
+	}
+	_, err = app.IncentivesKeeper.CreateGauge(ctx, false, addr, coins, distrTo, ctx.BlockTime(), 2)
+	require.NoError(t, err)
+
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Gauges, 1)
+	require.Equal(t, distrTo, genesis.Gauges[0].DistributeTo)
+
+	app = osmoapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	app.IncentivesKeeper.InitGenesis(ctx, *genesis)
+	require.Equal(t, genesis.Gauges, app.IncentivesKeeper.GetGauges(ctx))
+
+	genesis.Gauges[0].DistributeTo.Timestamp = time.Unix(0, 0)
+	require.Error(t, genesis.Validate())
+}
+
+// TestIncentivesScheduledGaugeGenesis tests that a gauge with a payout schedule is exported, validated
+// and initialized with its schedule, and that an invalid schedule fails validation.
+func TestIncentivesScheduledGaugeGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10000)}
+	err := simapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(sdk.NewInt64Coin("lptoken", 200)))
+	require.NoError(t, err)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "lptoken",
+		Duration:      time.Second,
+	}
+	payoutSchedule := types.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.NewDec(2), sdk.OneDec()}}
+	_, err = app.IncentivesKeeper.CreateScheduledGauge(ctx, addr, coins, distrTo, ctx.BlockTime(), 2, payoutSchedule)
+	require.NoError(t, err)
+
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Gauges, 1)
+	require.Equal(t, payoutSchedule.EpochWeights, genesis.Gauges[0].PayoutSchedule.EpochWeights)
+
+	app = osmoapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	app.IncentivesKeeper.InitGenesis(ctx, *genesis)
+	require.Equal(t, genesis.Gauges, app.IncentivesKeeper.GetGauges(ctx))
+
+	genesis.Gauges[0].NumEpochsPaidOver = 3
+	require.Error(t, genesis.Validate())
+}
+
+// TestIncentivesCancelledScheduledGaugeGenesis tests that gauges with a payout schedule that are
+// cancelled, before or after they paid out, are kept without their schedule, so that they pass
+// genesis validation and can be initialized.
+func TestIncentivesCancelledScheduledGaugeGenesis(t *testing.T) {
+	app := osmoapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockTime(time.Now())
+
+	addr := sdk.AccAddress([]byte("addr1---------------"))
+	coins := sdk.Coins{sdk.NewInt64Coin("stake", 10000)}
+	lockCoins := sdk.Coins{sdk.NewInt64Coin("lptoken", 200)}
+	err := simapp.FundAccount(app.BankKeeper, ctx, addr, coins.Add(coins...).Add(lockCoins...))
+	require.NoError(t, err)
+	_, err = app.LockupKeeper.CreateLock(ctx, addr, lockCoins, time.Second)
+	require.NoError(t, err)
+
+	distrTo := lockuptypes.QueryCondition{
+		LockQueryType: lockuptypes.ByDuration,
+		Denom:         "lptoken",
+		Duration:      time.Second,
+	}
+	weightsGaugeID, err := app.IncentivesKeeper.CreateScheduledGauge(ctx, addr, coins, distrTo, ctx.BlockTime(), 3,
+		types.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.NewDec(2), sdk.OneDec(), sdk.OneDec()}})
+	require.NoError(t, err)
+	decayGaugeID, err := app.IncentivesKeeper.CreateScheduledGauge(ctx, addr, coins, distrTo, ctx.BlockTime(), 3,
+		types.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(5, 1)})
+	require.NoError(t, err)
+
+	// the gauge with epoch weights pays out once before it is cancelled.
+	weightsGauge, err := app.IncentivesKeeper.GetGaugeByID(ctx, weightsGaugeID)
+	require.NoError(t, err)
+	_, err = app.IncentivesKeeper.Distribute(ctx, []types.Gauge{*weightsGauge})
+	require.NoError(t, err)
+
+	for _, gaugeID := range []uint64{weightsGaugeID, decayGaugeID} {
+		_, err = app.IncentivesKeeper.CancelGauge(ctx, addr, gaugeID)
+		require.NoError(t, err)
+	}
+
+	// cancelled gauges are finished, so they are only part of a genesis state including all gauges.
+	genesis := app.IncentivesKeeper.ExportGenesis(ctx)
+	require.Len(t, genesis.Gauges, 0)
+	genesis.Gauges = app.IncentivesKeeper.GetGauges(ctx)
+	require.NoError(t, genesis.Validate())
+	require.Len(t, genesis.Gauges, 2)
+	for _, gauge := range genesis.Gauges {
+		require.Nil(t, gauge.PayoutSchedule)
+		require.Equal(t, gauge.FilledEpochs, gauge.NumEpochsPaidOver)
+	}
+	require.Equal(t, uint64(1), genesis.Gauges[0].NumEpochsPaidOver)
+
+	app = osmoapp.Setup(false)
+	ctx = app.BaseApp.NewContext(false, tmproto.Header{})
+	app.IncentivesKeeper.InitGenesis(ctx, *genesis)
+	require.Equal(t, genesis.Gauges, app.IncentivesKeeper.GetGauges(ctx))
+}
@@ -18,6 +18,9 @@ import (
 
 var _ types.QueryServer = Querier{}
 
+// maxRewardsEstEpochs is the number of epochs past the current epoch that rewards can be estimated for.
+const maxRewardsEstEpochs = 365
+
 // Querier defines a wrapper around the incentives module keeper providing gRPC method handlers.
 type Querier struct {
 	Keeper
@@ -143,7 +146,7 @@ func (q Querier) RewardsEst(goCtx context.Context, req *types.RewardsEstRequest)
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
 	diff := req.EndEpoch - q.Keeper.GetEpochInfo(ctx).CurrentEpoch
-	if diff > 365 {
+	if diff > maxRewardsEstEpochs {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, "end epoch out of ranges")
 	}
 
@@ -174,6 +177,23 @@ func (q Querier) LockableDurations(ctx context.Context, _ *types.QueryLockableDu
 	return &types.QueryLockableDurationsResponse{LockableDurations: q.Keeper.GetLockableDurations(sdkCtx)}, nil
 }
 
+// GaugePayoutSchedule takes a gaugeID and returns the coins the gauge is expected to pay out in each of its
+// remaining epochs. At most as many epochs as the rewards estimation covers are returned.
+func (q Querier) GaugePayoutSchedule(goCtx context.Context, req *types.GaugePayoutScheduleRequest) (*types.GaugePayoutScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	gauge, err := q.Keeper.GetGaugeByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.GaugePayoutScheduleResponse{Payouts: q.Keeper.GetGaugePayoutSchedule(ctx, *gauge, maxRewardsEstEpochs)}, nil
+}
+
 // getGaugeFromIDJsonBytes returns gauges from the json bytes of gaugeIDs.
 func (q Querier) getGaugeFromIDJsonBytes(ctx sdk.Context, refValue []byte) ([]types.Gauge, error) {
 	gauges := []types.Gauge{}
@@ -44,6 +44,7 @@ func (suite *KeeperTestSuite) TestGRPCGaugeByID() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.Gauge.String(), expectedGauge.String())
 }
@@ -77,6 +78,7 @@ func (suite *KeeperTestSuite) TestGRPCGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -124,6 +126,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -178,6 +181,7 @@ func (suite *KeeperTestSuite) TestGRPCActiveGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -234,6 +238,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGauges() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.Data[0].String(), expectedGauge.String())
 
@@ -288,6 +293,7 @@ func (suite *KeeperTestSuite) TestGRPCUpcomingGaugesPerDenom() {
 		FilledEpochs:      0,
 		DistributedCoins:  sdk.Coins{},
 		StartTime:         startTime,
+		Owner:             defaultGaugeCreator.String(),
 	}
 	suite.Require().Equal(res.UpcomingGauges[0].String(), expectedGauge.String())
 
@@ -72,3 +72,20 @@ func FilterLocksByMinDuration(locks []lockuptypes.PeriodLock, minDuration time.D
 	}
 	return filteredLocks
 }
+
+// FilterLocksByMinEndTime returns locks that finish unlocking after the provided minimum end time,
+// counting locks that are not unlocking as if they began unlocking at blockTime, as lockup's
+// GetLocksPastTimeDenom does.
+func FilterLocksByMinEndTime(locks []lockuptypes.PeriodLock, blockTime time.Time, minEndTime time.Time) []lockuptypes.PeriodLock {
+	filteredLocks := make([]lockuptypes.PeriodLock, 0, len(locks))
+	for _, lock := range locks {
+		if lock.IsUnlocking() && !lock.EndTime.After(minEndTime) {
+			continue
+		}
+		if !lock.IsUnlocking() && blockTime.Add(lock.Duration).Before(minEndTime) {
+			continue
+		}
+		filteredLocks = append(filteredLocks, lock)
+	}
+	return filteredLocks
+}
@@ -37,7 +37,12 @@ func (server msgServer) CreateGauge(goCtx context.Context, msg *types.MsgCreateG
 		return nil, err
 	}
 
-	gaugeID, err := server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	var gaugeID uint64
+	if msg.PayoutSchedule != nil {
+		gaugeID, err = server.keeper.CreateScheduledGauge(ctx, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver, *msg.PayoutSchedule)
+	} else {
+		gaugeID, err = server.keeper.CreateGauge(ctx, msg.IsPerpetual, owner, msg.Coins, msg.DistributeTo, msg.StartTime, msg.NumEpochsPaidOver)
+	}
 	if err != nil {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
@@ -78,3 +83,28 @@ func (server msgServer) AddToGauge(goCtx context.Context, msg *types.MsgAddToGau
 
 	return &types.MsgAddToGaugeResponse{}, nil
 }
+
+// CancelGauge cancels a non-perpetual gauge and refunds its undistributed coins to the owner.
+// Emits cancel gauge event and returns the cancel gauge response.
+func (server msgServer) CancelGauge(goCtx context.Context, msg *types.MsgCancelGauge) (*types.MsgCancelGaugeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil {
+		return nil, err
+	}
+
+	refund, err := server.keeper.CancelGauge(ctx, owner, msg.GaugeId)
+	if err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtCancelGauge,
+			sdk.NewAttribute(types.AttributeGaugeID, utils.Uint64ToString(msg.GaugeId)),
+			sdk.NewAttribute(types.AttributeAmount, refund.String()),
+		),
+	})
+
+	return &types.MsgCancelGaugeResponse{}, nil
+}
@@ -239,3 +239,163 @@ func (suite *KeeperTestSuite) TestAddToGauge_Fee() {
 		}
 	}
 }
+
+// TestAddToGauge_Owner tests that only the owner can add to a gauge that can be cancelled,
+// while anyone can add to a perpetual gauge, and that no one can add to a cancelled gauge.
+func (suite *KeeperTestSuite) TestAddToGauge_Owner() {
+	tests := []struct {
+		name             string
+		isPerpetual      bool
+		senderIsNotOwner bool
+		isCancelled      bool
+		expectErr        bool
+	}{
+		{
+			name: "owner adds to a non-perpetual gauge",
+		},
+		{
+			name:             "non-owner tries to add to a non-perpetual gauge",
+			senderIsNotOwner: true,
+			expectErr:        true,
+		},
+		{
+			name:             "non-owner adds to a perpetual gauge",
+			isPerpetual:      true,
+			senderIsNotOwner: true,
+		},
+		{
+			name:        "owner tries to add to a cancelled gauge",
+			isCancelled: true,
+			expectErr:   true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest()
+
+		_, gaugeID, gaugeCoins, _ := suite.SetupLockAndGauge(tc.isPerpetual)
+		if tc.isCancelled {
+			_, err := suite.App.IncentivesKeeper.CancelGauge(suite.Ctx, defaultGaugeCreator, gaugeID)
+			suite.Require().NoError(err)
+			gaugeCoins = sdk.Coins{}
+		}
+		sender := defaultGaugeCreator
+		if tc.senderIsNotOwner {
+			sender = suite.TestAccs[0]
+		}
+		addition := sdk.Coins{sdk.NewInt64Coin("stake", 10)}
+		suite.FundAcc(sender, addition.Add(sdk.NewCoin(sdk.DefaultBondDenom, types.AddToGaugeFee)))
+		msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+
+		// System under test.
+		_, err := msgServer.AddToGauge(sdk.WrapSDKContext(suite.Ctx), types.NewMsgAddToGauge(sender, gaugeID, addition))
+
+		gauge, gaugeErr := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+		suite.Require().NoError(gaugeErr)
+		if tc.expectErr {
+			suite.Require().Error(err, "test: %v", tc.name)
+			suite.Require().Equal(gaugeCoins.String(), gauge.Coins.String(), "test: %v", tc.name)
+			continue
+		}
+		suite.Require().NoError(err, "test: %v", tc.name)
+		suite.Require().Equal(gaugeCoins.Add(addition...).String(), gauge.Coins.String(), "test: %v", tc.name)
+	}
+}
+
+func (suite *KeeperTestSuite) TestCancelGauge() {
+	tests := []struct {
+		name             string
+		isPerpetual      bool
+		distributions    int
+		senderIsNotOwner bool
+		nonexistentGauge bool
+		expectedRefund   sdk.Coins
+		expectErr        bool
+	}{
+		{
+			name:           "owner cancels an upcoming gauge and gets all its coins back",
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 10)},
+		},
+		{
+			name:           "owner cancels an active gauge and gets its undistributed coins back",
+			distributions:  1,
+			expectedRefund: sdk.Coins{sdk.NewInt64Coin("stake", 5)},
+		},
+		{
+			name:             "non-owner tries to cancel a gauge",
+			senderIsNotOwner: true,
+			expectErr:        true,
+		},
+		{
+			name:        "owner tries to cancel a perpetual gauge",
+			isPerpetual: true,
+			expectErr:   true,
+		},
+		{
+			name:          "owner tries to cancel a finished gauge",
+			distributions: 2,
+			expectErr:     true,
+		},
+		{
+			name:             "owner tries to cancel a nonexistent gauge",
+			nonexistentGauge: true,
+			expectErr:        true,
+		},
+	}
+
+	for _, tc := range tests {
+		suite.SetupTest()
+
+		_, gaugeID, gaugeCoins, startTime := suite.SetupLockAndGauge(tc.isPerpetual)
+		if tc.nonexistentGauge {
+			gaugeID = suite.App.IncentivesKeeper.GetLastGaugeID(suite.Ctx) + 1
+		}
+		if tc.distributions > 0 {
+			suite.Ctx = suite.Ctx.WithBlockTime(startTime)
+			gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+			suite.Require().NoError(err)
+			err = suite.App.IncentivesKeeper.MoveUpcomingGaugeToActiveGauge(suite.Ctx, *gauge)
+			suite.Require().NoError(err)
+			for i := 0; i < tc.distributions; i++ {
+				gauge, err = suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+				suite.Require().NoError(err)
+				_, err = suite.App.IncentivesKeeper.Distribute(suite.Ctx, []types.Gauge{*gauge})
+				suite.Require().NoError(err)
+			}
+		}
+		sender := defaultGaugeCreator
+		if tc.senderIsNotOwner {
+			sender = suite.TestAccs[0]
+		}
+		ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+		msgServer := keeper.NewMsgServerImpl(suite.App.IncentivesKeeper)
+		balanceBefore := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+
+		// System under test.
+		_, err := msgServer.CancelGauge(sdk.WrapSDKContext(ctx), types.NewMsgCancelGauge(sender, gaugeID))
+
+		balanceAfter := suite.App.BankKeeper.GetAllBalances(suite.Ctx, sender)
+		if tc.expectErr {
+			suite.Require().Error(err, "test: %v", tc.name)
+			suite.Require().Equal(balanceBefore.String(), balanceAfter.String(), "test: %v", tc.name)
+			suite.AssertEventEmitted(ctx, types.TypeEvtCancelGauge, 0)
+			continue
+		}
+		suite.Require().NoError(err, "test: %v", tc.name)
+		suite.AssertEventEmitted(ctx, types.TypeEvtCancelGauge, 1)
+		suite.Require().Equal(balanceBefore.Add(tc.expectedRefund...).String(), balanceAfter.String(), "test: %v", tc.name)
+
+		// the gauge is finished and no longer referenced by its denom.
+		suite.Require().Len(suite.App.IncentivesKeeper.GetNotFinishedGauges(suite.Ctx), 0)
+		finishedGauges := suite.App.IncentivesKeeper.GetFinishedGauges(suite.Ctx)
+		suite.Require().Len(finishedGauges, 1)
+		suite.Require().Equal(gaugeCoins.Sub(tc.expectedRefund).String(), finishedGauges[0].Coins.String())
+		suite.Require().Equal(finishedGauges[0].Coins.String(), finishedGauges[0].DistributedCoins.String())
+		suite.Require().Len(suite.App.IncentivesKeeper.GetAllGaugeIDsByDenom(suite.Ctx, defaultLPDenom), 0)
+		suite.Require().True(suite.App.IncentivesKeeper.GetModuleToDistributeCoins(suite.Ctx).Empty())
+
+		// a cancelled gauge can't be cancelled again.
+		_, err = msgServer.CancelGauge(sdk.WrapSDKContext(ctx), types.NewMsgCancelGauge(sender, gaugeID))
+		suite.Require().Error(err, "test: %v", tc.name)
+	}
+}
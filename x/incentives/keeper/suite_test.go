@@ -34,7 +34,8 @@ var (
 		lockDurations: []time.Duration{defaultLockDuration, 2 * defaultLockDuration},
 		lockAmounts:   []sdk.Coins{defaultLPSyntheticTokens, defaultLPSyntheticTokens},
 	}
-	defaultRewardDenom string = "rewardDenom"
+	defaultRewardDenom  string         = "rewardDenom"
+	defaultGaugeCreator sdk.AccAddress = sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
 )
 
 // TODO: Switch more code to use userLocks and perpGaugeDesc
@@ -126,11 +127,17 @@ func (suite *KeeperTestSuite) CreateGauge(isPerpetual bool, addr sdk.AccAddress,
 	return gaugeID, gauge
 }
 
-// AddToGauge adds coins to the specified gauge.
+// AddToGauge adds coins to the specified gauge, from its owner if it is not perpetual.
 func (suite *KeeperTestSuite) AddToGauge(coins sdk.Coins, gaugeID uint64) uint64 {
 	addr := sdk.AccAddress([]byte("addrx---------------"))
+	gauge, err := suite.App.IncentivesKeeper.GetGaugeByID(suite.Ctx, gaugeID)
+	suite.Require().NoError(err)
+	if !gauge.IsPerpetual {
+		addr, err = sdk.AccAddressFromBech32(gauge.Owner)
+		suite.Require().NoError(err)
+	}
 	suite.FundAcc(addr, coins)
-	err := suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, addr, coins, gaugeID)
+	err = suite.App.IncentivesKeeper.AddToGaugeRewards(suite.Ctx, addr, coins, gaugeID)
 	suite.Require().NoError(err)
 	return gaugeID
 }
@@ -146,7 +153,7 @@ func (suite *KeeperTestSuite) LockTokens(addr sdk.AccAddress, coins sdk.Coins, d
 func (suite *KeeperTestSuite) setupNewGaugeWithDuration(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeCreator
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
@@ -175,7 +182,7 @@ func (suite *KeeperTestSuite) SetupNewGauge(isPerpetual bool, coins sdk.Coins) (
 func (suite *KeeperTestSuite) setupNewGaugeWithDenom(isPerpetual bool, coins sdk.Coins, duration time.Duration, denom string) (
 	uint64, *types.Gauge, sdk.Coins, time.Time,
 ) {
-	addr := sdk.AccAddress([]byte("Gauge_Creation_Addr_"))
+	addr := defaultGaugeCreator
 	startTime2 := time.Now()
 	distrTo := lockuptypes.QueryCondition{
 		LockQueryType: lockuptypes.ByDuration,
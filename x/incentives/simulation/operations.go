@@ -163,20 +163,29 @@ func SimulateMsgAddToGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKe
 	return func(
 		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		gauge := RandomGauge(ctx, r, k)
+		if gauge == nil {
+			return simtypes.NoOpMsg(
+				types.ModuleName, types.TypeMsgAddToGauge, "No gauge exists"), nil, nil
+		}
+		gaugeId := gauge.Id
+
 		simAccount, _ := simtypes.RandomAcc(r, accs)
+		// only the owner can add to a gauge that can be cancelled.
+		if !gauge.IsPerpetual && gauge.Owner != "" {
+			owner, found := simtypes.FindAccount(accs, sdk.MustAccAddressFromBech32(gauge.Owner))
+			if !found {
+				return simtypes.NoOpMsg(
+					types.ModuleName, types.TypeMsgAddToGauge, "Gauge owner not found"), nil, nil
+			}
+			simAccount = owner
+		}
 		simCoins := bk.SpendableCoins(ctx, simAccount.Address)
 		if simCoins.AmountOf(sdk.DefaultBondDenom).LT(types.AddToGaugeFee) {
 			return simtypes.NoOpMsg(
 				types.ModuleName, types.TypeMsgAddToGauge, "Account have no coin"), nil, nil
 		}
 
-		gauge := RandomGauge(ctx, r, k)
-		if gauge == nil {
-			return simtypes.NoOpMsg(
-				types.ModuleName, types.TypeMsgAddToGauge, "No gauge exists"), nil, nil
-		}
-		gaugeId := RandomGauge(ctx, r, k).Id
-
 		rewards := genRewardCoins(r, simCoins, types.AddToGaugeFee)
 
 		msg := types.MsgAddToGauge{
@@ -192,9 +201,9 @@ func SimulateMsgAddToGauge(ak stakingTypes.AccountKeeper, bk stakingTypes.BankKe
 	}
 }
 
-// RandomGauge takes a context, then returns a random existing gauge.
+// RandomGauge takes a context, then returns a random upcoming or active gauge, as only those can be added to.
 func RandomGauge(ctx sdk.Context, r *rand.Rand, k keeper.Keeper) *types.Gauge {
-	gauges := k.GetGauges(ctx)
+	gauges := k.GetNotFinishedGauges(ctx)
 	if len(gauges) == 0 {
 		return nil
 	}
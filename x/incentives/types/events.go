@@ -4,6 +4,7 @@ package types
 const (
 	TypeEvtCreateGauge  = "create_gauge"
 	TypeEvtAddToGauge   = "add_to_gauge"
+	TypeEvtCancelGauge  = "cancel_gauge"
 	TypeEvtDistribution = "distribution"
 
 	AttributeGaugeID     = "gauge_id"
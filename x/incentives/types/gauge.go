@@ -1,6 +1,7 @@
 package types
 
 import (
+	"errors"
 	time "time"
 
 	lockuptypes "github.com/osmosis-labs/osmosis/v13/x/lockup/types"
@@ -29,6 +30,86 @@ func NewGauge(id uint64, isPerpetual bool, distrTo lockuptypes.QueryCondition, c
 	}
 }
 
+// ValidateDistrTo returns an error unless distrTo is a valid lock query condition for a gauge to distribute to.
+// ByTime conditions must have a timestamp set, and can't distribute to synthetic lockups.
+func ValidateDistrTo(distrTo lockuptypes.QueryCondition) error {
+	if sdk.ValidateDenom(distrTo.Denom) != nil {
+		return errors.New("denom should be valid for the condition")
+	}
+
+	switch distrTo.LockQueryType {
+	case lockuptypes.ByDuration:
+		return nil
+	case lockuptypes.ByTime:
+		if !distrTo.Timestamp.After(time.Unix(0, 0)) {
+			return errors.New("timestamp should be set for a by time condition")
+		}
+		if lockuptypes.IsSyntheticDenom(distrTo.Denom) {
+			return errors.New("by time condition can't distribute to synthetic lockups")
+		}
+		return nil
+	default:
+		return errors.New("lock query type is invalid")
+	}
+}
+
+// Validate returns an error unless the payout schedule is valid for a gauge paid over numEpochsPaidOver epochs.
+// The last epoch weight must be positive, so that the gauge pays out all of its coins.
+func (s PayoutSchedule) Validate(numEpochsPaidOver uint64) error {
+	hasDecayFactor := !s.DecayFactor.IsNil() && !s.DecayFactor.IsZero()
+	if len(s.EpochWeights) > 0 == hasDecayFactor {
+		return errors.New("payout schedule should have exactly one of epoch weights and decay factor")
+	}
+
+	if hasDecayFactor {
+		if !s.DecayFactor.IsPositive() || s.DecayFactor.GT(sdk.OneDec()) {
+			return errors.New("payout schedule decay factor should be in (0, 1]")
+		}
+		return nil
+	}
+
+	if uint64(len(s.EpochWeights)) != numEpochsPaidOver {
+		return errors.New("payout schedule should have one epoch weight per epoch paid over")
+	}
+	for _, weight := range s.EpochWeights {
+		if weight.IsNil() || weight.IsNegative() {
+			return errors.New("payout schedule epoch weights should not be negative")
+		}
+	}
+	if !s.EpochWeights[len(s.EpochWeights)-1].IsPositive() {
+		return errors.New("payout schedule last epoch weight should be positive")
+	}
+	return nil
+}
+
+// NextPayoutWeight returns the weight of a gauge's next payout and the total weight of its remaining payouts,
+// once filledEpochs of its numEpochsPaidOver epochs have been paid out. The next payout is
+// weight / remainWeight of the gauge's remaining coins.
+func (s PayoutSchedule) NextPayoutWeight(filledEpochs, numEpochsPaidOver uint64) (weight, remainWeight sdk.Dec) {
+	if filledEpochs+1 >= numEpochsPaidOver {
+		return sdk.OneDec(), sdk.OneDec()
+	}
+
+	if len(s.EpochWeights) == 0 {
+		// the remaining payouts are d^0, d^1, ..., d^(r-1) times the next payout,
+		// so the next payout is (1 - d) / (1 - d^r) of the remaining coins.
+		remainEpochs := numEpochsPaidOver - filledEpochs
+		if s.DecayFactor.Equal(sdk.OneDec()) {
+			return sdk.OneDec(), sdk.NewDec(int64(remainEpochs))
+		}
+		return sdk.OneDec().Sub(s.DecayFactor), sdk.OneDec().Sub(s.DecayFactor.Power(remainEpochs))
+	}
+
+	remainWeight = sdk.ZeroDec()
+	for _, w := range s.EpochWeights[filledEpochs:] {
+		remainWeight = remainWeight.Add(w)
+	}
+	if !remainWeight.IsPositive() {
+		return sdk.OneDec(), sdk.OneDec()
+	}
+	return s.EpochWeights[filledEpochs], remainWeight
+}
+
 // IsUpcomingGauge returns true if the gauge's distribution start time is after the provided time.
 func (gauge Gauge) IsUpcomingGauge(curTime time.Time) bool {
 	return curTime.Before(gauge.StartTime)
@@ -58,6 +58,12 @@ type Gauge struct {
 	FilledEpochs uint64 `protobuf:"varint,7,opt,name=filled_epochs,json=filledEpochs,proto3" json:"filled_epochs,omitempty"`
 	// distributed_coins are coins that have been distributed already
 	DistributedCoins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=distributed_coins,json=distributedCoins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"distributed_coins"`
+	// owner is the address that created the gauge and is allowed to cancel it.
+	// Empty for gauges created before ownership was recorded.
+	Owner string `protobuf:"bytes,9,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// payout_schedule, if set, is the schedule a non-perpetual gauge pays out
+	// its coins over, rather than paying out evenly each epoch
+	PayoutSchedule *PayoutSchedule `protobuf:"bytes,10,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule,omitempty" yaml:"payout_schedule"`
 }
 
 func (m *Gauge) Reset()         { *m = Gauge{} }
@@ -149,6 +155,66 @@ func (m *Gauge) GetDistributedCoins() github_com_cosmos_cosmos_sdk_types.Coins {
 	return nil
 }
 
+func (m *Gauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Gauge) GetPayoutSchedule() *PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedule
+	}
+	return nil
+}
+
+// PayoutSchedule is the schedule a non-perpetual gauge pays out its coins
+// over. Exactly one of epoch_weights and decay_factor is set.
+type PayoutSchedule struct {
+	// epoch_weights are the relative amounts paid out each epoch, one per epoch
+	// the gauge is paid over. e.g. [3, 2, 1] pays out half of the gauge's coins
+	// in its first epoch, a third in its second and a sixth in its last.
+	EpochWeights []github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,1,rep,name=epoch_weights,json=epochWeights,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"epoch_weights" yaml:"epoch_weights"`
+	// decay_factor is the ratio of each epoch's payout to the previous epoch's
+	// payout, in (0, 1]. e.g. 0.5 pays out half as much each epoch as in the
+	// epoch before.
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+}
+
+func (m *PayoutSchedule) Reset()         { *m = PayoutSchedule{} }
+func (m *PayoutSchedule) String() string { return proto.CompactTextString(m) }
+func (*PayoutSchedule) ProtoMessage()    {}
+func (*PayoutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c0304e2bb0159901, []int{1}
+}
+func (m *PayoutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutSchedule.Merge(m, src)
+}
+func (m *PayoutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutSchedule proto.InternalMessageInfo
+
 type LockableDurationsInfo struct {
 	// List of incentivised durations that gauges will pay out to
 	LockableDurations []time.Duration `protobuf:"bytes,1,rep,name=lockable_durations,json=lockableDurations,proto3,stdduration" json:"lockable_durations" yaml:"lockable_durations"`
@@ -158,7 +224,7 @@ func (m *LockableDurationsInfo) Reset()         { *m = LockableDurationsInfo{} }
 func (m *LockableDurationsInfo) String() string { return proto.CompactTextString(m) }
 func (*LockableDurationsInfo) ProtoMessage()    {}
 func (*LockableDurationsInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_c0304e2bb0159901, []int{1}
+	return fileDescriptor_c0304e2bb0159901, []int{2}
 }
 func (m *LockableDurationsInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -196,48 +262,58 @@ func (m *LockableDurationsInfo) GetLockableDurations() []time.Duration {
 
 func init() {
 	proto.RegisterType((*Gauge)(nil), "osmosis.incentives.Gauge")
+	proto.RegisterType((*PayoutSchedule)(nil), "osmosis.incentives.PayoutSchedule")
 	proto.RegisterType((*LockableDurationsInfo)(nil), "osmosis.incentives.LockableDurationsInfo")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/gauge.proto", fileDescriptor_c0304e2bb0159901) }
 
 var fileDescriptor_c0304e2bb0159901 = []byte{
-	// 545 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x93, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xc7, 0x9b, 0xad, 0x1d, 0xc3, 0xed, 0x10, 0xb5, 0x86, 0x94, 0x56, 0x22, 0x2d, 0x45, 0x48,
-	0xbd, 0xcc, 0xa6, 0x9b, 0xc4, 0x81, 0x63, 0x07, 0x42, 0x93, 0x90, 0x28, 0xd5, 0x0e, 0x88, 0x4b,
-	0xe4, 0x24, 0x6e, 0x66, 0x35, 0xc9, 0x2f, 0x8a, 0x9d, 0x6a, 0x7d, 0x03, 0x8e, 0x13, 0x27, 0x9e,
-	0x81, 0x27, 0xd9, 0x71, 0x47, 0x4e, 0x1b, 0x6a, 0xdf, 0x80, 0x27, 0x40, 0xb1, 0x13, 0xb5, 0x2a,
-	0x57, 0x4e, 0x8e, 0x7f, 0xdf, 0xdf, 0xbf, 0xef, 0x47, 0x0e, 0x72, 0x40, 0xc6, 0x20, 0x85, 0xa4,
-	0x22, 0xf1, 0x79, 0xa2, 0xc4, 0x82, 0x4b, 0x1a, 0xb2, 0x3c, 0xe4, 0x24, 0xcd, 0x40, 0x01, 0xc6,
-	0xa5, 0x4e, 0x36, 0x7a, 0xf7, 0x38, 0x84, 0x10, 0xb4, 0x4c, 0x8b, 0x2f, 0x93, 0xd9, 0x75, 0x42,
-	0x80, 0x30, 0xe2, 0x54, 0xdf, 0xbc, 0x7c, 0x46, 0x83, 0x3c, 0x63, 0x4a, 0x40, 0x52, 0xea, 0xbd,
-	0x5d, 0x5d, 0x89, 0x98, 0x4b, 0xc5, 0xe2, 0xb4, 0x6a, 0xe0, 0xeb, 0x59, 0xd4, 0x63, 0x92, 0xd3,
-	0xc5, 0xc8, 0xe3, 0x8a, 0x8d, 0xa8, 0x0f, 0xa2, 0x6a, 0xd0, 0xa9, 0x56, 0x8d, 0xc0, 0x9f, 0xe7,
-	0xa9, 0x3e, 0x8c, 0x34, 0xf8, 0x5e, 0x47, 0x8d, 0x0f, 0xc5, 0xd6, 0xf8, 0x09, 0xda, 0x13, 0x81,
-	0x6d, 0xf5, 0xad, 0x61, 0x7d, 0xba, 0x27, 0x02, 0xfc, 0x02, 0xb5, 0x84, 0x74, 0x53, 0x9e, 0xa5,
-	0x5c, 0xe5, 0x2c, 0xb2, 0xf7, 0xfa, 0xd6, 0xf0, 0x70, 0xda, 0x14, 0x72, 0x52, 0x85, 0xf0, 0x05,
-	0x3a, 0x0a, 0x84, 0x54, 0x99, 0xf0, 0x72, 0xc5, 0x5d, 0x05, 0xf6, 0x7e, 0xdf, 0x1a, 0x36, 0x4f,
-	0x1d, 0x52, 0x59, 0x37, 0xf3, 0xc8, 0xe7, 0x9c, 0x67, 0xcb, 0x73, 0x48, 0x02, 0x51, 0xb8, 0x1a,
-	0xd7, 0x6f, 0xef, 0x7b, 0xb5, 0x69, 0x6b, 0x53, 0x7a, 0x09, 0x98, 0xa1, 0x46, 0xb1, 0xb0, 0xb4,
-	0xeb, 0xfd, 0xfd, 0x61, 0xf3, 0xb4, 0x43, 0x8c, 0x25, 0x52, 0x58, 0x22, 0xa5, 0x25, 0x72, 0x0e,
-	0x22, 0x19, 0xbf, 0x2e, 0xaa, 0x7f, 0x3e, 0xf4, 0x86, 0xa1, 0x50, 0x57, 0xb9, 0x47, 0x7c, 0x88,
-	0x69, 0xe9, 0xdf, 0x1c, 0x27, 0x32, 0x98, 0x53, 0xb5, 0x4c, 0xb9, 0xd4, 0x05, 0x72, 0x6a, 0x3a,
-	0xe3, 0x2f, 0x08, 0x49, 0xc5, 0x32, 0xe5, 0x16, 0xf8, 0xec, 0x86, 0x5e, 0xb5, 0x4b, 0x0c, 0x5b,
-	0x52, 0xb1, 0x25, 0x97, 0x15, 0xdb, 0xf1, 0xf3, 0x62, 0xd0, 0x9f, 0xfb, 0x5e, 0x7b, 0xc9, 0xe2,
-	0xe8, 0xed, 0x60, 0x53, 0x3b, 0xb8, 0x79, 0xe8, 0x59, 0xd3, 0xc7, 0x3a, 0x50, 0xa4, 0x63, 0x8a,
-	0x8e, 0x93, 0x3c, 0x76, 0x79, 0x0a, 0xfe, 0x95, 0x74, 0x53, 0x26, 0x02, 0x17, 0x16, 0x3c, 0xb3,
-	0x0f, 0x34, 0xcc, 0x76, 0x92, 0xc7, 0xef, 0xb5, 0x34, 0x61, 0x22, 0xf8, 0xb4, 0xe0, 0x19, 0x7e,
-	0x89, 0x8e, 0x66, 0x22, 0x8a, 0x78, 0x50, 0xd6, 0xd8, 0x8f, 0x74, 0x66, 0xcb, 0x04, 0x4d, 0x32,
-	0xbe, 0x46, 0xed, 0x0d, 0xa2, 0xc0, 0x35, 0x78, 0x0e, 0xff, 0x3f, 0x9e, 0xa7, 0x5b, 0x53, 0x74,
-	0x64, 0xf0, 0xcd, 0x42, 0xcf, 0x3e, 0x82, 0x3f, 0x67, 0x5e, 0xc4, 0xdf, 0x95, 0x6f, 0x51, 0x5e,
-	0x24, 0x33, 0xc0, 0x80, 0x70, 0x54, 0x0a, 0x6e, 0xf5, 0x4a, 0xa5, 0x6d, 0x95, 0x4b, 0xed, 0xb2,
-	0xac, 0x6a, 0xc7, 0xaf, 0x4a, 0x94, 0x1d, 0x83, 0xf2, 0xdf, 0x16, 0x83, 0x1f, 0x05, 0xd2, 0x76,
-	0xb4, 0x3b, 0x74, 0x3c, 0xb9, 0x5d, 0x39, 0xd6, 0xdd, 0xca, 0xb1, 0x7e, 0xaf, 0x1c, 0xeb, 0x66,
-	0xed, 0xd4, 0xee, 0xd6, 0x4e, 0xed, 0xd7, 0xda, 0xa9, 0x7d, 0x7d, 0xb3, 0x65, 0xb0, 0x7c, 0x6f,
-	0x27, 0x11, 0xf3, 0x64, 0x75, 0xa1, 0x8b, 0xd1, 0x19, 0xbd, 0xde, 0xfe, 0x3b, 0xb5, 0x69, 0xef,
-	0x40, 0xaf, 0x77, 0xf6, 0x37, 0x00, 0x00, 0xff, 0xff, 0x58, 0x36, 0xb0, 0xbf, 0xc0, 0x03, 0x00,
-	0x00,
+	// 698 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
+	0x14, 0x8d, 0x81, 0xf0, 0xc8, 0x24, 0xf0, 0xc8, 0x3c, 0x5e, 0x65, 0x22, 0xd5, 0x4e, 0x5d, 0x15,
+	0x65, 0x83, 0x5d, 0x40, 0xea, 0xa2, 0x4b, 0xf3, 0x51, 0x21, 0x55, 0x6a, 0xea, 0x22, 0xb5, 0xea,
+	0xc6, 0x1a, 0xdb, 0x13, 0x67, 0x14, 0xc7, 0x63, 0x79, 0xc6, 0x81, 0xfc, 0x83, 0x2e, 0x59, 0x76,
+	0xdf, 0x5d, 0x7f, 0x09, 0x4b, 0x96, 0x55, 0x17, 0xa1, 0x82, 0x45, 0xf7, 0xf9, 0x05, 0x95, 0x67,
+	0x6c, 0x25, 0x84, 0x4d, 0x2b, 0x75, 0x65, 0xee, 0x3d, 0xf7, 0xde, 0x73, 0xcf, 0xe1, 0x66, 0x80,
+	0x46, 0xd9, 0x90, 0x32, 0xc2, 0x2c, 0x12, 0xfb, 0x38, 0xe6, 0x64, 0x84, 0x99, 0x15, 0xa2, 0x2c,
+	0xc4, 0x66, 0x92, 0x52, 0x4e, 0x21, 0x2c, 0x70, 0x73, 0x86, 0xb7, 0xb6, 0x42, 0x1a, 0x52, 0x01,
+	0x5b, 0xf9, 0x5f, 0xb2, 0xb2, 0xa5, 0x85, 0x94, 0x86, 0x11, 0xb6, 0x44, 0xe4, 0x65, 0x3d, 0x2b,
+	0xc8, 0x52, 0xc4, 0x09, 0x8d, 0x0b, 0x5c, 0x5f, 0xc4, 0x39, 0x19, 0x62, 0xc6, 0xd1, 0x30, 0x29,
+	0x07, 0xf8, 0x82, 0xcb, 0xf2, 0x10, 0xc3, 0xd6, 0x68, 0xcf, 0xc3, 0x1c, 0xed, 0x59, 0x3e, 0x25,
+	0xe5, 0x80, 0xed, 0x72, 0xd5, 0x88, 0xfa, 0x83, 0x2c, 0x11, 0x1f, 0x09, 0x19, 0x5f, 0xaa, 0xa0,
+	0xfa, 0x2a, 0xdf, 0x1a, 0x6e, 0x80, 0x25, 0x12, 0xa8, 0x4a, 0x5b, 0xe9, 0xac, 0x38, 0x4b, 0x24,
+	0x80, 0x4f, 0x40, 0x83, 0x30, 0x37, 0xc1, 0x69, 0x82, 0x79, 0x86, 0x22, 0x75, 0xa9, 0xad, 0x74,
+	0xd6, 0x9c, 0x3a, 0x61, 0xdd, 0x32, 0x05, 0x4f, 0xc1, 0x7a, 0x40, 0x18, 0x4f, 0x89, 0x97, 0x71,
+	0xec, 0x72, 0xaa, 0x2e, 0xb7, 0x95, 0x4e, 0x7d, 0x5f, 0x33, 0x4b, 0xe9, 0x92, 0xcf, 0x7c, 0x9b,
+	0xe1, 0x74, 0x7c, 0x48, 0xe3, 0x80, 0xe4, 0xaa, 0xec, 0x95, 0xab, 0x89, 0x5e, 0x71, 0x1a, 0xb3,
+	0xd6, 0x33, 0x0a, 0x11, 0xa8, 0xe6, 0x0b, 0x33, 0x75, 0xa5, 0xbd, 0xdc, 0xa9, 0xef, 0x6f, 0x9b,
+	0x52, 0x92, 0x99, 0x4b, 0x32, 0x0b, 0x49, 0xe6, 0x21, 0x25, 0xb1, 0xfd, 0x3c, 0xef, 0xfe, 0x7a,
+	0xa3, 0x77, 0x42, 0xc2, 0xfb, 0x99, 0x67, 0xfa, 0x74, 0x68, 0x15, 0xfa, 0xe5, 0x67, 0x97, 0x05,
+	0x03, 0x8b, 0x8f, 0x13, 0xcc, 0x44, 0x03, 0x73, 0xe4, 0x64, 0xf8, 0x01, 0x00, 0xc6, 0x51, 0xca,
+	0xdd, 0xdc, 0x3e, 0xb5, 0x2a, 0x56, 0x6d, 0x99, 0xd2, 0x5b, 0xb3, 0xf4, 0xd6, 0x3c, 0x2b, 0xbd,
+	0xb5, 0x1f, 0xe7, 0x44, 0xd3, 0x89, 0xde, 0x1c, 0xa3, 0x61, 0xf4, 0xd2, 0x98, 0xf5, 0x1a, 0x97,
+	0x37, 0xba, 0xe2, 0xd4, 0x44, 0x22, 0x2f, 0x87, 0x16, 0xd8, 0x8a, 0xb3, 0xa1, 0x8b, 0x13, 0xea,
+	0xf7, 0x99, 0x9b, 0x20, 0x12, 0xb8, 0x74, 0x84, 0x53, 0x75, 0x55, 0x98, 0xd9, 0x8c, 0xb3, 0xe1,
+	0xb1, 0x80, 0xba, 0x88, 0x04, 0x6f, 0x46, 0x38, 0x85, 0x4f, 0xc1, 0x7a, 0x8f, 0x44, 0x11, 0x0e,
+	0x8a, 0x1e, 0xf5, 0x1f, 0x51, 0xd9, 0x90, 0x49, 0x59, 0x0c, 0x2f, 0x40, 0x73, 0x66, 0x51, 0xe0,
+	0x4a, 0x7b, 0xd6, 0xfe, 0xbe, 0x3d, 0x9b, 0x73, 0x2c, 0x22, 0x03, 0x77, 0x40, 0x95, 0x9e, 0xc7,
+	0x38, 0x55, 0x6b, 0x6d, 0xa5, 0x53, 0xb3, 0x37, 0xa7, 0x13, 0xbd, 0x21, 0x4d, 0x10, 0x69, 0xc3,
+	0x91, 0x30, 0x0c, 0xc1, 0xbf, 0x09, 0x1a, 0xd3, 0x8c, 0xbb, 0xcc, 0xef, 0xe3, 0x20, 0x8b, 0xb0,
+	0x0a, 0x84, 0xad, 0x86, 0xf9, 0xf0, 0xf8, 0xcd, 0xae, 0x28, 0x7d, 0x57, 0x54, 0xda, 0xad, 0xe9,
+	0x44, 0x7f, 0x24, 0xa7, 0x2e, 0x0c, 0x31, 0x9c, 0x8d, 0xe4, 0x5e, 0xad, 0xf1, 0x53, 0x01, 0x1b,
+	0xf7, 0xdb, 0xe1, 0x00, 0xac, 0x0b, 0xef, 0xdc, 0x73, 0x4c, 0xc2, 0x3e, 0x67, 0xaa, 0xd2, 0x5e,
+	0xee, 0xd4, 0xec, 0x93, 0x5c, 0xfe, 0xf7, 0x89, 0xbe, 0xf3, 0x1b, 0xf2, 0x8f, 0xb0, 0x3f, 0x9d,
+	0xe8, 0x5b, 0x72, 0x87, 0x7b, 0xc3, 0x0c, 0xa7, 0x21, 0xe2, 0xf7, 0x32, 0x84, 0x7d, 0xd0, 0x08,
+	0xb0, 0x8f, 0xc6, 0x6e, 0x0f, 0xf9, 0x9c, 0xa6, 0xe2, 0xb7, 0x50, 0xb3, 0x8f, 0xff, 0x98, 0xeb,
+	0x3f, 0xc9, 0x35, 0x3f, 0xcb, 0x70, 0xea, 0x22, 0x3c, 0x91, 0xd1, 0x27, 0x05, 0xfc, 0xff, 0x9a,
+	0xfa, 0x03, 0xe4, 0x45, 0xf8, 0xa8, 0x78, 0x06, 0xd8, 0x69, 0xdc, 0xa3, 0x90, 0x02, 0x18, 0x15,
+	0x80, 0x5b, 0x3e, 0x10, 0x52, 0x75, 0x7e, 0x0f, 0x8b, 0x67, 0x5c, 0xf6, 0xda, 0xcf, 0x8a, 0x2b,
+	0xde, 0x96, 0xd4, 0x0f, 0x47, 0x18, 0x9f, 0xf3, 0x6b, 0x6e, 0x46, 0x8b, 0xa4, 0x76, 0xf7, 0xea,
+	0x56, 0x53, 0xae, 0x6f, 0x35, 0xe5, 0xc7, 0xad, 0xa6, 0x5c, 0xde, 0x69, 0x95, 0xeb, 0x3b, 0xad,
+	0xf2, 0xed, 0x4e, 0xab, 0x7c, 0x7c, 0x31, 0x27, 0xb8, 0xf8, 0x47, 0xef, 0x46, 0xc8, 0x63, 0x65,
+	0x60, 0x8d, 0xf6, 0x0e, 0xac, 0x8b, 0xf9, 0x87, 0x51, 0x98, 0xe0, 0xad, 0x8a, 0xf5, 0x0e, 0x7e,
+	0x0d, 0x00, 0xf9, 0x23, 0xe8, 0x24, 0x3b, 0x05, 0x00, 0x00,
 }
 
 func (m *Gauge) Marshal() (dAtA []byte, err error) {
@@ -260,6 +336,25 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PayoutSchedule != nil {
+		{
+			size, err := m.PayoutSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGauge(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintGauge(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.DistributedCoins) > 0 {
 		for iNdEx := len(m.DistributedCoins) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -284,12 +379,12 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintGauge(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintGauge(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -334,6 +429,53 @@ func (m *Gauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PayoutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGauge(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.EpochWeights) > 0 {
+		for iNdEx := len(m.EpochWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size := m.EpochWeights[iNdEx].Size()
+				i -= size
+				if _, err := m.EpochWeights[iNdEx].MarshalTo(dAtA[i:]); err != nil {
+					return 0, err
+				}
+				i = encodeVarintGauge(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *LockableDurationsInfo) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +556,31 @@ func (m *Gauge) Size() (n int) {
 			n += 1 + l + sovGauge(uint64(l))
 		}
 	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	if m.PayoutSchedule != nil {
+		l = m.PayoutSchedule.Size()
+		n += 1 + l + sovGauge(uint64(l))
+	}
+	return n
+}
+
+func (m *PayoutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.EpochWeights) > 0 {
+		for _, e := range m.EpochWeights {
+			l = e.Size()
+			n += 1 + l + sovGauge(uint64(l))
+		}
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovGauge(uint64(l))
 	return n
 }
 
@@ -678,6 +845,194 @@ func (m *Gauge) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PayoutSchedule == nil {
+				m.PayoutSchedule = &PayoutSchedule{}
+			}
+			if err := m.PayoutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGauge(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGauge
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochWeights", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v github_com_cosmos_cosmos_sdk_types.Dec
+			m.EpochWeights = append(m.EpochWeights, v)
+			if err := m.EpochWeights[len(m.EpochWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGauge
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGauge
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGauge
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGauge(dAtA[iNdEx:])
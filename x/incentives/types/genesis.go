@@ -3,6 +3,7 @@ package types
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"time"
 
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -43,5 +44,18 @@ func (gs GenesisState) Validate() error {
 	if gs.Params.DistrEpochIdentifier == "" {
 		return errors.New("epoch identifier should NOT be empty")
 	}
+	for _, gauge := range gs.Gauges {
+		if err := ValidateDistrTo(gauge.DistributeTo); err != nil {
+			return fmt.Errorf("gauge %d: %w", gauge.Id, err)
+		}
+		if gauge.PayoutSchedule != nil {
+			if gauge.IsPerpetual {
+				return fmt.Errorf("gauge %d: perpetual gauge can't have a payout schedule", gauge.Id)
+			}
+			if err := gauge.PayoutSchedule.Validate(gauge.NumEpochsPaidOver); err != nil {
+				return fmt.Errorf("gauge %d: %w", gauge.Id, err)
+			}
+		}
+	}
 	return nil
 }
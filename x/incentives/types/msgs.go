@@ -12,6 +12,7 @@ import (
 const (
 	TypeMsgCreateGauge = "create_gauge"
 	TypeMsgAddToGauge  = "add_to_gauge"
+	TypeMsgCancelGauge = "cancel_gauge"
 )
 
 var _ sdk.Msg = &MsgCreateGauge{}
@@ -39,11 +40,8 @@ func (m MsgCreateGauge) ValidateBasic() error {
 	if m.Owner == "" {
 		return errors.New("owner should be set")
 	}
-	if sdk.ValidateDenom(m.DistributeTo.Denom) != nil {
-		return errors.New("denom should be valid for the condition")
-	}
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] == "" {
-		return errors.New("lock query type is invalid")
+	if err := ValidateDistrTo(m.DistributeTo); err != nil {
+		return err
 	}
 	if m.StartTime.Equal(time.Time{}) {
 		return errors.New("distribution start time should be set")
@@ -54,9 +52,13 @@ func (m MsgCreateGauge) ValidateBasic() error {
 	if m.IsPerpetual && m.NumEpochsPaidOver != 1 {
 		return errors.New("distribution period should be 1 epoch for perpetual gauge")
 	}
-
-	if lockuptypes.LockQueryType_name[int32(m.DistributeTo.LockQueryType)] != "ByDuration" {
-		return errors.New("only duration query condition is allowed. Start time distr conditions is an obsolete codepath slated for deletion")
+	if m.PayoutSchedule != nil {
+		if m.IsPerpetual {
+			return errors.New("perpetual gauge can't have a payout schedule")
+		}
+		if err := m.PayoutSchedule.Validate(m.NumEpochsPaidOver); err != nil {
+			return err
+		}
 	}
 
 	return nil
@@ -112,3 +114,39 @@ func (m MsgAddToGauge) GetSigners() []sdk.AccAddress {
 	owner, _ := sdk.AccAddressFromBech32(m.Owner)
 	return []sdk.AccAddress{owner}
 }
+
+var _ sdk.Msg = &MsgCancelGauge{}
+
+// NewMsgCancelGauge creates a message to cancel a specific gauge.
+func NewMsgCancelGauge(owner sdk.AccAddress, gaugeId uint64) *MsgCancelGauge {
+	return &MsgCancelGauge{
+		Owner:   owner.String(),
+		GaugeId: gaugeId,
+	}
+}
+
+// Route takes a cancel gauge message, then returns the RouterKey used for slashing.
+func (m MsgCancelGauge) Route() string { return RouterKey }
+
+// Type takes a cancel gauge message, then returns a cancel gauge message type.
+func (m MsgCancelGauge) Type() string { return TypeMsgCancelGauge }
+
+// ValidateBasic checks that the cancel gauge message is valid.
+func (m MsgCancelGauge) ValidateBasic() error {
+	if m.Owner == "" {
+		return errors.New("owner should be set")
+	}
+
+	return nil
+}
+
+// GetSignBytes takes a cancel gauge message and turns it into a byte array.
+func (m MsgCancelGauge) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+// GetSigners takes a cancel gauge message and returns the owner in a byte array.
+func (m MsgCancelGauge) GetSigners() []sdk.AccAddress {
+	owner, _ := sdk.AccAddressFromBech32(m.Owner)
+	return []sdk.AccAddress{owner}
+}
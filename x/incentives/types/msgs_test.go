@@ -139,6 +139,115 @@ func TestMsgCreatePool(t *testing.T) {
 			}),
 			expectPass: true,
 		},
+		{
+			name: "valid by time condition",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "by time condition without timestamp",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "by time condition to synthetic lockups",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.DistributeTo.LockQueryType = lockuptypes.ByTime
+				msg.DistributeTo.Timestamp = time.Now()
+				msg.DistributeTo.Denom = "lptoken/superbonding/osmovaloper1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with epoch weights",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.ZeroDec(), sdk.OneDec()}}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "payout schedule with decay factor",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(5, 1)}
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "payout schedule for perpetual gauge",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.IsPerpetual = true
+				msg.NumEpochsPaidOver = 1
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(5, 1)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule without epoch weights or decay factor",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with both epoch weights and decay factor",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.OneDec(), sdk.OneDec()}, DecayFactor: sdk.NewDecWithPrec(5, 1)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with an epoch weight per epoch missing",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with negative epoch weight",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.NewDec(-1), sdk.OneDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with zero last epoch weight",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{EpochWeights: []sdk.Dec{sdk.OneDec(), sdk.ZeroDec()}}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with decay factor above one",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(15, 1)}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "payout schedule with negative decay factor",
+			msg: createMsg(func(msg incentivestypes.MsgCreateGauge) incentivestypes.MsgCreateGauge {
+				msg.PayoutSchedule = &incentivestypes.PayoutSchedule{DecayFactor: sdk.NewDecWithPrec(-5, 1)}
+				return msg
+			}),
+			expectPass: false,
+		},
 	}
 
 	for _, test := range tests {
@@ -216,6 +325,24 @@ func TestMsgAddToGauge(t *testing.T) {
 	}
 }
 
+// TestMsgCancelGauge tests if valid/invalid cancel gauge messages are properly validated/invalidated
+func TestMsgCancelGauge(t *testing.T) {
+	// generate a private/public key pair and get the respective address
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address())
+
+	msg := *incentivestypes.NewMsgCancelGauge(addr1, 1)
+	require.Equal(t, msg.Route(), incentivestypes.RouterKey)
+	require.Equal(t, msg.Type(), "cancel_gauge")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1.String())
+	require.NoError(t, msg.ValidateBasic())
+
+	msg.Owner = ""
+	require.Error(t, msg.ValidateBasic())
+}
+
 // // Test authz serialize and de-serializes for incentives msg.
 func TestAuthzMsg(t *testing.T) {
 	appParams.SetAddressPrefixes()
@@ -256,6 +383,31 @@ func TestAuthzMsg(t *testing.T) {
 				NumEpochsPaidOver: 1,
 			},
 		},
+		{
+			name: "MsgCreateGauge with payout schedule",
+			incentivesMsg: &incentivestypes.MsgCreateGauge{
+				IsPerpetual: false,
+				Owner:       addr1,
+				DistributeTo: lockuptypes.QueryCondition{
+					LockQueryType: lockuptypes.ByDuration,
+					Denom:         "lptoken",
+					Duration:      time.Second,
+				},
+				Coins:             sdk.NewCoins(coin),
+				StartTime:         someDate,
+				NumEpochsPaidOver: 2,
+				PayoutSchedule: &incentivestypes.PayoutSchedule{
+					EpochWeights: []sdk.Dec{sdk.NewDec(2), sdk.OneDec()},
+				},
+			},
+		},
+		{
+			name: "MsgCancelGauge",
+			incentivesMsg: &incentivestypes.MsgCancelGauge{
+				Owner:   addr1,
+				GaugeId: 1,
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
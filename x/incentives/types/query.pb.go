@@ -912,6 +912,152 @@ func (m *QueryLockableDurationsResponse) GetLockableDurations() []time.Duration
 	return nil
 }
 
+type GaugePayoutScheduleRequest struct {
+	// Gauge ID being queried
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *GaugePayoutScheduleRequest) Reset()         { *m = GaugePayoutScheduleRequest{} }
+func (m *GaugePayoutScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*GaugePayoutScheduleRequest) ProtoMessage()    {}
+func (*GaugePayoutScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{18}
+}
+func (m *GaugePayoutScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugePayoutScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugePayoutScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugePayoutScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugePayoutScheduleRequest.Merge(m, src)
+}
+func (m *GaugePayoutScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugePayoutScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugePayoutScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugePayoutScheduleRequest proto.InternalMessageInfo
+
+func (m *GaugePayoutScheduleRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type GaugePayoutScheduleResponse struct {
+	// Coins the gauge is expected to pay out in each of its remaining epochs,
+	// assuming no coins are added to it
+	Payouts []EpochPayout `protobuf:"bytes,1,rep,name=payouts,proto3" json:"payouts"`
+}
+
+func (m *GaugePayoutScheduleResponse) Reset()         { *m = GaugePayoutScheduleResponse{} }
+func (m *GaugePayoutScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*GaugePayoutScheduleResponse) ProtoMessage()    {}
+func (*GaugePayoutScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{19}
+}
+func (m *GaugePayoutScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GaugePayoutScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GaugePayoutScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GaugePayoutScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GaugePayoutScheduleResponse.Merge(m, src)
+}
+func (m *GaugePayoutScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GaugePayoutScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GaugePayoutScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GaugePayoutScheduleResponse proto.InternalMessageInfo
+
+func (m *GaugePayoutScheduleResponse) GetPayouts() []EpochPayout {
+	if m != nil {
+		return m.Payouts
+	}
+	return nil
+}
+
+// EpochPayout is the coins a gauge pays out at the end of an epoch
+type EpochPayout struct {
+	// Number of the epoch at whose end the coins are paid out
+	Epoch int64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// Coins paid out to the gauge's locks
+	Coins github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=coins,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"coins"`
+}
+
+func (m *EpochPayout) Reset()         { *m = EpochPayout{} }
+func (m *EpochPayout) String() string { return proto.CompactTextString(m) }
+func (*EpochPayout) ProtoMessage()    {}
+func (*EpochPayout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8124258a89427f98, []int{20}
+}
+func (m *EpochPayout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochPayout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochPayout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochPayout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochPayout.Merge(m, src)
+}
+func (m *EpochPayout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochPayout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochPayout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochPayout proto.InternalMessageInfo
+
+func (m *EpochPayout) GetEpoch() int64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *EpochPayout) GetCoins() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Coins
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ModuleToDistributeCoinsRequest)(nil), "osmosis.incentives.ModuleToDistributeCoinsRequest")
 	proto.RegisterType((*ModuleToDistributeCoinsResponse)(nil), "osmosis.incentives.ModuleToDistributeCoinsResponse")
@@ -931,78 +1077,87 @@ func init() {
 	proto.RegisterType((*RewardsEstResponse)(nil), "osmosis.incentives.RewardsEstResponse")
 	proto.RegisterType((*QueryLockableDurationsRequest)(nil), "osmosis.incentives.QueryLockableDurationsRequest")
 	proto.RegisterType((*QueryLockableDurationsResponse)(nil), "osmosis.incentives.QueryLockableDurationsResponse")
+	proto.RegisterType((*GaugePayoutScheduleRequest)(nil), "osmosis.incentives.GaugePayoutScheduleRequest")
+	proto.RegisterType((*GaugePayoutScheduleResponse)(nil), "osmosis.incentives.GaugePayoutScheduleResponse")
+	proto.RegisterType((*EpochPayout)(nil), "osmosis.incentives.EpochPayout")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/query.proto", fileDescriptor_8124258a89427f98) }
 
 var fileDescriptor_8124258a89427f98 = []byte{
-	// 1057 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xc7, 0x33, 0x4e, 0x5c, 0xda, 0xd7, 0x12, 0x9a, 0x21, 0x40, 0xe2, 0xb6, 0x6b, 0xb3, 0x6a,
-	0x53, 0x37, 0x25, 0x3b, 0x71, 0x4c, 0x53, 0x04, 0x02, 0x09, 0xe3, 0x52, 0x2a, 0x81, 0x14, 0x56,
-	0x20, 0x24, 0x24, 0xb4, 0x5a, 0x7b, 0x87, 0xed, 0x2a, 0xf6, 0x8e, 0xbb, 0xb3, 0x9b, 0x60, 0x45,
-	0xb9, 0x20, 0xce, 0x15, 0x88, 0x08, 0x71, 0xe8, 0x5f, 0xc0, 0x11, 0x24, 0x8e, 0x1c, 0x38, 0xf5,
-	0x58, 0x09, 0x09, 0x71, 0x4a, 0x51, 0xc2, 0x5f, 0xd0, 0xbf, 0x00, 0xed, 0xec, 0xac, 0xbd, 0xb6,
-	0xd7, 0xeb, 0x04, 0xd1, 0x2a, 0x27, 0x67, 0xf2, 0x7e, 0x7d, 0xde, 0xd7, 0xe3, 0xf7, 0x06, 0x14,
-	0xc6, 0xdb, 0x8c, 0x3b, 0x9c, 0x38, 0x6e, 0x93, 0xba, 0xbe, 0xb3, 0x45, 0x39, 0xb9, 0x17, 0x50,
-	0xaf, 0xab, 0x75, 0x3c, 0xe6, 0x33, 0x8c, 0xa5, 0x5d, 0xeb, 0xdb, 0x0b, 0xf3, 0x36, 0xb3, 0x99,
-	0x30, 0x93, 0xf0, 0xaf, 0xc8, 0xb3, 0x70, 0xd1, 0x66, 0xcc, 0x6e, 0x51, 0x62, 0x76, 0x1c, 0x62,
-	0xba, 0x2e, 0xf3, 0x4d, 0xdf, 0x61, 0x2e, 0x97, 0x56, 0x45, 0x5a, 0xc5, 0xa9, 0x11, 0x7c, 0x49,
-	0xac, 0xc0, 0x13, 0x0e, 0xb1, 0xbd, 0x29, 0x0a, 0x91, 0x86, 0xc9, 0x29, 0xd9, 0xaa, 0x34, 0xa8,
-	0x6f, 0x56, 0x48, 0x93, 0x39, 0xb1, 0x7d, 0x39, 0x69, 0x17, 0x80, 0x3d, 0xaf, 0x8e, 0x69, 0x3b,
-	0xee, 0x40, 0xae, 0x94, 0x9e, 0x6c, 0x33, 0xb0, 0xa9, 0xb4, 0x2f, 0xc6, 0xf6, 0x16, 0x6b, 0x6e,
-	0x06, 0x1d, 0xf1, 0x11, 0x99, 0xd4, 0x12, 0x28, 0x1f, 0x31, 0x2b, 0x68, 0xd1, 0x4f, 0x58, 0xdd,
-	0xe1, 0xbe, 0xe7, 0x34, 0x02, 0x9f, 0xbe, 0xc7, 0x1c, 0x97, 0xeb, 0xf4, 0x5e, 0x40, 0xb9, 0xaf,
-	0x7e, 0x83, 0xa0, 0x38, 0xd6, 0x85, 0x77, 0x98, 0xcb, 0x29, 0x36, 0x21, 0x1f, 0xa2, 0xf3, 0x05,
-	0x54, 0x9a, 0x2e, 0x9f, 0x5d, 0x5b, 0xd4, 0x22, 0x78, 0x2d, 0x84, 0xd7, 0x24, 0xb6, 0x16, 0x86,
-	0xd4, 0x56, 0x1f, 0xee, 0x17, 0xa7, 0x7e, 0x7a, 0x5c, 0x2c, 0xdb, 0x8e, 0x7f, 0x37, 0x68, 0x68,
-	0x4d, 0xd6, 0x26, 0xb2, 0xd3, 0xe8, 0x63, 0x85, 0x5b, 0x9b, 0xc4, 0xef, 0x76, 0x28, 0xd7, 0xa2,
-	0x1a, 0x51, 0x66, 0x55, 0x85, 0xf3, 0xb7, 0xc3, 0x96, 0x6a, 0xdd, 0x3b, 0x75, 0x89, 0x86, 0x67,
-	0x21, 0xe7, 0x58, 0x0b, 0xa8, 0x84, 0xca, 0x33, 0x7a, 0xce, 0xb1, 0xd4, 0x3a, 0xcc, 0x25, 0x7c,
-	0x24, 0x1b, 0x81, 0xbc, 0xd0, 0x42, 0xf8, 0x85, 0x6c, 0xa3, 0x5f, 0xb0, 0x26, 0xa2, 0xf4, 0xc8,
-	0x4f, 0xfd, 0x0c, 0x9e, 0x17, 0xe7, 0x58, 0x01, 0xfc, 0x3e, 0x40, 0x5f, 0x72, 0x99, 0x66, 0x69,
-	0xa0, 0xc5, 0xe8, 0x02, 0xc5, 0x8d, 0x6e, 0x98, 0x36, 0x95, 0xb1, 0x7a, 0x22, 0x52, 0xbd, 0x8f,
-	0x60, 0x36, 0xce, 0x2c, 0xe1, 0xaa, 0x30, 0x63, 0x99, 0xbe, 0xd9, 0xd3, 0x6d, 0x1c, 0x5b, 0x6d,
-	0x26, 0xd4, 0x4d, 0x17, 0xce, 0xf8, 0xf6, 0x00, 0x4f, 0x4e, 0xf0, 0x5c, 0x9d, 0xc8, 0x13, 0x55,
-	0x1c, 0x00, 0xfa, 0x02, 0x5e, 0x7c, 0xb7, 0x19, 0x56, 0x79, 0x3a, 0xfd, 0xee, 0x21, 0x98, 0x1f,
-	0xcc, 0x7f, 0x22, 0xba, 0xde, 0x81, 0x0b, 0x49, 0xaa, 0x0d, 0xea, 0xd5, 0xa9, 0xcb, 0xda, 0x71,
-	0xf7, 0xf3, 0x90, 0xb7, 0xc2, 0xb3, 0x68, 0xfc, 0x8c, 0x1e, 0x1d, 0x86, 0x34, 0xc9, 0xfd, 0x67,
-	0x4d, 0x1e, 0x20, 0xb8, 0x98, 0x5e, 0xfd, 0x44, 0x68, 0x63, 0xc0, 0x4b, 0x9f, 0x76, 0x9a, 0xac,
-	0xed, 0xb8, 0xf6, 0xd3, 0xb9, 0x13, 0x3f, 0x20, 0x78, 0x79, 0xb8, 0xc2, 0x89, 0xe8, 0x7c, 0x17,
-	0x2e, 0x0d, 0x72, 0x3d, 0xdb, 0x7b, 0xf1, 0x0b, 0x02, 0x65, 0x5c, 0x7d, 0xa9, 0xcf, 0x07, 0xf0,
-	0x42, 0x20, 0x3d, 0x0c, 0x31, 0xa9, 0xf8, 0x51, 0xa5, 0x9a, 0x0d, 0x06, 0x32, 0xff, 0x7f, 0xa2,
-	0x71, 0x98, 0xd3, 0xe9, 0xb6, 0xe9, 0x59, 0xfc, 0x16, 0xf7, 0x63, 0xa1, 0x96, 0x20, 0xcf, 0xb6,
-	0x5d, 0xea, 0x45, 0x42, 0xd5, 0xce, 0x3f, 0xd9, 0x2f, 0x9e, 0xeb, 0x9a, 0xed, 0xd6, 0x9b, 0xaa,
-	0xf8, 0xb7, 0xaa, 0x47, 0x66, 0xbc, 0x08, 0xa7, 0xc3, 0x45, 0x64, 0x38, 0x16, 0x5f, 0xc8, 0x95,
-	0xa6, 0xcb, 0x33, 0xfa, 0x73, 0xe1, 0xf9, 0x8e, 0xc5, 0xf1, 0x05, 0x38, 0x43, 0x5d, 0xcb, 0xa0,
-	0x1d, 0xd6, 0xbc, 0xbb, 0x30, 0x5d, 0x42, 0xe5, 0x69, 0xfd, 0x34, 0x75, 0xad, 0x5b, 0xe1, 0x59,
-	0xdd, 0x06, 0x9c, 0x2c, 0xfa, 0xec, 0x56, 0x50, 0x11, 0x2e, 0x7d, 0x1c, 0xea, 0xf2, 0x21, 0x6b,
-	0x6e, 0x9a, 0x8d, 0x16, 0xad, 0xcb, 0x8d, 0xde, 0x5b, 0x95, 0xdf, 0x21, 0x50, 0xc6, 0x79, 0x48,
-	0x4c, 0x06, 0xb8, 0x25, 0x8d, 0x46, 0xfc, 0x22, 0xe8, 0x33, 0x47, 0x6f, 0x06, 0x2d, 0x7e, 0x33,
-	0x68, 0x71, 0x7c, 0xed, 0x4a, 0xc8, 0xfc, 0x64, 0xbf, 0xb8, 0x18, 0x09, 0x39, 0x9a, 0x42, 0xfd,
-	0xf1, 0x71, 0x11, 0xe9, 0x73, 0xad, 0xe1, 0xc2, 0x6b, 0x7f, 0x9e, 0x85, 0xbc, 0x60, 0xc2, 0xbf,
-	0x23, 0x78, 0x65, 0xcc, 0x22, 0xc7, 0x6b, 0x69, 0x57, 0x28, 0xfb, 0x61, 0x50, 0xa8, 0x1e, 0x2b,
-	0x26, 0xea, 0x5f, 0x7d, 0xe7, 0xeb, 0x3f, 0xfe, 0xf9, 0x3e, 0xf7, 0x06, 0x5e, 0x27, 0x29, 0x6f,
-	0x96, 0xf8, 0x81, 0xd3, 0x16, 0x49, 0x0c, 0x9f, 0x19, 0x56, 0x2f, 0x8d, 0x21, 0xbe, 0x03, 0x7c,
-	0x1f, 0xc1, 0x99, 0xde, 0x8e, 0xc7, 0x97, 0xc7, 0xdf, 0xfc, 0xfe, 0x33, 0xa1, 0x70, 0x65, 0x82,
-	0x97, 0x44, 0x7b, 0x5d, 0xa0, 0x69, 0xf8, 0xb5, 0x2c, 0x34, 0xf1, 0xc3, 0x33, 0x1a, 0x5d, 0xc3,
-	0xb1, 0xc8, 0x8e, 0x63, 0xed, 0xe2, 0x1d, 0x38, 0x25, 0x7f, 0x55, 0xaf, 0x8e, 0x2d, 0xd3, 0x93,
-	0x4c, 0xcd, 0x72, 0x91, 0x18, 0xcb, 0x02, 0xe3, 0x32, 0x56, 0x27, 0x62, 0x70, 0xbc, 0x87, 0xe0,
-	0x5c, 0x72, 0x9b, 0xe0, 0xab, 0x69, 0x05, 0x52, 0x76, 0x7c, 0xa1, 0x3c, 0xd9, 0x51, 0xf2, 0x54,
-	0x04, 0xcf, 0x75, 0x7c, 0x2d, 0x8b, 0xc7, 0x14, 0x91, 0x72, 0x2c, 0xe1, 0x5f, 0x87, 0x16, 0x7f,
-	0x3c, 0xca, 0x30, 0x99, 0x54, 0x75, 0x68, 0xe8, 0x16, 0x56, 0x8f, 0x1e, 0x20, 0x71, 0xdf, 0x12,
-	0xb8, 0x37, 0x70, 0xf5, 0xc8, 0xb8, 0x46, 0x87, 0x7a, 0x46, 0x34, 0xcd, 0x1f, 0x20, 0x98, 0x1d,
-	0x9c, 0xc2, 0xf8, 0x5a, 0x1a, 0x41, 0xea, 0x8e, 0x2c, 0x2c, 0x1f, 0xc5, 0x55, 0x62, 0x56, 0x05,
-	0xe6, 0x0a, 0xbe, 0x9e, 0x85, 0x39, 0x34, 0xee, 0xf1, 0x6f, 0x23, 0xcb, 0xb3, 0xa7, 0x6c, 0x65,
-	0x72, 0xed, 0x61, 0x6d, 0xd7, 0x8e, 0x13, 0x22, 0xb1, 0xdf, 0x16, 0xd8, 0x37, 0xf1, 0x8d, 0x63,
-	0x60, 0x27, 0xf4, 0xdd, 0x43, 0x00, 0xfd, 0xd9, 0x8d, 0x53, 0x7f, 0x98, 0x23, 0x0b, 0xa5, 0xb0,
-	0x34, 0xc9, 0x4d, 0xc2, 0xdd, 0x14, 0x70, 0x15, 0x4c, 0xb2, 0xe0, 0xbc, 0x28, 0xce, 0xa0, 0xdc,
-	0x27, 0x3b, 0x62, 0x11, 0xed, 0xe2, 0x9f, 0x11, 0xcc, 0x8d, 0x8c, 0xec, 0x74, 0x49, 0x33, 0x17,
-	0x40, 0xba, 0xa4, 0xd9, 0x1b, 0x41, 0x5d, 0x17, 0xd4, 0xab, 0x58, 0xcb, 0xa2, 0x1e, 0x1d, 0xf8,
-	0xb5, 0x8d, 0x87, 0x07, 0x0a, 0x7a, 0x74, 0xa0, 0xa0, 0xbf, 0x0f, 0x14, 0xf4, 0xed, 0xa1, 0x32,
-	0xf5, 0xe8, 0x50, 0x99, 0xfa, 0xeb, 0x50, 0x99, 0xfa, 0x7c, 0x3d, 0xb1, 0xd8, 0x64, 0xce, 0x95,
-	0x96, 0xd9, 0xe0, 0xbd, 0x02, 0x5b, 0x95, 0x2a, 0xf9, 0x2a, 0x59, 0x46, 0x2c, 0xbb, 0xc6, 0x29,
-	0xb1, 0x77, 0xaa, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0xb6, 0xef, 0xf0, 0x21, 0x23, 0x0f, 0x00,
+	// 1153 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0x4f, 0x6f, 0x1b, 0xc5,
+	0x1b, 0xc7, 0x33, 0x4e, 0xd2, 0x36, 0x4f, 0xdb, 0xfc, 0x9a, 0x69, 0x7e, 0x90, 0x6c, 0xda, 0x75,
+	0x58, 0xb5, 0xa9, 0x9b, 0x36, 0xbb, 0x71, 0x4c, 0x53, 0x04, 0x02, 0x84, 0x49, 0x5b, 0x2a, 0x81,
+	0x14, 0x16, 0x10, 0x12, 0x12, 0xac, 0xd6, 0xde, 0xc1, 0x59, 0xc5, 0xde, 0xd9, 0x7a, 0x76, 0x13,
+	0xac, 0x28, 0x17, 0x84, 0x38, 0x16, 0x10, 0x11, 0xe2, 0xd0, 0x57, 0xc0, 0x11, 0x24, 0x8e, 0x1c,
+	0x38, 0xf5, 0x58, 0x89, 0x0b, 0xa7, 0x14, 0x25, 0xbc, 0x82, 0xbe, 0x02, 0xb4, 0x33, 0xb3, 0xf6,
+	0xda, 0x5e, 0xdb, 0x31, 0x22, 0x55, 0x4e, 0xe9, 0xf4, 0xf9, 0xf7, 0x79, 0x9e, 0x59, 0xcf, 0xf7,
+	0x01, 0x95, 0xb2, 0x1a, 0x65, 0x2e, 0x33, 0x5c, 0xaf, 0x4c, 0xbc, 0xc0, 0xdd, 0x22, 0xcc, 0x78,
+	0x10, 0x92, 0x7a, 0x43, 0xf7, 0xeb, 0x34, 0xa0, 0x18, 0x4b, 0xbb, 0xde, 0xb2, 0x2b, 0xd3, 0x15,
+	0x5a, 0xa1, 0xdc, 0x6c, 0x44, 0xff, 0x12, 0x9e, 0xca, 0xa5, 0x0a, 0xa5, 0x95, 0x2a, 0x31, 0x6c,
+	0xdf, 0x35, 0x6c, 0xcf, 0xa3, 0x81, 0x1d, 0xb8, 0xd4, 0x63, 0xd2, 0xaa, 0x4a, 0x2b, 0x3f, 0x95,
+	0xc2, 0xcf, 0x0d, 0x27, 0xac, 0x73, 0x87, 0xd8, 0x5e, 0xe6, 0x85, 0x8c, 0x92, 0xcd, 0x88, 0xb1,
+	0x95, 0x2f, 0x91, 0xc0, 0xce, 0x1b, 0x65, 0xea, 0xc6, 0xf6, 0xc5, 0xa4, 0x9d, 0x03, 0x36, 0xbd,
+	0x7c, 0xbb, 0xe2, 0x7a, 0x6d, 0xb9, 0x52, 0x7a, 0xaa, 0xd8, 0x61, 0x85, 0x48, 0xfb, 0x6c, 0x6c,
+	0xaf, 0xd2, 0xf2, 0x66, 0xe8, 0xf3, 0x3f, 0xc2, 0xa4, 0xcd, 0x83, 0xfa, 0x1e, 0x75, 0xc2, 0x2a,
+	0xf9, 0x90, 0xae, 0xb9, 0x2c, 0xa8, 0xbb, 0xa5, 0x30, 0x20, 0x6f, 0x53, 0xd7, 0x63, 0x26, 0x79,
+	0x10, 0x12, 0x16, 0x68, 0x5f, 0x21, 0xc8, 0xf6, 0x74, 0x61, 0x3e, 0xf5, 0x18, 0xc1, 0x36, 0x8c,
+	0x47, 0xe8, 0x6c, 0x06, 0xcd, 0x8f, 0xe6, 0xce, 0xae, 0xcc, 0xea, 0x02, 0x5e, 0x8f, 0xe0, 0x75,
+	0x89, 0xad, 0x47, 0x21, 0xc5, 0xe5, 0xc7, 0xfb, 0xd9, 0x91, 0x9f, 0x9e, 0x66, 0x73, 0x15, 0x37,
+	0xd8, 0x08, 0x4b, 0x7a, 0x99, 0xd6, 0x0c, 0xd9, 0xa9, 0xf8, 0xb3, 0xc4, 0x9c, 0x4d, 0x23, 0x68,
+	0xf8, 0x84, 0xe9, 0xa2, 0x86, 0xc8, 0xac, 0x69, 0x70, 0xe1, 0x5e, 0xd4, 0x52, 0xb1, 0x71, 0x7f,
+	0x4d, 0xa2, 0xe1, 0x49, 0xc8, 0xb8, 0xce, 0x0c, 0x9a, 0x47, 0xb9, 0x31, 0x33, 0xe3, 0x3a, 0xda,
+	0x1a, 0x4c, 0x25, 0x7c, 0x24, 0x9b, 0x01, 0xe3, 0x7c, 0x16, 0xdc, 0x2f, 0x62, 0xeb, 0xbe, 0x60,
+	0x9d, 0x47, 0x99, 0xc2, 0x4f, 0xfb, 0x18, 0xce, 0xf3, 0x73, 0x3c, 0x01, 0x7c, 0x17, 0xa0, 0x35,
+	0x72, 0x99, 0x66, 0xa1, 0xad, 0x45, 0xf1, 0x01, 0xc5, 0x8d, 0xae, 0xdb, 0x15, 0x22, 0x63, 0xcd,
+	0x44, 0xa4, 0xf6, 0x10, 0xc1, 0x64, 0x9c, 0x59, 0xc2, 0x15, 0x60, 0xcc, 0xb1, 0x03, 0xbb, 0x39,
+	0xb7, 0x5e, 0x6c, 0xc5, 0xb1, 0x68, 0x6e, 0x26, 0x77, 0xc6, 0xf7, 0xda, 0x78, 0x32, 0x9c, 0xe7,
+	0xda, 0x40, 0x1e, 0x51, 0xb1, 0x0d, 0xe8, 0x53, 0xb8, 0xf8, 0x56, 0x39, 0xaa, 0x72, 0x3c, 0xfd,
+	0xee, 0x21, 0x98, 0x6e, 0xcf, 0x7f, 0x22, 0xba, 0xde, 0x81, 0xb9, 0x24, 0xd5, 0x3a, 0xa9, 0xaf,
+	0x11, 0x8f, 0xd6, 0xe2, 0xee, 0xa7, 0x61, 0xdc, 0x89, 0xce, 0xbc, 0xf1, 0x09, 0x53, 0x1c, 0xf0,
+	0xdd, 0x94, 0xea, 0xff, 0x66, 0x26, 0x8f, 0x10, 0x5c, 0x4a, 0xaf, 0x7e, 0x22, 0x66, 0x63, 0xc1,
+	0xff, 0x3f, 0xf2, 0xcb, 0xb4, 0xe6, 0x7a, 0x95, 0xe3, 0xf9, 0x26, 0x7e, 0x40, 0xf0, 0x42, 0x67,
+	0x85, 0x13, 0xd1, 0xf9, 0x2e, 0x5c, 0x6e, 0xe7, 0x7a, 0xbe, 0xdf, 0xc5, 0x2f, 0x08, 0xd4, 0x5e,
+	0xf5, 0xe5, 0x7c, 0xde, 0x81, 0xff, 0x85, 0xd2, 0xc3, 0xe2, 0x2f, 0x15, 0x3b, 0xea, 0xa8, 0x26,
+	0xc3, 0xb6, 0xcc, 0xff, 0xdd, 0xd0, 0x18, 0x4c, 0x99, 0x64, 0xdb, 0xae, 0x3b, 0xec, 0x0e, 0x0b,
+	0xe2, 0x41, 0x2d, 0xc0, 0x38, 0xdd, 0xf6, 0x48, 0x5d, 0x0c, 0xaa, 0x78, 0xe1, 0xd9, 0x7e, 0xf6,
+	0x5c, 0xc3, 0xae, 0x55, 0x5f, 0xd5, 0xf8, 0x7f, 0x6b, 0xa6, 0x30, 0xe3, 0x59, 0x38, 0x13, 0x09,
+	0x91, 0xe5, 0x3a, 0x6c, 0x26, 0x33, 0x3f, 0x9a, 0x1b, 0x33, 0x4f, 0x47, 0xe7, 0xfb, 0x0e, 0xc3,
+	0x73, 0x30, 0x41, 0x3c, 0xc7, 0x22, 0x3e, 0x2d, 0x6f, 0xcc, 0x8c, 0xce, 0xa3, 0xdc, 0xa8, 0x79,
+	0x86, 0x78, 0xce, 0x9d, 0xe8, 0xac, 0x6d, 0x03, 0x4e, 0x16, 0x7d, 0x7e, 0x12, 0x94, 0x85, 0xcb,
+	0xef, 0x47, 0x73, 0x79, 0x97, 0x96, 0x37, 0xed, 0x52, 0x95, 0xac, 0x49, 0x45, 0x6f, 0x4a, 0xe5,
+	0x77, 0x08, 0xd4, 0x5e, 0x1e, 0x12, 0x93, 0x02, 0xae, 0x4a, 0xa3, 0x15, 0x6f, 0x04, 0x2d, 0x66,
+	0xb1, 0x33, 0xe8, 0xf1, 0xce, 0xa0, 0xc7, 0xf1, 0xc5, 0xab, 0x11, 0xf3, 0xb3, 0xfd, 0xec, 0xac,
+	0x18, 0x64, 0x77, 0x0a, 0xed, 0xc7, 0xa7, 0x59, 0x64, 0x4e, 0x55, 0x3b, 0x0b, 0x6b, 0x37, 0x41,
+	0xe1, 0xb7, 0xbe, 0x6e, 0x37, 0x68, 0x18, 0x7c, 0x50, 0xde, 0x20, 0x91, 0x94, 0xf7, 0x52, 0xd0,
+	0xcf, 0x60, 0x2e, 0xd5, 0x5b, 0xd2, 0xbf, 0x09, 0xa7, 0x7d, 0x6e, 0x89, 0x91, 0xb3, 0x69, 0x9f,
+	0x1e, 0xbf, 0x26, 0x91, 0x41, 0x7e, 0x80, 0x71, 0x94, 0xf6, 0x35, 0x82, 0xb3, 0x09, 0x73, 0xf4,
+	0xa3, 0x12, 0x97, 0x8c, 0xf8, 0x25, 0x8b, 0x43, 0xeb, 0x2e, 0x33, 0xc7, 0x75, 0x97, 0x2b, 0xdf,
+	0x9c, 0x87, 0x71, 0x7e, 0x55, 0xf8, 0x77, 0x04, 0x2f, 0xf6, 0xd8, 0x6f, 0xf0, 0x4a, 0x5a, 0x7b,
+	0xfd, 0xf7, 0x25, 0xa5, 0x30, 0x54, 0x8c, 0x18, 0xac, 0xf6, 0xc6, 0x97, 0x7f, 0xfc, 0xfd, 0x7d,
+	0xe6, 0x15, 0xbc, 0x6a, 0xa4, 0xac, 0x72, 0xf1, 0xde, 0x57, 0xe3, 0x49, 0xac, 0x80, 0x5a, 0x4e,
+	0x33, 0x8d, 0xc5, 0xdb, 0xc1, 0x0f, 0x11, 0x4c, 0x34, 0x57, 0x1f, 0x7c, 0xa5, 0xf7, 0x83, 0xd0,
+	0xda, 0x9e, 0x94, 0xab, 0x03, 0xbc, 0x24, 0xda, 0xcb, 0x1c, 0x4d, 0xc7, 0x37, 0xfb, 0xa1, 0xf1,
+	0xf7, 0xc8, 0x2a, 0x35, 0x2c, 0xd7, 0x31, 0x76, 0x5c, 0x67, 0x17, 0xef, 0xc0, 0x29, 0xf9, 0xd8,
+	0xbc, 0xd4, 0xb3, 0x4c, 0x73, 0x64, 0x5a, 0x3f, 0x17, 0x89, 0xb1, 0xc8, 0x31, 0xae, 0x60, 0x6d,
+	0x20, 0x06, 0xc3, 0x7b, 0x08, 0xce, 0x25, 0x45, 0x16, 0x5f, 0x4b, 0x2b, 0x90, 0xb2, 0xfa, 0x28,
+	0xb9, 0xc1, 0x8e, 0x92, 0x27, 0xcf, 0x79, 0x6e, 0xe0, 0xeb, 0xfd, 0x78, 0x6c, 0x1e, 0x29, 0x5f,
+	0x6b, 0xfc, 0x6b, 0xc7, 0x3e, 0x14, 0xbf, 0xf0, 0xd8, 0x18, 0x54, 0xb5, 0x43, 0x8b, 0x94, 0xe5,
+	0xa3, 0x07, 0x48, 0xdc, 0xd7, 0x38, 0xee, 0x2d, 0x5c, 0x38, 0x32, 0xae, 0xe5, 0x93, 0xba, 0x25,
+	0x44, 0xee, 0x11, 0x82, 0xc9, 0x76, 0x71, 0xc2, 0xd7, 0xd3, 0x08, 0x52, 0x57, 0x07, 0x65, 0xf1,
+	0x28, 0xae, 0x12, 0xb3, 0xc0, 0x31, 0x97, 0xf0, 0x8d, 0x7e, 0x98, 0x1d, 0x2a, 0x88, 0x7f, 0xeb,
+	0xda, 0x29, 0x9a, 0x93, 0xcd, 0x0f, 0xae, 0xdd, 0x39, 0xdb, 0x95, 0x61, 0x42, 0x24, 0xf6, 0xeb,
+	0x1c, 0xfb, 0x36, 0xbe, 0x35, 0x04, 0x76, 0x62, 0xbe, 0x7b, 0x08, 0xa0, 0x25, 0x69, 0x38, 0xf5,
+	0x87, 0xd9, 0xa5, 0xb3, 0xca, 0xc2, 0x20, 0x37, 0x09, 0x77, 0x9b, 0xc3, 0xe5, 0xb1, 0xd1, 0x0f,
+	0xae, 0x2e, 0xe2, 0x2c, 0xc2, 0x02, 0x63, 0x87, 0xeb, 0xf3, 0x2e, 0xfe, 0x19, 0xc1, 0x54, 0x97,
+	0x92, 0xa5, 0x8f, 0xb4, 0xaf, 0x2e, 0x2a, 0x2b, 0xc3, 0x84, 0x48, 0xea, 0x55, 0x4e, 0xbd, 0x8c,
+	0xf5, 0x7e, 0xd4, 0xdd, 0x3a, 0x18, 0xfd, 0xc8, 0x2e, 0xa6, 0x48, 0x18, 0xd6, 0x7b, 0xbe, 0x31,
+	0xa9, 0xca, 0xa8, 0x18, 0x47, 0xf6, 0x1f, 0xe6, 0x09, 0x17, 0xef, 0xa4, 0x50, 0x43, 0x8b, 0xc9,
+	0x14, 0xfc, 0xc5, 0x2c, 0xae, 0x3f, 0x3e, 0x50, 0xd1, 0x93, 0x03, 0x15, 0xfd, 0x75, 0xa0, 0xa2,
+	0x6f, 0x0f, 0xd5, 0x91, 0x27, 0x87, 0xea, 0xc8, 0x9f, 0x87, 0xea, 0xc8, 0x27, 0xab, 0x09, 0x71,
+	0x93, 0xb9, 0x97, 0xaa, 0x76, 0x89, 0x35, 0x0b, 0x6d, 0xe5, 0x0b, 0xc6, 0x17, 0xc9, 0x72, 0x5c,
+	0xf0, 0x4a, 0xa7, 0xf8, 0x1e, 0x51, 0xf8, 0x67, 0x00, 0xda, 0x71, 0x2a, 0x70, 0xf3, 0x10, 0x00,
 	0x00,
 }
 
@@ -1040,6 +1195,9 @@ type QueryClient interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(ctx context.Context, in *QueryLockableDurationsRequest, opts ...grpc.CallOption) (*QueryLockableDurationsResponse, error)
+	// GaugePayoutSchedule returns the coins a gauge is expected to pay out in
+	// each of its remaining epochs
+	GaugePayoutSchedule(ctx context.Context, in *GaugePayoutScheduleRequest, opts ...grpc.CallOption) (*GaugePayoutScheduleResponse, error)
 }
 
 type queryClient struct {
@@ -1131,6 +1289,15 @@ func (c *queryClient) LockableDurations(ctx context.Context, in *QueryLockableDu
 	return out, nil
 }
 
+func (c *queryClient) GaugePayoutSchedule(ctx context.Context, in *GaugePayoutScheduleRequest, opts ...grpc.CallOption) (*GaugePayoutScheduleResponse, error) {
+	out := new(GaugePayoutScheduleResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Query/GaugePayoutSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// ModuleToDistributeCoins returns coins that are going to be distributed
@@ -1155,6 +1322,9 @@ type QueryServer interface {
 	// LockableDurations returns lockable durations that are valid to distribute
 	// incentives for
 	LockableDurations(context.Context, *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error)
+	// GaugePayoutSchedule returns the coins a gauge is expected to pay out in
+	// each of its remaining epochs
+	GaugePayoutSchedule(context.Context, *GaugePayoutScheduleRequest) (*GaugePayoutScheduleResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -1188,6 +1358,9 @@ func (*UnimplementedQueryServer) RewardsEst(ctx context.Context, req *RewardsEst
 func (*UnimplementedQueryServer) LockableDurations(ctx context.Context, req *QueryLockableDurationsRequest) (*QueryLockableDurationsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LockableDurations not implemented")
 }
+func (*UnimplementedQueryServer) GaugePayoutSchedule(ctx context.Context, req *GaugePayoutScheduleRequest) (*GaugePayoutScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GaugePayoutSchedule not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -1355,6 +1528,24 @@ func _Query_LockableDurations_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_GaugePayoutSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GaugePayoutScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GaugePayoutSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Query/GaugePayoutSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GaugePayoutSchedule(ctx, req.(*GaugePayoutScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -1395,6 +1586,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LockableDurations",
 			Handler:    _Query_LockableDurations_Handler,
 		},
+		{
+			MethodName: "GaugePayoutSchedule",
+			Handler:    _Query_GaugePayoutSchedule_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/query.proto",
@@ -2105,6 +2300,113 @@ func (m *QueryLockableDurationsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *GaugePayoutScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugePayoutScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugePayoutScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GaugePayoutScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GaugePayoutScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GaugePayoutScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for iNdEx := len(m.Payouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Payouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochPayout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochPayout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochPayout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Coins) > 0 {
+		for iNdEx := len(m.Coins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Coins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -2395,6 +2697,51 @@ func (m *QueryLockableDurationsResponse) Size() (n int) {
 	return n
 }
 
+func (m *GaugePayoutScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *GaugePayoutScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Payouts) > 0 {
+		for _, e := range m.Payouts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EpochPayout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if len(m.Coins) > 0 {
+		for _, e := range m.Coins {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -4179,6 +4526,262 @@ func (m *QueryLockableDurationsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GaugePayoutScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugePayoutScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugePayoutScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GaugePayoutScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GaugePayoutScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GaugePayoutScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payouts = append(m.Payouts, EpochPayout{})
+			if err := m.Payouts[len(m.Payouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochPayout) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochPayout: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochPayout: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Coins = append(m.Coins, types.Coin{})
+			if err := m.Coins[len(m.Coins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
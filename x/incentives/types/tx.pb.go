@@ -55,6 +55,9 @@ type MsgCreateGauge struct {
 	// num_epochs_paid_over is the number of epochs distribution will be completed
 	// over
 	NumEpochsPaidOver uint64 `protobuf:"varint,6,opt,name=num_epochs_paid_over,json=numEpochsPaidOver,proto3" json:"num_epochs_paid_over,omitempty"`
+	// payout_schedule, if set, is the schedule a non-perpetual gauge pays out
+	// its coins over, rather than paying out evenly each epoch
+	PayoutSchedule *PayoutSchedule `protobuf:"bytes,7,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule,omitempty" yaml:"payout_schedule"`
 }
 
 func (m *MsgCreateGauge) Reset()         { *m = MsgCreateGauge{} }
@@ -132,6 +135,13 @@ func (m *MsgCreateGauge) GetNumEpochsPaidOver() uint64 {
 	return 0
 }
 
+func (m *MsgCreateGauge) GetPayoutSchedule() *PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedule
+	}
+	return nil
+}
+
 type MsgCreateGaugeResponse struct {
 }
 
@@ -268,55 +278,153 @@ func (m *MsgAddToGaugeResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddToGaugeResponse proto.InternalMessageInfo
 
+// MsgCancelGauge cancels an upcoming or active non-perpetual gauge and refunds
+// its undistributed coins to the gauge owner
+type MsgCancelGauge struct {
+	// owner is the address of the gauge owner
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty" yaml:"owner"`
+	// gauge_id is the ID of the gauge to cancel
+	GaugeId uint64 `protobuf:"varint,2,opt,name=gauge_id,json=gaugeId,proto3" json:"gauge_id,omitempty"`
+}
+
+func (m *MsgCancelGauge) Reset()         { *m = MsgCancelGauge{} }
+func (m *MsgCancelGauge) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGauge) ProtoMessage()    {}
+func (*MsgCancelGauge) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{4}
+}
+func (m *MsgCancelGauge) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGauge) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGauge.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGauge) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGauge.Merge(m, src)
+}
+func (m *MsgCancelGauge) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGauge) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGauge.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGauge proto.InternalMessageInfo
+
+func (m *MsgCancelGauge) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgCancelGauge) GetGaugeId() uint64 {
+	if m != nil {
+		return m.GaugeId
+	}
+	return 0
+}
+
+type MsgCancelGaugeResponse struct {
+}
+
+func (m *MsgCancelGaugeResponse) Reset()         { *m = MsgCancelGaugeResponse{} }
+func (m *MsgCancelGaugeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelGaugeResponse) ProtoMessage()    {}
+func (*MsgCancelGaugeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ea120e22291556e, []int{5}
+}
+func (m *MsgCancelGaugeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelGaugeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelGaugeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelGaugeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelGaugeResponse.Merge(m, src)
+}
+func (m *MsgCancelGaugeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelGaugeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelGaugeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelGaugeResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateGauge)(nil), "osmosis.incentives.MsgCreateGauge")
 	proto.RegisterType((*MsgCreateGaugeResponse)(nil), "osmosis.incentives.MsgCreateGaugeResponse")
 	proto.RegisterType((*MsgAddToGauge)(nil), "osmosis.incentives.MsgAddToGauge")
 	proto.RegisterType((*MsgAddToGaugeResponse)(nil), "osmosis.incentives.MsgAddToGaugeResponse")
+	proto.RegisterType((*MsgCancelGauge)(nil), "osmosis.incentives.MsgCancelGauge")
+	proto.RegisterType((*MsgCancelGaugeResponse)(nil), "osmosis.incentives.MsgCancelGaugeResponse")
 }
 
 func init() { proto.RegisterFile("osmosis/incentives/tx.proto", fileDescriptor_8ea120e22291556e) }
 
 var fileDescriptor_8ea120e22291556e = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x6e, 0xd3, 0x30,
-	0x1c, 0xae, 0x69, 0xf7, 0xcf, 0xdd, 0xd0, 0x88, 0x06, 0x64, 0x05, 0x25, 0x5d, 0x0e, 0x28, 0x20,
-	0xcd, 0x66, 0x9b, 0xc4, 0x81, 0x1b, 0x9d, 0x10, 0xda, 0xa1, 0xa2, 0x44, 0x95, 0x90, 0x26, 0xa1,
-	0xc8, 0x49, 0x4c, 0x66, 0xad, 0x89, 0xa3, 0xd8, 0xe9, 0xd6, 0xb7, 0x98, 0xc4, 0x5b, 0xf0, 0x06,
-	0xdc, 0x38, 0xee, 0xb8, 0x23, 0xa7, 0x0e, 0xb5, 0x6f, 0xb0, 0x27, 0x40, 0x71, 0x92, 0xb5, 0x15,
-	0x7f, 0x76, 0xe1, 0xe4, 0xda, 0xdf, 0xf7, 0xfb, 0xfc, 0xf3, 0xf7, 0xfd, 0x1a, 0xf8, 0x84, 0x8b,
-	0x88, 0x0b, 0x26, 0x30, 0x8b, 0x7d, 0x1a, 0x4b, 0x36, 0xa4, 0x02, 0xcb, 0x73, 0x94, 0xa4, 0x5c,
-	0x72, 0x4d, 0x2b, 0x41, 0x34, 0x03, 0x5b, 0x5b, 0x21, 0x0f, 0xb9, 0x82, 0x71, 0xfe, 0xab, 0x60,
-	0xb6, 0xcc, 0x90, 0xf3, 0x70, 0x40, 0xb1, 0xda, 0x79, 0xd9, 0x67, 0x2c, 0x59, 0x44, 0x85, 0x24,
-	0x51, 0x52, 0x12, 0x0c, 0x5f, 0x69, 0x61, 0x8f, 0x08, 0x8a, 0x87, 0x7b, 0x1e, 0x95, 0x64, 0x0f,
-	0xfb, 0x9c, 0xc5, 0x15, 0xfe, 0x87, 0x3e, 0x42, 0x92, 0x85, 0xb4, 0xc4, 0xb7, 0x2b, 0x7c, 0xc0,
-	0xfd, 0xd3, 0x2c, 0x51, 0x4b, 0x01, 0x59, 0x5f, 0xea, 0xf0, 0x7e, 0x57, 0x84, 0x87, 0x29, 0x25,
-	0x92, 0xbe, 0xcb, 0x6b, 0xb4, 0x1d, 0xb8, 0xce, 0x84, 0x9b, 0xd0, 0x34, 0xa1, 0x32, 0x23, 0x03,
-	0x1d, 0xb4, 0x81, 0xbd, 0xea, 0x34, 0x99, 0xe8, 0x55, 0x47, 0xda, 0x33, 0xb8, 0xc4, 0xcf, 0x62,
-	0x9a, 0xea, 0xf7, 0xda, 0xc0, 0x5e, 0xeb, 0x6c, 0xde, 0x8c, 0xcd, 0xf5, 0x11, 0x89, 0x06, 0xaf,
-	0x2d, 0x75, 0x6c, 0x39, 0x05, 0xac, 0x1d, 0xc1, 0x8d, 0x80, 0x09, 0x99, 0x32, 0x2f, 0x93, 0xd4,
-	0x95, 0x5c, 0xaf, 0xb7, 0x81, 0xdd, 0xdc, 0x37, 0x50, 0xe5, 0x4d, 0xd1, 0x10, 0xfa, 0x90, 0xd1,
-	0x74, 0x74, 0xc8, 0xe3, 0x80, 0x49, 0xc6, 0xe3, 0x4e, 0xe3, 0x72, 0x6c, 0xd6, 0x9c, 0xf5, 0x59,
-	0x69, 0x9f, 0x6b, 0x04, 0x2e, 0xe5, 0x2f, 0x16, 0x7a, 0xa3, 0x5d, 0xb7, 0x9b, 0xfb, 0xdb, 0xa8,
-	0xf0, 0x04, 0xe5, 0x9e, 0xa0, 0xd2, 0x13, 0x74, 0xc8, 0x59, 0xdc, 0x79, 0x99, 0x57, 0x7f, 0xbd,
-	0x36, 0xed, 0x90, 0xc9, 0x93, 0xcc, 0x43, 0x3e, 0x8f, 0x70, 0x69, 0x60, 0xb1, 0xec, 0x8a, 0xe0,
-	0x14, 0xcb, 0x51, 0x42, 0x85, 0x2a, 0x10, 0x4e, 0xa1, 0xac, 0x7d, 0x84, 0x50, 0x48, 0x92, 0x4a,
-	0x37, 0xf7, 0x5f, 0x5f, 0x52, 0xad, 0xb6, 0x50, 0x11, 0x0e, 0xaa, 0xc2, 0x41, 0xfd, 0x2a, 0x9c,
-	0xce, 0xd3, 0xfc, 0xa2, 0x9b, 0xb1, 0xb9, 0x59, 0x3c, 0xfd, 0x36, 0x35, 0xeb, 0xe2, 0xda, 0x04,
-	0xce, 0x9a, 0xd2, 0xca, 0xd9, 0x1a, 0x86, 0x5b, 0x71, 0x16, 0xb9, 0x34, 0xe1, 0xfe, 0x89, 0x70,
-	0x13, 0xc2, 0x02, 0x97, 0x0f, 0x69, 0xaa, 0x2f, 0xb7, 0x81, 0xdd, 0x70, 0x1e, 0xc4, 0x59, 0xf4,
-	0x56, 0x41, 0x3d, 0xc2, 0x82, 0xf7, 0x43, 0x9a, 0x5a, 0x3a, 0x7c, 0xb4, 0x18, 0x8a, 0x43, 0x45,
-	0xc2, 0x63, 0x41, 0xad, 0x6f, 0x00, 0x6e, 0x74, 0x45, 0xf8, 0x26, 0x08, 0xfa, 0xbc, 0x88, 0xeb,
-	0x36, 0x0b, 0xf0, 0xef, 0x2c, 0xb6, 0xe1, 0xaa, 0x9a, 0x09, 0x97, 0x05, 0x2a, 0xb6, 0x86, 0xb3,
-	0xa2, 0xf6, 0x47, 0x81, 0x46, 0xe1, 0x4a, 0x4a, 0xcf, 0x48, 0x1a, 0x08, 0xbd, 0xfe, 0xff, 0xdd,
-	0xad, 0xb4, 0xad, 0xc7, 0xf0, 0xe1, 0x42, 0xeb, 0xd5, 0xa3, 0xf6, 0xbf, 0x03, 0x58, 0xef, 0x8a,
-	0x50, 0xfb, 0x04, 0x9b, 0xf3, 0x83, 0x68, 0xa1, 0xdf, 0xff, 0x42, 0x68, 0xd1, 0x97, 0xd6, 0x8b,
-	0xbb, 0x39, 0xd5, 0x35, 0xda, 0x31, 0x84, 0x73, 0xbe, 0xed, 0xfc, 0xa5, 0x72, 0x46, 0x69, 0x3d,
-	0xbf, 0x93, 0x52, 0x69, 0x77, 0x7a, 0x97, 0x13, 0x03, 0x5c, 0x4d, 0x0c, 0xf0, 0x73, 0x62, 0x80,
-	0x8b, 0xa9, 0x51, 0xbb, 0x9a, 0x1a, 0xb5, 0x1f, 0x53, 0xa3, 0x76, 0xfc, 0x6a, 0xce, 0xa8, 0x52,
-	0x6e, 0x77, 0x40, 0x3c, 0x51, 0x6d, 0xf0, 0x70, 0xef, 0x00, 0x9f, 0x2f, 0x7c, 0x42, 0x72, 0xf3,
-	0xbc, 0x65, 0x35, 0x71, 0x07, 0xbf, 0x02, 0x00, 0x00, 0xff, 0xff, 0x5c, 0x02, 0x5b, 0x96, 0x65,
-	0x04, 0x00, 0x00,
+	// 665 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcd, 0x4e, 0xdb, 0x4c,
+	0x14, 0x8d, 0x49, 0xf8, 0x9b, 0x00, 0x1f, 0x9f, 0x45, 0xa9, 0x71, 0x2b, 0x3b, 0x78, 0x51, 0xa5,
+	0x48, 0xcc, 0x14, 0x90, 0xba, 0xe8, 0xae, 0x41, 0x55, 0xc5, 0x02, 0x35, 0x35, 0x48, 0x95, 0x90,
+	0x2a, 0x6b, 0x6c, 0x4f, 0xcd, 0x08, 0xdb, 0x63, 0x79, 0xc6, 0x81, 0xbc, 0x05, 0x6f, 0xd0, 0x7d,
+	0xdf, 0xa0, 0x6f, 0xc0, 0x92, 0x65, 0x57, 0xa1, 0x82, 0x37, 0x60, 0xdd, 0x45, 0xe5, 0xb1, 0x9d,
+	0x9f, 0x16, 0xca, 0x86, 0x95, 0x33, 0x3e, 0xe7, 0x9e, 0xb9, 0xf7, 0x9c, 0xeb, 0x80, 0x67, 0x8c,
+	0x47, 0x8c, 0x53, 0x8e, 0x68, 0xec, 0x91, 0x58, 0xd0, 0x1e, 0xe1, 0x48, 0x9c, 0xc1, 0x24, 0x65,
+	0x82, 0xa9, 0x6a, 0x09, 0xc2, 0x11, 0xa8, 0xaf, 0x04, 0x2c, 0x60, 0x12, 0x46, 0xf9, 0xaf, 0x82,
+	0xa9, 0x9b, 0x01, 0x63, 0x41, 0x48, 0x90, 0x3c, 0xb9, 0xd9, 0x17, 0x24, 0x68, 0x44, 0xb8, 0xc0,
+	0x51, 0x52, 0x12, 0x0c, 0x4f, 0x6a, 0x21, 0x17, 0x73, 0x82, 0x7a, 0x5b, 0x2e, 0x11, 0x78, 0x0b,
+	0x79, 0x8c, 0xc6, 0x15, 0x7e, 0x47, 0x1f, 0x01, 0xce, 0x02, 0x52, 0xe2, 0x6b, 0x15, 0x1e, 0x32,
+	0xef, 0x24, 0x4b, 0xe4, 0xa3, 0x80, 0xac, 0x5f, 0x75, 0xb0, 0xb4, 0xcf, 0x83, 0xdd, 0x94, 0x60,
+	0x41, 0xde, 0xe7, 0x35, 0xea, 0x3a, 0x58, 0xa0, 0xdc, 0x49, 0x48, 0x9a, 0x10, 0x91, 0xe1, 0x50,
+	0x53, 0x5a, 0x4a, 0x7b, 0xce, 0x6e, 0x52, 0xde, 0xad, 0x5e, 0xa9, 0x2f, 0xc0, 0x34, 0x3b, 0x8d,
+	0x49, 0xaa, 0x4d, 0xb5, 0x94, 0xf6, 0x7c, 0x67, 0xf9, 0x76, 0x60, 0x2e, 0xf4, 0x71, 0x14, 0xbe,
+	0xb1, 0xe4, 0x6b, 0xcb, 0x2e, 0x60, 0x75, 0x0f, 0x2c, 0xfa, 0x94, 0x8b, 0x94, 0xba, 0x99, 0x20,
+	0x8e, 0x60, 0x5a, 0xbd, 0xa5, 0xb4, 0x9b, 0xdb, 0x06, 0xac, 0xbc, 0x29, 0x1a, 0x82, 0x1f, 0x33,
+	0x92, 0xf6, 0x77, 0x59, 0xec, 0x53, 0x41, 0x59, 0xdc, 0x69, 0x5c, 0x0c, 0xcc, 0x9a, 0xbd, 0x30,
+	0x2a, 0x3d, 0x64, 0x2a, 0x06, 0xd3, 0xf9, 0xc4, 0x5c, 0x6b, 0xb4, 0xea, 0xed, 0xe6, 0xf6, 0x1a,
+	0x2c, 0x3c, 0x81, 0xb9, 0x27, 0xb0, 0xf4, 0x04, 0xee, 0x32, 0x1a, 0x77, 0x5e, 0xe5, 0xd5, 0xdf,
+	0xae, 0xcc, 0x76, 0x40, 0xc5, 0x71, 0xe6, 0x42, 0x8f, 0x45, 0xa8, 0x34, 0xb0, 0x78, 0x6c, 0x72,
+	0xff, 0x04, 0x89, 0x7e, 0x42, 0xb8, 0x2c, 0xe0, 0x76, 0xa1, 0xac, 0x7e, 0x02, 0x80, 0x0b, 0x9c,
+	0x0a, 0x27, 0xf7, 0x5f, 0x9b, 0x96, 0xad, 0xea, 0xb0, 0x08, 0x07, 0x56, 0xe1, 0xc0, 0xc3, 0x2a,
+	0x9c, 0xce, 0xf3, 0xfc, 0xa2, 0xdb, 0x81, 0xb9, 0x5c, 0x8c, 0x3e, 0x4c, 0xcd, 0x3a, 0xbf, 0x32,
+	0x15, 0x7b, 0x5e, 0x6a, 0xe5, 0x6c, 0x15, 0x81, 0x95, 0x38, 0x8b, 0x1c, 0x92, 0x30, 0xef, 0x98,
+	0x3b, 0x09, 0xa6, 0xbe, 0xc3, 0x7a, 0x24, 0xd5, 0x66, 0x5a, 0x4a, 0xbb, 0x61, 0xff, 0x1f, 0x67,
+	0xd1, 0x3b, 0x09, 0x75, 0x31, 0xf5, 0x3f, 0xf4, 0x48, 0xaa, 0x06, 0xe0, 0xbf, 0x04, 0xf7, 0x59,
+	0x26, 0x1c, 0xee, 0x1d, 0x13, 0x3f, 0x0b, 0x89, 0x36, 0x2b, 0xdb, 0xb1, 0xe0, 0xdf, 0x5b, 0x05,
+	0xbb, 0x92, 0x7a, 0x50, 0x32, 0x3b, 0xfa, 0xed, 0xc0, 0x5c, 0x2d, 0x5a, 0xfa, 0x43, 0xc4, 0xb2,
+	0x97, 0x92, 0x09, 0xae, 0xa5, 0x81, 0xd5, 0xc9, 0xf4, 0x6d, 0xc2, 0x13, 0x16, 0x73, 0x62, 0x7d,
+	0x57, 0xc0, 0xe2, 0x3e, 0x0f, 0xde, 0xfa, 0xfe, 0x21, 0x2b, 0xf6, 0x62, 0x18, 0xba, 0xf2, 0xef,
+	0xd0, 0xd7, 0xc0, 0x9c, 0x5c, 0x3e, 0x87, 0xfa, 0x72, 0x3f, 0x1a, 0xf6, 0xac, 0x3c, 0xef, 0xf9,
+	0x2a, 0x01, 0xb3, 0x29, 0x39, 0xc5, 0xa9, 0xcf, 0xb5, 0xfa, 0xe3, 0xc7, 0x58, 0x69, 0x5b, 0x4f,
+	0xc1, 0x93, 0x89, 0xd6, 0x87, 0x43, 0x1d, 0x14, 0xcb, 0x8e, 0x63, 0x8f, 0x84, 0x8f, 0x35, 0x54,
+	0xe5, 0xe1, 0x48, 0xb4, 0xba, 0x6e, 0xfb, 0xeb, 0x14, 0xa8, 0xef, 0xf3, 0x40, 0xfd, 0x0c, 0x9a,
+	0xe3, 0x1f, 0xd8, 0x9d, 0x21, 0x4e, 0xc6, 0xa0, 0x6f, 0x3c, 0xcc, 0xa9, 0xae, 0x51, 0x8f, 0x00,
+	0x18, 0x8b, 0x69, 0xfd, 0x9e, 0xca, 0x11, 0x45, 0x7f, 0xf9, 0x20, 0x65, 0xa8, 0x9d, 0xb7, 0x3e,
+	0x66, 0xd7, 0xbd, 0xad, 0x8f, 0x38, 0xfa, 0xc6, 0xc3, 0x9c, 0x4a, 0xbe, 0xd3, 0xbd, 0xb8, 0x36,
+	0x94, 0xcb, 0x6b, 0x43, 0xf9, 0x79, 0x6d, 0x28, 0xe7, 0x37, 0x46, 0xed, 0xf2, 0xc6, 0xa8, 0xfd,
+	0xb8, 0x31, 0x6a, 0x47, 0xaf, 0xc7, 0x62, 0x2f, 0xf5, 0x36, 0x43, 0xec, 0xf2, 0xea, 0x80, 0x7a,
+	0x5b, 0x3b, 0xe8, 0x6c, 0xe2, 0x9f, 0x37, 0x5f, 0x05, 0x77, 0x46, 0x7e, 0xa8, 0x3b, 0xbf, 0x07,
+	0x00, 0x32, 0x31, 0xdf, 0xa1, 0x9c, 0x05, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -333,6 +441,7 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	CreateGauge(ctx context.Context, in *MsgCreateGauge, opts ...grpc.CallOption) (*MsgCreateGaugeResponse, error)
 	AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...grpc.CallOption) (*MsgAddToGaugeResponse, error)
+	CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error)
 }
 
 type msgClient struct {
@@ -361,10 +470,20 @@ func (c *msgClient) AddToGauge(ctx context.Context, in *MsgAddToGauge, opts ...g
 	return out, nil
 }
 
+func (c *msgClient) CancelGauge(ctx context.Context, in *MsgCancelGauge, opts ...grpc.CallOption) (*MsgCancelGaugeResponse, error) {
+	out := new(MsgCancelGaugeResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.incentives.Msg/CancelGauge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateGauge(context.Context, *MsgCreateGauge) (*MsgCreateGaugeResponse, error)
 	AddToGauge(context.Context, *MsgAddToGauge) (*MsgAddToGaugeResponse, error)
+	CancelGauge(context.Context, *MsgCancelGauge) (*MsgCancelGaugeResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -377,6 +496,9 @@ func (*UnimplementedMsgServer) CreateGauge(ctx context.Context, req *MsgCreateGa
 func (*UnimplementedMsgServer) AddToGauge(ctx context.Context, req *MsgAddToGauge) (*MsgAddToGaugeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddToGauge not implemented")
 }
+func (*UnimplementedMsgServer) CancelGauge(ctx context.Context, req *MsgCancelGauge) (*MsgCancelGaugeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelGauge not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -418,6 +540,24 @@ func _Msg_AddToGauge_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelGauge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelGauge)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelGauge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.incentives.Msg/CancelGauge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelGauge(ctx, req.(*MsgCancelGauge))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.incentives.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -430,6 +570,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddToGauge",
 			Handler:    _Msg_AddToGauge_Handler,
 		},
+		{
+			MethodName: "CancelGauge",
+			Handler:    _Msg_CancelGauge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/incentives/tx.proto",
@@ -455,17 +599,29 @@ func (m *MsgCreateGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PayoutSchedule != nil {
+		{
+			size, err := m.PayoutSchedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if m.NumEpochsPaidOver != 0 {
 		i = encodeVarintTx(dAtA, i, uint64(m.NumEpochsPaidOver))
 		i--
 		dAtA[i] = 0x30
 	}
-	n1, err1 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
-	if err1 != nil {
-		return 0, err1
+	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.StartTime):])
+	if err2 != nil {
+		return 0, err2
 	}
-	i -= n1
-	i = encodeVarintTx(dAtA, i, uint64(n1))
+	i -= n2
+	i = encodeVarintTx(dAtA, i, uint64(n2))
 	i--
 	dAtA[i] = 0x2a
 	if len(m.Coins) > 0 {
@@ -607,6 +763,64 @@ func (m *MsgAddToGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgCancelGauge) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGauge) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGauge) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.GaugeId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GaugeId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelGaugeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelGaugeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelGaugeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -644,6 +858,10 @@ func (m *MsgCreateGauge) Size() (n int) {
 	if m.NumEpochsPaidOver != 0 {
 		n += 1 + sovTx(uint64(m.NumEpochsPaidOver))
 	}
+	if m.PayoutSchedule != nil {
+		l = m.PayoutSchedule.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
 	return n
 }
 
@@ -687,6 +905,31 @@ func (m *MsgAddToGaugeResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgCancelGauge) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.GaugeId != 0 {
+		n += 1 + sovTx(uint64(m.GaugeId))
+	}
+	return n
+}
+
+func (m *MsgCancelGaugeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -893,6 +1136,42 @@ func (m *MsgCreateGauge) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PayoutSchedule == nil {
+				m.PayoutSchedule = &PayoutSchedule{}
+			}
+			if err := m.PayoutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1149,6 +1428,157 @@ func (m *MsgAddToGaugeResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgCancelGauge) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGauge: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGauge: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GaugeId", wireType)
+			}
+			m.GaugeId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GaugeId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelGaugeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelGaugeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
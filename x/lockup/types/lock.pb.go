@@ -160,9 +160,9 @@ type QueryCondition struct {
 	// duration. Duration field must not be nil when the lock query type is
 	// `ByLockDuration`.
 	Duration time.Duration `protobuf:"bytes,3,opt,name=duration,proto3,stdduration" json:"duration" yaml:"duration"`
-	// Timestamp is used by locks started before the specified duration.
+	// Timestamp is used to query locks unlocking after the specified time,
+	// counting locks not yet unlocking as if they began unlocking now.
 	// Timestamp field must not be nil when the lock query type is `ByLockTime`.
-	// Querying locks with timestamp is currently not implemented.
 	Timestamp time.Time `protobuf:"bytes,4,opt,name=timestamp,proto3,stdtime" json:"timestamp" yaml:"timestamp"`
 }
 
@@ -317,7 +317,7 @@ func init() {
 func init() { proto.RegisterFile("osmosis/lockup/lock.proto", fileDescriptor_7e9d7527a237b489) }
 
 var fileDescriptor_7e9d7527a237b489 = []byte{
-	// 595 bytes of a gzipped FileDescriptorProto
+	// 592 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0xbf, 0x6f, 0xd3, 0x40,
 	0x14, 0xb6, 0xdd, 0xa4, 0xb4, 0x57, 0x92, 0x46, 0xa7, 0x0e, 0x69, 0x00, 0x3b, 0xf2, 0x80, 0x22,
 	0xd4, 0xda, 0x24, 0xdd, 0x18, 0xdd, 0x30, 0x44, 0xea, 0x00, 0xa6, 0x62, 0x60, 0x89, 0xfc, 0xe3,
@@ -354,8 +354,7 @@ var fileDescriptor_7e9d7527a237b489 = []byte{
 	0x3e, 0x7e, 0x55, 0x25, 0xeb, 0xe4, 0x62, 0xae, 0xca, 0x97, 0x73, 0x55, 0xfe, 0x31, 0x57, 0xe5,
 	0xf3, 0x85, 0x2a, 0x5d, 0x2e, 0x54, 0xe9, 0xfb, 0x42, 0x95, 0xde, 0x0c, 0xd6, 0x8c, 0x5b, 0xb9,
 	0xec, 0x30, 0x74, 0xdc, 0x4c, 0x04, 0xe6, 0x59, 0xff, 0xc8, 0xfc, 0x20, 0xfe, 0x57, 0xcc, 0xc8,
-	0xee, 0x26, 0x7b, 0xd0, 0xd1, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x55, 0x7f, 0xcc, 0xd3, 0xce,
-	0x04, 0x00, 0x00,
+	0xee, 0x26, 0x7b, 0xd0, 0xd1, 0xef, 0x01, 0x00, 0x55, 0x7f, 0xcc, 0xd3, 0xce, 0x04, 0x00, 0x00,
 }
 
 func (m *PeriodLock) Marshal() (dAtA []byte, err error) {
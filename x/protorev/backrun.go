@@ -0,0 +1,230 @@
+package protorev
+
+import (
+	"strconv"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// BackrunSwappedPools backruns the routes through the pools swapped through since it was last called,
+// which happens at the end of every delivered tx. Routes are evaluated in order until MaxRoutesPerBlock
+// routes have been evaluated in the block, and each route is backrun if any amount in is profitable.
+//
+// Backruns are run by the protocol rather than on behalf of the tx sender, so they are not charged to the tx.
+// Instead, they run against their own gas meter, capped by MaxGasPerTx. Running out of gas, like any other
+// panic, reverts all backruns after the tx but not the tx itself. Routes evaluated by reverted backruns
+// still count towards MaxRoutesPerBlock.
+func (k Keeper) BackrunSwappedPools(ctx sdk.Context) {
+	ctx = ctx.WithGasMeter(sdk.NewInfiniteGasMeter())
+
+	poolIds := k.getSwappedPools(ctx)
+	if len(poolIds) == 0 {
+		return
+	}
+	k.clearSwappedPools(ctx)
+
+	// Backruns are only worth running against state that gets committed.
+	if ctx.IsCheckTx() {
+		return
+	}
+
+	params := k.GetParams(ctx)
+	if !params.Enabled {
+		return
+	}
+
+	defer func() {
+		if recoveryError := recover(); recoveryError != nil {
+			osmoutils.PrintPanicRecoveryError(ctx, recoveryError)
+		}
+	}()
+
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(sdk.NewGasMeter(params.MaxGasPerTx)).WithEventManager(sdk.NewEventManager())
+
+	k.backrunRoutesThroughPools(ctx, cacheCtx, params, poolIds)
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	// The backruns swapped through the pools of their routes, which must not be backrun in turn.
+	k.clearSwappedPools(ctx)
+}
+
+// backrunRoutesThroughPools backruns the routes through any of the given pools on backrunCtx, as described
+// in BackrunSwappedPools. The routes evaluated are counted on ctx, so that the count is kept even if the
+// backruns are reverted.
+func (k Keeper) backrunRoutesThroughPools(ctx, backrunCtx sdk.Context, params types.Params, poolIds []uint64) {
+	swappedPools := make(map[uint64]bool, len(poolIds))
+	for _, poolId := range poolIds {
+		swappedPools[poolId] = true
+	}
+
+	for _, route := range k.GetBackrunRoutes(ctx) {
+		if !routeSwapsThroughAny(route, swappedPools) {
+			continue
+		}
+		if k.getRoutesEvaluatedThisBlock(ctx) >= params.MaxRoutesPerBlock {
+			return
+		}
+		k.incrementRoutesEvaluatedThisBlock(ctx)
+
+		amountIn, err := k.findBackrunAmountIn(backrunCtx, route)
+		if err != nil {
+			backrunCtx.Logger().Debug("could not size backrun", "pool_ids", route.PoolIds(), "error", err.Error())
+			continue
+		}
+		if amountIn.IsZero() {
+			continue
+		}
+
+		if err := k.executeBackrun(backrunCtx, params, route, amountIn); err != nil {
+			backrunCtx.Logger().Error("backrun failed", "pool_ids", route.PoolIds(), "error", err.Error())
+		}
+	}
+}
+
+func routeSwapsThroughAny(route types.BackrunRoute, poolIds map[uint64]bool) bool {
+	for _, hop := range route.Hops {
+		if poolIds[hop.PoolId] {
+			return true
+		}
+	}
+	return false
+}
+
+// findBackrunAmountIn returns the amount in, up to the max amount in of the route, that maximizes the
+// estimated profit of a backrun along it, or zero if no amount in is profitable.
+//
+// The profit of a swap along a cyclic route is concave in the amount in, so it is maximized where its
+// marginal profit, estimated over steps of MaxAmountIn / MarginalProfitStepDivisor, crosses zero.
+// That amount in is binary searched for.
+func (k Keeper) findBackrunAmountIn(ctx sdk.Context, route types.BackrunRoute) (sdk.Int, error) {
+	step := route.MaxAmountIn.QuoRaw(types.MarginalProfitStepDivisor)
+	if !step.IsPositive() {
+		step = sdk.OneInt()
+	}
+
+	estimateProfit := func(amountIn sdk.Int) (sdk.Int, error) {
+		tokenOutAmount, err := k.gammKeeper.MultihopEstimateOutGivenExactAmountIn(ctx, route.Hops, sdk.NewCoin(route.TokenInDenom, amountIn))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return tokenOutAmount.Sub(amountIn), nil
+	}
+	// marginalLoss is the marginal profit over the next step, negated so that it increases with the amount in.
+	marginalLoss := func(amountIn sdk.Int) (sdk.Int, error) {
+		profit, err := estimateProfit(amountIn)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		nextProfit, err := estimateProfit(amountIn.Add(step))
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		return profit.Sub(nextProfit), nil
+	}
+
+	amountIn, err := func() (sdk.Int, error) {
+		lowerbound, upperbound := step, route.MaxAmountIn.Sub(step)
+		if upperbound.LT(lowerbound) {
+			return route.MaxAmountIn, nil
+		}
+
+		lossAtUpperbound, err := marginalLoss(upperbound)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if lossAtUpperbound.IsNegative() {
+			return route.MaxAmountIn, nil
+		}
+
+		lossAtLowerbound, err := marginalLoss(lowerbound)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+		if !lossAtLowerbound.IsNegative() {
+			return lowerbound, nil
+		}
+
+		return osmoutils.BinarySearch(marginalLoss, lowerbound, upperbound, sdk.ZeroInt(), types.MarginalProfitErrTolerance, types.BinarySearchMaxIterations)
+	}()
+	if err != nil {
+		return sdk.Int{}, err
+	}
+
+	profit, err := estimateProfit(amountIn)
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if !profit.IsPositive() {
+		return sdk.ZeroInt(), nil
+	}
+	return amountIn, nil
+}
+
+// executeBackrun swaps amountIn along the route and pays out the profit, as set by the ProfitRecipient param.
+// The swap is funded by minting its token in, which is burned once swapped back, so that the module holds
+// no inventory. Nothing is swapped, minted or paid out unless the backrun makes a profit.
+func (k Keeper) executeBackrun(ctx sdk.Context, params types.Params, route types.BackrunRoute, amountIn sdk.Int) error {
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	tokenIn := sdk.NewCoin(route.TokenInDenom, amountIn)
+	if err := k.bankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return err
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(types.ModuleName)
+	// The swap must return more than it took in to make a profit.
+	tokenOutAmount, err := k.gammKeeper.MultihopSwapExactAmountIn(cacheCtx, moduleAddr, route.Hops, tokenIn, amountIn.AddRaw(1))
+	if err != nil {
+		return err
+	}
+
+	if err := k.bankKeeper.BurnCoins(cacheCtx, types.ModuleName, sdk.NewCoins(tokenIn)); err != nil {
+		return err
+	}
+
+	profit := sdk.NewCoins(sdk.NewCoin(route.TokenInDenom, tokenOutAmount.Sub(amountIn)))
+	if err := k.payOutProfit(cacheCtx, params, profit); err != nil {
+		return err
+	}
+
+	emitBackrunEvent(cacheCtx, route, tokenIn, profit)
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return nil
+}
+
+// payOutProfit sends the profit held by the module account to the profit recipient module account,
+// or to the community pool if there is none.
+func (k Keeper) payOutProfit(ctx sdk.Context, params types.Params, profit sdk.Coins) error {
+	if params.ProfitRecipient == "" {
+		return k.communityPoolKeeper.FundCommunityPool(ctx, profit, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	if k.accountKeeper.GetModuleAddress(params.ProfitRecipient) == nil {
+		return types.UnknownProfitRecipientError{ProfitRecipient: params.ProfitRecipient}
+	}
+	return k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, params.ProfitRecipient, profit)
+}
+
+func emitBackrunEvent(ctx sdk.Context, route types.BackrunRoute, tokenIn sdk.Coin, profit sdk.Coins) {
+	formattedPoolIds := make([]string, 0, len(route.Hops))
+	for _, poolId := range route.PoolIds() {
+		formattedPoolIds = append(formattedPoolIds, strconv.FormatUint(poolId, 10))
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.TypeEvtBackrun,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+		sdk.NewAttribute(types.AttributeKeyPoolIds, strings.Join(formattedPoolIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyTokenIn, tokenIn.String()),
+		sdk.NewAttribute(types.AttributeKeyProfit, profit.String()),
+	))
+}
@@ -0,0 +1,204 @@
+package protorev_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	txfeestypes "github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// TestBackrunSwappedPools tests that the routes through the pools swapped through are backrun
+// with the most profitable amount in, and that the profit goes to the profit recipient.
+//
+// Pool 1 prices bar at one foo and pool 2 at half a foo, so swapping foo for bar in pool 2
+// and back in pool 1 is profitable. Pool 3 holds foo and baz.
+func (s *KeeperTestSuite) TestBackrunSwappedPools() {
+	profitableRoute := newBackrunRoute(2, 1)
+	unprofitableRoute := newBackrunRoute(1, 2)
+
+	paramsWith := func(modify func(params *types.Params)) types.Params {
+		params := types.DefaultParams()
+		modify(&params)
+		return params
+	}
+
+	tests := map[string]struct {
+		params        types.Params
+		routes        []types.BackrunRoute
+		swappedPoolId uint64
+		isCheckTx     bool
+
+		expectedBackruns int
+	}{
+		"profitable route through swapped pool is backrun": {
+			params:           types.DefaultParams(),
+			routes:           []types.BackrunRoute{profitableRoute},
+			swappedPoolId:    1,
+			expectedBackruns: 1,
+		},
+		"profit is sent to the profit recipient": {
+			params:           paramsWith(func(params *types.Params) { params.ProfitRecipient = txfeestypes.ModuleName }),
+			routes:           []types.BackrunRoute{profitableRoute},
+			swappedPoolId:    2,
+			expectedBackruns: 1,
+		},
+		"unprofitable route is not backrun": {
+			params:        types.DefaultParams(),
+			routes:        []types.BackrunRoute{unprofitableRoute},
+			swappedPoolId: 1,
+		},
+		"route through other pools is not backrun": {
+			params:        types.DefaultParams(),
+			routes:        []types.BackrunRoute{profitableRoute},
+			swappedPoolId: 3,
+		},
+		"only max routes per block routes are evaluated": {
+			params:           paramsWith(func(params *types.Params) { params.MaxRoutesPerBlock = 1 }),
+			routes:           []types.BackrunRoute{unprofitableRoute, profitableRoute},
+			swappedPoolId:    1,
+			expectedBackruns: 0,
+		},
+		"backruns are disabled": {
+			params:        paramsWith(func(params *types.Params) { params.Enabled = false }),
+			routes:        []types.BackrunRoute{profitableRoute},
+			swappedPoolId: 1,
+		},
+		"unknown profit recipient": {
+			params:        paramsWith(func(params *types.Params) { params.ProfitRecipient = "unknown" }),
+			routes:        []types.BackrunRoute{profitableRoute},
+			swappedPoolId: 1,
+		},
+		"backruns run out of gas": {
+			params:        paramsWith(func(params *types.Params) { params.MaxGasPerTx = 1 }),
+			routes:        []types.BackrunRoute{profitableRoute},
+			swappedPoolId: 1,
+		},
+		"check tx": {
+			params:        types.DefaultParams(),
+			routes:        []types.BackrunRoute{profitableRoute},
+			swappedPoolId: 1,
+			isCheckTx:     true,
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount.MulRaw(2)))
+			s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(baz, defaultPoolAmount))
+			s.keeper.SetParams(s.Ctx, tc.params)
+			s.keeper.SetBackrunRoutes(s.Ctx, tc.routes)
+
+			// A user swap through the pool tracks it for backruns.
+			s.RunBasicSwap(tc.swappedPoolId)
+			s.Require().Equal([]uint64{tc.swappedPoolId}, s.keeper.GetSwappedPools(s.Ctx))
+
+			// The profits of a range of amounts in along the profitable route, none of which may beat the backrun.
+			bestEstimatedProfit := sdk.ZeroInt()
+			for i := int64(1); i <= 20; i++ {
+				amountIn := profitableRoute.MaxAmountIn.MulRaw(i).QuoRaw(20)
+				tokenOutAmount, err := s.App.GAMMKeeper.MultihopEstimateOutGivenExactAmountIn(s.Ctx, profitableRoute.Hops, sdk.NewCoin(foo, amountIn))
+				s.Require().NoError(err)
+				bestEstimatedProfit = sdk.MaxInt(bestEstimatedProfit, tokenOutAmount.Sub(amountIn))
+			}
+
+			moduleAddr := s.App.AccountKeeper.GetModuleAddress(types.ModuleName)
+			recipientAddr := s.App.AccountKeeper.GetModuleAddress(txfeestypes.ModuleName)
+			communityPoolBefore := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(foo)
+			recipientBalanceBefore := s.App.BankKeeper.GetBalance(s.Ctx, recipientAddr, foo).Amount
+			supplyBefore := s.App.BankKeeper.GetSupply(s.Ctx, foo).Amount
+
+			ctx := s.Ctx.WithEventManager(sdk.NewEventManager()).WithIsCheckTx(tc.isCheckTx)
+			gasConsumedBefore := ctx.GasMeter().GasConsumed()
+			s.keeper.BackrunSwappedPools(ctx)
+
+			// Backruns are not charged to the tx, and the swapped pools are only backrun once.
+			s.Require().Equal(gasConsumedBefore, ctx.GasMeter().GasConsumed())
+			s.Require().Empty(s.keeper.GetSwappedPools(s.Ctx))
+			s.AssertEventEmitted(ctx, types.TypeEvtBackrun, tc.expectedBackruns)
+
+			// The module never keeps any tokens, nor changes the supply.
+			s.Require().True(s.App.BankKeeper.GetAllBalances(s.Ctx, moduleAddr).IsZero())
+			s.Require().Equal(supplyBefore, s.App.BankKeeper.GetSupply(s.Ctx, foo).Amount)
+
+			communityPoolProfit := s.App.DistrKeeper.GetFeePoolCommunityCoins(s.Ctx).AmountOf(foo).Sub(communityPoolBefore).TruncateInt()
+			recipientProfit := s.App.BankKeeper.GetBalance(s.Ctx, recipientAddr, foo).Amount.Sub(recipientBalanceBefore)
+			if tc.expectedBackruns == 0 {
+				s.Require().True(communityPoolProfit.IsZero())
+				s.Require().True(recipientProfit.IsZero())
+				return
+			}
+
+			profit := communityPoolProfit
+			if tc.params.ProfitRecipient != "" {
+				s.Require().True(communityPoolProfit.IsZero())
+				profit = recipientProfit
+			}
+			s.Require().True(profit.IsPositive())
+			s.Require().True(profit.GTE(bestEstimatedProfit), "profit %s is less than estimated profit %s", profit, bestEstimatedProfit)
+
+			// After the backrun, the route is no longer profitable.
+			amountIn, err := s.keeper.FindBackrunAmountIn(s.Ctx, profitableRoute)
+			s.Require().NoError(err)
+			s.Require().True(amountIn.IsZero())
+		})
+	}
+}
+
+// TestBackrunRoutesPerBlock tests that the number of routes evaluated for backruns
+// is counted across the txs of a block.
+func (s *KeeperTestSuite) TestBackrunRoutesPerBlock() {
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount))
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount))
+	params := types.DefaultParams()
+	params.MaxRoutesPerBlock = 3
+	s.keeper.SetParams(s.Ctx, params)
+	s.keeper.SetBackrunRoutes(s.Ctx, []types.BackrunRoute{newBackrunRoute(1, 2), newBackrunRoute(2, 1)})
+
+	expectedRoutesEvaluated := []uint64{2, 3, 3}
+	for _, expected := range expectedRoutesEvaluated {
+		s.keeper.TrackSwappedPool(s.Ctx, 1)
+		s.keeper.BackrunSwappedPools(s.Ctx)
+		s.Require().Equal(expected, s.keeper.GetRoutesEvaluatedThisBlock(s.Ctx))
+	}
+}
+
+// TestBackrunRoutesPerBlockOutOfGas tests that the routes evaluated by backruns that run out of gas
+// still count towards the max routes per block.
+func (s *KeeperTestSuite) TestBackrunRoutesPerBlockOutOfGas() {
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount))
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount.MulRaw(2)))
+	params := types.DefaultParams()
+	params.MaxRoutesPerBlock = 2
+	params.MaxGasPerTx = 1
+	s.keeper.SetParams(s.Ctx, params)
+	s.keeper.SetBackrunRoutes(s.Ctx, []types.BackrunRoute{newBackrunRoute(2, 1)})
+
+	expectedRoutesEvaluated := []uint64{1, 2, 2}
+	for _, expected := range expectedRoutesEvaluated {
+		s.keeper.TrackSwappedPool(s.Ctx, 1)
+		s.keeper.BackrunSwappedPools(s.Ctx)
+		s.Require().Equal(expected, s.keeper.GetRoutesEvaluatedThisBlock(s.Ctx))
+	}
+}
+
+// TestBackrunDecoratorEvents tests that the post handler only returns the events of the backruns,
+// and not those already emitted on the ctx by the ante handler.
+func (s *KeeperTestSuite) TestBackrunDecoratorEvents() {
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount))
+	s.PrepareBalancerPoolWithCoins(sdk.NewCoin(foo, defaultPoolAmount), sdk.NewCoin(bar, defaultPoolAmount.MulRaw(2)))
+	s.keeper.SetBackrunRoutes(s.Ctx, []types.BackrunRoute{newBackrunRoute(2, 1)})
+	s.keeper.TrackSwappedPool(s.Ctx, 1)
+
+	ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+	ctx.EventManager().EmitEvent(sdk.NewEvent("ante"))
+
+	postHandler := sdk.ChainAnteDecorators(protorev.NewBackrunDecorator(*s.keeper))
+	newCtx, err := postHandler(ctx, nil, false)
+	s.Require().NoError(err)
+	s.AssertEventEmitted(newCtx, "ante", 0)
+	s.AssertEventEmitted(newCtx, types.TypeEvtBackrun, 1)
+}
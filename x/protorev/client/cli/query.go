@@ -0,0 +1,31 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/client/queryproto"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// GetQueryCmd returns the cli query commands for this module.
+func GetQueryCmd() *cobra.Command {
+	cmd := osmocli.QueryIndexCmd(types.ModuleName)
+	cmd.AddCommand(
+		GetCmdBackrunRoutes(),
+		osmocli.GetParams[*queryproto.ParamsRequest](types.ModuleName, queryproto.NewQueryClient),
+	)
+	return cmd
+}
+
+// GetCmdBackrunRoutes returns the routes the module may backrun.
+func GetCmdBackrunRoutes() *cobra.Command {
+	return osmocli.SimpleQueryCmd[*queryproto.BackrunRoutesRequest](
+		"backrun-routes",
+		"Query the routes the module may backrun",
+		`{{.Short}}{{.ExampleHeader}}
+{{.CommandPrefix}} backrun-routes
+`,
+		types.ModuleName, queryproto.NewQueryClient,
+	)
+}
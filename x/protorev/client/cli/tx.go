@@ -0,0 +1,62 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils/osmocli"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func GetTxCmd() *cobra.Command {
+	txCmd := osmocli.TxIndexCmd(types.ModuleName)
+	txCmd.AddCommand(
+		NewSetBackrunRoutesCmd(),
+	)
+
+	return txCmd
+}
+
+func NewSetBackrunRoutesCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:   "set-backrun-routes [routes-file]",
+		Short: "Replaces the backrun routes with the routes in the given JSON file. Only the admin can send it.",
+		Long: `Replaces the backrun routes with the routes in the given JSON file. Only the admin can send it.
+Sample routes file contents:
+{
+	"routes": [
+		{
+			"token_in_denom": "uosmo",
+			"hops": [
+				{"pool_id": "1", "token_out_denom": "uatom"},
+				{"pool_id": "2", "token_out_denom": "uosmo"}
+			],
+			"max_amount_in": "1000000000"
+		}
+	]
+}
+`,
+		Example:          "osmosisd tx protorev set-backrun-routes routes.json --from admin",
+		NumArgs:          1,
+		ParseAndBuildMsg: NewMsgSetBackrunRoutes,
+	}.BuildCommandCustomFn()
+}
+
+func NewMsgSetBackrunRoutes(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	contents, err := os.ReadFile(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	msg := types.MsgSetBackrunRoutes{}
+	if err := clientCtx.Codec.UnmarshalJSON(contents, &msg); err != nil {
+		return nil, err
+	}
+	msg.Admin = clientCtx.GetFromAddress().String()
+
+	return &msg, nil
+}
@@ -0,0 +1,30 @@
+package client
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/client/queryproto"
+)
+
+type Querier struct {
+	K protorev.Keeper
+}
+
+func NewQuerier(k protorev.Keeper) Querier {
+	return Querier{k}
+}
+
+func (q Querier) Params(ctx sdk.Context,
+	req queryproto.ParamsRequest,
+) (*queryproto.ParamsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &queryproto.ParamsResponse{Params: params}, nil
+}
+
+func (q Querier) BackrunRoutes(ctx sdk.Context,
+	req queryproto.BackrunRoutesRequest,
+) (*queryproto.BackrunRoutesResponse, error) {
+	routes := q.K.GetBackrunRoutes(ctx)
+	return &queryproto.BackrunRoutesResponse{Routes: routes}, nil
+}
@@ -0,0 +1,23 @@
+package protorev
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (k Keeper) TrackSwappedPool(ctx sdk.Context, poolId uint64) {
+	k.trackSwappedPool(ctx, poolId)
+}
+
+func (k Keeper) GetSwappedPools(ctx sdk.Context) []uint64 {
+	return k.getSwappedPools(ctx)
+}
+
+func (k Keeper) GetRoutesEvaluatedThisBlock(ctx sdk.Context) uint64 {
+	return k.getRoutesEvaluatedThisBlock(ctx)
+}
+
+func (k Keeper) FindBackrunAmountIn(ctx sdk.Context, route types.BackrunRoute) (sdk.Int, error) {
+	return k.findBackrunAmountIn(ctx, route)
+}
@@ -0,0 +1,75 @@
+package protorev
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+type Keeper struct {
+	storeKey     sdk.StoreKey
+	transientKey *sdk.TransientStoreKey
+
+	paramSpace paramtypes.Subspace
+
+	gammKeeper          types.GammKeeper
+	accountKeeper       types.AccountKeeper
+	bankKeeper          types.BankKeeper
+	communityPoolKeeper types.CommunityPoolKeeper
+}
+
+func NewKeeper(
+	storeKey sdk.StoreKey,
+	transientKey *sdk.TransientStoreKey,
+	paramSpace paramtypes.Subspace,
+	gammKeeper types.GammKeeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	communityPoolKeeper types.CommunityPoolKeeper,
+) *Keeper {
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return &Keeper{
+		storeKey:            storeKey,
+		transientKey:        transientKey,
+		paramSpace:          paramSpace,
+		gammKeeper:          gammKeeper,
+		accountKeeper:       accountKeeper,
+		bankKeeper:          bankKeeper,
+		communityPoolKeeper: communityPoolKeeper,
+	}
+}
+
+// GetParams returns the total set of protorev parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of protorev parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// InitGenesis initializes the protorev module's state from a provided genesis
+// state.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
+	if err := genState.Validate(); err != nil {
+		panic(err)
+	}
+
+	k.SetParams(ctx, genState.Params)
+	k.SetBackrunRoutes(ctx, genState.Routes)
+}
+
+// ExportGenesis returns the protorev module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Params: k.GetParams(ctx),
+		Routes: k.GetBackrunRoutes(ctx),
+	}
+}
@@ -0,0 +1,71 @@
+package protorev_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/suite"
+
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+const (
+	foo = "foo"
+	bar = "bar"
+	baz = "baz"
+)
+
+var defaultPoolAmount = sdk.NewInt(1_000_000_000)
+
+type KeeperTestSuite struct {
+	apptesting.KeeperTestHelper
+	keeper *protorev.Keeper
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
+
+func (s *KeeperTestSuite) SetupTest() {
+	s.Setup()
+	s.keeper = s.App.ProtoRevKeeper
+}
+
+// newBackrunRoute returns a route starting and ending in foo, swapping through the given pools
+// to bar and back.
+func newBackrunRoute(firstPoolId, secondPoolId uint64) types.BackrunRoute {
+	return types.BackrunRoute{
+		TokenInDenom: foo,
+		Hops: []gammtypes.SwapAmountInRoute{
+			{PoolId: firstPoolId, TokenOutDenom: bar},
+			{PoolId: secondPoolId, TokenOutDenom: foo},
+		},
+		MaxAmountIn: defaultPoolAmount,
+	}
+}
+
+func (s *KeeperTestSuite) TestInitExportGenesis() {
+	genesis := types.GenesisState{
+		Params: types.NewParams(true, s.TestAccs[0].String(), "", 10, 1_000_000),
+		Routes: []types.BackrunRoute{newBackrunRoute(1, 2), newBackrunRoute(2, 1)},
+	}
+
+	s.keeper.InitGenesis(s.Ctx, &genesis)
+
+	s.Require().Equal(&genesis, s.keeper.ExportGenesis(s.Ctx))
+}
+
+func (s *KeeperTestSuite) TestSetBackrunRoutes() {
+	s.keeper.SetBackrunRoutes(s.Ctx, []types.BackrunRoute{newBackrunRoute(1, 2), newBackrunRoute(2, 1), newBackrunRoute(1, 3)})
+	s.Require().Equal([]types.BackrunRoute{newBackrunRoute(1, 2), newBackrunRoute(2, 1), newBackrunRoute(1, 3)}, s.keeper.GetBackrunRoutes(s.Ctx))
+
+	// Setting fewer routes replaces all of the previous ones.
+	s.keeper.SetBackrunRoutes(s.Ctx, []types.BackrunRoute{newBackrunRoute(3, 1)})
+	s.Require().Equal([]types.BackrunRoute{newBackrunRoute(3, 1)}, s.keeper.GetBackrunRoutes(s.Ctx))
+
+	s.keeper.SetBackrunRoutes(s.Ctx, nil)
+	s.Require().Empty(s.keeper.GetBackrunRoutes(s.Ctx))
+}
@@ -0,0 +1,32 @@
+package protorev
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+var _ types.GammHooks = &gammhook{}
+
+type gammhook struct {
+	k Keeper
+}
+
+func (k Keeper) GammHooks() types.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+}
+
+// AfterSwap tracks the swapped pool, for its routes to be backrun at the end of the tx.
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+	hook.k.trackSwappedPool(ctx, poolId)
+}
+
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
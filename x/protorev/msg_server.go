@@ -0,0 +1,46 @@
+package protorev
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+type msgServer struct {
+	keeper *Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{
+		keeper: keeper,
+	}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// SetBackrunRoutes replaces the backrun routes, if the sender is the admin.
+func (server msgServer) SetBackrunRoutes(goCtx context.Context, msg *types.MsgSetBackrunRoutes) (*types.MsgSetBackrunRoutesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	admin := server.keeper.GetParams(ctx).Admin
+	if admin == "" || msg.Admin != admin {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrUnauthorized, types.ErrNotAdmin.Error())
+	}
+
+	server.keeper.SetBackrunRoutes(ctx, msg.Routes)
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.AttributeValueCategory),
+			sdk.NewAttribute(sdk.AttributeKeySender, msg.Admin),
+		),
+	})
+
+	return &types.MsgSetBackrunRoutesResponse{}, nil
+}
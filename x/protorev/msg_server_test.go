@@ -0,0 +1,55 @@
+package protorev_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/osmosis-labs/osmosis/v13/x/protorev"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func (s *KeeperTestSuite) TestMsgSetBackrunRoutes() {
+	tests := map[string]struct {
+		admin  string
+		sender sdk.AccAddress
+
+		expectPass bool
+	}{
+		"admin sets the routes": {
+			admin:      s.TestAccs[0].String(),
+			sender:     s.TestAccs[0],
+			expectPass: true,
+		},
+		"sender is not the admin": {
+			admin:  s.TestAccs[0].String(),
+			sender: s.TestAccs[1],
+		},
+		"there is no admin": {
+			admin:  "",
+			sender: s.TestAccs[0],
+		},
+	}
+
+	for name, tc := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			params := types.DefaultParams()
+			params.Admin = tc.admin
+			s.keeper.SetParams(s.Ctx, params)
+			previousRoutes := []types.BackrunRoute{newBackrunRoute(2, 1)}
+			s.keeper.SetBackrunRoutes(s.Ctx, previousRoutes)
+
+			routes := []types.BackrunRoute{newBackrunRoute(1, 2), newBackrunRoute(1, 3)}
+			msgServer := protorev.NewMsgServerImpl(s.keeper)
+			_, err := msgServer.SetBackrunRoutes(sdk.WrapSDKContext(s.Ctx), types.NewMsgSetBackrunRoutes(tc.sender, routes))
+
+			if tc.expectPass {
+				s.Require().NoError(err)
+				s.Require().Equal(routes, s.keeper.GetBackrunRoutes(s.Ctx))
+			} else {
+				s.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+				s.Require().Equal(previousRoutes, s.keeper.GetBackrunRoutes(s.Ctx))
+			}
+		})
+	}
+}
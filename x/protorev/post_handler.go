@@ -0,0 +1,23 @@
+package protorev
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BackrunDecorator backruns the pools swapped through by a tx once its msgs have run.
+// It is meant to be chained into the app's post handler. The events of the backruns are emitted on a
+// fresh event manager, as those already on the ctx were emitted by the ante handler and the app appends
+// the events on the ctx returned by the post handler to those of the tx.
+type BackrunDecorator struct {
+	k Keeper
+}
+
+func NewBackrunDecorator(k Keeper) BackrunDecorator {
+	return BackrunDecorator{k: k}
+}
+
+func (d BackrunDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	d.k.BackrunSwappedPools(ctx)
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,80 @@
+package protorev
+
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+// GetBackrunRoutes returns the backrun routes, in the order they were set.
+func (k Keeper) GetBackrunRoutes(ctx sdk.Context) []types.BackrunRoute {
+	store := ctx.KVStore(k.storeKey)
+	routes, err := osmoutils.GatherValuesFromStorePrefix(store, types.KeyPrefixBackrunRoutes, func(bz []byte) (types.BackrunRoute, error) {
+		route := types.BackrunRoute{}
+		err := route.Unmarshal(bz)
+		return route, err
+	})
+	if err != nil {
+		panic(err)
+	}
+	return routes
+}
+
+// SetBackrunRoutes replaces the backrun routes with the given ones.
+func (k Keeper) SetBackrunRoutes(ctx sdk.Context, routes []types.BackrunRoute) {
+	routeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixBackrunRoutes)
+	for _, key := range osmoutils.GatherAllKeysFromStore(routeStore) {
+		routeStore.Delete([]byte(key))
+	}
+
+	for i := range routes {
+		osmoutils.MustSet(routeStore, sdk.Uint64ToBigEndian(uint64(i)), &routes[i])
+	}
+}
+
+// trackSwappedPool places an entry into a transient store, to track that
+// this pool was swapped through since the last backrun.
+func (k Keeper) trackSwappedPool(ctx sdk.Context, poolId uint64) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixSwappedPools)
+	store.Set(sdk.Uint64ToBigEndian(poolId), []byte{1})
+}
+
+// getSwappedPools returns the ids of the pools swapped through since the last backrun, in increasing order.
+func (k Keeper) getSwappedPools(ctx sdk.Context) []uint64 {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixSwappedPools)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	poolIds := []uint64{}
+	for ; iter.Valid(); iter.Next() {
+		poolIds = append(poolIds, sdk.BigEndianToUint64(iter.Key()))
+	}
+	return poolIds
+}
+
+// clearSwappedPools forgets about the pools swapped through since the last backrun.
+func (k Keeper) clearSwappedPools(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.TransientStore(k.transientKey), types.KeyPrefixSwappedPools)
+	for _, poolId := range k.getSwappedPools(ctx) {
+		store.Delete(sdk.Uint64ToBigEndian(poolId))
+	}
+}
+
+// getRoutesEvaluatedThisBlock returns the number of routes evaluated for backruns this block.
+func (k Keeper) getRoutesEvaluatedThisBlock(ctx sdk.Context) uint64 {
+	bz := ctx.TransientStore(k.transientKey).Get(types.KeyRoutesEvaluatedThisBlock)
+	if bz == nil {
+		return 0
+	}
+	return binary.BigEndian.Uint64(bz)
+}
+
+// incrementRoutesEvaluatedThisBlock counts one more route as evaluated for backruns this block.
+func (k Keeper) incrementRoutesEvaluatedThisBlock(ctx sdk.Context) {
+	count := k.getRoutesEvaluatedThisBlock(ctx) + 1
+	ctx.TransientStore(k.transientKey).Set(types.KeyRoutesEvaluatedThisBlock, sdk.Uint64ToBigEndian(count))
+}
@@ -0,0 +1,23 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+)
+
+const (
+	// DefaultMaxRoutesPerBlock is the default number of routes evaluated for backruns in a block.
+	DefaultMaxRoutesPerBlock = 100
+	// DefaultMaxGasPerTx is the default gas available to the backruns after a tx.
+	DefaultMaxGasPerTx = 10_000_000
+
+	// MarginalProfitStepDivisor divides the max amount in of a route into the step over which
+	// the marginal profit of a backrun is estimated when sizing it.
+	MarginalProfitStepDivisor = 10_000
+	// BinarySearchMaxIterations is the number of iterations after which sizing a backrun is given up.
+	BinarySearchMaxIterations = 100
+)
+
+// MarginalProfitErrTolerance is how far from zero the marginal profit of a sized backrun may be.
+var MarginalProfitErrTolerance = osmoutils.ErrTolerance{AdditiveTolerance: sdk.NewInt(2)}
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var ErrNotAdmin = errors.New("only the protorev admin can set backrun routes")
+
+type RouteNotCyclicError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+}
+
+func (e RouteNotCyclicError) Error() string {
+	return fmt.Sprintf("backrun route must end in its token in denom (%s), ends in (%s)", e.TokenInDenom, e.TokenOutDenom)
+}
+
+type RouteTooShortError struct {
+	Hops int
+}
+
+func (e RouteTooShortError) Error() string {
+	return fmt.Sprintf("backrun route must have at least 2 hops, has (%d)", e.Hops)
+}
+
+type RepeatedPoolError struct {
+	PoolId uint64
+}
+
+func (e RepeatedPoolError) Error() string {
+	return fmt.Sprintf("backrun route swaps through pool (%d) more than once", e.PoolId)
+}
+
+type NonPositiveMaxAmountInError struct {
+	MaxAmountIn sdk.Int
+}
+
+func (e NonPositiveMaxAmountInError) Error() string {
+	return fmt.Sprintf("backrun route max amount in must be positive, was (%s)", e.MaxAmountIn)
+}
+
+type UnknownProfitRecipientError struct {
+	ProfitRecipient string
+}
+
+func (e UnknownProfitRecipientError) Error() string {
+	return fmt.Sprintf("profit recipient (%s) is not a module account", e.ProfitRecipient)
+}
@@ -0,0 +1,11 @@
+package types
+
+const (
+	AttributeValueCategory = ModuleName
+
+	TypeEvtBackrun = "backrun"
+
+	AttributeKeyPoolIds = "pool_ids"
+	AttributeKeyTokenIn = "token_in"
+	AttributeKeyProfit  = "profit"
+)
@@ -0,0 +1,17 @@
+package types
+
+// DefaultGenesis returns the default protorev genesis state, without any backrun routes.
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+		Routes: []BackrunRoute{},
+	}
+}
+
+// Validate performs basic genesis state validation.
+func (g *GenesisState) Validate() error {
+	if err := g.Params.Validate(); err != nil {
+		return err
+	}
+	return ValidateBackrunRoutes(g.Routes)
+}
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/protorev/v1beta1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params holds parameters for the protorev module
+type Params struct {
+	// enabled turns backruns on or off.
+	Enabled bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+	// admin is the address allowed to set the backrun routes.
+	Admin string `protobuf:"bytes,2,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
+	// profit_recipient is the name of the module account that receives backrun
+	// profits. If empty, profits are sent to the community pool.
+	ProfitRecipient string `protobuf:"bytes,3,opt,name=profit_recipient,json=profitRecipient,proto3" json:"profit_recipient,omitempty" yaml:"profit_recipient"`
+	// max_routes_per_block is the largest number of routes evaluated for
+	// backruns in a block.
+	MaxRoutesPerBlock uint64 `protobuf:"varint,4,opt,name=max_routes_per_block,json=maxRoutesPerBlock,proto3" json:"max_routes_per_block,omitempty" yaml:"max_routes_per_block"`
+	// max_gas_per_tx is the gas available to the backruns after a tx. Backrun
+	// gas is not charged to the tx.
+	MaxGasPerTx uint64 `protobuf:"varint,5,opt,name=max_gas_per_tx,json=maxGasPerTx,proto3" json:"max_gas_per_tx,omitempty" yaml:"max_gas_per_tx"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c77fc2da5752af2, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *Params) GetAdmin() string {
+	if m != nil {
+		return m.Admin
+	}
+	return ""
+}
+
+func (m *Params) GetProfitRecipient() string {
+	if m != nil {
+		return m.ProfitRecipient
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRoutesPerBlock() uint64 {
+	if m != nil {
+		return m.MaxRoutesPerBlock
+	}
+	return 0
+}
+
+func (m *Params) GetMaxGasPerTx() uint64 {
+	if m != nil {
+		return m.MaxGasPerTx
+	}
+	return 0
+}
+
+// GenesisState defines the protorev module's genesis state.
+type GenesisState struct {
+	// params is the container of protorev parameters.
+	Params Params         `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Routes []BackrunRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3c77fc2da5752af2, []int{1}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *GenesisState) GetRoutes() []BackrunRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "osmosis.protorev.v1beta1.Params")
+	proto.RegisterType((*GenesisState)(nil), "osmosis.protorev.v1beta1.GenesisState")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/genesis.proto", fileDescriptor_3c77fc2da5752af2)
+}
+
+var fileDescriptor_3c77fc2da5752af2 = []byte{
+	// 423 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0xeb, 0xae, 0x2b, 0xe0, 0x4e, 0x63, 0x58, 0x43, 0x84, 0x4d, 0x4a, 0x22, 0x1f, 0x4a,
+	0x0f, 0x90, 0xa8, 0x1b, 0x27, 0x0e, 0x3b, 0x44, 0x88, 0xdd, 0x50, 0x65, 0x38, 0x71, 0xa9, 0x9c,
+	0xcc, 0x84, 0x68, 0x71, 0x1c, 0xd9, 0x6e, 0x95, 0xbd, 0x05, 0x07, 0x1e, 0x86, 0x47, 0xd8, 0x71,
+	0x47, 0x4e, 0x11, 0x6a, 0xdf, 0x20, 0x4f, 0x80, 0x62, 0x27, 0x54, 0x42, 0xf4, 0x66, 0x7f, 0xdf,
+	0xef, 0xfb, 0x94, 0xfc, 0xff, 0x86, 0x53, 0xa1, 0xb8, 0x50, 0x99, 0x0a, 0x4b, 0x29, 0xb4, 0x90,
+	0x6c, 0x1d, 0xae, 0xe7, 0x31, 0xd3, 0x74, 0x1e, 0xa6, 0xac, 0x60, 0x2a, 0x53, 0x81, 0x31, 0x90,
+	0xd3, 0x71, 0x41, 0xcf, 0x05, 0x1d, 0x77, 0x76, 0x9a, 0x8a, 0x54, 0x18, 0x35, 0x6c, 0x4f, 0x16,
+	0x38, 0x7b, 0xb5, 0xb7, 0xf7, 0x6f, 0x81, 0x39, 0xe0, 0x9f, 0x43, 0x38, 0x5e, 0x50, 0x49, 0xb9,
+	0x42, 0xaf, 0xe1, 0x23, 0x56, 0xd0, 0x38, 0x67, 0x37, 0x0e, 0xf0, 0xc1, 0xec, 0x71, 0x84, 0x9a,
+	0xda, 0x3b, 0xbe, 0xa3, 0x3c, 0x7f, 0x87, 0x3b, 0x03, 0x93, 0x1e, 0x41, 0x53, 0x78, 0x48, 0x6f,
+	0x78, 0x56, 0x38, 0x43, 0x1f, 0xcc, 0x9e, 0x44, 0x27, 0x4d, 0xed, 0x1d, 0x59, 0xd6, 0xc8, 0x98,
+	0x58, 0x1b, 0x7d, 0x80, 0x27, 0xa5, 0x14, 0x5f, 0x33, 0xbd, 0x94, 0x2c, 0xc9, 0xca, 0x8c, 0x15,
+	0xda, 0x39, 0x30, 0x91, 0xf3, 0xa6, 0xf6, 0x5e, 0xd8, 0xc8, 0xbf, 0x04, 0x26, 0x4f, 0xad, 0x44,
+	0x7a, 0x05, 0x2d, 0xe0, 0x29, 0xa7, 0xd5, 0x52, 0x8a, 0x95, 0x66, 0x6a, 0x59, 0x32, 0xb9, 0x8c,
+	0x73, 0x91, 0xdc, 0x3a, 0x23, 0x1f, 0xcc, 0x46, 0x91, 0xd7, 0xd4, 0xde, 0xb9, 0xed, 0xfa, 0x1f,
+	0x85, 0xc9, 0x33, 0x4e, 0x2b, 0x62, 0xd4, 0x05, 0x93, 0x51, 0xab, 0xa1, 0x2b, 0x78, 0xdc, 0xb2,
+	0x29, 0xb5, 0xa0, 0xae, 0x9c, 0x43, 0xd3, 0xf5, 0xb2, 0xa9, 0xbd, 0xe7, 0xbb, 0xae, 0x9d, 0x8f,
+	0xc9, 0x84, 0xd3, 0xea, 0x9a, 0xb6, 0x15, 0x9f, 0x2b, 0xfc, 0x03, 0xc0, 0xa3, 0x6b, 0xbb, 0xa5,
+	0x4f, 0x9a, 0x6a, 0x86, 0xae, 0xe0, 0xb8, 0x34, 0xa3, 0x34, 0xf3, 0x9b, 0x5c, 0xf8, 0xc1, 0xbe,
+	0xad, 0x05, 0x76, 0xe4, 0xd1, 0xe8, 0xbe, 0xf6, 0x06, 0xa4, 0x4b, 0xa1, 0xf7, 0x70, 0x6c, 0x3f,
+	0xdc, 0x19, 0xfa, 0x07, 0xb3, 0xc9, 0xc5, 0x74, 0x7f, 0x3e, 0xa2, 0xc9, 0xad, 0x5c, 0x15, 0xe6,
+	0x8f, 0xfa, 0x16, 0x9b, 0x8d, 0x3e, 0xde, 0x6f, 0x5c, 0xf0, 0xb0, 0x71, 0xc1, 0xef, 0x8d, 0x0b,
+	0xbe, 0x6f, 0xdd, 0xc1, 0xc3, 0xd6, 0x1d, 0xfc, 0xda, 0xba, 0x83, 0x2f, 0x6f, 0xd3, 0x4c, 0x7f,
+	0x5b, 0xc5, 0x41, 0x22, 0x78, 0xd8, 0x35, 0xbf, 0xc9, 0x69, 0xac, 0xfa, 0x4b, 0xb8, 0x9e, 0x5f,
+	0x86, 0xd5, 0xee, 0xc9, 0xe8, 0xbb, 0x92, 0xa9, 0x78, 0x6c, 0xee, 0x97, 0x7f, 0x06, 0x00, 0x40,
+	0xda, 0x58, 0x29, 0xab, 0x02, 0x00, 0x00,
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxGasPerTx != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxGasPerTx))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxRoutesPerBlock != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MaxRoutesPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProfitRecipient) > 0 {
+		i -= len(m.ProfitRecipient)
+		copy(dAtA[i:], m.ProfitRecipient)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ProfitRecipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Admin) > 0 {
+		i -= len(m.Admin)
+		copy(dAtA[i:], m.Admin)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Admin)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Enabled {
+		n += 2
+	}
+	l = len(m.Admin)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ProfitRecipient)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.MaxRoutesPerBlock != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxRoutesPerBlock))
+	}
+	if m.MaxGasPerTx != 0 {
+		n += 1 + sovGenesis(uint64(m.MaxGasPerTx))
+	}
+	return n
+}
+
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Admin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Admin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProfitRecipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProfitRecipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRoutesPerBlock", wireType)
+			}
+			m.MaxRoutesPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRoutesPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxGasPerTx", wireType)
+			}
+			m.MaxGasPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxGasPerTx |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, BackrunRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,72 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func TestGenesisStateValidate(t *testing.T) {
+	route := types.BackrunRoute{
+		TokenInDenom: "uosmo",
+		Hops: []gammtypes.SwapAmountInRoute{
+			{PoolId: 1, TokenOutDenom: "uatom"},
+			{PoolId: 2, TokenOutDenom: "uosmo"},
+		},
+		MaxAmountIn: sdk.NewInt(1_000_000),
+	}
+	admin := sdk.AccAddress([]byte("admin_______________")).String()
+
+	tests := map[string]struct {
+		genesis    types.GenesisState
+		expectPass bool
+	}{
+		"default genesis": {
+			genesis:    *types.DefaultGenesis(),
+			expectPass: true,
+		},
+		"admin, profit recipient and routes": {
+			genesis: types.GenesisState{
+				Params: types.NewParams(true, admin, "txfees", 10, 1_000_000),
+				Routes: []types.BackrunRoute{route},
+			},
+			expectPass: true,
+		},
+		"invalid admin": {
+			genesis: types.GenesisState{
+				Params: types.NewParams(true, "admin", "", 10, 1_000_000),
+			},
+		},
+		"profit recipient with spaces": {
+			genesis: types.GenesisState{
+				Params: types.NewParams(true, admin, " txfees", 10, 1_000_000),
+			},
+		},
+		"zero max gas per tx": {
+			genesis: types.GenesisState{
+				Params: types.NewParams(true, admin, "", 10, 0),
+			},
+		},
+		"invalid route": {
+			genesis: types.GenesisState{
+				Params: types.DefaultParams(),
+				Routes: []types.BackrunRoute{{TokenInDenom: "uosmo", Hops: route.Hops[:1], MaxAmountIn: route.MaxAmountIn}},
+			},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.genesis.Validate()
+			if tc.expectPass {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
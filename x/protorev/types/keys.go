@@ -0,0 +1,22 @@
+package types
+
+const (
+	ModuleName = "protorev"
+
+	StoreKey          = ModuleName
+	TransientStoreKey = "transient_" + ModuleName
+	RouterKey         = ModuleName
+
+	QuerierRoute = ModuleName
+)
+
+var (
+	// KeyPrefixBackrunRoutes defines the prefix under which the backrun routes are stored, by index.
+	KeyPrefixBackrunRoutes = []byte{0x01}
+
+	// KeyPrefixSwappedPools defines the transient store prefix of the pools swapped through since the last backrun.
+	KeyPrefixSwappedPools = []byte{0x01}
+
+	// KeyRoutesEvaluatedThisBlock defines the transient store key of the number of routes evaluated for backruns this block.
+	KeyRoutesEvaluatedThisBlock = []byte{0x02}
+)
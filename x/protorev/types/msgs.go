@@ -0,0 +1,41 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// constants
+const (
+	TypeMsgSetBackrunRoutes = "set_backrun_routes"
+)
+
+var _ sdk.Msg = &MsgSetBackrunRoutes{}
+
+// NewMsgSetBackrunRoutes creates a msg to replace the backrun routes.
+func NewMsgSetBackrunRoutes(admin sdk.AccAddress, routes []BackrunRoute) *MsgSetBackrunRoutes {
+	return &MsgSetBackrunRoutes{
+		Admin:  admin.String(),
+		Routes: routes,
+	}
+}
+
+func (m MsgSetBackrunRoutes) Route() string { return RouterKey }
+func (m MsgSetBackrunRoutes) Type() string  { return TypeMsgSetBackrunRoutes }
+func (m MsgSetBackrunRoutes) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Admin)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid admin address (%s)", err)
+	}
+
+	return ValidateBackrunRoutes(m.Routes)
+}
+
+func (m MsgSetBackrunRoutes) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetBackrunRoutes) GetSigners() []sdk.AccAddress {
+	admin, _ := sdk.AccAddressFromBech32(m.Admin)
+	return []sdk.AccAddress{admin}
+}
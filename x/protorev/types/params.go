@@ -0,0 +1,120 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys.
+var (
+	KeyEnabled           = []byte("Enabled")
+	KeyAdmin             = []byte("Admin")
+	KeyProfitRecipient   = []byte("ProfitRecipient")
+	KeyMaxRoutesPerBlock = []byte("MaxRoutesPerBlock")
+	KeyMaxGasPerTx       = []byte("MaxGasPerTx")
+)
+
+// ParamKeyTable for the protorev module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(enabled bool, admin string, profitRecipient string, maxRoutesPerBlock uint64, maxGasPerTx uint64) Params {
+	return Params{
+		Enabled:           enabled,
+		Admin:             admin,
+		ProfitRecipient:   profitRecipient,
+		MaxRoutesPerBlock: maxRoutesPerBlock,
+		MaxGasPerTx:       maxGasPerTx,
+	}
+}
+
+// DefaultParams returns the default parameters for the module.
+// Without an admin, the backrun routes can only be set at genesis.
+func DefaultParams() Params {
+	return NewParams(true, "", "", DefaultMaxRoutesPerBlock, DefaultMaxGasPerTx)
+}
+
+// Validate validates params.
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateAdmin(p.Admin); err != nil {
+		return err
+	}
+	if err := validateProfitRecipient(p.ProfitRecipient); err != nil {
+		return err
+	}
+	if err := validateMaxRoutesPerBlock(p.MaxRoutesPerBlock); err != nil {
+		return err
+	}
+	return validateMaxGasPerTx(p.MaxGasPerTx)
+}
+
+// Implements params.ParamSet.
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyAdmin, &p.Admin, validateAdmin),
+		paramtypes.NewParamSetPair(KeyProfitRecipient, &p.ProfitRecipient, validateProfitRecipient),
+		paramtypes.NewParamSetPair(KeyMaxRoutesPerBlock, &p.MaxRoutesPerBlock, validateMaxRoutesPerBlock),
+		paramtypes.NewParamSetPair(KeyMaxGasPerTx, &p.MaxGasPerTx, validateMaxGasPerTx),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateAdmin(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid admin address (%s): %w", v, err)
+	}
+	return nil
+}
+
+func validateProfitRecipient(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if strings.TrimSpace(v) != v {
+		return fmt.Errorf("profit recipient (%s) must not have leading or trailing spaces", v)
+	}
+	return nil
+}
+
+func validateMaxRoutesPerBlock(i interface{}) error {
+	if _, ok := i.(uint64); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+	return nil
+}
+
+func validateMaxGasPerTx(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("max gas per tx must be positive")
+	}
+	return nil
+}
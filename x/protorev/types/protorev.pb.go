@@ -0,0 +1,447 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: osmosis/protorev/v1beta1/protorev.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/gogo/protobuf/gogoproto"
+	proto "github.com/gogo/protobuf/proto"
+	types "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BackrunRoute is a cyclic swap route that the protorev module may take after
+// a tx swaps through any of its pools. The route starts and ends in
+// token_in_denom.
+type BackrunRoute struct {
+	TokenInDenom string `protobuf:"bytes,1,opt,name=token_in_denom,json=tokenInDenom,proto3" json:"token_in_denom,omitempty" yaml:"token_in_denom"`
+	// hops are the swaps along the route. The token out denom of the last hop
+	// must be token_in_denom.
+	Hops []types.SwapAmountInRoute `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops" yaml:"hops"`
+	// max_amount_in is the largest amount of token_in_denom a backrun along the
+	// route may swap.
+	MaxAmountIn github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=max_amount_in,json=maxAmountIn,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_amount_in" yaml:"max_amount_in"`
+}
+
+func (m *BackrunRoute) Reset()         { *m = BackrunRoute{} }
+func (m *BackrunRoute) String() string { return proto.CompactTextString(m) }
+func (*BackrunRoute) ProtoMessage()    {}
+func (*BackrunRoute) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1e9f2391fd9fec01, []int{0}
+}
+func (m *BackrunRoute) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BackrunRoute) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BackrunRoute.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BackrunRoute) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BackrunRoute.Merge(m, src)
+}
+func (m *BackrunRoute) XXX_Size() int {
+	return m.Size()
+}
+func (m *BackrunRoute) XXX_DiscardUnknown() {
+	xxx_messageInfo_BackrunRoute.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BackrunRoute proto.InternalMessageInfo
+
+func (m *BackrunRoute) GetTokenInDenom() string {
+	if m != nil {
+		return m.TokenInDenom
+	}
+	return ""
+}
+
+func (m *BackrunRoute) GetHops() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*BackrunRoute)(nil), "osmosis.protorev.v1beta1.BackrunRoute")
+}
+
+func init() {
+	proto.RegisterFile("osmosis/protorev/v1beta1/protorev.proto", fileDescriptor_1e9f2391fd9fec01)
+}
+
+var fileDescriptor_1e9f2391fd9fec01 = []byte{
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0x51, 0xcf, 0x4e, 0xfa, 0x40,
+	0x18, 0x6c, 0xe1, 0x97, 0x5f, 0x62, 0x41, 0x0f, 0x15, 0x93, 0x4a, 0x62, 0x4b, 0x7a, 0x10, 0x2e,
+	0x74, 0x83, 0x78, 0xf2, 0x62, 0x6c, 0x8c, 0x09, 0x17, 0x63, 0xea, 0xcd, 0x4b, 0xb3, 0x85, 0xa6,
+	0x54, 0xd8, 0xfd, 0x1a, 0x76, 0x8b, 0xe5, 0x21, 0x4c, 0x7c, 0x2c, 0x8e, 0x1c, 0x8d, 0x87, 0xc6,
+	0xc0, 0x1b, 0xf0, 0x04, 0xa6, 0xdb, 0x3f, 0xca, 0x69, 0xbf, 0x99, 0x6f, 0x76, 0x66, 0x36, 0xab,
+	0x74, 0x81, 0x11, 0x60, 0x21, 0x43, 0xd1, 0x02, 0x38, 0x2c, 0xfc, 0x25, 0x5a, 0x0e, 0x3c, 0x9f,
+	0xe3, 0x41, 0x45, 0x58, 0x62, 0x50, 0xb5, 0x42, 0x68, 0x55, 0x7c, 0x21, 0x6c, 0xb7, 0x02, 0x08,
+	0x40, 0xb0, 0x28, 0x9b, 0x72, 0x41, 0xfb, 0xa2, 0x34, 0x0e, 0x30, 0x21, 0x95, 0x29, 0x4f, 0xf2,
+	0xb5, 0xf9, 0x5e, 0x53, 0x9a, 0x36, 0x1e, 0xcf, 0x16, 0x31, 0x75, 0x20, 0xe6, 0xbe, 0x7a, 0xab,
+	0x9c, 0x70, 0x98, 0xf9, 0xd4, 0x0d, 0xa9, 0x3b, 0xf1, 0x29, 0x10, 0x4d, 0xee, 0xc8, 0xbd, 0x23,
+	0xfb, 0x7c, 0x9f, 0x1a, 0x67, 0x2b, 0x4c, 0xe6, 0x37, 0xe6, 0xe1, 0xde, 0x74, 0x9a, 0x82, 0x18,
+	0xd1, 0xfb, 0x0c, 0xaa, 0x4f, 0xca, 0xbf, 0x29, 0x44, 0x4c, 0xab, 0x75, 0xea, 0xbd, 0xc6, 0x55,
+	0xd7, 0x2a, 0xfb, 0x66, 0xf9, 0x65, 0x57, 0xeb, 0xf9, 0x0d, 0x47, 0x77, 0x04, 0x62, 0xca, 0x47,
+	0x79, 0xae, 0x7d, 0xba, 0x4e, 0x0d, 0x69, 0x9f, 0x1a, 0x8d, 0x3c, 0x23, 0xb3, 0x30, 0x1d, 0xe1,
+	0xa4, 0xbe, 0x2a, 0xc7, 0x04, 0x27, 0x2e, 0x16, 0x7a, 0x37, 0xa4, 0x5a, 0x5d, 0x34, 0x7a, 0xc8,
+	0x6e, 0x7c, 0xa5, 0xc6, 0x65, 0x10, 0xf2, 0x69, 0xec, 0x59, 0x63, 0x20, 0x68, 0x2c, 0xd2, 0x8a,
+	0xa3, 0xcf, 0x26, 0x33, 0xc4, 0x57, 0x91, 0xcf, 0xac, 0x11, 0xe5, 0xfb, 0xd4, 0x68, 0xe5, 0xde,
+	0x07, 0x66, 0xa6, 0xd3, 0x20, 0x38, 0x29, 0xbb, 0xd8, 0x8f, 0xeb, 0xad, 0x2e, 0x6f, 0xb6, 0xba,
+	0xfc, 0xbd, 0xd5, 0xe5, 0x8f, 0x9d, 0x2e, 0x6d, 0x76, 0xba, 0xf4, 0xb9, 0xd3, 0xa5, 0x97, 0xeb,
+	0x3f, 0x31, 0xc5, 0x9b, 0xfa, 0x73, 0xec, 0xb1, 0x12, 0xa0, 0xe5, 0x60, 0x88, 0x92, 0xdf, 0xff,
+	0x13, 0xc1, 0xde, 0x7f, 0x81, 0x87, 0x3f, 0x03, 0x00, 0xaf, 0xd3, 0xe6, 0x71, 0xe0, 0x01, 0x00,
+	0x00,
+}
+
+func (m *BackrunRoute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BackrunRoute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BackrunRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxAmountIn.Size()
+		i -= size
+		if _, err := m.MaxAmountIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintProtorev(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintProtorev(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.TokenInDenom) > 0 {
+		i -= len(m.TokenInDenom)
+		copy(dAtA[i:], m.TokenInDenom)
+		i = encodeVarintProtorev(dAtA, i, uint64(len(m.TokenInDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintProtorev(dAtA []byte, offset int, v uint64) int {
+	offset -= sovProtorev(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BackrunRoute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenInDenom)
+	if l > 0 {
+		n += 1 + l + sovProtorev(uint64(l))
+	}
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovProtorev(uint64(l))
+		}
+	}
+	l = m.MaxAmountIn.Size()
+	n += 1 + l + sovProtorev(uint64(l))
+	return n
+}
+
+func sovProtorev(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozProtorev(x uint64) (n int) {
+	return sovProtorev(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BackrunRoute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BackrunRoute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BackrunRoute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenInDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenInDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, types.SwapAmountInRoute{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmountIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAmountIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipProtorev(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthProtorev
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipProtorev(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowProtorev
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowProtorev
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthProtorev
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupProtorev
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthProtorev
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthProtorev        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowProtorev          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupProtorev = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,56 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+)
+
+// Validate returns an error if the route is not a cycle of at least two hops through
+// distinct pools, or if its max amount in is not positive.
+func (r BackrunRoute) Validate() error {
+	if err := sdk.ValidateDenom(r.TokenInDenom); err != nil {
+		return err
+	}
+	if err := gammtypes.SwapAmountInRoutes(r.Hops).Validate(); err != nil {
+		return err
+	}
+	if len(r.Hops) < 2 {
+		return RouteTooShortError{Hops: len(r.Hops)}
+	}
+	if tokenOutDenom := r.Hops[len(r.Hops)-1].TokenOutDenom; tokenOutDenom != r.TokenInDenom {
+		return RouteNotCyclicError{TokenInDenom: r.TokenInDenom, TokenOutDenom: tokenOutDenom}
+	}
+
+	seenPools := make(map[uint64]bool, len(r.Hops))
+	for _, hop := range r.Hops {
+		if seenPools[hop.PoolId] {
+			return RepeatedPoolError{PoolId: hop.PoolId}
+		}
+		seenPools[hop.PoolId] = true
+	}
+
+	if r.MaxAmountIn.IsNil() || !r.MaxAmountIn.IsPositive() {
+		return NonPositiveMaxAmountInError{MaxAmountIn: r.MaxAmountIn}
+	}
+	return nil
+}
+
+// PoolIds returns the ids of the pools along the route.
+func (r BackrunRoute) PoolIds() []uint64 {
+	poolIds := make([]uint64, 0, len(r.Hops))
+	for _, hop := range r.Hops {
+		poolIds = append(poolIds, hop.PoolId)
+	}
+	return poolIds
+}
+
+// ValidateBackrunRoutes validates each of the routes.
+func ValidateBackrunRoutes(routes []BackrunRoute) error {
+	for _, route := range routes {
+		if err := route.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
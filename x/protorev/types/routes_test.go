@@ -0,0 +1,81 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/protorev/types"
+)
+
+func TestBackrunRouteValidate(t *testing.T) {
+	validRoute := func() types.BackrunRoute {
+		return types.BackrunRoute{
+			TokenInDenom: "uosmo",
+			Hops: []gammtypes.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: "uatom"},
+				{PoolId: 2, TokenOutDenom: "usdc"},
+				{PoolId: 3, TokenOutDenom: "uosmo"},
+			},
+			MaxAmountIn: sdk.NewInt(1_000_000),
+		}
+	}
+	withRoute := func(modify func(route *types.BackrunRoute)) types.BackrunRoute {
+		route := validRoute()
+		modify(&route)
+		return route
+	}
+
+	tests := map[string]struct {
+		route       types.BackrunRoute
+		expectedErr error
+	}{
+		"valid route": {
+			route: validRoute(),
+		},
+		"two hops": {
+			route: withRoute(func(route *types.BackrunRoute) { route.Hops = route.Hops[1:] }),
+		},
+		"invalid token in denom": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.TokenInDenom = "1" }),
+			expectedErr: sdk.ValidateDenom("1"),
+		},
+		"no hops": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.Hops = nil }),
+			expectedErr: gammtypes.ErrEmptyRoutes,
+		},
+		"one hop": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.Hops = route.Hops[2:] }),
+			expectedErr: types.RouteTooShortError{Hops: 1},
+		},
+		"route does not end in its token in denom": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.Hops = route.Hops[:2] }),
+			expectedErr: types.RouteNotCyclicError{TokenInDenom: "uosmo", TokenOutDenom: "usdc"},
+		},
+		"repeated pool": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.Hops[2].PoolId = 1 }),
+			expectedErr: types.RepeatedPoolError{PoolId: 1},
+		},
+		"zero max amount in": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.MaxAmountIn = sdk.ZeroInt() }),
+			expectedErr: types.NonPositiveMaxAmountInError{MaxAmountIn: sdk.ZeroInt()},
+		},
+		"nil max amount in": {
+			route:       withRoute(func(route *types.BackrunRoute) { route.MaxAmountIn = sdk.Int{} }),
+			expectedErr: types.NonPositiveMaxAmountInError{MaxAmountIn: sdk.Int{}},
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := tc.route.Validate()
+			if tc.expectedErr != nil {
+				require.EqualError(t, err, tc.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
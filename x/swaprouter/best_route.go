@@ -0,0 +1,159 @@
+package swaprouter
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// BestRouteExactAmountIn searches all pools with a module route for the route of at most
+// maxHops pools that swaps tokenIn for the largest amount of tokenOutDenom, as estimated by
+// MultihopEstimateOutGivenExactAmountIn. A route never goes through the same pool or denom twice,
+// and pools that cannot currently be swapped against are skipped. Between routes with the same
+// output, the shorter one and then the one found first, in order of the pools' liquidity, is returned.
+// It also returns the price impact of the route, as computed by routePriceImpact.
+//
+// To bound the cost of the search, each denom is only swapped through the
+// types.MaxBestRoutePoolsPerDenom pools holding the most of it, and the search stops after
+// estimating types.MaxBestRouteEstimates candidate routes.
+func (k Keeper) BestRouteExactAmountIn(
+	ctx sdk.Context,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	maxHops int,
+) (bestRoutes []types.SwapAmountInRoute, tokenOutAmount sdk.Int, priceImpact sdk.Dec, err error) {
+	if tokenIn.Denom == tokenOutDenom {
+		return nil, sdk.Int{}, sdk.Dec{}, fmt.Errorf("token in denom (%s) must differ from token out denom", tokenIn.Denom)
+	}
+	if maxHops <= 0 || maxHops > types.MaxBestRouteMaxHops {
+		return nil, sdk.Int{}, sdk.Dec{}, fmt.Errorf("max hops must be between 1 and %d, was (%d)", types.MaxBestRouteMaxHops, maxHops)
+	}
+
+	pools, poolsByDenom, err := k.getSwappablePoolsByDenom(ctx)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	tokenOutAmount = sdk.ZeroInt()
+	numEstimates := 0
+	routes := make([]types.SwapAmountInRoute, 0, maxHops)
+	visitedPools := map[uint64]bool{}
+	visitedDenoms := map[string]bool{tokenIn.Denom: true}
+
+	// search extends the current routes with every pool holding denom, in a depth-first manner.
+	var search func(denom string)
+	search = func(denom string) {
+		if len(routes) == maxHops || numEstimates == types.MaxBestRouteEstimates {
+			return
+		}
+		for _, poolId := range poolsByDenom[denom] {
+			if visitedPools[poolId] {
+				continue
+			}
+			visitedPools[poolId] = true
+			for _, coin := range pools[poolId].GetTotalPoolLiquidity(ctx) {
+				if visitedDenoms[coin.Denom] {
+					continue
+				}
+				if numEstimates == types.MaxBestRouteEstimates {
+					return
+				}
+				routes = append(routes, types.SwapAmountInRoute{PoolId: poolId, TokenOutDenom: coin.Denom})
+				if coin.Denom == tokenOutDenom {
+					numEstimates++
+					// Routes that fail to estimate, e.g. because a pool lacks liquidity, are skipped.
+					amount, err := k.MultihopEstimateOutGivenExactAmountIn(ctx, routes, tokenIn)
+					if err == nil && (amount.GT(tokenOutAmount) || (amount.Equal(tokenOutAmount) && len(routes) < len(bestRoutes))) {
+						tokenOutAmount = amount
+						bestRoutes = append([]types.SwapAmountInRoute{}, routes...)
+					}
+				} else {
+					visitedDenoms[coin.Denom] = true
+					search(coin.Denom)
+					visitedDenoms[coin.Denom] = false
+				}
+				routes = routes[:len(routes)-1]
+			}
+			visitedPools[poolId] = false
+		}
+	}
+	search(tokenIn.Denom)
+
+	if len(bestRoutes) == 0 {
+		return nil, sdk.Int{}, sdk.Dec{}, types.NoRouteFoundError{TokenInDenom: tokenIn.Denom, TokenOutDenom: tokenOutDenom, MaxHops: maxHops}
+	}
+
+	priceImpact, err = routePriceImpact(ctx, pools, bestRoutes, tokenIn, tokenOutAmount)
+	if err != nil {
+		return nil, sdk.Int{}, sdk.Dec{}, err
+	}
+
+	return bestRoutes, tokenOutAmount, priceImpact, nil
+}
+
+// getSwappablePoolsByDenom returns every routed pool that can currently be swapped against by its id,
+// along with the ids of at most types.MaxBestRoutePoolsPerDenom of those pools holding each denom,
+// in decreasing order of the amount of the denom they hold, then increasing order of id.
+func (k Keeper) getSwappablePoolsByDenom(ctx sdk.Context) (map[uint64]types.PoolI, map[string][]uint64, error) {
+	poolIds, err := k.getRoutedPoolIds(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pools := map[uint64]types.PoolI{}
+	poolLiquidity := map[uint64]sdk.Coins{}
+	poolsByDenom := map[string][]uint64{}
+	for _, poolId := range poolIds {
+		_, pool, err := k.getPoolForSwap(ctx, poolId)
+		if err != nil {
+			continue
+		}
+		pools[poolId] = pool
+		poolLiquidity[poolId] = pool.GetTotalPoolLiquidity(ctx)
+		for _, coin := range poolLiquidity[poolId] {
+			poolsByDenom[coin.Denom] = append(poolsByDenom[coin.Denom], poolId)
+		}
+	}
+
+	for denom, denomPoolIds := range poolsByDenom {
+		// the pool ids are in increasing order, which the stable sort keeps between pools holding the same amount.
+		sort.SliceStable(denomPoolIds, func(i, j int) bool {
+			return poolLiquidity[denomPoolIds[i]].AmountOf(denom).GT(poolLiquidity[denomPoolIds[j]].AmountOf(denom))
+		})
+		if len(denomPoolIds) > types.MaxBestRoutePoolsPerDenom {
+			poolsByDenom[denom] = denomPoolIds[:types.MaxBestRoutePoolsPerDenom]
+		}
+	}
+	return pools, poolsByDenom, nil
+}
+
+// routePriceImpact returns the relative shortfall of tokenOutAmount from the amount of
+// tokens out implied by the spot prices of the pools along the routes, before swap fees.
+// Swap fees therefore count towards the price impact.
+func routePriceImpact(
+	ctx sdk.Context,
+	pools map[uint64]types.PoolI,
+	routes []types.SwapAmountInRoute,
+	tokenIn sdk.Coin,
+	tokenOutAmount sdk.Int,
+) (sdk.Dec, error) {
+	spotTokenOutAmount := tokenIn.Amount.ToDec()
+	tokenInDenom := tokenIn.Denom
+	for _, route := range routes {
+		// SpotPrice returns the amount of base asset per quote asset.
+		spotPrice, err := pools[route.PoolId].SpotPrice(ctx, route.TokenOutDenom, tokenInDenom)
+		if err != nil {
+			return sdk.Dec{}, err
+		}
+		spotTokenOutAmount = spotTokenOutAmount.Mul(spotPrice)
+		tokenInDenom = route.TokenOutDenom
+	}
+
+	if !spotTokenOutAmount.IsPositive() {
+		return sdk.ZeroDec(), nil
+	}
+	return sdk.OneDec().Sub(tokenOutAmount.ToDec().Quo(spotTokenOutAmount)), nil
+}
@@ -0,0 +1,230 @@
+package swaprouter_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammkeeper "github.com/osmosis-labs/osmosis/v13/x/gamm/keeper"
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+// TestBestRouteExactAmountIn tests that the route with the largest estimated amount out
+// within the given number of hops is found, and that the expected errors are returned
+// when no route qualifies.
+func (suite *KeeperTestSuite) TestBestRouteExactAmountIn() {
+	var (
+		shallowPoolAmount = sdk.NewInt(10_000_000)
+		deepPoolAmount    = defaultInitPoolAmount
+		tokenIn           = sdk.NewCoin(foo, defaultSwapAmount)
+	)
+
+	tests := map[string]struct {
+		poolCoins     []sdk.Coins
+		frozenPoolIds []uint64
+		tokenIn       sdk.Coin
+		tokenOutDenom string
+		maxHops       int
+
+		expectedRoutes []types.SwapAmountInRoute
+		// expectedSpotPrice is the amount of token out per token in along the route
+		// at spot prices, and defaults to one.
+		expectedSpotPrice sdk.Dec
+		expectedErr       error
+	}{
+		"single pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  bar,
+			maxHops:        3,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"uneven reserves": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount.MulRaw(2))),
+			},
+			tokenIn:           tokenIn,
+			tokenOutDenom:     bar,
+			maxHops:           3,
+			expectedRoutes:    []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+			expectedSpotPrice: sdk.NewDec(2),
+		},
+		"deeper pool is preferred": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  bar,
+			maxHops:        3,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: bar}},
+		},
+		"frozen pool is skipped": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			frozenPoolIds:  []uint64{2},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  bar,
+			maxHops:        3,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"two deep hops beat one shallow hop": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(uosmo, deepPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       3,
+			expectedRoutes: []types.SwapAmountInRoute{
+				{PoolId: 2, TokenOutDenom: uosmo},
+				{PoolId: 3, TokenOutDenom: bar},
+			},
+		},
+		"max hops excludes the better route": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, shallowPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(uosmo, deepPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:        tokenIn,
+			tokenOutDenom:  bar,
+			maxHops:        1,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"three hops through a multi-asset pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(uosmo, deepPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, deepPoolAmount), sdk.NewCoin(baz, deepPoolAmount), sdk.NewCoin("qux", deepPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(baz, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       3,
+			expectedRoutes: []types.SwapAmountInRoute{
+				{PoolId: 1, TokenOutDenom: uosmo},
+				{PoolId: 2, TokenOutDenom: baz},
+				{PoolId: 3, TokenOutDenom: bar},
+			},
+		},
+		"no route within max hops": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(uosmo, deepPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       1,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: bar, MaxHops: 1},
+		},
+		"only route is through a frozen pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			frozenPoolIds: []uint64{1},
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       3,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: bar, MaxHops: 3},
+		},
+		"token out denom is in no pool": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: baz,
+			maxHops:       3,
+			expectedErr:   types.NoRouteFoundError{TokenInDenom: foo, TokenOutDenom: baz, MaxHops: 3},
+		},
+		"same denom in and out": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: foo,
+			maxHops:       3,
+			expectedErr:   fmt.Errorf("token in denom (%s) must differ from token out denom", foo),
+		},
+		"only the pools holding the most of a denom are searched": {
+			// the last pool would give the most bar, but holds the least foo.
+			poolCoins: append(
+				repeatPoolCoins(sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)), types.MaxBestRoutePoolsPerDenom),
+				sdk.NewCoins(sdk.NewCoin(foo, shallowPoolAmount), sdk.NewCoin(bar, deepPoolAmount.MulRaw(10))),
+			),
+			tokenIn:        tokenIn,
+			tokenOutDenom:  bar,
+			maxHops:        1,
+			expectedRoutes: []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+		},
+		"max hops above the limit": {
+			poolCoins: []sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, deepPoolAmount), sdk.NewCoin(bar, deepPoolAmount)),
+			},
+			tokenIn:       tokenIn,
+			tokenOutDenom: bar,
+			maxHops:       types.MaxBestRouteMaxHops + 1,
+			expectedErr:   fmt.Errorf("max hops must be between 1 and %d, was (%d)", types.MaxBestRouteMaxHops, types.MaxBestRouteMaxHops+1),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			suite.createBalancerPoolsFromCoins(tc.poolCoins)
+			suite.freezePools(tc.frozenPoolIds)
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			routes, tokenOutAmount, priceImpact, err := swaprouterKeeper.BestRouteExactAmountIn(suite.Ctx, tc.tokenIn, tc.tokenOutDenom, tc.maxHops)
+
+			if tc.expectedErr != nil {
+				suite.Require().EqualError(err, tc.expectedErr.Error())
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expectedRoutes, routes)
+
+			// The amount out is the estimate for the returned route.
+			expectedTokenOutAmount, err := swaprouterKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, routes, tc.tokenIn)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+
+			spotPrice := tc.expectedSpotPrice
+			if spotPrice.IsNil() {
+				spotPrice = sdk.OneDec()
+			}
+			expectedPriceImpact := sdk.OneDec().Sub(tokenOutAmount.ToDec().Quo(tc.tokenIn.Amount.ToDec().Mul(spotPrice)))
+			suite.Require().Equal(expectedPriceImpact, priceImpact)
+			suite.Require().True(priceImpact.IsPositive())
+		})
+	}
+}
+
+// repeatPoolCoins returns n copies of the given pool coins.
+func repeatPoolCoins(poolCoins sdk.Coins, n int) []sdk.Coins {
+	repeated := make([]sdk.Coins, n)
+	for i := range repeated {
+		repeated[i] = poolCoins
+	}
+	return repeated
+}
+
+// freezePools freezes the given gamm pools through their freeze admin.
+func (suite *KeeperTestSuite) freezePools(poolIds []uint64) {
+	admin := suite.TestAccs[0].String()
+	suite.App.GetSubspace(gammtypes.ModuleName).Set(suite.Ctx, gammtypes.KeyPoolFreezeAdmin, admin)
+	msgServer := gammkeeper.NewMsgServerImpl(suite.App.GAMMKeeper)
+	for _, poolId := range poolIds {
+		_, err := msgServer.SetPoolFrozen(sdk.WrapSDKContext(suite.Ctx), &gammtypes.MsgSetPoolFrozen{
+			Sender: admin,
+			PoolId: poolId,
+			Frozen: true,
+		})
+		suite.Require().NoError(err)
+	}
+}
@@ -21,6 +21,10 @@ const (
 	FlagSwapRoutePoolIds = "swap-route-pool-ids"
 	// Will be parsed to []string.
 	FlagSwapRouteDenoms = "swap-route-denoms"
+	// Will be parsed to uint64.
+	FlagMaxHops = "max-hops"
+	// Will be parsed to string.
+	FlagSplitRoutesFile = "routes-file"
 )
 
 type createBalancerPoolInputs struct {
@@ -63,6 +67,20 @@ func FlagSetSwapAmountOutRoutes() *flag.FlagSet {
 	return fs
 }
 
+func FlagSetSplitRoutes() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.String(FlagSplitRoutesFile, "", "Split routes json file path")
+	return fs
+}
+
+func FlagSetQueryBestRoute() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+
+	fs.Uint64(FlagMaxHops, 0, "maximum number of pools in the route (defaults to 3)")
+	return fs
+}
+
 func FlagSetCreatePool() *flag.FlagSet {
 	fs := flag.NewFlagSet("", flag.ContinueOnError)
 
@@ -95,3 +95,21 @@ func parseCreateStableswapPoolFlags(fs *pflag.FlagSet) (*createStableswapPoolInp
 
 	return pool, nil
 }
+
+// parseSplitRoutesFile decodes the json file given by the --routes-file flag into routes,
+// erroring on unexpected fields.
+func parseSplitRoutesFile(fs *pflag.FlagSet, routes interface{}) error {
+	routesFile, _ := fs.GetString(FlagSplitRoutesFile)
+	if routesFile == "" {
+		return fmt.Errorf("must pass in a routes json using the --%s flag", FlagSplitRoutesFile)
+	}
+
+	contents, err := os.ReadFile(routesFile)
+	if err != nil {
+		return err
+	}
+
+	dec := json.NewDecoder(bytes.NewReader(contents))
+	dec.DisallowUnknownFields()
+	return dec.Decode(routes)
+}
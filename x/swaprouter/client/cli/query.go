@@ -28,6 +28,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdEstimateSwapExactAmountIn(),
 		GetCmdEstimateSwapExactAmountOut(),
 		GetCmdNumPools(),
+		GetCmdBestRoute(),
 	)
 
 	return cmd
@@ -173,3 +174,49 @@ $ %s query swaprouter num-pools
 
 	return cmd
 }
+
+// GetCmdBestRoute returns the swap route that returns the most of a token out for a token in.
+func GetCmdBestRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "best-route <tokenIn> <tokenOutDenom>",
+		Short: "Query the best swap route for a token in",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the swap route of at most --max-hops pools that returns the most of tokenOutDenom for tokenIn,
+along with the estimated amount out and the price impact of the swap.
+Example:
+$ %s query swaprouter best-route 1000000uosmo uion --max-hops=2
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := queryproto.NewQueryClient(clientCtx)
+
+			maxHops, err := cmd.Flags().GetUint64(FlagMaxHops)
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BestRoute(cmd.Context(), &queryproto.BestRouteRequest{
+				TokenIn:       args[0],
+				TokenOutDenom: args[1],
+				MaxHops:       maxHops,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetQueryBestRoute())
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
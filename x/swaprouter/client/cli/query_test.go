@@ -36,6 +36,12 @@ func (s *QueryTestSuite) TestQueriesNeverAlterState() {
 			&swaprouterqueryproto.NumPoolsRequest{},
 			&swaprouterqueryproto.NumPoolsResponse{},
 		},
+		{
+			"Query best route",
+			"/osmosis.swaprouter.v1beta1.Query/BestRoute",
+			&swaprouterqueryproto.BestRouteRequest{TokenIn: "1000foo", TokenOutDenom: "bar"},
+			&swaprouterqueryproto.BestRouteResponse{},
+		},
 	}
 
 	for _, tc := range testCases {
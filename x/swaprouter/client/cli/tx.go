@@ -34,6 +34,8 @@ func NewTxCmd() *cobra.Command {
 		NewCreatePoolCmd(),
 		NewSwapExactAmountInCmd(),
 		NewSwapExactAmountOutCmd(),
+		NewSplitRouteSwapExactAmountInCmd(),
+		NewSplitRouteSwapExactAmountOutCmd(),
 	)
 
 	return txCmd
@@ -149,6 +151,130 @@ func NewBuildSwapExactAmountOutMsg(clientCtx client.Context, tokenOutStr, tokenI
 	return txf, msg, nil
 }
 
+func NewSplitRouteSwapExactAmountInCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-in [token-in-denom] [token-out-min-amount]",
+		Short: "swap exact amount in, split across several routes",
+		Long:  `Must provide path to a routes JSON file (--routes-file) describing the routes to swap through, and the amount of token in swapped through each`,
+		Example: `Sample routes JSON file contents:
+[
+	{
+		"pools": [{"pool_id": 1, "token_out_denom": "uosmo"}, {"pool_id": 2, "token_out_denom": "uion"}],
+		"token_in_amount": "1000"
+	},
+	{
+		"pools": [{"pool_id": 3, "token_out_denom": "uion"}],
+		"token_in_amount": "2000"
+	}
+]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+func NewSplitRouteSwapExactAmountOutCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "split-route-swap-exact-amount-out [token-out-denom] [token-in-max-amount]",
+		Short: "swap exact amount out, split across several routes",
+		Long:  `Must provide path to a routes JSON file (--routes-file) describing the routes to swap through, and the amount of token out swapped for through each`,
+		Example: `Sample routes JSON file contents:
+[
+	{
+		"pools": [{"pool_id": 1, "token_in_denom": "uatom"}, {"pool_id": 2, "token_in_denom": "uosmo"}],
+		"token_out_amount": "1000"
+	},
+	{
+		"pools": [{"pool_id": 3, "token_in_denom": "uatom"}],
+		"token_out_amount": "2000"
+	}
+]
+`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf := tx.NewFactoryCLI(clientCtx, cmd.Flags()).WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			txf, msg, err := NewBuildSplitRouteSwapExactAmountOutMsg(clientCtx, args[0], args[1], txf, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(FlagSetSplitRoutes())
+	flags.AddTxFlagsToCmd(cmd)
+	_ = cmd.MarkFlagRequired(FlagSplitRoutesFile)
+
+	return cmd
+}
+
+func NewBuildSplitRouteSwapExactAmountInMsg(clientCtx client.Context, tokenInDenom, tokenOutMinAmtStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes := []types.SwapAmountInSplitRoute{}
+	if err := parseSplitRoutesFile(fs, &routes); err != nil {
+		return txf, nil, fmt.Errorf("failed to parse routes: %w", err)
+	}
+
+	tokenOutMinAmt, ok := sdk.NewIntFromString(tokenOutMinAmtStr)
+	if !ok {
+		return txf, nil, fmt.Errorf("invalid token out min amount, %s", tokenOutMinAmtStr)
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountIn{
+		Sender:            clientCtx.GetFromAddress().String(),
+		Routes:            routes,
+		TokenInDenom:      tokenInDenom,
+		TokenOutMinAmount: tokenOutMinAmt,
+	}
+
+	return txf, msg, nil
+}
+
+func NewBuildSplitRouteSwapExactAmountOutMsg(clientCtx client.Context, tokenOutDenom, tokenInMaxAmountStr string, txf tx.Factory, fs *flag.FlagSet) (tx.Factory, sdk.Msg, error) {
+	routes := []types.SwapAmountOutSplitRoute{}
+	if err := parseSplitRoutesFile(fs, &routes); err != nil {
+		return txf, nil, fmt.Errorf("failed to parse routes: %w", err)
+	}
+
+	tokenInMaxAmount, ok := sdk.NewIntFromString(tokenInMaxAmountStr)
+	if !ok {
+		return txf, nil, errors.New("invalid token in max amount")
+	}
+	msg := &types.MsgSplitRouteSwapExactAmountOut{
+		Sender:           clientCtx.GetFromAddress().String(),
+		Routes:           routes,
+		TokenOutDenom:    tokenOutDenom,
+		TokenInMaxAmount: tokenInMaxAmount,
+	}
+
+	return txf, msg, nil
+}
+
 func NewCreatePoolCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "create-pool [flags]",
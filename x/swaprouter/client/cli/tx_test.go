@@ -3,10 +3,14 @@ package cli_test
 import (
 	"testing"
 
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/client/cli"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 func TestParseCoinsNoSort(t *testing.T) {
@@ -69,3 +73,57 @@ func TestParseCoinsNoSort(t *testing.T) {
 		})
 	}
 }
+
+func TestNewBuildSplitRouteSwapExactAmountInMsg(t *testing.T) {
+	sender := sdk.AccAddress([]byte("addr1---------------"))
+	clientCtx := client.Context{}.WithFromAddress(sender)
+
+	tests := map[string]struct {
+		routesJson     string
+		expectedRoutes []types.SwapAmountInSplitRoute
+		expectErr      bool
+	}{
+		"two routes": {
+			routesJson: `[
+				{"pools": [{"pool_id": 1, "token_out_denom": "uosmo"}, {"pool_id": 2, "token_out_denom": "uion"}], "token_in_amount": "1000"},
+				{"pools": [{"pool_id": 3, "token_out_denom": "uion"}], "token_in_amount": "2000"}
+			]`,
+			expectedRoutes: []types.SwapAmountInSplitRoute{
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: "uosmo"}, {PoolId: 2, TokenOutDenom: "uion"}},
+					TokenInAmount: sdk.NewInt(1000),
+				},
+				{
+					Pools:         []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: "uion"}},
+					TokenInAmount: sdk.NewInt(2000),
+				},
+			},
+		},
+		"unknown field": {
+			routesJson: `[{"pools": [{"pool_id": 1, "token_out_denom": "uion"}], "token_in_amount": "1000", "unknown": true}]`,
+			expectErr:  true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			routesFile := testutil.WriteToNewTempFile(t, tc.routesJson)
+			fs := cli.FlagSetSplitRoutes()
+			require.NoError(t, fs.Set(cli.FlagSplitRoutesFile, routesFile.Name()))
+
+			_, msg, err := cli.NewBuildSplitRouteSwapExactAmountInMsg(clientCtx, "uatom", "100", tx.Factory{}, fs)
+			if tc.expectErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, &types.MsgSplitRouteSwapExactAmountIn{
+				Sender:            sender.String(),
+				Routes:            tc.expectedRoutes,
+				TokenInDenom:      "uatom",
+				TokenOutMinAmount: sdk.NewInt(100),
+			}, msg)
+			require.NoError(t, msg.ValidateBasic())
+		})
+	}
+}
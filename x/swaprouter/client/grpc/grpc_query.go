@@ -60,3 +60,13 @@ func (q Querier) EstimateSwapExactAmountIn(grpcCtx context.Context,
 	return q.Q.EstimateSwapExactAmountIn(ctx, *req)
 }
 
+func (q Querier) BestRoute(grpcCtx context.Context,
+	req *queryproto.BestRouteRequest,
+) (*queryproto.BestRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.BestRoute(ctx, *req)
+}
+
@@ -79,3 +79,38 @@ func (q Querier) NumPools(ctx sdk.Context, _ queryproto.NumPoolsRequest) (*query
 		NumPools: q.K.GetNextPoolId(ctx) - 1,
 	}, nil
 }
+
+// BestRoute returns the swap route that returns the most tokens out for the given token in.
+func (q Querier) BestRoute(ctx sdk.Context, req queryproto.BestRouteRequest) (*queryproto.BestRouteResponse, error) {
+	if req.TokenIn == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid token")
+	}
+
+	tokenIn, err := sdk.ParseCoinNormalized(req.TokenIn)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token: %s", err.Error())
+	}
+
+	if err := sdk.ValidateDenom(req.TokenOutDenom); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid token out denom: %s", err.Error())
+	}
+
+	maxHops := types.DefaultBestRouteMaxHops
+	if req.MaxHops != 0 {
+		if req.MaxHops > types.MaxBestRouteMaxHops {
+			return nil, status.Errorf(codes.InvalidArgument, "max hops must be at most %d", types.MaxBestRouteMaxHops)
+		}
+		maxHops = int(req.MaxHops)
+	}
+
+	routes, tokenOutAmount, priceImpact, err := q.K.BestRouteExactAmountIn(ctx, tokenIn, req.TokenOutDenom, maxHops)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &queryproto.BestRouteResponse{
+		Routes:         routes,
+		TokenOutAmount: tokenOutAmount,
+		PriceImpact:    priceImpact,
+	}, nil
+}
@@ -412,6 +412,118 @@ func (m *NumPoolsResponse) GetNumPools() uint64 {
 	return 0
 }
 
+// =============================== BestRoute
+type BestRouteRequest struct {
+	TokenIn       string `protobuf:"bytes,1,opt,name=token_in,json=tokenIn,proto3" json:"token_in,omitempty" yaml:"token_in"`
+	TokenOutDenom string `protobuf:"bytes,2,opt,name=token_out_denom,json=tokenOutDenom,proto3" json:"token_out_denom,omitempty" yaml:"token_out_denom"`
+	// max_hops is the maximum number of pools in the route. If unset, it
+	// defaults to 3.
+	MaxHops uint64 `protobuf:"varint,3,opt,name=max_hops,json=maxHops,proto3" json:"max_hops,omitempty" yaml:"max_hops"`
+}
+
+func (m *BestRouteRequest) Reset()         { *m = BestRouteRequest{} }
+func (m *BestRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*BestRouteRequest) ProtoMessage()    {}
+func (*BestRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{8}
+}
+func (m *BestRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestRouteRequest.Merge(m, src)
+}
+func (m *BestRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestRouteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestRouteRequest proto.InternalMessageInfo
+
+func (m *BestRouteRequest) GetTokenIn() string {
+	if m != nil {
+		return m.TokenIn
+	}
+	return ""
+}
+
+func (m *BestRouteRequest) GetTokenOutDenom() string {
+	if m != nil {
+		return m.TokenOutDenom
+	}
+	return ""
+}
+
+func (m *BestRouteRequest) GetMaxHops() uint64 {
+	if m != nil {
+		return m.MaxHops
+	}
+	return 0
+}
+
+type BestRouteResponse struct {
+	Routes         []types.SwapAmountInRoute              `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes" yaml:"routes"`
+	TokenOutAmount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=token_out_amount,json=tokenOutAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"token_out_amount" yaml:"token_out_amount"`
+	// price_impact is the relative shortfall of token_out_amount from the amount
+	// implied by the spot prices of the pools along the route, swap fees
+	// included.
+	PriceImpact github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=price_impact,json=priceImpact,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"price_impact" yaml:"price_impact"`
+}
+
+func (m *BestRouteResponse) Reset()         { *m = BestRouteResponse{} }
+func (m *BestRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*BestRouteResponse) ProtoMessage()    {}
+func (*BestRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4d9de31afe32e1e0, []int{9}
+}
+func (m *BestRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BestRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BestRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BestRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BestRouteResponse.Merge(m, src)
+}
+func (m *BestRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BestRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BestRouteResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BestRouteResponse proto.InternalMessageInfo
+
+func (m *BestRouteResponse) GetRoutes() []types.SwapAmountInRoute {
+	if m != nil {
+		return m.Routes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "osmosis.swaprouter.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "osmosis.swaprouter.v1beta1.ParamsResponse")
@@ -421,6 +533,8 @@ func init() {
 	proto.RegisterType((*EstimateSwapExactAmountOutResponse)(nil), "osmosis.swaprouter.v1beta1.EstimateSwapExactAmountOutResponse")
 	proto.RegisterType((*NumPoolsRequest)(nil), "osmosis.swaprouter.v1beta1.NumPoolsRequest")
 	proto.RegisterType((*NumPoolsResponse)(nil), "osmosis.swaprouter.v1beta1.NumPoolsResponse")
+	proto.RegisterType((*BestRouteRequest)(nil), "osmosis.swaprouter.v1beta1.BestRouteRequest")
+	proto.RegisterType((*BestRouteResponse)(nil), "osmosis.swaprouter.v1beta1.BestRouteResponse")
 }
 
 func init() {
@@ -428,59 +542,68 @@ func init() {
 }
 
 var fileDescriptor_4d9de31afe32e1e0 = []byte{
-	// 820 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
-	0x14, 0x8f, 0xd3, 0x6c, 0x36, 0x9d, 0x55, 0x9b, 0xee, 0xb0, 0x40, 0xd6, 0x42, 0x71, 0x18, 0x60,
-	0xc9, 0xb6, 0xc4, 0x56, 0xba, 0x37, 0x44, 0x4b, 0x89, 0x14, 0x44, 0x2e, 0xb4, 0x98, 0x1b, 0x2a,
-	0x8a, 0x26, 0xc9, 0x60, 0xac, 0xc6, 0x33, 0x6e, 0x66, 0xdc, 0x36, 0x42, 0x5c, 0x38, 0x21, 0x21,
-	0x24, 0x24, 0x10, 0xe2, 0x23, 0xf5, 0x58, 0x89, 0x0b, 0xe2, 0x10, 0x50, 0xc3, 0x27, 0x88, 0xf8,
-	0x00, 0xc8, 0x33, 0x63, 0x27, 0xad, 0x14, 0x37, 0x14, 0x71, 0xb2, 0xfd, 0xde, 0xef, 0xbd, 0xf9,
-	0xfd, 0xde, 0x1f, 0x0f, 0x78, 0xc6, 0x78, 0xc0, 0xb8, 0xcf, 0x1d, 0x7e, 0x8e, 0xc3, 0x11, 0x8b,
-	0x04, 0x19, 0x39, 0x67, 0xcd, 0x1e, 0x11, 0xb8, 0xe9, 0x9c, 0x46, 0x64, 0x34, 0xb6, 0xc3, 0x11,
-	0x13, 0x0c, 0x9a, 0x1a, 0x67, 0xcf, 0x71, 0xb6, 0xc6, 0x99, 0x4f, 0x3c, 0xe6, 0x31, 0x09, 0x73,
-	0xe2, 0x37, 0x15, 0x61, 0xd6, 0x33, 0x32, 0x7b, 0x84, 0x92, 0x38, 0x99, 0x42, 0xbe, 0x91, 0x81,
-	0x14, 0x17, 0x1a, 0xb4, 0x93, 0x01, 0x8a, 0x4d, 0x5d, 0x69, 0xd3, 0xe0, 0x6a, 0x5f, 0xa2, 0x9d,
-	0x1e, 0xe6, 0x24, 0x45, 0xf5, 0x99, 0x4f, 0xb5, 0x7f, 0x7b, 0xd1, 0x2f, 0x65, 0xa6, 0xa8, 0x10,
-	0x7b, 0x3e, 0xc5, 0xc2, 0x67, 0x09, 0xf6, 0x35, 0x8f, 0x31, 0x6f, 0x48, 0x1c, 0x1c, 0xfa, 0x0e,
-	0xa6, 0x94, 0x09, 0xe9, 0x4c, 0xb8, 0x3f, 0xd5, 0x5e, 0xf9, 0xd5, 0x8b, 0xbe, 0x70, 0x30, 0x1d,
-	0x27, 0x2e, 0x75, 0x48, 0x57, 0x55, 0x46, 0x7d, 0x68, 0x97, 0x75, 0x3b, 0x4a, 0xf8, 0x01, 0xe1,
-	0x02, 0x07, 0xa1, 0x02, 0xa0, 0x32, 0xd8, 0x38, 0xc2, 0x23, 0x1c, 0x70, 0x97, 0x9c, 0x46, 0x84,
-	0x0b, 0xe4, 0x82, 0xcd, 0xc4, 0xc0, 0x43, 0x46, 0x39, 0x81, 0x07, 0xa0, 0x18, 0x4a, 0x4b, 0xc5,
-	0xa8, 0x19, 0xf5, 0x47, 0xbb, 0xc8, 0x5e, 0xde, 0x22, 0x5b, 0xc5, 0xb6, 0x0a, 0x97, 0x13, 0x2b,
-	0xe7, 0xea, 0x38, 0xf4, 0x6d, 0x1e, 0xd4, 0xda, 0x5c, 0xf8, 0x01, 0x16, 0xe4, 0xd3, 0x73, 0x1c,
-	0xb6, 0x2f, 0x70, 0x5f, 0x7c, 0x10, 0xb0, 0x88, 0x8a, 0x0e, 0xd5, 0x07, 0xc3, 0xe7, 0xa0, 0xc8,
-	0x09, 0x1d, 0x90, 0x91, 0x3c, 0x66, 0xbd, 0xf5, 0x78, 0x36, 0xb1, 0x36, 0xc6, 0x38, 0x18, 0xbe,
-	0x8b, 0x94, 0x1d, 0xb9, 0x1a, 0x00, 0x77, 0xc0, 0xc3, 0x90, 0xb1, 0x61, 0xd7, 0x1f, 0x54, 0xf2,
-	0x35, 0xa3, 0x5e, 0x68, 0xc1, 0xd9, 0xc4, 0xda, 0x54, 0x58, 0xed, 0x40, 0x6e, 0x31, 0x7e, 0xeb,
-	0x0c, 0xa0, 0x0d, 0x4a, 0x82, 0x9d, 0x10, 0xda, 0xf5, 0x69, 0x65, 0x4d, 0x66, 0x7e, 0x69, 0x36,
-	0xb1, 0xca, 0x0a, 0x9d, 0x78, 0x90, 0xfb, 0x50, 0xbe, 0x76, 0x28, 0x3c, 0x06, 0x45, 0xa9, 0x89,
-	0x57, 0x0a, 0xb5, 0xb5, 0xfa, 0xa3, 0xdd, 0x46, 0x96, 0xdc, 0x58, 0x4d, 0x2a, 0x24, 0x76, 0xb5,
-	0x5e, 0x8e, 0x95, 0xcf, 0xa9, 0xab, 0x54, 0xc8, 0xd5, 0x39, 0xd1, 0x2f, 0x06, 0x78, 0x3d, 0xa3,
-	0x14, 0xba, 0xe4, 0x1c, 0x6c, 0x29, 0x66, 0x2c, 0x12, 0x5d, 0x2c, 0xbd, 0xba, 0x2a, 0x9d, 0x38,
-	0xfd, 0xef, 0x13, 0xeb, 0x99, 0xe7, 0x8b, 0x2f, 0xa3, 0x9e, 0xdd, 0x67, 0x81, 0xee, 0xb8, 0x7e,
-	0x34, 0xf8, 0xe0, 0xc4, 0x11, 0xe3, 0x90, 0x70, 0xbb, 0x43, 0xc5, 0x6c, 0x62, 0xbd, 0xba, 0xa8,
-	0x74, 0x9e, 0x0f, 0xb9, 0x9b, 0xd2, 0x74, 0x18, 0xe9, 0xe3, 0xd1, 0xf7, 0xf9, 0xa5, 0xd4, 0x0e,
-	0x23, 0xf1, 0x7f, 0xb7, 0xe9, 0xf3, 0xb4, 0xec, 0x6b, 0xb2, 0xec, 0xf6, 0x6a, 0x65, 0x8f, 0x99,
-	0xad, 0x50, 0x77, 0xd8, 0x04, 0xeb, 0x69, 0x05, 0x2a, 0x05, 0xc9, 0xfc, 0xc9, 0x6c, 0x62, 0x6d,
-	0xdd, 0x2a, 0x0e, 0x72, 0x4b, 0x49, 0x55, 0xd0, 0xcf, 0x06, 0x40, 0x59, 0xf5, 0xd0, 0xbd, 0x0a,
-	0x41, 0x39, 0x99, 0xa2, 0x9b, 0xad, 0xfa, 0xe8, 0x5f, 0xb7, 0xea, 0x95, 0x9b, 0x43, 0x99, 0x76,
-	0x6a, 0x43, 0xcf, 0xa6, 0x6e, 0xd4, 0x63, 0x50, 0xfe, 0x38, 0x0a, 0x8e, 0x18, 0x1b, 0xa6, 0x5b,
-	0xdb, 0x06, 0x5b, 0x73, 0x93, 0x26, 0xd6, 0x04, 0xeb, 0x34, 0x0a, 0xba, 0x71, 0x7d, 0xd5, 0xea,
-	0x16, 0x16, 0x25, 0xa7, 0x2e, 0xe4, 0x96, 0xa8, 0x0e, 0xdd, 0xfd, 0xfb, 0x01, 0x78, 0xf0, 0x49,
-	0xfc, 0x97, 0x82, 0xdf, 0x19, 0xa0, 0xa8, 0x76, 0x19, 0x3e, 0xbf, 0x7b, 0xdf, 0x35, 0x0d, 0x73,
-	0x7b, 0x15, 0xa8, 0xa2, 0x87, 0xb6, 0xbf, 0xf9, 0xf5, 0xaf, 0x1f, 0xf3, 0x6f, 0x42, 0xe4, 0x64,
-	0xfc, 0x70, 0x35, 0x85, 0x3f, 0x0c, 0xf0, 0x74, 0xe9, 0xd6, 0xc0, 0xf7, 0xb2, 0x4e, 0xbd, 0xeb,
-	0xbf, 0x63, 0xee, 0xdd, 0x33, 0x5a, 0xcb, 0x68, 0x4b, 0x19, 0xef, 0xc3, 0xbd, 0x54, 0x86, 0x87,
-	0x83, 0x20, 0x15, 0xf0, 0x95, 0x1e, 0xf4, 0xaf, 0x1d, 0xa2, 0x53, 0xa9, 0x4b, 0x84, 0xc4, 0xc9,
-	0x74, 0x83, 0xbb, 0x3e, 0x85, 0x53, 0x03, 0x98, 0xcb, 0x87, 0x0d, 0xde, 0x87, 0xe4, 0x7c, 0x69,
-	0xcd, 0xfd, 0xfb, 0x86, 0x6b, 0x91, 0x1f, 0x4a, 0x91, 0x07, 0x70, 0xff, 0x3f, 0x88, 0x64, 0x91,
-	0x80, 0x3f, 0x19, 0xa0, 0x94, 0xcc, 0x29, 0xdc, 0xc9, 0x22, 0x75, 0x6b, 0xc0, 0xcd, 0x77, 0x56,
-	0x03, 0x6b, 0xbe, 0x0d, 0xc9, 0xf7, 0x6d, 0xf8, 0x56, 0xd6, 0x6c, 0xa5, 0x1b, 0xd0, 0x3a, 0xbe,
-	0xbc, 0xae, 0x1a, 0x57, 0xd7, 0x55, 0xe3, 0xcf, 0xeb, 0xaa, 0xf1, 0xc3, 0xb4, 0x9a, 0xbb, 0x9a,
-	0x56, 0x73, 0xbf, 0x4d, 0xab, 0xb9, 0xcf, 0x5a, 0x0b, 0xbb, 0xab, 0x53, 0x35, 0x86, 0xb8, 0xc7,
-	0xd3, 0xbc, 0x67, 0xcd, 0x17, 0xce, 0xc5, 0x62, 0xf6, 0xfe, 0xd0, 0x27, 0x54, 0xa8, 0xbb, 0x5e,
-	0xdd, 0xba, 0x45, 0xf9, 0x78, 0xf1, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f, 0xcc, 0x3e, 0x24,
-	0x02, 0x09, 0x00, 0x00,
+	// 975 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x41, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0xfe, 0x3b, 0xf1, 0xe4, 0x9f, 0x38, 0x99, 0x16, 0x70, 0x57, 0xc8, 0x1b, 0x06,
+	0x08, 0x6e, 0x82, 0x77, 0xe5, 0xf4, 0x86, 0x68, 0x29, 0xab, 0x1a, 0xd5, 0x17, 0x52, 0x96, 0x1b,
+	0x2a, 0x5a, 0x8d, 0xed, 0xc1, 0x59, 0xd5, 0x3b, 0xb3, 0xf5, 0xcc, 0xb6, 0x8e, 0x10, 0x17, 0x4e,
+	0x48, 0x08, 0x81, 0x04, 0x42, 0xfd, 0x24, 0x7c, 0x86, 0x1e, 0x23, 0x71, 0x41, 0x1c, 0x0c, 0x4a,
+	0xf8, 0x04, 0xfe, 0x04, 0x68, 0x67, 0x66, 0xd7, 0x5b, 0x8b, 0x6c, 0xdd, 0x40, 0x4f, 0xbb, 0x33,
+	0xef, 0x37, 0xef, 0xfd, 0x7e, 0xef, 0xcd, 0x7b, 0xbb, 0x60, 0x8f, 0xf1, 0x90, 0xf1, 0x80, 0x3b,
+	0xfc, 0x31, 0x8e, 0xc6, 0x2c, 0x16, 0x64, 0xec, 0x3c, 0x6a, 0xf7, 0x88, 0xc0, 0x6d, 0xe7, 0x61,
+	0x4c, 0xc6, 0x27, 0x76, 0x34, 0x66, 0x82, 0x41, 0x53, 0xe3, 0xec, 0x39, 0xce, 0xd6, 0x38, 0xf3,
+	0xea, 0x90, 0x0d, 0x99, 0x84, 0x39, 0xc9, 0x9b, 0x3a, 0x61, 0x36, 0x0b, 0x3c, 0x0f, 0x09, 0x25,
+	0x89, 0x33, 0x85, 0x7c, 0xb3, 0x00, 0x29, 0x26, 0x1a, 0x74, 0x50, 0x00, 0x4a, 0xb6, 0x7c, 0xb9,
+	0xa7, 0xc1, 0x8d, 0xbe, 0x44, 0x3b, 0x3d, 0xcc, 0x49, 0x86, 0xea, 0xb3, 0x80, 0x6a, 0xfb, 0x7e,
+	0xde, 0x2e, 0x65, 0x66, 0xa8, 0x08, 0x0f, 0x03, 0x8a, 0x45, 0xc0, 0x52, 0xec, 0xeb, 0x43, 0xc6,
+	0x86, 0x23, 0xe2, 0xe0, 0x28, 0x70, 0x30, 0xa5, 0x4c, 0x48, 0x63, 0xca, 0xfd, 0x9a, 0xb6, 0xca,
+	0x55, 0x2f, 0xfe, 0xc2, 0xc1, 0xf4, 0x24, 0x35, 0xa9, 0x20, 0xbe, 0x5c, 0x39, 0x6a, 0xa1, 0x4d,
+	0xd6, 0xe2, 0x29, 0x11, 0x84, 0x84, 0x0b, 0x1c, 0x46, 0x0a, 0x80, 0x6a, 0x60, 0xf3, 0x1e, 0x1e,
+	0xe3, 0x90, 0x7b, 0xe4, 0x61, 0x4c, 0xb8, 0x40, 0x1e, 0xd8, 0x4a, 0x37, 0x78, 0xc4, 0x28, 0x27,
+	0xf0, 0x36, 0xa8, 0x44, 0x72, 0xa7, 0x6e, 0xec, 0x1a, 0xcd, 0x8d, 0x43, 0x64, 0x5f, 0x5c, 0x22,
+	0x5b, 0x9d, 0x75, 0xcb, 0x4f, 0xa7, 0xd6, 0x8a, 0xa7, 0xcf, 0xa1, 0x6f, 0x4a, 0x60, 0xb7, 0xc3,
+	0x45, 0x10, 0x62, 0x41, 0x3e, 0x7d, 0x8c, 0xa3, 0xce, 0x04, 0xf7, 0xc5, 0x87, 0x21, 0x8b, 0xa9,
+	0xe8, 0x52, 0x1d, 0x18, 0x5e, 0x07, 0x15, 0x4e, 0xe8, 0x80, 0x8c, 0x65, 0x98, 0xaa, 0xbb, 0x33,
+	0x9b, 0x5a, 0x9b, 0x27, 0x38, 0x1c, 0xbd, 0x87, 0xd4, 0x3e, 0xf2, 0x34, 0x00, 0x1e, 0x80, 0xb5,
+	0x88, 0xb1, 0x91, 0x1f, 0x0c, 0xea, 0xa5, 0x5d, 0xa3, 0x59, 0x76, 0xe1, 0x6c, 0x6a, 0x6d, 0x29,
+	0xac, 0x36, 0x20, 0xaf, 0x92, 0xbc, 0x75, 0x07, 0xd0, 0x06, 0xeb, 0x82, 0x3d, 0x20, 0xd4, 0x0f,
+	0x68, 0x7d, 0x55, 0x7a, 0xbe, 0x32, 0x9b, 0x5a, 0x35, 0x85, 0x4e, 0x2d, 0xc8, 0x5b, 0x93, 0xaf,
+	0x5d, 0x0a, 0xef, 0x83, 0x8a, 0xd4, 0xc4, 0xeb, 0xe5, 0xdd, 0xd5, 0xe6, 0xc6, 0x61, 0xab, 0x48,
+	0x6e, 0xa2, 0x26, 0x13, 0x92, 0x98, 0xdc, 0x57, 0x12, 0xe5, 0x73, 0xea, 0xca, 0x15, 0xf2, 0xb4,
+	0x4f, 0xf4, 0xc4, 0x00, 0x6f, 0x14, 0xa4, 0x42, 0xa7, 0x9c, 0x83, 0x6d, 0xc5, 0x8c, 0xc5, 0xc2,
+	0xc7, 0xd2, 0xaa, 0xb3, 0xd2, 0x4d, 0xdc, 0xff, 0x3e, 0xb5, 0xf6, 0x86, 0x81, 0x38, 0x8e, 0x7b,
+	0x76, 0x9f, 0x85, 0xba, 0xe2, 0xfa, 0xd1, 0xe2, 0x83, 0x07, 0x8e, 0x38, 0x89, 0x08, 0xb7, 0xbb,
+	0x54, 0xcc, 0xa6, 0xd6, 0x6b, 0x79, 0xa5, 0x73, 0x7f, 0xc8, 0xdb, 0x92, 0x5b, 0x47, 0xb1, 0x0e,
+	0x8f, 0xbe, 0x2b, 0x5d, 0x48, 0xed, 0x28, 0x16, 0x2f, 0xbb, 0x4c, 0x9f, 0x67, 0x69, 0x5f, 0x95,
+	0x69, 0xb7, 0x97, 0x4b, 0x7b, 0xc2, 0x6c, 0x89, 0xbc, 0xc3, 0x36, 0xa8, 0x66, 0x19, 0xa8, 0x97,
+	0x25, 0xf3, 0xab, 0xb3, 0xa9, 0xb5, 0xbd, 0x90, 0x1c, 0xe4, 0xad, 0xa7, 0x59, 0x41, 0x3f, 0x1b,
+	0x00, 0x15, 0xe5, 0x43, 0xd7, 0x2a, 0x02, 0xb5, 0xf4, 0x16, 0x3d, 0x5b, 0xaa, 0xbb, 0x2f, 0x5c,
+	0xaa, 0x57, 0x9f, 0xbd, 0x94, 0x59, 0xa5, 0x36, 0xf5, 0xdd, 0xd4, 0x85, 0xda, 0x01, 0xb5, 0x8f,
+	0xe3, 0xf0, 0x1e, 0x63, 0xa3, 0xac, 0x6b, 0x3b, 0x60, 0x7b, 0xbe, 0xa5, 0x89, 0xb5, 0x41, 0x95,
+	0xc6, 0xa1, 0x9f, 0xe4, 0x57, 0xb5, 0x6e, 0x39, 0x2f, 0x39, 0x33, 0x21, 0x6f, 0x9d, 0xea, 0xa3,
+	0xe8, 0x17, 0x03, 0x6c, 0xbb, 0x84, 0xab, 0x94, 0xa6, 0x15, 0xcf, 0x37, 0x90, 0xb1, 0x44, 0x03,
+	0xb9, 0xa0, 0x36, 0xbf, 0x6c, 0x03, 0x42, 0x59, 0x28, 0xcb, 0x5f, 0x75, 0xcd, 0x45, 0x89, 0x19,
+	0x20, 0x95, 0x78, 0x14, 0x8b, 0x3b, 0xc9, 0x3a, 0x89, 0x19, 0xe2, 0x89, 0x7f, 0xcc, 0x22, 0x2e,
+	0x9b, 0xb6, 0x9c, 0x8f, 0x99, 0x5a, 0x90, 0xb7, 0x16, 0xe2, 0xc9, 0xdd, 0xe4, 0xed, 0xb4, 0x04,
+	0x76, 0x72, 0xc4, 0x75, 0x06, 0xe6, 0xad, 0x6c, 0xfc, 0xf7, 0xad, 0xfc, 0x8f, 0x4d, 0x5a, 0x7a,
+	0xc9, 0x4d, 0x0a, 0x8f, 0xc1, 0xff, 0xa3, 0x71, 0xd0, 0x27, 0x7e, 0x10, 0x46, 0xb8, 0x2f, 0xf4,
+	0x44, 0xeb, 0xbc, 0x40, 0xc0, 0x3b, 0xa4, 0x3f, 0x9b, 0x5a, 0x57, 0x74, 0x1b, 0xe6, 0x7c, 0x21,
+	0x6f, 0x43, 0x2e, 0xbb, 0x72, 0x75, 0xf8, 0xfd, 0x1a, 0xf8, 0xdf, 0x27, 0xc9, 0x17, 0x0b, 0x7e,
+	0x6b, 0x80, 0x8a, 0x9a, 0xeb, 0xf0, 0xfa, 0xf3, 0x67, 0xbf, 0xbe, 0x36, 0xe6, 0xfe, 0x32, 0x50,
+	0x55, 0x28, 0xb4, 0xff, 0xf5, 0xaf, 0x7f, 0xfd, 0x58, 0x7a, 0x0b, 0x22, 0xa7, 0xe0, 0xe3, 0xab,
+	0x29, 0xfc, 0x61, 0x80, 0x6b, 0x17, 0x4e, 0x50, 0xf8, 0x7e, 0x51, 0xd4, 0xe7, 0x7d, 0x83, 0xcc,
+	0x9b, 0x97, 0x3c, 0xad, 0x65, 0x74, 0xa4, 0x8c, 0x0f, 0xe0, 0xcd, 0x4c, 0xc6, 0x10, 0x87, 0x61,
+	0x26, 0xe0, 0x4b, 0x3d, 0xf4, 0xbe, 0x72, 0x88, 0x76, 0xa5, 0x7e, 0x28, 0x48, 0xe2, 0x4c, 0x57,
+	0xdc, 0x0f, 0x28, 0x3c, 0x37, 0x80, 0x79, 0xf1, 0xe0, 0x81, 0x97, 0x21, 0x39, 0x1f, 0xe0, 0xe6,
+	0xad, 0xcb, 0x1e, 0xd7, 0x22, 0x3f, 0x92, 0x22, 0x6f, 0xc3, 0x5b, 0xff, 0x42, 0x24, 0x8b, 0x05,
+	0xfc, 0xc9, 0x00, 0xeb, 0xe9, 0xcc, 0x82, 0x07, 0x45, 0xa4, 0x16, 0x86, 0x9d, 0xf9, 0xee, 0x72,
+	0x60, 0xcd, 0xb7, 0x25, 0xf9, 0xbe, 0x03, 0xdf, 0x2e, 0xba, 0x5b, 0xd9, 0x34, 0x84, 0x4f, 0x0c,
+	0x50, 0xcd, 0x26, 0x09, 0x2c, 0x0c, 0xb5, 0x38, 0x29, 0xcd, 0xd6, 0x92, 0x68, 0xcd, 0xcc, 0x96,
+	0xcc, 0x9a, 0x70, 0xaf, 0x88, 0x59, 0x8f, 0x70, 0xa1, 0x7e, 0x39, 0xdd, 0xfb, 0x4f, 0xcf, 0x1a,
+	0xc6, 0xe9, 0x59, 0xc3, 0xf8, 0xf3, 0xac, 0x61, 0xfc, 0x70, 0xde, 0x58, 0x39, 0x3d, 0x6f, 0xac,
+	0xfc, 0x76, 0xde, 0x58, 0xf9, 0xcc, 0xcd, 0xf5, 0xbd, 0xf6, 0xd5, 0x1a, 0xe1, 0x1e, 0xcf, 0x1c,
+	0x3f, 0x6a, 0xdf, 0x70, 0x26, 0x79, 0xf7, 0xfd, 0x51, 0x40, 0xa8, 0x50, 0xbf, 0xa4, 0xea, 0xe7,
+	0xb0, 0x22, 0x1f, 0x37, 0xfe, 0x1e, 0x00, 0x64, 0x93, 0x67, 0xfb, 0xa9, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -501,6 +624,11 @@ type QueryClient interface {
 	// Estimates swap amount in given out.
 	EstimateSwapExactAmountOut(ctx context.Context, in *EstimateSwapExactAmountOutRequest, opts ...grpc.CallOption) (*EstimateSwapExactAmountOutResponse, error)
 	NumPools(ctx context.Context, in *NumPoolsRequest, opts ...grpc.CallOption) (*NumPoolsResponse, error)
+	// BestRoute searches all routed pools for the swap route of at most max_hops
+	// pools that returns the most of token_out_denom for token_in. Each denom is
+	// only swapped through the 10 pools holding the most of it, and at most 1000
+	// candidate routes are estimated.
+	BestRoute(ctx context.Context, in *BestRouteRequest, opts ...grpc.CallOption) (*BestRouteResponse, error)
 }
 
 type queryClient struct {
@@ -547,6 +675,15 @@ func (c *queryClient) NumPools(ctx context.Context, in *NumPoolsRequest, opts ..
 	return out, nil
 }
 
+func (c *queryClient) BestRoute(ctx context.Context, in *BestRouteRequest, opts ...grpc.CallOption) (*BestRouteResponse, error) {
+	out := new(BestRouteResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.swaprouter.v1beta1.Query/BestRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
@@ -555,6 +692,11 @@ type QueryServer interface {
 	// Estimates swap amount in given out.
 	EstimateSwapExactAmountOut(context.Context, *EstimateSwapExactAmountOutRequest) (*EstimateSwapExactAmountOutResponse, error)
 	NumPools(context.Context, *NumPoolsRequest) (*NumPoolsResponse, error)
+	// BestRoute searches all routed pools for the swap route of at most max_hops
+	// pools that returns the most of token_out_denom for token_in. Each denom is
+	// only swapped through the 10 pools holding the most of it, and at most 1000
+	// candidate routes are estimated.
+	BestRoute(context.Context, *BestRouteRequest) (*BestRouteResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -573,6 +715,9 @@ func (*UnimplementedQueryServer) EstimateSwapExactAmountOut(ctx context.Context,
 func (*UnimplementedQueryServer) NumPools(ctx context.Context, req *NumPoolsRequest) (*NumPoolsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NumPools not implemented")
 }
+func (*UnimplementedQueryServer) BestRoute(ctx context.Context, req *BestRouteRequest) (*BestRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BestRoute not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -650,6 +795,24 @@ func _Query_NumPools_Handler(srv interface{}, ctx context.Context, dec func(inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BestRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BestRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BestRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.swaprouter.v1beta1.Query/BestRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BestRoute(ctx, req.(*BestRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.swaprouter.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -670,6 +833,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NumPools",
 			Handler:    _Query_NumPools_Handler,
 		},
+		{
+			MethodName: "BestRoute",
+			Handler:    _Query_BestRoute_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/swaprouter/v1beta1/query.proto",
@@ -960,6 +1127,105 @@ func (m *NumPoolsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *BestRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BestRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxHops != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MaxHops))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TokenOutDenom) > 0 {
+		i -= len(m.TokenOutDenom)
+		copy(dAtA[i:], m.TokenOutDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenOutDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenIn) > 0 {
+		i -= len(m.TokenIn)
+		copy(dAtA[i:], m.TokenIn)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TokenIn)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BestRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BestRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BestRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PriceImpact.Size()
+		i -= size
+		if _, err := m.PriceImpact.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.TokenOutAmount.Size()
+		i -= size
+		if _, err := m.TokenOutAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Routes) > 0 {
+		for iNdEx := len(m.Routes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Routes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -1086,6 +1352,45 @@ func (m *NumPoolsResponse) Size() (n int) {
 	return n
 }
 
+func (m *BestRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TokenIn)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TokenOutDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MaxHops != 0 {
+		n += 1 + sovQuery(uint64(m.MaxHops))
+	}
+	return n
+}
+
+func (m *BestRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Routes) > 0 {
+		for _, e := range m.Routes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.TokenOutAmount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.PriceImpact.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1846,6 +2151,291 @@ func (m *NumPoolsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *BestRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BestRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BestRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenIn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenOutDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxHops", wireType)
+			}
+			m.MaxHops = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxHops |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BestRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BestRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BestRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Routes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Routes = append(m.Routes, types.SwapAmountInRoute{})
+			if err := m.Routes[len(m.Routes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenOutAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TokenOutAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceImpact", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PriceImpact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
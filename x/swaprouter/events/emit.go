@@ -2,10 +2,12 @@ package events
 
 import (
 	"strconv"
+	"strings"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 func EmitSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
@@ -25,6 +27,30 @@ func newSwapEvent(sender sdk.AccAddress, poolId uint64, input sdk.Coins, output
 	)
 }
 
+// EmitSplitRouteSwapEvent emits an event for one of the routes of a split-route swap,
+// on top of the swap events emitted by each pool along the route.
+func EmitSplitRouteSwapEvent(ctx sdk.Context, sender sdk.AccAddress, poolIds []uint64, input sdk.Coin, output sdk.Coin) {
+	ctx.EventManager().EmitEvents(sdk.Events{
+		newSplitRouteSwapEvent(sender, poolIds, input, output),
+	})
+}
+
+func newSplitRouteSwapEvent(sender sdk.AccAddress, poolIds []uint64, input sdk.Coin, output sdk.Coin) sdk.Event {
+	formattedPoolIds := make([]string, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		formattedPoolIds = append(formattedPoolIds, strconv.FormatUint(poolId, 10))
+	}
+
+	return sdk.NewEvent(
+		swaproutertypes.TypeEvtSplitRouteSwapped,
+		sdk.NewAttribute(sdk.AttributeKeyModule, swaproutertypes.AttributeValueCategory),
+		sdk.NewAttribute(sdk.AttributeKeySender, sender.String()),
+		sdk.NewAttribute(swaproutertypes.AttributeKeyPoolIds, strings.Join(formattedPoolIds, ",")),
+		sdk.NewAttribute(types.AttributeKeyTokensIn, input.String()),
+		sdk.NewAttribute(types.AttributeKeyTokensOut, output.String()),
+	)
+}
+
 func EmitAddLiquidityEvent(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, liquidity sdk.Coins) {
 	ctx.EventManager().EmitEvents(sdk.Events{
 		newAddLiquidityEvent(sender, poolId, liquidity),
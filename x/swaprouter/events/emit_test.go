@@ -10,6 +10,7 @@ import (
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 type SwapRouterEventsTestSuite struct {
@@ -83,6 +84,56 @@ func (suite *SwapRouterEventsTestSuite) TestEmitSwapEvent() {
 	}
 }
 
+func (suite *SwapRouterEventsTestSuite) TestEmitSplitRouteSwapEvent() {
+	testcases := map[string]struct {
+		ctx             sdk.Context
+		testAccountAddr sdk.AccAddress
+		poolIds         []uint64
+		expectedPoolIds string
+		tokenIn         sdk.Coin
+		tokenOut        sdk.Coin
+	}{
+		"single pool": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolIds:         []uint64{1},
+			expectedPoolIds: "1",
+			tokenIn:         sdk.NewCoin(testDenomA, sdk.NewInt(1234)),
+			tokenOut:        sdk.NewCoin(testDenomB, sdk.NewInt(5678)),
+		},
+		"multiple pools": {
+			ctx:             suite.CreateTestContext(),
+			testAccountAddr: sdk.AccAddress([]byte(addressString)),
+			poolIds:         []uint64{200, 3, 45},
+			expectedPoolIds: "200,3,45",
+			tokenIn:         sdk.NewCoin(testDenomA, sdk.NewInt(12)),
+			tokenOut:        sdk.NewCoin(testDenomD, sdk.NewInt(34)),
+		},
+	}
+
+	for name, tc := range testcases {
+		suite.Run(name, func() {
+			expectedEvents := sdk.Events{
+				sdk.NewEvent(
+					swaproutertypes.TypeEvtSplitRouteSwapped,
+					sdk.NewAttribute(sdk.AttributeKeyModule, swaproutertypes.AttributeValueCategory),
+					sdk.NewAttribute(sdk.AttributeKeySender, tc.testAccountAddr.String()),
+					sdk.NewAttribute(swaproutertypes.AttributeKeyPoolIds, tc.expectedPoolIds),
+					sdk.NewAttribute(types.AttributeKeyTokensIn, tc.tokenIn.String()),
+					sdk.NewAttribute(types.AttributeKeyTokensOut, tc.tokenOut.String()),
+				),
+			}
+
+			// System under test.
+			events.EmitSplitRouteSwapEvent(tc.ctx, tc.testAccountAddr, tc.poolIds, tc.tokenIn, tc.tokenOut)
+
+			// Assertions
+			actualEvents := tc.ctx.EventManager().Events()
+			suite.Equal(expectedEvents, actualEvents)
+		})
+	}
+}
+
 func (suite *SwapRouterEventsTestSuite) TestEmitAddLiquidityEvent() {
 	testcases := map[string]struct {
 		ctx             sdk.Context
@@ -15,6 +15,7 @@ type Keeper struct {
 
 	gammKeeper           types.SwapI
 	concentratedKeeper   types.SwapI
+	cosmwasmpoolKeeper   types.SwapI
 	poolIncentivesKeeper types.PoolIncentivesKeeperI
 	bankKeeper           types.BankI
 	accountKeeper        types.AccountI
@@ -27,7 +28,7 @@ type Keeper struct {
 	paramSpace paramtypes.Subspace
 }
 
-func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.SwapI, concentratedKeeper types.SwapI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI) *Keeper {
+func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper types.SwapI, concentratedKeeper types.SwapI, cosmwasmpoolKeeper types.SwapI, bankKeeper types.BankI, accountKeeper types.AccountI, communityPoolKeeper types.CommunityPoolI) *Keeper {
 	// set KeyTable if it has not already been set
 	if !paramSpace.HasKeyTable() {
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
@@ -37,9 +38,10 @@ func NewKeeper(storeKey sdk.StoreKey, paramSpace paramtypes.Subspace, gammKeeper
 		types.Balancer:     gammKeeper,
 		types.StableSwap:   gammKeeper,
 		types.Concentrated: concentratedKeeper,
+		types.CosmWasm:     cosmwasmpoolKeeper,
 	}
 
-	return &Keeper{storeKey: storeKey, paramSpace: paramSpace, gammKeeper: gammKeeper, concentratedKeeper: concentratedKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, communityPoolKeeper: communityPoolKeeper, routes: routes}
+	return &Keeper{storeKey: storeKey, paramSpace: paramSpace, gammKeeper: gammKeeper, concentratedKeeper: concentratedKeeper, cosmwasmpoolKeeper: cosmwasmpoolKeeper, bankKeeper: bankKeeper, accountKeeper: accountKeeper, communityPoolKeeper: communityPoolKeeper, routes: routes}
 }
 
 // GetParams returns the total set of swaprouter parameters.
@@ -62,13 +64,23 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState *types.GenesisState) {
 	}
 
 	k.SetParams(ctx, genState.Params)
+
+	for _, poolRoute := range genState.PoolRoutes {
+		k.SetPoolRoute(ctx, poolRoute.PoolId, poolRoute.PoolType)
+	}
 }
 
 // ExportGenesis returns the swaprouter module's exported genesis.
 func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	poolRoutes, err := k.getAllPoolRoutes(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
 		Params:     k.GetParams(ctx),
 		NextPoolId: k.GetNextPoolId(ctx),
+		PoolRoutes: poolRoutes,
 	}
 }
 
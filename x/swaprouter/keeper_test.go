@@ -19,10 +19,6 @@ const testExpectedPoolId = 3
 var testPoolCreationFee = sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000_000_000)}
 
 func TestKeeperTestSuite(t *testing.T) {
-
-	// TODO: re-enable this once swaprouter is fully merged.
-	t.SkipNow()
-
 	suite.Run(t, new(KeeperTestSuite))
 }
 
@@ -54,3 +50,47 @@ func (suite *KeeperTestSuite) createBalancerPoolsFromCoins(poolCoins []sdk.Coins
 		suite.PrepareBalancerPoolWithCoins(curPoolCoins...)
 	}
 }
+
+// TestInitGenesis tests that genesis pool routes are restored, so that swaps
+// against the imported pools are routed to the module owning them.
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.Setup()
+
+	suite.App.SwapRouterKeeper.InitGenesis(suite.Ctx, &types.GenesisState{
+		Params:     types.Params{PoolCreationFee: testPoolCreationFee},
+		NextPoolId: testExpectedPoolId,
+		PoolRoutes: []types.ModuleRoute{
+			{PoolId: 1, PoolType: types.Balancer},
+			{PoolId: 2, PoolType: types.Concentrated},
+		},
+	})
+
+	suite.Require().Equal(uint64(testExpectedPoolId), suite.App.SwapRouterKeeper.GetNextPoolId(suite.Ctx))
+	suite.Require().Equal(testPoolCreationFee, suite.App.SwapRouterKeeper.GetParams(suite.Ctx).PoolCreationFee)
+
+	swapModule, err := suite.App.SwapRouterKeeper.GetPoolModule(suite.Ctx, 1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.GAMMKeeper, swapModule)
+
+	swapModule, err = suite.App.SwapRouterKeeper.GetPoolModule(suite.Ctx, 2)
+	suite.Require().NoError(err)
+	suite.Require().Equal(suite.App.ConcentratedLiquidityKeeper, swapModule)
+}
+
+// TestExportGenesis tests that the routes of created pools are exported.
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.Setup()
+
+	suite.createBalancerPoolsFromCoins([]sdk.Coins{
+		sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("bar", 1_000_000)),
+		sdk.NewCoins(sdk.NewInt64Coin("foo", 1_000_000), sdk.NewInt64Coin("baz", 1_000_000)),
+	})
+
+	genesis := suite.App.SwapRouterKeeper.ExportGenesis(suite.Ctx)
+
+	suite.Require().Equal([]types.ModuleRoute{
+		{PoolId: 1, PoolType: types.Balancer},
+		{PoolId: 2, PoolType: types.Balancer},
+	}, genesis.PoolRoutes)
+	suite.Require().NoError(genesis.Validate())
+}
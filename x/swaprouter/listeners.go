@@ -0,0 +1,71 @@
+package swaprouter
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+)
+
+var _ gammtypes.GammHooks = &gammhook{}
+
+type gammhook struct {
+	k Keeper
+}
+
+// GammHooks returns the gamm hooks used by the swaprouter to register the
+// module route of every pool created in x/gamm.
+func (k Keeper) GammHooks() gammtypes.GammHooks {
+	return &gammhook{k}
+}
+
+// AfterPoolCreated is called after CreatePool
+func (hook *gammhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	pool, err := hook.k.gammKeeper.GetPool(ctx, poolId)
+	// Will halt pool creation
+	if err != nil {
+		panic(err)
+	}
+	hook.k.SetPoolRoute(ctx, poolId, pool.GetType())
+}
+
+func (hook *gammhook) AfterSwap(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, input sdk.Coins, output sdk.Coins) {
+}
+
+func (hook *gammhook) AfterJoinPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, enterCoins sdk.Coins, shareOutAmount sdk.Int) {
+}
+
+func (hook *gammhook) AfterExitPool(ctx sdk.Context, sender sdk.AccAddress, poolId uint64, shareInAmount sdk.Int, exitCoins sdk.Coins) {
+}
+
+var _ types.PoolCreationListener = &poolcreationhook{}
+
+// poolcreationhook registers the module route of every pool created in the
+// pool module it listens to.
+type poolcreationhook struct {
+	k          Keeper
+	poolModule types.SwapI
+}
+
+// ConcentratedLiquidityListener returns the listener used by the swaprouter to
+// register the module route of every pool created in x/concentrated-liquidity.
+func (k Keeper) ConcentratedLiquidityListener() types.PoolCreationListener {
+	return &poolcreationhook{k, k.concentratedKeeper}
+}
+
+// CosmWasmPoolListener returns the listener used by the swaprouter to register
+// the module route of every pool created in x/cosmwasmpool.
+func (k Keeper) CosmWasmPoolListener() types.PoolCreationListener {
+	return &poolcreationhook{k, k.cosmwasmpoolKeeper}
+}
+
+// AfterPoolCreated is called after a pool is created in the hook's pool
+// module.
+func (hook *poolcreationhook) AfterPoolCreated(ctx sdk.Context, sender sdk.AccAddress, poolId uint64) {
+	pool, err := hook.poolModule.GetPool(ctx, poolId)
+	// Will halt pool creation
+	if err != nil {
+		panic(err)
+	}
+	hook.k.SetPoolRoute(ctx, poolId, pool.GetType())
+}
@@ -1,8 +1,17 @@
 package swaprouter
 
 import (
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	appparams "github.com/osmosis-labs/osmosis/v13/app/params"
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
+	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/events"
 	"github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
@@ -15,18 +24,127 @@ func (k Keeper) RouteExactAmountIn(
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 	tokenOutMinAmount sdk.Int) (tokenOutAmount sdk.Int, err error) {
-	panic("not implemented")
+	var (
+		isMultiHopRouted bool
+		routeSwapFee     sdk.Dec
+		sumOfSwapFees    sdk.Dec
+	)
+
+	// Ensure that provided route is not empty and has valid denom format.
+	route := types.SwapAmountInRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// In this loop, we check if:
+	// - the route is of length 2
+	// - route 1 and route 2 don't trade via the same pool
+	// - route 1 contains uosmo
+	// - both route 1 and route 2 are incentivized pools
+	//
+	// If all of the above is true, then we collect the additive and max fee between the
+	// two pools to later calculate the following:
+	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
+	// fee_per_pool = total_swap_fee * ((pool_fee) / (swapfee1 + swapfee2))
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenOutDenom, tokenIn.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	// Iterate through the route and execute a series of swaps through each pool.
+	for i, route := range routes {
+		// To prevent the multihop swap from being interrupted prematurely, we keep
+		// the minimum expected output at a very low number until the last pool
+		_outMinAmount := sdk.NewInt(1)
+		if len(routes)-1 == i {
+			_outMinAmount = tokenOutMinAmount
+		}
+
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+
+		// If we determined the route is an osmo multi-hop and both routes are incentivized,
+		// we modify the swap fee accordingly.
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		tokenOutAmount, err = swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, route.TokenOutDenom, _outMinAmount, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, err
 }
 
+// MultihopEstimateOutGivenExactAmountIn estimates the amount of the last token out
+// that RouteExactAmountIn would return for the given routes and tokenIn, applying
+// the same OSMO-routed swap fee discount. It does not mutate state.
 func (k Keeper) MultihopEstimateOutGivenExactAmountIn(
 	ctx sdk.Context,
 	routes []types.SwapAmountInRoute,
 	tokenIn sdk.Coin,
 ) (tokenOutAmount sdk.Int, err error) {
-	panic("not implemented")
+	var (
+		isMultiHopRouted bool
+		routeSwapFee     sdk.Dec
+		sumOfSwapFees    sdk.Dec
+	)
+
+	route := types.SwapAmountInRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenOutDenom, tokenIn.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	for _, route := range routes {
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+
+		// If we determined the route is an osmo multi-hop and both routes are incentivized,
+		// we modify the swap fee accordingly.
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		tokenOut, err := swapModule.CalcOutAmtGivenIn(ctx, pool, tokenIn, route.TokenOutDenom, swapFee)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		tokenOutAmount = tokenOut.Amount
+		if !tokenOutAmount.IsPositive() {
+			return sdk.Int{}, fmt.Errorf("token amount must be positive, was (%s)", tokenOutAmount)
+		}
+
+		// Chain output of current pool as the input for the next routed pool
+		tokenIn = sdk.NewCoin(route.TokenOutDenom, tokenOutAmount)
+	}
+	return tokenOutAmount, err
 }
 
-// MultihopSwapExactAmountOut defines the output denom and output amount for the last pool.
+// RouteExactAmountOut defines the output denom and output amount for the last pool.
 // Calculation starts by providing the tokenOutAmount of the final pool to calculate the required tokenInAmount
 // the calculated tokenInAmount is used as defined tokenOutAmount of the previous pool, calculating in reverse order of the swap
 // Transaction succeeds if the calculated tokenInAmount of the first pool is less than the defined tokenInMaxAmount defined.
@@ -36,13 +154,457 @@ func (k Keeper) RouteExactAmountOut(ctx sdk.Context,
 	tokenInMaxAmount sdk.Int,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	panic("not implemented")
+	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
+
+	// Ensure that provided route is not empty and has valid denom format.
+	route := types.SwapAmountOutRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// in this loop, we check if:
+	// - the route is of length 2
+	// - route 1 and route 2 don't trade via the same pool
+	// - route 1 contains uosmo
+	// - both route 1 and route 2 are incentivized pools
+	// if all of the above is true, then we collect the additive and max fee between the two pools to later calculate the following:
+	// total_swap_fee = total_swap_fee = max(swapfee1, swapfee2)
+	// fee_per_pool = total_swap_fee * ((pool_fee) / (swapfee1 + swapfee2))
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenInDenom, tokenOut.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	// Determine what the estimated input would be for each pool along the multi-hop route
+	// if we determined the route is an osmo multi-hop and both routes are incentivized,
+	// we utilize a separate function that calculates the discounted swap fees
+	var insExpected []sdk.Int
+	if isMultiHopRouted {
+		insExpected, err = k.createOsmoMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees)
+	} else {
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if len(insExpected) == 0 {
+		return sdk.Int{}, nil
+	}
+
+	insExpected[0] = tokenInMaxAmount
+
+	// Iterates through each routed pool and executes their respective swaps. Note that all of the work to get the return
+	// value of this method is done when we calculate insExpected – this for loop primarily serves to execute the actual
+	// swaps on each pool.
+	for i, route := range routes {
+		_tokenOut := tokenOut
+
+		// If there is one pool left in the route, set the expected output of the current swap
+		// to the estimated input of the final pool.
+		if i != len(routes)-1 {
+			_tokenOut = sdk.NewCoin(routes[i+1].TokenInDenom, insExpected[i+1])
+		}
+
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+		if isMultiHopRouted {
+			swapFee = routeSwapFee.Mul((swapFee.Quo(sumOfSwapFees)))
+		}
+
+		_tokenInAmount, swapErr := swapModule.SwapExactAmountOut(ctx, sender, pool, route.TokenInDenom, insExpected[i], _tokenOut, swapFee)
+		if swapErr != nil {
+			return sdk.Int{}, swapErr
+		}
+
+		// Sets the final amount of tokens that need to be input into the first pool. Even though this is the final return value for the
+		// whole method and will not change after the first iteration, we still iterate through the rest of the pools to execute their respective
+		// swaps.
+		if i == 0 {
+			tokenInAmount = _tokenInAmount
+		}
+	}
+
+	return tokenInAmount, nil
 }
 
+// MultihopEstimateInGivenExactAmountOut estimates the amount of the first token in
+// that RouteExactAmountOut would require for the given routes and tokenOut, applying
+// the same OSMO-routed swap fee discount. It does not mutate state.
 func (k Keeper) MultihopEstimateInGivenExactAmountOut(
 	ctx sdk.Context,
 	routes []types.SwapAmountOutRoute,
 	tokenOut sdk.Coin,
 ) (tokenInAmount sdk.Int, err error) {
-	panic("not implemented")
+	isMultiHopRouted, routeSwapFee, sumOfSwapFees := false, sdk.Dec{}, sdk.Dec{}
+	route := types.SwapAmountOutRoutes(routes)
+	if err := route.Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	if k.isOsmoRoutedMultihop(ctx, route, routes[0].TokenInDenom, tokenOut.Denom) {
+		isMultiHopRouted = true
+		routeSwapFee, sumOfSwapFees, err = k.getOsmoRoutedMultihopTotalSwapFee(ctx, route)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+	}
+
+	// Determine what the estimated input would be for each pool along the multi-hop route
+	// if we determined the route is an osmo multi-hop and both routes are incentivized,
+	// we utilize a separate function that calculates the discounted swap fees
+	var insExpected []sdk.Int
+	if isMultiHopRouted {
+		insExpected, err = k.createOsmoMultihopExpectedSwapOuts(ctx, routes, tokenOut, routeSwapFee, sumOfSwapFees)
+	} else {
+		insExpected, err = k.createMultihopExpectedSwapOuts(ctx, routes, tokenOut)
+	}
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	if len(insExpected) == 0 {
+		return sdk.Int{}, nil
+	}
+
+	return insExpected[0], nil
+}
+
+// SplitRouteExactAmountIn swaps tokenInDenom for the token out denom shared by all routes,
+// swapping the amount in of each route along it with RouteExactAmountIn. A split-route swap
+// event is emitted for each route. It errors, without swapping anything, if any route fails
+// or if the total amount out is less than tokenOutMinAmount.
+func (k Keeper) SplitRouteExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountInSplitRoute,
+	tokenInDenom string,
+	tokenOutMinAmount sdk.Int,
+) (tokenOutAmount sdk.Int, err error) {
+	if err := types.SwapAmountInSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// Swaps happen in a cache context, so that no route is swapped, and no event emitted,
+	// unless all of them are.
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	tokenOutAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenIn := sdk.NewCoin(tokenInDenom, route.TokenInAmount)
+		// The minimum amount out applies to the total, so each route only needs to return something.
+		routeTokenOutAmount, err := k.RouteExactAmountIn(cacheCtx, sender, route.Pools, tokenIn, sdk.OneInt())
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		events.EmitSplitRouteSwapEvent(cacheCtx, sender, types.SwapAmountInRoutes(route.Pools).PoolIds(), tokenIn, sdk.NewCoin(route.TokenOutDenom(), routeTokenOutAmount))
+		tokenOutAmount = tokenOutAmount.Add(routeTokenOutAmount)
+	}
+
+	if tokenOutAmount.LT(tokenOutMinAmount) {
+		return sdk.Int{}, types.TokenOutBelowMinError{TokenOutAmount: tokenOutAmount, TokenOutMinAmount: tokenOutMinAmount}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenOutAmount, nil
+}
+
+// SplitRouteExactAmountOut swaps the token in denom shared by all routes for tokenOutDenom,
+// swapping for the amount out of each route along it with RouteExactAmountOut. A split-route
+// swap event is emitted for each route. It errors, without swapping anything, if any route fails
+// or if the total amount in is greater than tokenInMaxAmount.
+func (k Keeper) SplitRouteExactAmountOut(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	routes []types.SwapAmountOutSplitRoute,
+	tokenOutDenom string,
+	tokenInMaxAmount sdk.Int,
+) (tokenInAmount sdk.Int, err error) {
+	if err := types.SwapAmountOutSplitRoutes(routes).Validate(); err != nil {
+		return sdk.Int{}, err
+	}
+
+	// Swaps happen in a cache context, so that no route is swapped, and no event emitted,
+	// unless all of them are.
+	cacheCtx, write := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithEventManager(sdk.NewEventManager())
+
+	tokenInAmount = sdk.ZeroInt()
+	for _, route := range routes {
+		tokenOut := sdk.NewCoin(tokenOutDenom, route.TokenOutAmount)
+		// The maximum amount in applies to the total, which is checked once all routes are swapped.
+		routeTokenInAmount, err := k.RouteExactAmountOut(cacheCtx, sender, route.Pools, tokenInMaxAmount, tokenOut)
+		if err != nil {
+			return sdk.Int{}, err
+		}
+
+		events.EmitSplitRouteSwapEvent(cacheCtx, sender, types.SwapAmountOutRoutes(route.Pools).PoolIds(), sdk.NewCoin(route.TokenInDenom(), routeTokenInAmount), tokenOut)
+		tokenInAmount = tokenInAmount.Add(routeTokenInAmount)
+	}
+
+	if tokenInAmount.GT(tokenInMaxAmount) {
+		return sdk.Int{}, types.TokenInAboveMaxError{TokenInAmount: tokenInAmount, TokenInMaxAmount: tokenInMaxAmount}
+	}
+
+	write()
+	ctx.EventManager().EmitEvents(cacheCtx.EventManager().Events())
+	return tokenInAmount, nil
+}
+
+// GetPoolModule returns the swap module implementation that owns the pool
+// with the given id, as determined by the pool's stored module route.
+// Returns error if no route is stored for the pool or if the route's pool
+// type has no swap module registered.
+func (k Keeper) GetPoolModule(ctx sdk.Context, poolId uint64) (types.SwapI, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	moduleRoute := &types.ModuleRoute{}
+	found, err := osmoutils.Get(store, types.FormatModuleRouteKey(poolId), moduleRoute)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, types.FailedToFindRouteError{PoolId: poolId}
+	}
+
+	swapModule, routeExists := k.routes[moduleRoute.PoolType]
+	if !routeExists || swapModule == nil {
+		return nil, types.UndefinedRouteError{PoolId: poolId, PoolType: moduleRoute.PoolType}
+	}
+
+	return swapModule, nil
+}
+
+// SetPoolRoute stores the mapping from the given pool id to its pool type,
+// so that swaps against the pool are routed to the module handling that type.
+func (k Keeper) SetPoolRoute(ctx sdk.Context, poolId uint64, poolType types.PoolType) {
+	store := ctx.KVStore(k.storeKey)
+	osmoutils.MustSet(store, types.FormatModuleRouteKey(poolId), &types.ModuleRoute{PoolType: poolType})
+}
+
+// getRoutedPoolIds returns the ids of all pools with a stored module route, in increasing order.
+func (k Keeper) getRoutedPoolIds(ctx sdk.Context) ([]uint64, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.SwapModuleRouterPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	poolIds := []uint64{}
+	for ; iterator.Valid(); iterator.Next() {
+		// Module route keys are the prefix followed by the decimal pool id, see FormatModuleRouteKey.
+		poolId, err := strconv.ParseUint(string(iterator.Key()), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		poolIds = append(poolIds, poolId)
+	}
+
+	sort.Slice(poolIds, func(i, j int) bool { return poolIds[i] < poolIds[j] })
+	return poolIds, nil
+}
+
+// getAllPoolRoutes returns the module routes of all pools, with their pool ids set, in increasing
+// order of pool id.
+func (k Keeper) getAllPoolRoutes(ctx sdk.Context) ([]types.ModuleRoute, error) {
+	poolIds, err := k.getRoutedPoolIds(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	poolRoutes := make([]types.ModuleRoute, 0, len(poolIds))
+	for _, poolId := range poolIds {
+		moduleRoute := types.ModuleRoute{}
+		osmoutils.MustGet(store, types.FormatModuleRouteKey(poolId), &moduleRoute)
+		moduleRoute.PoolId = poolId
+		poolRoutes = append(poolRoutes, moduleRoute)
+	}
+	return poolRoutes, nil
+}
+
+// getPoolForSwap returns the swap module and the pool for the given pool id,
+// erroring if the pool cannot be found or is not active, i.e. not allowed to
+// be swapped against.
+func (k Keeper) getPoolForSwap(ctx sdk.Context, poolId uint64) (types.SwapI, types.PoolI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	pool, err := swapModule.GetPool(ctx, poolId)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !pool.IsActive(ctx) {
+		return nil, nil, types.InactivePoolError{PoolId: poolId}
+	}
+
+	return swapModule, pool, nil
+}
+
+// GetPool returns the pool with the given id from the module it is routed to.
+func (k Keeper) GetPool(ctx sdk.Context, poolId uint64) (types.PoolI, error) {
+	swapModule, err := k.getPoolModuleOrGamm(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return swapModule.GetPool(ctx, poolId)
+}
+
+// GetPoolDenoms returns the denoms of the pool with the given id, from the
+// module it is routed to.
+func (k Keeper) GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error) {
+	swapModule, err := k.getPoolModuleOrGamm(ctx, poolId)
+	if err != nil {
+		return nil, err
+	}
+	return swapModule.GetPoolDenoms(ctx, poolId)
+}
+
+// CalculateSpotPrice returns the spot price of the quote asset in terms of
+// the base asset in the pool with the given id, from the module it is routed
+// to.
+func (k Keeper) CalculateSpotPrice(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string) (sdk.Dec, error) {
+	swapModule, err := k.getPoolModuleOrGamm(ctx, poolId)
+	if err != nil {
+		return sdk.Dec{}, err
+	}
+	return swapModule.CalculateSpotPrice(ctx, poolId, baseAssetDenom, quoteAssetDenom)
+}
+
+// SwapExactAmountIn swaps tokenIn for tokenOutDenom in the given pool,
+// through the module the pool is routed to.
+func (k Keeper) SwapExactAmountIn(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	pool types.PoolI,
+	tokenIn sdk.Coin,
+	tokenOutDenom string,
+	tokenOutMinAmount sdk.Int,
+	swapFee sdk.Dec,
+) (sdk.Int, error) {
+	swapModule, err := k.getPoolModuleOrGamm(ctx, pool.GetId())
+	if err != nil {
+		return sdk.Int{}, err
+	}
+	return swapModule.SwapExactAmountIn(ctx, sender, pool, tokenIn, tokenOutDenom, tokenOutMinAmount, swapFee)
+}
+
+// getPoolModuleOrGamm returns the module the pool is routed to. Pools without
+// a stored route, such as those created in genesis, are x/gamm pools.
+func (k Keeper) getPoolModuleOrGamm(ctx sdk.Context, poolId uint64) (types.SwapI, error) {
+	swapModule, err := k.GetPoolModule(ctx, poolId)
+	if errors.As(err, &types.FailedToFindRouteError{}) {
+		return k.gammKeeper, nil
+	}
+	return swapModule, err
+}
+
+func (k Keeper) isOsmoRoutedMultihop(ctx sdk.Context, route types.MultihopRoute, inDenom, outDenom string) (isRouted bool) {
+	if route.Length() != 2 {
+		return false
+	}
+	intemediateDenoms := route.IntermediateDenoms()
+	if len(intemediateDenoms) != 1 || intemediateDenoms[0] != appparams.BaseCoinUnit {
+		return false
+	}
+	if inDenom == outDenom {
+		return false
+	}
+	poolIds := route.PoolIds()
+	if poolIds[0] == poolIds[1] {
+		return false
+	}
+
+	route0Incentivized := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolIds[0])
+	route1Incentivized := k.poolIncentivesKeeper.IsPoolIncentivized(ctx, poolIds[1])
+
+	return route0Incentivized && route1Incentivized
+}
+
+func (k Keeper) getOsmoRoutedMultihopTotalSwapFee(ctx sdk.Context, route types.MultihopRoute) (
+	totalPathSwapFee sdk.Dec, sumOfSwapFees sdk.Dec, err error) {
+	additiveSwapFee := sdk.ZeroDec()
+	maxSwapFee := sdk.ZeroDec()
+
+	for _, poolId := range route.PoolIds() {
+		_, pool, poolErr := k.getPoolForSwap(ctx, poolId)
+		if poolErr != nil {
+			return sdk.Dec{}, sdk.Dec{}, poolErr
+		}
+		swapFee := pool.GetSwapFee(ctx)
+		additiveSwapFee = additiveSwapFee.Add(swapFee)
+		maxSwapFee = sdk.MaxDec(maxSwapFee, swapFee)
+	}
+	averageSwapFee := additiveSwapFee.QuoInt64(2)
+	maxSwapFee = sdk.MaxDec(maxSwapFee, averageSwapFee)
+	return maxSwapFee, additiveSwapFee, nil
+}
+
+// createMultihopExpectedSwapOuts defines the output denom and output amount for the last pool in
+// the route of pools the caller is intending to hop through in a fixed-output multihop tx. It estimates the input
+// amount for this last pool and then chains that input as the output of the previous pool in the route, repeating
+// until the first pool is reached. It returns an array of inputs, each of which correspond to a pool ID in the
+// route of pools for the original multihop transaction.
+func (k Keeper) createMultihopExpectedSwapOuts(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, pool.GetSwapFee(ctx))
+		if err != nil {
+			return nil, err
+		}
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
+}
+
+// createOsmoMultihopExpectedSwapOuts does the same as createMultihopExpectedSwapOuts, however discounts the swap fee
+func (k Keeper) createOsmoMultihopExpectedSwapOuts(
+	ctx sdk.Context,
+	routes []types.SwapAmountOutRoute,
+	tokenOut sdk.Coin,
+	cumulativeRouteSwapFee, sumOfSwapFees sdk.Dec,
+) ([]sdk.Int, error) {
+	insExpected := make([]sdk.Int, len(routes))
+	for i := len(routes) - 1; i >= 0; i-- {
+		route := routes[i]
+
+		swapModule, pool, err := k.getPoolForSwap(ctx, route.PoolId)
+		if err != nil {
+			return nil, err
+		}
+
+		swapFee := pool.GetSwapFee(ctx)
+		tokenIn, err := swapModule.CalcInAmtGivenOut(ctx, pool, tokenOut, route.TokenInDenom, cumulativeRouteSwapFee.Mul((swapFee.Quo(sumOfSwapFees))))
+		if err != nil {
+			return nil, err
+		}
+
+		insExpected[i] = tokenIn.Amount
+		tokenOut = tokenIn
+	}
+
+	return insExpected, nil
 }
@@ -25,6 +25,346 @@ var (
 	gammKeeperType        = reflect.TypeOf(&gamm.Keeper{})
 )
 
+// TestGetPoolModule tests that the correct pool module is returned for a given pool id.
+// Additionally, validates that the expected errors are produced when the route is
+// missing or the pool type has no swap module defined.
+func (suite *KeeperTestSuite) TestGetPoolModule() {
+	tests := map[string]struct {
+		poolId            uint64
+		preCreatePoolType types.PoolType
+		routesOverwrite   map[types.PoolType]types.SwapI
+
+		expectedModule reflect.Type
+		expectError    error
+	}{
+		"valid balancer pool": {
+			preCreatePoolType: types.Balancer,
+			poolId:            1,
+			expectedModule:    gammKeeperType,
+		},
+		"non-existent pool": {
+			preCreatePoolType: types.Balancer,
+			poolId:            2,
+			expectError:       types.FailedToFindRouteError{PoolId: 2},
+		},
+		"undefined route": {
+			preCreatePoolType: types.Balancer,
+			poolId:            1,
+			routesOverwrite: map[types.PoolType]types.SwapI{
+				types.StableSwap: &gamm.Keeper{}, // undefined for balancer.
+			},
+			expectError: types.UndefinedRouteError{PoolId: 1, PoolType: types.Balancer},
+		},
+		"route to nil swap module": {
+			preCreatePoolType: types.Balancer,
+			poolId:            1,
+			routesOverwrite: map[types.PoolType]types.SwapI{
+				types.Balancer: nil,
+			},
+			expectError: types.UndefinedRouteError{PoolId: 1, PoolType: types.Balancer},
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			suite.createPoolFromType(tc.preCreatePoolType)
+
+			if len(tc.routesOverwrite) > 0 {
+				swaprouterKeeper.SetPoolRoutesUnsafe(tc.routesOverwrite)
+			}
+
+			swapModule, err := swaprouterKeeper.GetPoolModule(suite.Ctx, tc.poolId)
+
+			if tc.expectError != nil {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expectError)
+				suite.Require().Nil(swapModule)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().NotNil(swapModule)
+			suite.Require().Equal(tc.expectedModule, reflect.TypeOf(swapModule))
+		})
+	}
+}
+
+// TestRouteExactAmountIn tests that swaps are routed through the pool module
+// of every pool in the route, and that inactive or unrouted pools error.
+func (suite *KeeperTestSuite) TestRouteExactAmountIn() {
+	tests := map[string]struct {
+		routes            []types.SwapAmountInRoute
+		tokenOutMinAmount sdk.Int
+
+		expectError bool
+	}{
+		"single hop": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two hops": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}, {PoolId: 2, TokenOutDenom: baz}},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"token out min amount not met": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}},
+			tokenOutMinAmount: defaultSwapAmount,
+			expectError:       true,
+		},
+		"empty routes": {
+			routes:            []types.SwapAmountInRoute{},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectError:       true,
+		},
+		"pool without route": {
+			routes:            []types.SwapAmountInRoute{{PoolId: 3, TokenOutDenom: bar}},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectError:       true,
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+
+			suite.createBalancerPoolsFromCoins([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(bar, defaultInitPoolAmount), sdk.NewCoin(baz, defaultInitPoolAmount)),
+			})
+
+			tokenIn := sdk.NewCoin(foo, defaultSwapAmount)
+			suite.FundAcc(suite.TestAccs[1], sdk.NewCoins(tokenIn))
+
+			expectedTokenOut, estimateErr := swaprouterKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, tc.routes, tokenIn)
+
+			tokenOutAmount, err := swaprouterKeeper.RouteExactAmountIn(suite.Ctx, suite.TestAccs[1], tc.routes, tokenIn, tc.tokenOutMinAmount)
+			if tc.expectError {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(estimateErr)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOut.String(), tokenOutAmount.String())
+
+			lastDenom := tc.routes[len(tc.routes)-1].TokenOutDenom
+			suite.Require().Equal(tokenOutAmount.String(), suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], lastDenom).Amount.String())
+			suite.Require().True(suite.App.BankKeeper.GetBalance(suite.Ctx, suite.TestAccs[1], foo).IsZero())
+		})
+	}
+}
+
+// TestSplitRouteExactAmountIn tests that a split-route swap swaps the amount in of each route
+// along it and applies the minimum amount out to the total, swapping nothing when any route fails.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountIn() {
+	var (
+		directRoute = []types.SwapAmountInRoute{{PoolId: 1, TokenOutDenom: bar}}
+		osmoRoute   = []types.SwapAmountInRoute{{PoolId: 2, TokenOutDenom: uosmo}, {PoolId: 3, TokenOutDenom: bar}}
+	)
+
+	tests := map[string]struct {
+		routes            []types.SwapAmountInSplitRoute
+		tokenOutMinAmount sdk.Int
+
+		expectedErr string
+	}{
+		"single route": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: defaultSwapAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+		},
+		"two routes": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: defaultSwapAmount},
+				{Pools: osmoRoute, TokenInAmount: defaultSwapAmount.MulRaw(2)},
+			},
+			tokenOutMinAmount: defaultSwapAmount.MulRaw(2),
+		},
+		"token out min amount not met by the total": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: defaultSwapAmount},
+				{Pools: osmoRoute, TokenInAmount: defaultSwapAmount},
+			},
+			tokenOutMinAmount: defaultSwapAmount.MulRaw(2),
+			expectedErr:       "is less than the minimum amount",
+		},
+		"second route fails": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: defaultSwapAmount},
+				{Pools: []types.SwapAmountInRoute{{PoolId: 4, TokenOutDenom: bar}}, TokenInAmount: defaultSwapAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.FailedToFindRouteError{PoolId: 4}.Error(),
+		},
+		"routes with different token out denoms": {
+			routes: []types.SwapAmountInSplitRoute{
+				{Pools: directRoute, TokenInAmount: defaultSwapAmount},
+				{Pools: osmoRoute[:1], TokenInAmount: defaultSwapAmount},
+			},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrSplitRoutesDenomMismatch.Error(),
+		},
+		"empty routes": {
+			routes:            []types.SwapAmountInSplitRoute{},
+			tokenOutMinAmount: sdk.OneInt(),
+			expectedErr:       types.ErrEmptyRoutes.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+			sender := suite.TestAccs[1]
+
+			suite.createBalancerPoolsFromCoins([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+			})
+
+			initialFunds := sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount.MulRaw(10)))
+			suite.FundAcc(sender, initialFunds)
+
+			// The routes share no pool, so each can be estimated on its own.
+			expectedTokenOutAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				routeTokenOutAmount, err := swaprouterKeeper.MultihopEstimateOutGivenExactAmountIn(suite.Ctx, route.Pools, sdk.NewCoin(foo, route.TokenInAmount))
+				if err == nil {
+					expectedTokenOutAmount = expectedTokenOutAmount.Add(routeTokenOutAmount)
+				}
+			}
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			tokenOutAmount, err := swaprouterKeeper.SplitRouteExactAmountIn(ctx, sender, tc.routes, foo, tc.tokenOutMinAmount)
+
+			if tc.expectedErr != "" {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+				// Nothing is swapped.
+				suite.Require().Equal(initialFunds, suite.App.BankKeeper.GetAllBalances(ctx, sender))
+				suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenOutAmount, tokenOutAmount)
+			suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, len(tc.routes))
+
+			totalTokenInAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenInAmount = totalTokenInAmount.Add(route.TokenInAmount)
+			}
+			suite.Require().Equal(initialFunds.AmountOf(foo).Sub(totalTokenInAmount), suite.App.BankKeeper.GetBalance(ctx, sender, foo).Amount)
+			suite.Require().Equal(tokenOutAmount, suite.App.BankKeeper.GetBalance(ctx, sender, bar).Amount)
+		})
+	}
+}
+
+// TestSplitRouteExactAmountOut tests that a split-route swap swaps for the amount out of each route
+// along it and applies the maximum amount in to the total, swapping nothing when any route fails.
+func (suite *KeeperTestSuite) TestSplitRouteExactAmountOut() {
+	var (
+		directRoute = []types.SwapAmountOutRoute{{PoolId: 1, TokenInDenom: foo}}
+		osmoRoute   = []types.SwapAmountOutRoute{{PoolId: 2, TokenInDenom: foo}, {PoolId: 3, TokenInDenom: uosmo}}
+	)
+
+	tests := map[string]struct {
+		routes           []types.SwapAmountOutSplitRoute
+		tokenInMaxAmount sdk.Int
+
+		expectedErr string
+	}{
+		"single route": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: defaultSwapAmount},
+			},
+			tokenInMaxAmount: defaultSwapAmount.MulRaw(2),
+		},
+		"two routes": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: defaultSwapAmount},
+				{Pools: osmoRoute, TokenOutAmount: defaultSwapAmount.MulRaw(2)},
+			},
+			tokenInMaxAmount: defaultSwapAmount.MulRaw(4),
+		},
+		"token in max amount exceeded by the total": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: defaultSwapAmount},
+				{Pools: osmoRoute, TokenOutAmount: defaultSwapAmount},
+			},
+			tokenInMaxAmount: defaultSwapAmount.MulRaw(2),
+			expectedErr:      "is greater than the maximum amount",
+		},
+		"second route fails": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: defaultSwapAmount},
+				{Pools: []types.SwapAmountOutRoute{{PoolId: 4, TokenInDenom: foo}}, TokenOutAmount: defaultSwapAmount},
+			},
+			tokenInMaxAmount: defaultSwapAmount.MulRaw(4),
+			expectedErr:      types.FailedToFindRouteError{PoolId: 4}.Error(),
+		},
+		"routes with different token in denoms": {
+			routes: []types.SwapAmountOutSplitRoute{
+				{Pools: directRoute, TokenOutAmount: defaultSwapAmount},
+				{Pools: osmoRoute[1:], TokenOutAmount: defaultSwapAmount},
+			},
+			tokenInMaxAmount: defaultSwapAmount.MulRaw(4),
+			expectedErr:      types.ErrSplitRoutesDenomMismatch.Error(),
+		},
+	}
+
+	for name, tc := range tests {
+		suite.Run(name, func() {
+			suite.SetupTest()
+			swaprouterKeeper := suite.App.SwapRouterKeeper
+			sender := suite.TestAccs[1]
+
+			suite.createBalancerPoolsFromCoins([]sdk.Coins{
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(foo, defaultInitPoolAmount), sdk.NewCoin(uosmo, defaultInitPoolAmount)),
+				sdk.NewCoins(sdk.NewCoin(uosmo, defaultInitPoolAmount), sdk.NewCoin(bar, defaultInitPoolAmount)),
+			})
+
+			initialFunds := sdk.NewCoins(sdk.NewCoin(foo, defaultSwapAmount.MulRaw(10)))
+			suite.FundAcc(sender, initialFunds)
+
+			// The routes share no pool, so each can be estimated on its own.
+			expectedTokenInAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				routeTokenInAmount, err := swaprouterKeeper.MultihopEstimateInGivenExactAmountOut(suite.Ctx, route.Pools, sdk.NewCoin(bar, route.TokenOutAmount))
+				if err == nil {
+					expectedTokenInAmount = expectedTokenInAmount.Add(routeTokenInAmount)
+				}
+			}
+
+			ctx := suite.Ctx.WithEventManager(sdk.NewEventManager())
+			tokenInAmount, err := swaprouterKeeper.SplitRouteExactAmountOut(ctx, sender, tc.routes, bar, tc.tokenInMaxAmount)
+
+			if tc.expectedErr != "" {
+				suite.Require().ErrorContains(err, tc.expectedErr)
+				// Nothing is swapped.
+				suite.Require().Equal(initialFunds, suite.App.BankKeeper.GetAllBalances(ctx, sender))
+				suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, 0)
+				return
+			}
+			suite.Require().NoError(err)
+			suite.Require().Equal(expectedTokenInAmount, tokenInAmount)
+			suite.AssertEventEmitted(ctx, types.TypeEvtSplitRouteSwapped, len(tc.routes))
+
+			totalTokenOutAmount := sdk.ZeroInt()
+			for _, route := range tc.routes {
+				totalTokenOutAmount = totalTokenOutAmount.Add(route.TokenOutAmount)
+			}
+			suite.Require().Equal(initialFunds.AmountOf(foo).Sub(tokenInAmount), suite.App.BankKeeper.GetBalance(ctx, sender, foo).Amount)
+			suite.Require().Equal(totalTokenOutAmount, suite.App.BankKeeper.GetBalance(ctx, sender, bar).Amount)
+		})
+	}
+}
+
 // TestEstimateMultihopSwapExactAmountIn tests that the estimation done via `EstimateSwapExactAmountIn`
 // results in the same amount of token out as the actual swap.
 func (suite *KeeperTestSuite) TestEstimateMultihopSwapExactAmountIn() {
@@ -4,3 +4,18 @@ const (
 	MinPoolAssets = 2
 	MaxPoolAssets = 8
 )
+
+const (
+	// DefaultBestRouteMaxHops is the number of hops searched by the best route
+	// query when the request does not specify one.
+	DefaultBestRouteMaxHops = 3
+	// MaxBestRouteMaxHops bounds the number of hops searched by the best route
+	// query, as the number of candidate routes grows exponentially with it.
+	MaxBestRouteMaxHops = 4
+	// MaxBestRoutePoolsPerDenom bounds the number of pools holding a denom that the
+	// best route query swaps that denom through, keeping those with the most of it.
+	MaxBestRoutePoolsPerDenom = 10
+	// MaxBestRouteEstimates bounds the number of candidate routes whose amount out the
+	// best route query estimates, after which the best route found so far is returned.
+	MaxBestRouteEstimates = 1000
+)
@@ -3,6 +3,8 @@ package types
 import (
 	"errors"
 	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
 var (
@@ -10,6 +12,8 @@ var (
 	ErrInvalidPool       = errors.New("attempting to create an invalid pool")
 	ErrTooFewPoolAssets  = errors.New("pool should have at least 2 assets, as they must be swapping between at least two assets")
 	ErrTooManyPoolAssets = errors.New("pool has too many assets (currently capped at 8 assets per pool)")
+
+	ErrSplitRoutesDenomMismatch = errors.New("split routes must all swap from the same token in denom to the same token out denom")
 )
 
 type nonPositiveAmountError struct {
@@ -20,6 +24,32 @@ func (e nonPositiveAmountError) Error() string {
 	return fmt.Sprintf("min out amount or max in amount should be positive, was (%s)", e.Amount)
 }
 
+type nonPositiveSplitRouteAmountError struct {
+	Amount string
+}
+
+func (e nonPositiveSplitRouteAmountError) Error() string {
+	return fmt.Sprintf("amount swapped through each split route should be positive, was (%s)", e.Amount)
+}
+
+type TokenOutBelowMinError struct {
+	TokenOutAmount    sdk.Int
+	TokenOutMinAmount sdk.Int
+}
+
+func (e TokenOutBelowMinError) Error() string {
+	return fmt.Sprintf("token out amount (%s) is less than the minimum amount (%s)", e.TokenOutAmount, e.TokenOutMinAmount)
+}
+
+type TokenInAboveMaxError struct {
+	TokenInAmount    sdk.Int
+	TokenInMaxAmount sdk.Int
+}
+
+func (e TokenInAboveMaxError) Error() string {
+	return fmt.Sprintf("token in amount (%s) is greater than the maximum amount (%s)", e.TokenInAmount, e.TokenInMaxAmount)
+}
+
 type FailedToFindRouteError struct {
 	PoolId uint64
 }
@@ -36,3 +66,21 @@ type UndefinedRouteError struct {
 func (e UndefinedRouteError) Error() string {
 	return fmt.Sprintf("route is not defined for the given pool type (%s) and pool id (%d)", e.PoolType, e.PoolId)
 }
+
+type InactivePoolError struct {
+	PoolId uint64
+}
+
+func (e InactivePoolError) Error() string {
+	return fmt.Sprintf("pool with id (%d) is not active, swaps are disabled", e.PoolId)
+}
+
+type NoRouteFoundError struct {
+	TokenInDenom  string
+	TokenOutDenom string
+	MaxHops       int
+}
+
+func (e NoRouteFoundError) Error() string {
+	return fmt.Sprintf("no route found from (%s) to (%s) within (%d) hops", e.TokenInDenom, e.TokenOutDenom, e.MaxHops)
+}
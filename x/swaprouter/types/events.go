@@ -2,4 +2,8 @@ package types
 
 const (
 	AttributeValueCategory = ModuleName
+
+	TypeEvtSplitRouteSwapped = "split_route_swapped"
+
+	AttributeKeyPoolIds = "pool_ids"
 )
@@ -1,5 +1,10 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+)
+
 // DefaultGenesis returns the default swaprouter genesis state.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -14,5 +19,19 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	seenPoolIds := make(map[uint64]bool, len(gs.PoolRoutes))
+	for _, poolRoute := range gs.PoolRoutes {
+		if poolRoute.PoolId == 0 {
+			return errors.New("pool route has invalid pool id 0")
+		}
+		if seenPoolIds[poolRoute.PoolId] {
+			return fmt.Errorf("duplicate route for pool id %d", poolRoute.PoolId)
+		}
+		if _, ok := PoolType_name[int32(poolRoute.PoolType)]; !ok {
+			return fmt.Errorf("pool route for pool id %d has invalid pool type %d", poolRoute.PoolId, poolRoute.PoolType)
+		}
+		seenPoolIds[poolRoute.PoolId] = true
+	}
 	return nil
 }
@@ -79,6 +79,8 @@ type GenesisState struct {
 	NextPoolId uint64 `protobuf:"varint,1,opt,name=next_pool_id,json=nextPoolId,proto3" json:"next_pool_id,omitempty"`
 	// params is the container of swaprouter parameters.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// pool_routes is the container of the mappings from pool id to pool type.
+	PoolRoutes []ModuleRoute `protobuf:"bytes,3,rep,name=pool_routes,json=poolRoutes,proto3" json:"pool_routes" yaml:"pool_routes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -128,6 +130,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPoolRoutes() []ModuleRoute {
+	if m != nil {
+		return m.PoolRoutes
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "osmosis.swaprouter.v1beta1.Params")
 	proto.RegisterType((*GenesisState)(nil), "osmosis.swaprouter.v1beta1.GenesisState")
@@ -138,31 +147,34 @@ func init() {
 }
 
 var fileDescriptor_7ec914d8a231e19c = []byte{
-	// 377 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x3d, 0x6e, 0xe2, 0x40,
-	0x14, 0xf6, 0xec, 0x22, 0x0a, 0x83, 0xb4, 0x5a, 0x6b, 0x0b, 0x43, 0x61, 0x2c, 0x57, 0x6e, 0x98,
-	0x11, 0x20, 0x6d, 0xb1, 0xd5, 0x0a, 0xa4, 0x5d, 0x45, 0x4a, 0x81, 0x48, 0x97, 0xc6, 0x1a, 0xdb,
-	0x83, 0x63, 0xc5, 0xf6, 0xb3, 0x3c, 0x63, 0x02, 0xb7, 0x88, 0x94, 0x3e, 0x07, 0xc8, 0x49, 0x28,
-	0x29, 0x53, 0x91, 0x08, 0x6e, 0x90, 0x13, 0x44, 0x1e, 0x8f, 0x13, 0x94, 0x28, 0x95, 0xfd, 0xe6,
-	0xfb, 0x79, 0xef, 0x7b, 0x33, 0xba, 0x0b, 0x3c, 0x05, 0x1e, 0x73, 0xc2, 0x6f, 0x68, 0x5e, 0x40,
-	0x29, 0x58, 0x41, 0x56, 0x23, 0x9f, 0x09, 0x3a, 0x22, 0x11, 0xcb, 0x18, 0x8f, 0x39, 0xce, 0x0b,
-	0x10, 0x60, 0xf4, 0x15, 0x13, 0xbf, 0x33, 0xb1, 0x62, 0xf6, 0x7f, 0x45, 0x10, 0x81, 0xa4, 0x91,
-	0xea, 0xaf, 0x56, 0xf4, 0x7b, 0x11, 0x40, 0x94, 0x30, 0x22, 0x2b, 0xbf, 0x5c, 0x12, 0x9a, 0x6d,
-	0x1a, 0x28, 0x90, 0x6e, 0x5e, 0xad, 0xa9, 0x0b, 0x05, 0x59, 0x1f, 0x55, 0x61, 0x59, 0x50, 0x11,
-	0x43, 0xd6, 0xe0, 0x35, 0x9b, 0xf8, 0x94, 0xb3, 0xb7, 0x51, 0x03, 0x88, 0x15, 0xee, 0xdc, 0x23,
-	0xbd, 0x3d, 0xa7, 0x05, 0x4d, 0xb9, 0x71, 0x87, 0xf4, 0x9f, 0x39, 0x40, 0xe2, 0x05, 0x05, 0x93,
-	0x16, 0xde, 0x92, 0x31, 0x13, 0xd9, 0xdf, 0xdd, 0xce, 0xb8, 0x87, 0x55, 0xd7, 0xca, 0xa7, 0x09,
-	0x82, 0x67, 0x10, 0x67, 0xd3, 0xf3, 0xed, 0x7e, 0xa0, 0xbd, 0xec, 0x07, 0xe6, 0x86, 0xa6, 0xc9,
-	0x1f, 0xe7, 0x93, 0x83, 0xf3, 0xf0, 0x34, 0x70, 0xa3, 0x58, 0x5c, 0x95, 0x3e, 0x0e, 0x20, 0x55,
-	0xe3, 0xab, 0xcf, 0x90, 0x87, 0xd7, 0x44, 0x6c, 0x72, 0xc6, 0xa5, 0x19, 0x5f, 0xfc, 0xa8, 0xf4,
-	0x33, 0x25, 0xff, 0xc7, 0x98, 0x53, 0xe8, 0xdd, 0xff, 0xf5, 0x66, 0x2f, 0x04, 0x15, 0xcc, 0xb0,
-	0xf5, 0x6e, 0xc6, 0xd6, 0xc2, 0x93, 0x7d, 0xe2, 0xd0, 0x44, 0x36, 0x72, 0x5b, 0x0b, 0xbd, 0x3a,
-	0x9b, 0x03, 0x24, 0x67, 0xa1, 0xf1, 0x57, 0x6f, 0xe7, 0x32, 0x91, 0xf9, 0xcd, 0x46, 0x6e, 0x67,
-	0xec, 0xe0, 0xaf, 0xef, 0x02, 0xd7, 0xd9, 0xa7, 0xad, 0x2a, 0xc4, 0x42, 0xe9, 0xa6, 0xf3, 0xed,
-	0xc1, 0x42, 0xbb, 0x83, 0x85, 0x9e, 0x0f, 0x16, 0xba, 0x3d, 0x5a, 0xda, 0xee, 0x68, 0x69, 0x8f,
-	0x47, 0x4b, 0xbb, 0xfc, 0x7d, 0x12, 0x44, 0xb9, 0x0e, 0x13, 0xea, 0xf3, 0xa6, 0x20, 0xab, 0xd1,
-	0x84, 0xac, 0x4f, 0x9f, 0x87, 0x0c, 0xe7, 0xb7, 0xe5, 0xb6, 0x27, 0xaf, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0x7d, 0xee, 0x88, 0x63, 0x41, 0x02, 0x00, 0x00,
+	// 422 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x51, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0xf5, 0x72, 0xa7, 0x14, 0x9b, 0x93, 0x10, 0x16, 0x85, 0xcf, 0x85, 0x63, 0xb9, 0xc1, 0x4d,
+	0x76, 0x95, 0x3b, 0x89, 0x82, 0x0a, 0xf9, 0x24, 0x10, 0x12, 0x48, 0x91, 0xe9, 0x68, 0xac, 0xb5,
+	0xbd, 0x67, 0x2c, 0x6c, 0x8f, 0xe5, 0x5d, 0x1f, 0x97, 0xbf, 0x40, 0xa2, 0xe7, 0x03, 0xf8, 0x92,
+	0x94, 0x29, 0x53, 0x05, 0x94, 0xfc, 0x01, 0x5f, 0x80, 0xbc, 0xbb, 0x86, 0x08, 0x74, 0xa9, 0xec,
+	0x99, 0x79, 0xef, 0xcd, 0xbe, 0x37, 0x38, 0x04, 0x51, 0x83, 0x28, 0x05, 0x15, 0x9f, 0x59, 0xdb,
+	0x41, 0x2f, 0x79, 0x47, 0xef, 0x16, 0x29, 0x97, 0x6c, 0x41, 0x0b, 0xde, 0x70, 0x51, 0x0a, 0xd2,
+	0x76, 0x20, 0xc1, 0x76, 0x0d, 0x92, 0xfc, 0x45, 0x12, 0x83, 0x74, 0x9f, 0x16, 0x50, 0x80, 0x82,
+	0xd1, 0xe1, 0x4f, 0x33, 0xdc, 0xcb, 0x02, 0xa0, 0xa8, 0x38, 0x55, 0x55, 0xda, 0xdf, 0x52, 0xd6,
+	0xac, 0xc6, 0x51, 0xa6, 0xd4, 0x12, 0xcd, 0xd1, 0x85, 0x19, 0x79, 0xff, 0xb2, 0xf2, 0xbe, 0x63,
+	0xb2, 0x84, 0x66, 0x9c, 0x6b, 0x34, 0x4d, 0x99, 0xe0, 0x7f, 0x9e, 0x9a, 0x41, 0x39, 0xce, 0xe7,
+	0x27, 0x1c, 0xd5, 0x90, 0xf7, 0x15, 0x4f, 0x54, 0x57, 0xc3, 0x83, 0x6f, 0x08, 0x4f, 0x96, 0xac,
+	0x63, 0xb5, 0xb0, 0xbf, 0x22, 0xfc, 0xa4, 0x05, 0xa8, 0x92, 0xac, 0xe3, 0x6a, 0x63, 0x72, 0xcb,
+	0xb9, 0x83, 0xfc, 0xb3, 0x70, 0x7a, 0x75, 0x49, 0xcc, 0x23, 0x87, 0xb5, 0xa3, 0x6f, 0x72, 0x03,
+	0x65, 0x13, 0xbd, 0x5d, 0xef, 0x66, 0xd6, 0xaf, 0xdd, 0xcc, 0x59, 0xb1, 0xba, 0x7a, 0x11, 0xfc,
+	0xa7, 0x10, 0x7c, 0xff, 0x31, 0x0b, 0x8b, 0x52, 0x7e, 0xec, 0x53, 0x92, 0x41, 0x6d, 0xdc, 0x9a,
+	0xcf, 0x5c, 0xe4, 0x9f, 0xa8, 0x5c, 0xb5, 0x5c, 0x28, 0x31, 0x11, 0x3f, 0x1e, 0xf8, 0x37, 0x86,
+	0xfe, 0x8a, 0xf3, 0x60, 0x8b, 0xf0, 0xc5, 0x6b, 0x7d, 0x89, 0xf7, 0x92, 0x49, 0x6e, 0xfb, 0xf8,
+	0xa2, 0xe1, 0xf7, 0x32, 0x51, 0x8b, 0xca, 0xdc, 0x41, 0x3e, 0x0a, 0xcf, 0x63, 0x3c, 0xf4, 0x96,
+	0x00, 0xd5, 0x9b, 0xdc, 0x7e, 0x89, 0x27, 0xad, 0xb2, 0xe4, 0x3c, 0xf2, 0x51, 0x38, 0xbd, 0x0a,
+	0xc8, 0xc3, 0xb7, 0x23, 0xda, 0x7c, 0x74, 0x3e, 0xb8, 0x88, 0x0d, 0xcf, 0xce, 0xf1, 0x54, 0xc9,
+	0x2b, 0xac, 0x70, 0xce, 0x54, 0x06, 0xcf, 0x4e, 0xc9, 0xbc, 0x53, 0xd1, 0xc6, 0x43, 0x33, 0x72,
+	0x4d, 0x22, 0xf6, 0x51, 0x22, 0x5a, 0x29, 0x88, 0xf1, 0x50, 0x29, 0x98, 0x88, 0x96, 0xeb, 0xbd,
+	0x87, 0x36, 0x7b, 0x0f, 0xfd, 0xdc, 0x7b, 0xe8, 0xcb, 0xc1, 0xb3, 0x36, 0x07, 0xcf, 0xda, 0x1e,
+	0x3c, 0xeb, 0xc3, 0xf3, 0xa3, 0xbc, 0xcc, 0xd2, 0x79, 0xc5, 0x52, 0x31, 0x16, 0xf4, 0x6e, 0x71,
+	0x4d, 0xef, 0x8f, 0x4f, 0xac, 0x32, 0x4c, 0x27, 0xea, 0xa8, 0xd7, 0xbf, 0x07, 0x00, 0x13, 0xd5,
+	0x91, 0xf6, 0xd7, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -222,6 +234,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PoolRoutes) > 0 {
+		for iNdEx := len(m.PoolRoutes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PoolRoutes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -277,6 +303,12 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PoolRoutes) > 0 {
+		for _, e := range m.PoolRoutes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -451,6 +483,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolRoutes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PoolRoutes = append(m.PoolRoutes, ModuleRoute{})
+			if err := m.PoolRoutes[len(m.PoolRoutes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
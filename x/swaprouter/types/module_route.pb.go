@@ -35,18 +35,23 @@ const (
 	// Concentrated is the pool model specific to concentrated liquidity. It is
 	// defined in x/concentrated-liquidity.
 	Concentrated PoolType = 2
+	// CosmWasm is the pool model whose math is implemented by a CosmWasm
+	// contract. It is defined in x/cosmwasmpool.
+	CosmWasm PoolType = 3
 )
 
 var PoolType_name = map[int32]string{
 	0: "Balancer",
 	1: "StableSwap",
 	2: "Concentrated",
+	3: "CosmWasm",
 }
 
 var PoolType_value = map[string]int32{
 	"Balancer":     0,
 	"StableSwap":   1,
 	"Concentrated": 2,
+	"CosmWasm":     3,
 }
 
 func (x PoolType) String() string {
@@ -64,6 +69,9 @@ func (PoolType) EnumDescriptor() ([]byte, []int) {
 type ModuleRoute struct {
 	// pool_type specifies the type of the pool
 	PoolType PoolType `protobuf:"varint,1,opt,name=pool_type,json=poolType,proto3,enum=osmosis.swaprouter.v1beta1.PoolType" json:"pool_type,omitempty"`
+	// pool_id is the id of the pool the route belongs to. It is only set when
+	// routes are exported to genesis, as the store keys routes by pool id.
+	PoolId uint64 `protobuf:"varint,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty" yaml:"pool_id"`
 }
 
 func (m *ModuleRoute) Reset()         { *m = ModuleRoute{} }
@@ -106,6 +114,13 @@ func (m *ModuleRoute) GetPoolType() PoolType {
 	return Balancer
 }
 
+func (m *ModuleRoute) GetPoolId() uint64 {
+	if m != nil {
+		return m.PoolId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("osmosis.swaprouter.v1beta1.PoolType", PoolType_name, PoolType_value)
 	proto.RegisterType((*ModuleRoute)(nil), "osmosis.swaprouter.v1beta1.ModuleRoute")
@@ -116,24 +131,27 @@ func init() {
 }
 
 var fileDescriptor_c26575d86edff56b = []byte{
-	// 265 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0xcd, 0x2f, 0xce, 0xcd,
-	0x2f, 0xce, 0x2c, 0xd6, 0x2f, 0x2e, 0x4f, 0x2c, 0x28, 0xca, 0x2f, 0x2d, 0x49, 0x2d, 0xd2, 0x2f,
-	0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x8d, 0x07, 0x8b,
-	0xea, 0x15, 0x14, 0xe5, 0x97, 0xe4, 0x0b, 0x49, 0x41, 0x95, 0xeb, 0x21, 0x94, 0xeb, 0x41, 0x95,
-	0x4b, 0x89, 0xa4, 0xe7, 0xa7, 0xe7, 0x83, 0x95, 0xe9, 0x83, 0x58, 0x10, 0x1d, 0x4a, 0x01, 0x5c,
-	0xdc, 0xbe, 0x60, 0x73, 0x82, 0x40, 0xaa, 0x85, 0x1c, 0xb9, 0x38, 0x0b, 0xf2, 0xf3, 0x73, 0xe2,
-	0x4b, 0x2a, 0x0b, 0x52, 0x25, 0x18, 0x15, 0x18, 0x35, 0xf8, 0x8c, 0x54, 0xf4, 0x70, 0x1b, 0xaa,
-	0x17, 0x90, 0x9f, 0x9f, 0x13, 0x52, 0x59, 0x90, 0x1a, 0xc4, 0x51, 0x00, 0x65, 0x69, 0x39, 0x70,
-	0x71, 0xc0, 0x44, 0x85, 0x78, 0xb8, 0x38, 0x9c, 0x12, 0x73, 0x12, 0xf3, 0x92, 0x53, 0x8b, 0x04,
-	0x18, 0x84, 0xf8, 0xb8, 0xb8, 0x82, 0x4b, 0x12, 0x93, 0x72, 0x52, 0x83, 0xcb, 0x13, 0x0b, 0x04,
-	0x18, 0x85, 0x04, 0xb8, 0x78, 0x9c, 0xf3, 0xf3, 0x92, 0x53, 0xf3, 0x4a, 0x8a, 0x12, 0x4b, 0x52,
-	0x53, 0x04, 0x98, 0xa4, 0x58, 0x3a, 0x16, 0xcb, 0x31, 0x38, 0x05, 0x9c, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x59, 0x7a, 0x66, 0x49, 0x46, 0x69, 0x92, 0x5e, 0x72, 0x7e,
-	0xae, 0x3e, 0xd4, 0x55, 0xba, 0x39, 0x89, 0x49, 0xc5, 0x30, 0x8e, 0x7e, 0x99, 0xa1, 0xb1, 0x7e,
-	0x05, 0x72, 0x60, 0x81, 0x3c, 0x52, 0x9c, 0xc4, 0x06, 0xf6, 0xac, 0x31, 0x20, 0x00, 0x00, 0xff,
-	0xff, 0x6e, 0x93, 0xbb, 0x4a, 0x4f, 0x01, 0x00, 0x00,
+	// 312 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0x31, 0x4b, 0x33, 0x31,
+	0x18, 0xc7, 0x2f, 0x7d, 0x4b, 0xdf, 0x1a, 0x4b, 0x39, 0x82, 0x43, 0xe9, 0x10, 0x4b, 0x71, 0x28,
+	0x4a, 0x13, 0x6a, 0xc1, 0xc1, 0xcd, 0x76, 0x72, 0x50, 0x4a, 0x2b, 0x08, 0x2e, 0x25, 0xd7, 0x0b,
+	0xb5, 0x90, 0xf4, 0x09, 0x97, 0xb4, 0xf5, 0x06, 0x77, 0x47, 0xbf, 0x83, 0x5f, 0xc6, 0xb1, 0xa3,
+	0x93, 0xc8, 0xdd, 0x37, 0xf0, 0x13, 0xc8, 0x9d, 0x57, 0x74, 0x71, 0xfb, 0x27, 0xcf, 0xef, 0xf9,
+	0xc1, 0xf3, 0xc7, 0x5d, 0xb0, 0x1a, 0xec, 0xc2, 0x72, 0xbb, 0x11, 0x26, 0x82, 0x95, 0x93, 0x11,
+	0x5f, 0xf7, 0x02, 0xe9, 0x44, 0x8f, 0x6b, 0x08, 0x57, 0x4a, 0x4e, 0xf3, 0x5f, 0x66, 0x22, 0x70,
+	0x40, 0x9a, 0x05, 0xce, 0x7e, 0x70, 0x56, 0xe0, 0xcd, 0x83, 0x39, 0xcc, 0x21, 0xc7, 0x78, 0x96,
+	0xbe, 0x37, 0xda, 0x8f, 0x78, 0xff, 0x2a, 0xf7, 0x8c, 0x33, 0x9a, 0x5c, 0xe0, 0x3d, 0x03, 0xa0,
+	0xa6, 0x2e, 0x36, 0xb2, 0x81, 0x5a, 0xa8, 0x53, 0x3f, 0x3d, 0x62, 0x7f, 0x4b, 0xd9, 0x08, 0x40,
+	0xdd, 0xc4, 0x46, 0x8e, 0xab, 0xa6, 0x48, 0xe4, 0x04, 0xff, 0xcf, 0x15, 0x8b, 0xb0, 0x51, 0x6a,
+	0xa1, 0x4e, 0x79, 0x40, 0x3e, 0xdf, 0x0f, 0xeb, 0xb1, 0xd0, 0xea, 0xbc, 0x5d, 0x0c, 0xda, 0xe3,
+	0x4a, 0x96, 0x2e, 0xc3, 0xe3, 0x6b, 0x5c, 0xdd, 0x29, 0x48, 0x0d, 0x57, 0x07, 0x42, 0x89, 0xe5,
+	0x4c, 0x46, 0xbe, 0x47, 0xea, 0x18, 0x4f, 0x9c, 0x08, 0x94, 0x9c, 0x6c, 0x84, 0xf1, 0x11, 0xf1,
+	0x71, 0x6d, 0x08, 0xcb, 0x99, 0x5c, 0xba, 0x48, 0x38, 0x19, 0xfa, 0xa5, 0x8c, 0x1f, 0x82, 0xd5,
+	0xb7, 0xc2, 0x6a, 0xff, 0x5f, 0xb3, 0xfc, 0xf4, 0x42, 0xbd, 0xc1, 0xe8, 0x35, 0xa1, 0x68, 0x9b,
+	0x50, 0xf4, 0x91, 0x50, 0xf4, 0x9c, 0x52, 0x6f, 0x9b, 0x52, 0xef, 0x2d, 0xa5, 0xde, 0xdd, 0xd9,
+	0x7c, 0xe1, 0xee, 0x57, 0x01, 0x9b, 0x81, 0xe6, 0xc5, 0x41, 0x5d, 0x25, 0x02, 0xbb, 0x7b, 0xf0,
+	0x75, 0xaf, 0xcf, 0x1f, 0x7e, 0xf7, 0x9c, 0x75, 0x60, 0x83, 0x4a, 0xde, 0x53, 0xff, 0x6b, 0x00,
+	0x95, 0xd3, 0x05, 0x9e, 0x8a, 0x01, 0x00, 0x00,
 }
 
 func (m *ModuleRoute) Marshal() (dAtA []byte, err error) {
@@ -156,6 +174,11 @@ func (m *ModuleRoute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PoolId != 0 {
+		i = encodeVarintModuleRoute(dAtA, i, uint64(m.PoolId))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.PoolType != 0 {
 		i = encodeVarintModuleRoute(dAtA, i, uint64(m.PoolType))
 		i--
@@ -184,6 +207,9 @@ func (m *ModuleRoute) Size() (n int) {
 	if m.PoolType != 0 {
 		n += 1 + sovModuleRoute(uint64(m.PoolType))
 	}
+	if m.PoolId != 0 {
+		n += 1 + sovModuleRoute(uint64(m.PoolId))
+	}
 	return n
 }
 
@@ -241,6 +267,25 @@ func (m *ModuleRoute) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PoolId", wireType)
+			}
+			m.PoolId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowModuleRoute
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PoolId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipModuleRoute(dAtA[iNdEx:])
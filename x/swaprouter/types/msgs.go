@@ -7,8 +7,10 @@ import (
 
 // constants.
 const (
-	TypeMsgSwapExactAmountIn  = "swap_exact_amount_in"
-	TypeMsgSwapExactAmountOut = "swap_exact_amount_out"
+	TypeMsgSwapExactAmountIn            = "swap_exact_amount_in"
+	TypeMsgSwapExactAmountOut           = "swap_exact_amount_out"
+	TypeMsgSplitRouteSwapExactAmountIn  = "split_route_swap_exact_amount_in"
+	TypeMsgSplitRouteSwapExactAmountOut = "split_route_swap_exact_amount_out"
 )
 
 var _ sdk.Msg = &MsgSwapExactAmountIn{}
@@ -38,10 +40,8 @@ func (msg MsgSwapExactAmountIn) ValidateBasic() error {
 	return nil
 }
 
-// TODO: uncomment when types are registered.
 func (msg MsgSwapExactAmountIn) GetSignBytes() []byte {
-	// return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
-	panic("not implemented")
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
 func (msg MsgSwapExactAmountIn) GetSigners() []sdk.AccAddress {
@@ -78,10 +78,8 @@ func (msg MsgSwapExactAmountOut) ValidateBasic() error {
 	return nil
 }
 
-// TODO: uncomment when types are registered.
 func (msg MsgSwapExactAmountOut) GetSignBytes() []byte {
-	// return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
-	panic("not implemented")
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
 }
 
 func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
@@ -91,3 +89,77 @@ func (msg MsgSwapExactAmountOut) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountIn{}
+
+func (msg MsgSplitRouteSwapExactAmountIn) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountIn) Type() string  { return TypeMsgSplitRouteSwapExactAmountIn }
+func (msg MsgSplitRouteSwapExactAmountIn) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenInDenom); err != nil {
+		return err
+	}
+
+	if err := SwapAmountInSplitRoutes(msg.Routes).Validate(); err != nil {
+		return err
+	}
+
+	if msg.TokenOutMinAmount.IsNil() || !msg.TokenOutMinAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenOutMinAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountIn) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+var _ sdk.Msg = &MsgSplitRouteSwapExactAmountOut{}
+
+func (msg MsgSplitRouteSwapExactAmountOut) Route() string { return RouterKey }
+func (msg MsgSplitRouteSwapExactAmountOut) Type() string  { return TypeMsgSplitRouteSwapExactAmountOut }
+func (msg MsgSplitRouteSwapExactAmountOut) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	if err := sdk.ValidateDenom(msg.TokenOutDenom); err != nil {
+		return err
+	}
+
+	if err := SwapAmountOutSplitRoutes(msg.Routes).Validate(); err != nil {
+		return err
+	}
+
+	if msg.TokenInMaxAmount.IsNil() || !msg.TokenInMaxAmount.IsPositive() {
+		return nonPositiveAmountError{msg.TokenInMaxAmount.String()}
+	}
+
+	return nil
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSplitRouteSwapExactAmountOut) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
@@ -273,12 +273,262 @@ func TestMsgSwapExactAmountOut(t *testing.T) {
 	}
 }
 
-// Test authz serialize and de-serializes for swaprouter msg.
-func TestAuthzMsg(t *testing.T) {
+func TestMsgSplitRouteSwapExactAmountIn(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		properMsg := types.MsgSplitRouteSwapExactAmountIn{
+			Sender: addr1,
+			Routes: []types.SwapAmountInSplitRoute{{
+				Pools: []types.SwapAmountInRoute{{
+					PoolId:        0,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(100),
+			}, {
+				Pools: []types.SwapAmountInRoute{{
+					PoolId:        1,
+					TokenOutDenom: "test3",
+				}, {
+					PoolId:        2,
+					TokenOutDenom: "test2",
+				}},
+				TokenInAmount: sdk.NewInt(200),
+			}},
+			TokenInDenom:      "test",
+			TokenOutMinAmount: sdk.NewInt(300),
+		}
 
-	// TODO: remove when types are registered.
-	t.SkipNow()
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_in")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountIn
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty pools",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = nil
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools[0].TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token in denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenInDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes with different token out denoms",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[1].Pools = msg.Routes[1].Pools[:1]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount in route",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "unset amount in route",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.Routes[0].TokenInAmount = sdk.Int{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountIn) types.MsgSplitRouteSwapExactAmountIn {
+				msg.TokenOutMinAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
 
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+func TestMsgSplitRouteSwapExactAmountOut(t *testing.T) {
+	appParams.SetAddressPrefixes()
+	pk1 := ed25519.GenPrivKey().PubKey()
+	addr1 := sdk.AccAddress(pk1.Address()).String()
+	invalidAddr := sdk.AccAddress("invalid")
+
+	createMsg := func(after func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		properMsg := types.MsgSplitRouteSwapExactAmountOut{
+			Sender: addr1,
+			Routes: []types.SwapAmountOutSplitRoute{{
+				Pools: []types.SwapAmountOutRoute{{
+					PoolId:       0,
+					TokenInDenom: "test",
+				}},
+				TokenOutAmount: sdk.NewInt(100),
+			}, {
+				Pools: []types.SwapAmountOutRoute{{
+					PoolId:       1,
+					TokenInDenom: "test",
+				}, {
+					PoolId:       2,
+					TokenInDenom: "test3",
+				}},
+				TokenOutAmount: sdk.NewInt(200),
+			}},
+			TokenOutDenom:    "test2",
+			TokenInMaxAmount: sdk.NewInt(300),
+		}
+
+		return after(properMsg)
+	}
+
+	msg := createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+		// Do nothing
+		return msg
+	})
+
+	require.Equal(t, msg.Route(), types.RouterKey)
+	require.Equal(t, msg.Type(), "split_route_swap_exact_amount_out")
+	signers := msg.GetSigners()
+	require.Equal(t, len(signers), 1)
+	require.Equal(t, signers[0].String(), addr1)
+
+	tests := []struct {
+		name       string
+		msg        types.MsgSplitRouteSwapExactAmountOut
+		expectPass bool
+	}{
+		{
+			name: "proper msg",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				// Do nothing
+				return msg
+			}),
+			expectPass: true,
+		},
+		{
+			name: "invalid sender",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Sender = invalidAddr.String()
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "empty routes",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes = []types.SwapAmountOutSplitRoute{}
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "invalid token out denom",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenOutDenom = "1"
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "routes with different token in denoms",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].Pools = msg.Routes[1].Pools[1:]
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "negative amount out route",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.Routes[1].TokenOutAmount = sdk.NewInt(-10)
+				return msg
+			}),
+			expectPass: false,
+		},
+		{
+			name: "zero amount criteria",
+			msg: createMsg(func(msg types.MsgSplitRouteSwapExactAmountOut) types.MsgSplitRouteSwapExactAmountOut {
+				msg.TokenInMaxAmount = sdk.NewInt(0)
+				return msg
+			}),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		if test.expectPass {
+			require.NoError(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		} else {
+			require.Error(t, test.msg.ValidateBasic(), "test: %v", test.name)
+		}
+	}
+}
+
+// Test authz serialize and de-serializes for swaprouter msg.
+func TestAuthzMsg(t *testing.T) {
 	pk1 := ed25519.GenPrivKey().PubKey()
 	addr1 := sdk.AccAddress(pk1.Address()).String()
 	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(1))
@@ -317,6 +567,36 @@ func TestAuthzMsg(t *testing.T) {
 				TokenInMaxAmount: sdk.NewInt(1),
 			},
 		},
+		{
+			name: "MsgSplitRouteSwapExactAmountIn",
+			gammMsg: &types.MsgSplitRouteSwapExactAmountIn{
+				Sender: addr1,
+				Routes: []types.SwapAmountInSplitRoute{{
+					Pools: []types.SwapAmountInRoute{{
+						PoolId:        1,
+						TokenOutDenom: "test",
+					}},
+					TokenInAmount: sdk.NewInt(1),
+				}},
+				TokenInDenom:      sdk.DefaultBondDenom,
+				TokenOutMinAmount: sdk.NewInt(1),
+			},
+		},
+		{
+			name: "MsgSplitRouteSwapExactAmountOut",
+			gammMsg: &types.MsgSplitRouteSwapExactAmountOut{
+				Sender: addr1,
+				Routes: []types.SwapAmountOutSplitRoute{{
+					Pools: []types.SwapAmountOutRoute{{
+						PoolId:       1,
+						TokenInDenom: "test",
+					}},
+					TokenOutAmount: sdk.NewInt(1),
+				}},
+				TokenOutDenom:    sdk.DefaultBondDenom,
+				TokenInMaxAmount: sdk.NewInt(1),
+			},
+		},
 	}
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
@@ -12,6 +12,9 @@ type PoolI interface {
 	GetAddress() sdk.AccAddress
 	String() string
 	GetId() uint64
+	// GetType returns the type of the pool, used to route swaps to the module
+	// implementing the pool's model.
+	GetType() PoolType
 	// GetSwapFee returns the pool's swap fee, based on the current state.
 	// Pools may choose to make their swap fees dependent upon state
 	// (prior TWAPs, network downtime, other pool states, etc.)
@@ -32,6 +32,10 @@ type SwapI interface {
 	InitializePool(ctx sdk.Context, pool PoolI, creatorAddress sdk.AccAddress) error
 
 	GetPool(ctx sdk.Context, poolId uint64) (PoolI, error)
+	GetPoolDenoms(ctx sdk.Context, poolId uint64) ([]string, error)
+	// CalculateSpotPrice returns the spot price of the quote asset in terms of
+	// the base asset, using the specified pool.
+	CalculateSpotPrice(ctx sdk.Context, poolId uint64, baseAssetDenom, quoteAssetDenom string) (sdk.Dec, error)
 
 	SwapExactAmountIn(
 		ctx sdk.Context,
@@ -161,3 +165,65 @@ func (routes SwapAmountOutRoutes) PoolIds() []uint64 {
 func (routes SwapAmountOutRoutes) Length() int {
 	return len(routes)
 }
+
+type SwapAmountInSplitRoutes []SwapAmountInSplitRoute
+
+// Validate checks that there is at least one route, that each route is valid and
+// swaps a positive amount in, and that all routes end with the same token out denom.
+func (routes SwapAmountInSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		if err := SwapAmountInRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		if route.TokenInAmount.IsNil() || !route.TokenInAmount.IsPositive() {
+			return nonPositiveSplitRouteAmountError{route.TokenInAmount.String()}
+		}
+
+		if route.TokenOutDenom() != routes[0].TokenOutDenom() {
+			return ErrSplitRoutesDenomMismatch
+		}
+	}
+
+	return nil
+}
+
+// TokenOutDenom returns the denom of the token out of the route's last pool.
+func (route SwapAmountInSplitRoute) TokenOutDenom() string {
+	return route.Pools[len(route.Pools)-1].TokenOutDenom
+}
+
+type SwapAmountOutSplitRoutes []SwapAmountOutSplitRoute
+
+// Validate checks that there is at least one route, that each route is valid and
+// swaps a positive amount out, and that all routes start with the same token in denom.
+func (routes SwapAmountOutSplitRoutes) Validate() error {
+	if len(routes) == 0 {
+		return ErrEmptyRoutes
+	}
+
+	for _, route := range routes {
+		if err := SwapAmountOutRoutes(route.Pools).Validate(); err != nil {
+			return err
+		}
+
+		if route.TokenOutAmount.IsNil() || !route.TokenOutAmount.IsPositive() {
+			return nonPositiveSplitRouteAmountError{route.TokenOutAmount.String()}
+		}
+
+		if route.TokenInDenom() != routes[0].TokenInDenom() {
+			return ErrSplitRoutesDenomMismatch
+		}
+	}
+
+	return nil
+}
+
+// TokenInDenom returns the denom of the token into the route's first pool.
+func (route SwapAmountOutSplitRoute) TokenInDenom() string {
+	return route.Pools[0].TokenInDenom
+}
@@ -14,7 +14,6 @@ const (
 	// arithmeticTwapType is the type of twap that is calculated by taking the arithmetic weighted average of the spot prices.
 	arithmeticTwapType twapType = true
 	// geometricTwapType is the type of twap that is calculated by taking the geometric weighted average of the spot prices.
-	// nolint: unused
 	geometricTwapType twapType = false
 )
 
@@ -69,6 +68,40 @@ func (k Keeper) GetArithmeticTwapToNow(
 	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, arithmeticStrategy)
 }
 
+// GetGeometricTwap returns a geometric time weighted average price.
+// The returned twap is the time weighted geometric mean price of:
+// * the base asset, in units of the quote asset (1 unit of base = x units of quote)
+// * from (startTime, endTime),
+// * as determined by prices from AMM pool `poolId`.
+//
+// The geometric mean is less sensitive to short-lived price spikes than the arithmetic mean,
+// which makes it harder to manipulate for volatile pairs.
+// startTime, endTime and the error conditions are the same as for GetArithmeticTwap.
+func (k Keeper) GetGeometricTwap(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+	endTime time.Time,
+) (sdk.Dec, error) {
+	geometricStrategy := &geometric{k}
+	return k.getTwap(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, endTime, geometricStrategy)
+}
+
+// GetGeometricTwapToNow returns geometric twap from start time until the current block time for quote and base
+// assets in a given pool.
+func (k Keeper) GetGeometricTwapToNow(
+	ctx sdk.Context,
+	poolId uint64,
+	baseAssetDenom string,
+	quoteAssetDenom string,
+	startTime time.Time,
+) (sdk.Dec, error) {
+	geometricStrategy := &geometric{k}
+	return k.getTwapToNow(ctx, poolId, baseAssetDenom, quoteAssetDenom, startTime, geometricStrategy)
+}
+
 // getTwap computes and returns twap from the start time until the end time. The type
 // of twap returned depends on the strategy given and can be either arithmetic or geometric.
 func (k Keeper) getTwap(
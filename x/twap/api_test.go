@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
 	"github.com/osmosis-labs/osmosis/v13/x/twap"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/types"
 )
@@ -77,6 +78,10 @@ var (
 		sdk.ZeroDec(),                // TODO: choose correct
 	)
 
+	// geometric twaps are computed with an approximate power function,
+	// so they are only compared up to this tolerance.
+	geometricTwapTolerance = sdk.MustNewDecFromStr("0.0000001")
+
 	spotPriceError = errors.New("twap: error in pool spot price occurred between start and end time, twap result may be faulty")
 )
 
@@ -837,3 +842,177 @@ func (s *TestSuite) TestGetArithmeticTwapToNow_ThreeAsset() {
 		})
 	}
 }
+
+// TestGetGeometricTwap tests if we get the expected twap value from `GetGeometricTwap`.
+// Records are set manually, the same way as in TestGetArithmeticTwap.
+func (s *TestSuite) TestGetGeometricTwap() {
+	tests := map[string]struct {
+		recordsToSet []types.TwapRecord
+		ctxTime      time.Time
+		input        getTwapInput
+		expTwap      sdk.Dec
+		expectError  error
+	}{
+		"(1 record) start and end point to same record": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+		},
+		"(1 record) start and end point to same record, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteAB),
+			expTwap:      sdk.NewDecWithPrec(1, 1),
+		},
+		"(2 record) start and end exact, different records": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(10*time.Second), baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+		},
+		"(2 record) start exact, end after second record": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteBA),
+			// 10 for 10s, 5 for 10s = sqrt(10 * 5)
+			expTwap: sdk.MustNewDecFromStr("7.071067811865475244"),
+		},
+		"(2 record) start exact, end after second record, sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, baseTime.Add(20*time.Second), baseQuoteAB),
+			// .1 for 10s, .2 for 10s = sqrt(.1 * .2)
+			expTwap: sdk.MustNewDecFromStr("0.141421356237309505"),
+		},
+		"(2 record) start and end interpolated": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime.Add(5*time.Second), baseTime.Add(20*time.Second), baseQuoteBA),
+			// 10 for 5s, 5 for 10s = (10 * 5^2)^(1/3)
+			expTwap: sdk.MustNewDecFromStr("6.299605249474365823"),
+		},
+
+		// error catching
+		"end time in future": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expectError:  types.EndTimeInFutureError{BlockTime: baseTime, EndTime: tPlusOne},
+		},
+		"start time after end time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime,
+			input:        makeSimpleTwapInput(tPlusOne, baseTime, baseQuoteBA),
+			expectError:  types.StartTimeAfterEndTimeError{StartTime: tPlusOne, EndTime: baseTime},
+		},
+		"start time too old": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      baseTime.Add(time.Second),
+			input:        makeSimpleTwapInput(baseTime.Add(-time.Hour), baseTime, baseQuoteBA),
+			expectError:  twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+		"spot price error exactly at start time": {
+			recordsToSet: []types.TwapRecord{withLastErrTime(baseRecord, baseTime)},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapInput(baseTime, tPlusOne, baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+			expectError:  spotPriceError,
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			twap, err := s.twapkeeper.GetGeometricTwap(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime, test.input.endTime)
+
+			if test.expectError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectError, err)
+				if !test.expTwap.IsNil() {
+					osmoassert.DecApproxEq(s.T(), test.expTwap, twap, geometricTwapTolerance)
+				}
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twap, geometricTwapTolerance)
+		})
+	}
+}
+
+func (s *TestSuite) TestGetGeometricTwapToNow() {
+	makeSimpleTwapToNowInput := func(startTime time.Time, isQuoteTokenA bool) getTwapInput {
+		return makeSimpleTwapInput(startTime, startTime, isQuoteTokenA)
+	}
+
+	tests := map[string]struct {
+		recordsToSet  []types.TwapRecord
+		ctxTime       time.Time
+		input         getTwapInput
+		expTwap       sdk.Dec
+		expectedError error
+	}{
+		"(1 record) start time = record time": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapToNowInput(baseTime, baseQuoteBA),
+			expTwap:      sdk.NewDec(10),
+		},
+		"(1 record) start time = record time, use sp1": {
+			recordsToSet: []types.TwapRecord{baseRecord},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapToNowInput(baseTime, baseQuoteAB),
+			expTwap:      sdk.NewDecWithPrec(1, 1),
+		},
+		"(2 record) to now: start time = second record time": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      tPlusOneMin,
+			input:        makeSimpleTwapToNowInput(baseTime.Add(10*time.Second), baseQuoteBA),
+			expTwap:      sdk.NewDec(5),
+		},
+		"(2 record) first record time < start time < second record time": {
+			recordsToSet: []types.TwapRecord{baseRecord, tPlus10sp5Record},
+			ctxTime:      baseTime.Add(20 * time.Second),
+			input:        makeSimpleTwapToNowInput(baseTime.Add(5*time.Second), baseQuoteBA),
+			// 10 for 5s, 5 for 10s = (10 * 5^2)^(1/3)
+			expTwap: sdk.MustNewDecFromStr("6.299605249474365823"),
+		},
+
+		// error catching
+		"start time too old": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOne,
+			input:         makeSimpleTwapToNowInput(baseTime.Add(-time.Hour), baseQuoteBA),
+			expectedError: twap.TimeTooOldError{Time: baseTime.Add(-time.Hour)},
+		},
+		"start time too new": {
+			recordsToSet:  []types.TwapRecord{baseRecord},
+			ctxTime:       tPlusOne,
+			input:         makeSimpleTwapToNowInput(baseTime.Add(time.Hour), baseQuoteBA),
+			expectedError: types.StartTimeAfterEndTimeError{StartTime: baseTime.Add(time.Hour), EndTime: tPlusOne},
+		},
+	}
+	for name, test := range tests {
+		s.Run(name, func() {
+			s.SetupTest()
+			s.preSetRecords(test.recordsToSet)
+			s.Ctx = s.Ctx.WithBlockTime(test.ctxTime)
+
+			twap, err := s.twapkeeper.GetGeometricTwapToNow(s.Ctx, test.input.poolId,
+				test.input.baseAssetDenom, test.input.quoteAssetDenom,
+				test.input.startTime)
+
+			if test.expectedError != nil {
+				s.Require().Error(err)
+				s.Require().Equal(test.expectedError, err)
+				return
+			}
+			s.Require().NoError(err)
+			osmoassert.DecApproxEq(s.T(), test.expTwap, twap, geometricTwapTolerance)
+		})
+	}
+}
@@ -9,11 +9,14 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	"github.com/osmosis-labs/osmosis/v13/app/apptesting/osmoassert"
 	"github.com/osmosis-labs/osmosis/v13/x/gamm/types"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/client"
 	"github.com/osmosis-labs/osmosis/v13/x/twap/client/queryproto"
 )
 
+var geometricTwapTolerance = sdk.MustNewDecFromStr("0.0000001")
+
 type QueryTestSuite struct {
 	apptesting.KeeperTestHelper
 
@@ -187,6 +190,37 @@ func (suite *QueryTestSuite) TestQueryTwap() {
 				suite.Require().NoError(err, "unexpected error - ArithmeticTwapToNow")
 				suite.Require().Equal(tc.result, resultToNow.ArithmeticTwap.String())
 			}
+
+			// The spot price is constant over the queried period,
+			// so the geometric twap matches the arithmetic one up to the pow approximation.
+			geometricResult, err := client.GeometricTwap(ctx, queryproto.GeometricTwapRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+				EndTime:    tc.endTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - GeometricTwap")
+			} else {
+				suite.Require().NoError(err, "unexpected error - GeometricTwap")
+				osmoassert.DecApproxEq(suite.T(), sdk.MustNewDecFromStr(tc.result), geometricResult.GeometricTwap, geometricTwapTolerance)
+			}
+
+			geometricResultToNow, err := client.GeometricTwapToNow(ctx, queryproto.GeometricTwapToNowRequest{
+				PoolId:     tc.poolId,
+				BaseAsset:  tc.baseAssetDenom,
+				QuoteAsset: tc.quoteAssetDenom,
+				StartTime:  startTime,
+			})
+
+			if tc.expectErr {
+				suite.Require().Error(err, "expected error - GeometricTwapToNow")
+			} else {
+				suite.Require().NoError(err, "unexpected error - GeometricTwapToNow")
+				osmoassert.DecApproxEq(suite.T(), sdk.MustNewDecFromStr(tc.result), geometricResultToNow.GeometricTwap, geometricTwapTolerance)
+			}
 		})
 	}
 }
@@ -16,8 +16,10 @@ import (
 // See twapLog and computeGeometricTwap functions for more details.
 var (
 	geometricTwapMathBase = sdk.NewDec(2)
-	// TODO: analyze choice.
-	geometricTwapPowPrecision = sdk.MustNewDecFromStr("0.00000001")
+	// geometricTwapPowPrecision is the precision of the fractional power
+	// approximation. Since the fractional base is 1/2, the series converges
+	// geometrically so a fine precision is cheap.
+	geometricTwapPowPrecision = sdk.MustNewDecFromStr("0.000000000000000010")
 )
 
 func newTwapRecord(k types.AmmInterface, ctx sdk.Context, poolId uint64, denom0, denom1 string) (types.TwapRecord, error) {
@@ -309,7 +311,24 @@ func twapLog(price sdk.Dec) sdk.Dec {
 }
 
 // twapPow exponentiates the geometricTwapMathBase to the given exponent.
-// TODO: basic test and benchmark.
+// PowApprox only converges for exponents in [0, 1), so the integer part of the
+// exponent is applied with exact integer power and the fractional part f is
+// approximated as 1 / (1/2)^f, which converges quickly. Negative exponents
+// are handled by taking the reciprocal of the result.
+// TODO: benchmark.
 func twapPow(exponent sdk.Dec) sdk.Dec {
-	return osmomath.PowApprox(geometricTwapMathBase, exponent, geometricTwapPowPrecision)
+	absExponent := exponent.Abs()
+	integer := absExponent.TruncateDec()
+	fractional := absExponent.Sub(integer)
+
+	result := geometricTwapMathBase.Power(integer.TruncateInt().Uint64())
+	if !fractional.IsZero() {
+		fractionalBase := sdk.OneDec().Quo(geometricTwapMathBase)
+		result = result.Quo(osmomath.PowApprox(fractionalBase, fractional, geometricTwapPowPrecision))
+	}
+
+	if exponent.IsNegative() {
+		return sdk.OneDec().Quo(result)
+	}
+	return result
 }
@@ -743,6 +743,29 @@ func TestComputeGeometricTwap(t *testing.T) {
 	}
 }
 
+func TestTwapPow(t *testing.T) {
+	tests := map[string]struct {
+		exponent    sdk.Dec
+		expectedPow sdk.Dec
+	}{
+		"zero exponent":               {sdk.ZeroDec(), sdk.OneDec()},
+		"integer exponent":            {sdk.NewDec(3), sdk.NewDec(8)},
+		"fractional exponent":         {sdk.MustNewDecFromStr("0.5"), sdk.MustNewDecFromStr("1.414213562373095049")},
+		"mixed exponent":              {sdk.MustNewDecFromStr("2.5"), sdk.MustNewDecFromStr("5.656854249492380195")},
+		"negative integer exponent":   {sdk.NewDec(-1), sdk.MustNewDecFromStr("0.5")},
+		"negative mixed exponent":     {sdk.MustNewDecFromStr("-1.5"), sdk.MustNewDecFromStr("0.353553390593273762")},
+		"large exponent":              {sdk.NewDec(40), sdk.NewDec(1099511627776)},
+		"round trip with twap log":    {twap.TwapLog(sdk.NewDec(1000000)), sdk.NewDec(1000000)},
+		"round trip with small price": {twap.TwapLog(sdk.MustNewDecFromStr("0.001")), sdk.MustNewDecFromStr("0.001")},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			actualPow := twap.TwapPow(tc.exponent)
+			osmoassert.DecApproxEq(t, tc.expectedPow, actualPow, osmomath.GetPowPrecision())
+		})
+	}
+}
+
 // TODO: split up this test case to cover both arithmetic and geometric twap
 func TestComputeArithmeticTwap_ThreeAsset(t *testing.T) {
 	testThreeAssetCaseFromDeltas := func(startAccum, accumDiff sdk.Dec, timeDelta time.Duration, expectedTwap sdk.Dec) computeThreeAssetArithmeticTwapTestCase {
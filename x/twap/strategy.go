@@ -22,3 +22,13 @@ var _ twapStrategy = &arithmetic{}
 func (s *arithmetic) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
 	return computeTwap(startRecord, endRecord, quoteAsset, arithmeticTwapType)
 }
+
+type geometric struct {
+	keeper Keeper
+}
+
+var _ twapStrategy = &geometric{}
+
+func (s *geometric) computeTwap(startRecord types.TwapRecord, endRecord types.TwapRecord, quoteAsset string) (sdk.Dec, error) {
+	return computeTwap(startRecord, endRecord, quoteAsset, geometricTwapType)
+}
@@ -34,7 +34,11 @@ func (k Keeper) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumb
 			// The only thing that could be done is a costly griefing attack to reduce the amount of osmo given as tx fees.
 			// However the idea of the txfees FeeToken gating is that the pool is sufficiently liquid for that base token.
 			minAmountOut := sdk.ZeroInt()
-			_, err := k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, feetoken.PoolID, coinBalance, baseDenom, minAmountOut)
+			pool, err := k.gammKeeper.GetPool(cacheCtx, feetoken.PoolID)
+			if err != nil {
+				return err
+			}
+			_, err = k.gammKeeper.SwapExactAmountIn(cacheCtx, nonNativeFeeAddr, pool, coinBalance, baseDenom, minAmountOut, pool.GetSwapFee(cacheCtx))
 			return err
 		})
 	}
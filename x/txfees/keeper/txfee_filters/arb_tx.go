@@ -2,53 +2,193 @@ package txfee_filters
 
 import (
 	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// We check if a tx is an arbitrage for the mempool right now by seeing:
-// 1) does start token of a msg = final token of msg (definitionally correct)
-// 2) does it have multiple swap messages, with different tx ins. If so, we assume its an arb.
-//   - This has false positives, but is intended to avoid the obvious solution of splitting
-//     an arb into multiple messages.
-//
-// 3) We record all denoms seen across all swaps, and see if any duplicates. (TODO)
-// 4) Contains both JoinPool and ExitPool messages in one tx.
-//   - Has some false positives, but they seem relatively contrived.
-//
-// TODO: Move the first component to a future router module.
-func IsArbTxLoose(tx sdk.Tx) bool {
+// ArbTxFilter reports whether the msgs of a tx look like an arbitrage.
+// Swap msgs of both gamm and the swap router, including split route swap msgs, are recognized as swaps.
+type ArbTxFilter func(msgs []sdk.Msg) bool
+
+// ArbTxFilters returns the pipeline of filters enabled by the given options.
+func ArbTxFilters(opts types.ArbTxFilterOptions) []ArbTxFilter {
+	filters := []ArbTxFilter{}
+	if opts.SameInOutDenom {
+		filters = append(filters, sameInOutDenomFilter)
+	}
+	if opts.MixedSwapInDenoms {
+		filters = append(filters, mixedSwapInDenomsFilter)
+	}
+	if opts.JoinAndExitPool {
+		filters = append(filters, joinAndExitPoolFilter)
+	}
+	if opts.DuplicateIntermediateDenoms {
+		filters = append(filters, duplicateIntermediateDenomsFilter)
+	}
+	if opts.CyclicRoutes {
+		filters = append(filters, cyclicRoutesFilter)
+	}
+	if opts.MaxSwapMsgs > 0 {
+		filters = append(filters, maxSwapMsgsFilter(opts.MaxSwapMsgs))
+	}
+	return filters
+}
+
+// IsArbTx returns true if any of the filters enabled by the options matches the tx.
+func IsArbTx(tx sdk.Tx, opts types.ArbTxFilterOptions) bool {
 	msgs := tx.GetMsgs()
+	for _, filter := range ArbTxFilters(opts) {
+		if filter(msgs) {
+			return true
+		}
+	}
+	return false
+}
 
-	swapInDenom := ""
-	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+// IsArbTxLoose checks if a tx is an arbitrage with the default filters.
+// These are the same in and out denom, mixed swap in denoms, and join and exit pool filters.
+func IsArbTxLoose(tx sdk.Tx) bool {
+	return IsArbTx(tx, types.DefaultArbTxFilterOptions())
+}
 
+// swapMsgs returns the routes of denoms of the msgs that are swaps.
+// A split route swap msg has one route per split.
+func swapMsgs(msgs []sdk.Msg) []swaproutertypes.SwapMsgRoute {
+	swaps := []swaproutertypes.SwapMsgRoute{}
 	for _, m := range msgs {
-		// (4) Check that the tx doesn't have both JoinPool & ExitPool msgs
-		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
-		if isLpMsg {
-			lpTypesSeen[lpMsg.LiquidityChangeType()] = true
-			if len(lpTypesSeen) > 1 {
-				return true
+		swaps = append(swaps, swapMsgRoutes(m)...)
+	}
+	return swaps
+}
+
+// swapMsgRoutes returns the routes of denoms of a msg, if it is a swap.
+// Each route of a split route swap msg is returned as a swap router swap msg of its own.
+func swapMsgRoutes(m sdk.Msg) []swaproutertypes.SwapMsgRoute {
+	switch msg := m.(type) {
+	case swaproutertypes.SwapMsgRoute:
+		return []swaproutertypes.SwapMsgRoute{msg}
+	case *swaproutertypes.MsgSplitRouteSwapExactAmountIn:
+		routes := make([]swaproutertypes.SwapMsgRoute, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			if len(route.Pools) == 0 {
+				continue
 			}
+			routes = append(routes, swaproutertypes.MsgSwapExactAmountIn{
+				Routes:  route.Pools,
+				TokenIn: sdk.Coin{Denom: msg.TokenInDenom, Amount: route.TokenInAmount},
+			})
 		}
-
-		swapMsg, isSwapMsg := m.(gammtypes.SwapMsgRoute)
-		if !isSwapMsg {
-			continue
+		return routes
+	case *swaproutertypes.MsgSplitRouteSwapExactAmountOut:
+		routes := make([]swaproutertypes.SwapMsgRoute, 0, len(msg.Routes))
+		for _, route := range msg.Routes {
+			if len(route.Pools) == 0 {
+				continue
+			}
+			routes = append(routes, swaproutertypes.MsgSwapExactAmountOut{
+				Routes:   route.Pools,
+				TokenOut: sdk.Coin{Denom: msg.TokenOutDenom, Amount: route.TokenOutAmount},
+			})
 		}
+		return routes
+	}
+	return nil
+}
 
-		// (1) Check that swap denom in != swap denom out
+// sameInOutDenomFilter matches a swap whose start token is its final token,
+// which is definitionally an arbitrage.
+func sameInOutDenomFilter(msgs []sdk.Msg) bool {
+	for _, swapMsg := range swapMsgs(msgs) {
 		if swapMsg.TokenInDenom() == swapMsg.TokenOutDenom() {
 			return true
 		}
+	}
+	return false
+}
 
-		// (2)
+// mixedSwapInDenomsFilter matches multiple swap msgs with different in denoms.
+// This has false positives, but is intended to avoid the obvious solution of splitting
+// an arb into multiple messages.
+func mixedSwapInDenomsFilter(msgs []sdk.Msg) bool {
+	swapInDenom := ""
+	for _, swapMsg := range swapMsgs(msgs) {
 		if swapInDenom != "" && swapMsg.TokenInDenom() != swapInDenom {
 			return true
 		}
 		swapInDenom = swapMsg.TokenInDenom()
 	}
+	return false
+}
+
+// joinAndExitPoolFilter matches txs with both JoinPool and ExitPool msgs.
+// This has some false positives, but they seem relatively contrived.
+func joinAndExitPoolFilter(msgs []sdk.Msg) bool {
+	lpTypesSeen := make(map[gammtypes.LiquidityChangeType]bool, 2)
+	for _, m := range msgs {
+		lpMsg, isLpMsg := m.(gammtypes.LiquidityChangeMsg)
+		if !isLpMsg {
+			continue
+		}
+		lpTypesSeen[lpMsg.LiquidityChangeType()] = true
+		if len(lpTypesSeen) > 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// duplicateIntermediateDenomsFilter records the intermediate denoms of all swap msgs,
+// and matches if any of them is seen in two msgs. The routes of a split route swap msg
+// may share intermediate denoms, as they all swap from the same denom to the same denom.
+func duplicateIntermediateDenomsFilter(msgs []sdk.Msg) bool {
+	seen := map[string]bool{}
+	for _, m := range msgs {
+		msgSeen := map[string]bool{}
+		for _, swapMsg := range swapMsgRoutes(m) {
+			path := swapMsg.TokenDenomsOnPath()
+			if len(path) < 3 {
+				continue
+			}
+			for _, denom := range path[1 : len(path)-1] {
+				if seen[denom] {
+					return true
+				}
+				msgSeen[denom] = true
+			}
+		}
+		for denom := range msgSeen {
+			seen[denom] = true
+		}
+	}
+	return false
+}
 
+// cyclicRoutesFilter matches a swap whose route goes through the same denom twice.
+func cyclicRoutesFilter(msgs []sdk.Msg) bool {
+	for _, swapMsg := range swapMsgs(msgs) {
+		seen := map[string]bool{}
+		for _, denom := range swapMsg.TokenDenomsOnPath() {
+			if seen[denom] {
+				return true
+			}
+			seen[denom] = true
+		}
+	}
 	return false
 }
+
+// maxSwapMsgsFilter matches txs with more than maxSwapMsgs swap msgs.
+// A split route swap msg counts as a single swap msg.
+func maxSwapMsgsFilter(maxSwapMsgs uint64) ArbTxFilter {
+	return func(msgs []sdk.Msg) bool {
+		numSwapMsgs := uint64(0)
+		for _, m := range msgs {
+			if len(swapMsgRoutes(m)) > 0 {
+				numSwapMsgs++
+			}
+		}
+		return numSwapMsgs > maxSwapMsgs
+	}
+}
@@ -0,0 +1,212 @@
+package txfee_filters_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	gammtypes "github.com/osmosis-labs/osmosis/v13/x/gamm/types"
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/keeper/txfee_filters"
+	"github.com/osmosis-labs/osmosis/v13/x/txfees/types"
+)
+
+type mockTx struct {
+	msgs []sdk.Msg
+}
+
+func (tx mockTx) GetMsgs() []sdk.Msg   { return tx.msgs }
+func (tx mockTx) ValidateBasic() error { return nil }
+
+// swapIn returns a gamm swap msg going through the given denoms.
+func swapIn(denoms ...string) sdk.Msg {
+	routes := []gammtypes.SwapAmountInRoute{}
+	for i, denom := range denoms[1:] {
+		routes = append(routes, gammtypes.SwapAmountInRoute{PoolId: uint64(i + 1), TokenOutDenom: denom})
+	}
+	return &gammtypes.MsgSwapExactAmountIn{Routes: routes, TokenIn: sdk.NewInt64Coin(denoms[0], 10)}
+}
+
+// routerSwapOut returns a swap router swap msg going through the given denoms.
+func routerSwapOut(denoms ...string) sdk.Msg {
+	routes := []swaproutertypes.SwapAmountOutRoute{}
+	for i, denom := range denoms[:len(denoms)-1] {
+		routes = append(routes, swaproutertypes.SwapAmountOutRoute{PoolId: uint64(i + 1), TokenInDenom: denom})
+	}
+	return &swaproutertypes.MsgSwapExactAmountOut{Routes: routes, TokenOut: sdk.NewInt64Coin(denoms[len(denoms)-1], 10)}
+}
+
+// splitRouteSwapIn returns a swap router split route swap msg, with one route through each of the given denoms.
+// All routes should start with the same denom and end with the same denom.
+func splitRouteSwapIn(routeDenoms ...[]string) sdk.Msg {
+	routes := []swaproutertypes.SwapAmountInSplitRoute{}
+	for _, denoms := range routeDenoms {
+		pools := []swaproutertypes.SwapAmountInRoute{}
+		for i, denom := range denoms[1:] {
+			pools = append(pools, swaproutertypes.SwapAmountInRoute{PoolId: uint64(i + 1), TokenOutDenom: denom})
+		}
+		routes = append(routes, swaproutertypes.SwapAmountInSplitRoute{Pools: pools, TokenInAmount: sdk.NewInt(10)})
+	}
+	return &swaproutertypes.MsgSplitRouteSwapExactAmountIn{Routes: routes, TokenInDenom: routeDenoms[0][0], TokenOutMinAmount: sdk.OneInt()}
+}
+
+// splitRouteSwapOut returns a swap router split route swap msg, with one route through each of the given denoms.
+// All routes should start with the same denom and end with the same denom.
+func splitRouteSwapOut(routeDenoms ...[]string) sdk.Msg {
+	routes := []swaproutertypes.SwapAmountOutSplitRoute{}
+	for _, denoms := range routeDenoms {
+		pools := []swaproutertypes.SwapAmountOutRoute{}
+		for i, denom := range denoms[:len(denoms)-1] {
+			pools = append(pools, swaproutertypes.SwapAmountOutRoute{PoolId: uint64(i + 1), TokenInDenom: denom})
+		}
+		routes = append(routes, swaproutertypes.SwapAmountOutSplitRoute{Pools: pools, TokenOutAmount: sdk.NewInt(10)})
+	}
+	lastRoute := routeDenoms[0]
+	return &swaproutertypes.MsgSplitRouteSwapExactAmountOut{Routes: routes, TokenOutDenom: lastRoute[len(lastRoute)-1], TokenInMaxAmount: sdk.NewInt(100)}
+}
+
+func TestIsArbTx(t *testing.T) {
+	allFilters := types.ArbTxFilterOptions{
+		SameInOutDenom:              true,
+		MixedSwapInDenoms:           true,
+		JoinAndExitPool:             true,
+		DuplicateIntermediateDenoms: true,
+		CyclicRoutes:                true,
+		MaxSwapMsgs:                 2,
+	}
+
+	tests := map[string]struct {
+		msgs        []sdk.Msg
+		opts        types.ArbTxFilterOptions
+		expectIsArb bool
+	}{
+		"single swap": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "ustar")},
+			opts:        allFilters,
+			expectIsArb: false,
+		},
+		"no swaps": {
+			msgs:        []sdk.Msg{&gammtypes.MsgJoinPool{}},
+			opts:        allFilters,
+			expectIsArb: false,
+		},
+		"same in and out denom": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "uosmo")},
+			opts:        types.ArbTxFilterOptions{SameInOutDenom: true},
+			expectIsArb: true,
+		},
+		"same in and out denom, filter disabled": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "uosmo")},
+			opts:        types.ArbTxFilterOptions{MixedSwapInDenoms: true, JoinAndExitPool: true},
+			expectIsArb: false,
+		},
+		"mixed swap in denoms": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uatom", "ustar")},
+			opts:        types.ArbTxFilterOptions{MixedSwapInDenoms: true},
+			expectIsArb: true,
+		},
+		"same swap in denoms": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uosmo", "ustar")},
+			opts:        types.DefaultArbTxFilterOptions(),
+			expectIsArb: false,
+		},
+		"join and exit pool": {
+			msgs:        []sdk.Msg{&gammtypes.MsgJoinPool{}, &gammtypes.MsgExitPool{}},
+			opts:        types.ArbTxFilterOptions{JoinAndExitPool: true},
+			expectIsArb: true,
+		},
+		"duplicate intermediate denoms across swaps": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "ustar"), swapIn("uosmo", "uatom", "uion")},
+			opts:        types.ArbTxFilterOptions{DuplicateIntermediateDenoms: true},
+			expectIsArb: true,
+		},
+		"duplicate intermediate denoms, filter disabled": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "ustar"), swapIn("uosmo", "uatom", "uion")},
+			opts:        types.DefaultArbTxFilterOptions(),
+			expectIsArb: false,
+		},
+		"duplicate in denoms only": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "ustar"), swapIn("uosmo", "uion", "ustar")},
+			opts:        types.ArbTxFilterOptions{DuplicateIntermediateDenoms: true},
+			expectIsArb: false,
+		},
+		"cyclic route": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom", "ustar", "uatom", "uion")},
+			opts:        types.ArbTxFilterOptions{CyclicRoutes: true},
+			expectIsArb: true,
+		},
+		"cyclic swap router route": {
+			msgs:        []sdk.Msg{routerSwapOut("uosmo", "uatom", "uosmo", "ustar")},
+			opts:        types.ArbTxFilterOptions{CyclicRoutes: true},
+			expectIsArb: true,
+		},
+		"acyclic swap router route": {
+			msgs:        []sdk.Msg{routerSwapOut("uosmo", "uatom", "ustar")},
+			opts:        allFilters,
+			expectIsArb: false,
+		},
+		"cyclic split route swap in": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uatom", "uosmo"}, []string{"uosmo", "ustar", "uosmo"})},
+			opts:        types.ArbTxFilterOptions{SameInOutDenom: true},
+			expectIsArb: true,
+		},
+		"cyclic split route swap out": {
+			msgs:        []sdk.Msg{splitRouteSwapOut([]string{"uosmo", "uatom", "uosmo"}, []string{"uosmo", "ustar", "uosmo"})},
+			opts:        types.ArbTxFilterOptions{SameInOutDenom: true},
+			expectIsArb: true,
+		},
+		"split route swap with a cyclic route": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uion"}, []string{"uosmo", "uatom", "ustar", "uatom", "uion"})},
+			opts:        types.ArbTxFilterOptions{CyclicRoutes: true},
+			expectIsArb: true,
+		},
+		"acyclic split route swap in": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uatom", "uion"}, []string{"uosmo", "ustar", "uion"})},
+			opts:        allFilters,
+			expectIsArb: false,
+		},
+		"acyclic split route swap out with shared intermediate denoms": {
+			msgs:        []sdk.Msg{splitRouteSwapOut([]string{"uosmo", "uatom", "uion"}, []string{"uosmo", "uatom", "uion"}, []string{"uosmo", "uion"})},
+			opts:        allFilters,
+			expectIsArb: false,
+		},
+		"duplicate intermediate denoms across a split route swap and a swap": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uatom", "uion"}, []string{"uosmo", "ustar", "uion"}), swapIn("uosmo", "uatom", "ujuno")},
+			opts:        types.ArbTxFilterOptions{DuplicateIntermediateDenoms: true},
+			expectIsArb: true,
+		},
+		"mixed swap in denoms across a split route swap and a swap": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uatom"}), swapIn("uatom", "ustar")},
+			opts:        types.ArbTxFilterOptions{MixedSwapInDenoms: true},
+			expectIsArb: true,
+		},
+		"split route swap counts as one swap msg": {
+			msgs:        []sdk.Msg{splitRouteSwapIn([]string{"uosmo", "uatom"}, []string{"uosmo", "ustar", "uatom"}, []string{"uosmo", "uion", "uatom"}), swapIn("uosmo", "ustar")},
+			opts:        types.ArbTxFilterOptions{MaxSwapMsgs: 2},
+			expectIsArb: false,
+		},
+		"at max swap msgs": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uosmo", "ustar")},
+			opts:        types.ArbTxFilterOptions{MaxSwapMsgs: 2},
+			expectIsArb: false,
+		},
+		"over max swap msgs": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uosmo", "ustar"), swapIn("uosmo", "uion")},
+			opts:        types.ArbTxFilterOptions{MaxSwapMsgs: 2},
+			expectIsArb: true,
+		},
+		"max swap msgs disabled": {
+			msgs:        []sdk.Msg{swapIn("uosmo", "uatom"), swapIn("uosmo", "ustar"), swapIn("uosmo", "uion")},
+			opts:        types.ArbTxFilterOptions{MaxSwapMsgs: 0},
+			expectIsArb: false,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			isArb := txfee_filters.IsArbTx(mockTx{msgs: tc.msgs}, tc.opts)
+			require.Equal(t, tc.expectIsArb, isArb)
+		})
+	}
+}
@@ -3,6 +3,8 @@ package types
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	swaproutertypes "github.com/osmosis-labs/osmosis/v13/x/swaprouter/types"
 )
 
 // SpotPriceCalculator defines the contract that must be fulfilled by a spot price calculator
@@ -13,13 +15,16 @@ type SpotPriceCalculator interface {
 
 // GammKeeper defines the contract needed for AccountKeeper related APIs.
 type GammKeeper interface {
+	GetPool(ctx sdk.Context, poolId uint64) (swaproutertypes.PoolI, error)
+
 	SwapExactAmountIn(
 		ctx sdk.Context,
 		sender sdk.AccAddress,
-		poolId uint64,
+		pool swaproutertypes.PoolI,
 		tokenIn sdk.Coin,
 		tokenOutDenom string,
 		tokenOutMinAmount sdk.Int,
+		swapFee sdk.Dec,
 	) (tokenOutAmount sdk.Int, err error)
 }
 
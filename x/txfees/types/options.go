@@ -27,6 +27,34 @@ type MempoolFeeOptions struct {
 	MinGasPriceForArbitrageTx sdk.Dec
 	HighGasTxThreshold        uint64
 	MinGasPriceForHighGasTx   sdk.Dec
+	ArbTxFilter               ArbTxFilterOptions
+}
+
+// ArbTxFilterOptions selects the heuristics used to detect arbitrage txs in the mempool.
+// A tx matched by any enabled heuristic has to pay MinGasPriceForArbitrageTx.
+type ArbTxFilterOptions struct {
+	// SameInOutDenom matches swaps whose route starts and ends in the same denom.
+	SameInOutDenom bool
+	// MixedSwapInDenoms matches txs with several swaps that have different in denoms.
+	MixedSwapInDenoms bool
+	// JoinAndExitPool matches txs that both join and exit pools.
+	JoinAndExitPool bool
+	// DuplicateIntermediateDenoms matches txs where the same intermediate denom
+	// is swapped through more than once, across all swaps of the tx.
+	DuplicateIntermediateDenoms bool
+	// CyclicRoutes matches swaps whose route goes through the same denom twice.
+	CyclicRoutes bool
+	// MaxSwapMsgs matches txs with more swap msgs than this. Zero disables the check.
+	MaxSwapMsgs uint64
+}
+
+// DefaultArbTxFilterOptions returns the heuristics used before they were configurable.
+func DefaultArbTxFilterOptions() ArbTxFilterOptions {
+	return ArbTxFilterOptions{
+		SameInOutDenom:    true,
+		MixedSwapInDenoms: true,
+		JoinAndExitPool:   true,
+	}
 }
 
 func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
@@ -35,6 +63,7 @@ func NewDefaultMempoolFeeOptions() MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: DefaultMinGasPriceForArbitrageTx.Clone(),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   DefaultMinGasPriceForHighGasTx.Clone(),
+		ArbTxFilter:               DefaultArbTxFilterOptions(),
 	}
 }
 
@@ -44,6 +73,7 @@ func NewMempoolFeeOptions(opts servertypes.AppOptions) MempoolFeeOptions {
 		MinGasPriceForArbitrageTx: parseMinGasPriceForArbitrageTx(opts),
 		HighGasTxThreshold:        DefaultHighGasTxThreshold,
 		MinGasPriceForHighGasTx:   parseMinGasPriceForHighGasTx(opts),
+		ArbTxFilter:               parseArbTxFilterOptions(opts),
 	}
 }
 
@@ -67,6 +97,42 @@ func parseMinGasPriceForHighGasTx(opts servertypes.AppOptions) sdk.Dec {
 	return parseDecFromConfig(opts, "min-gas-price-for-high-gas-tx", DefaultMinGasPriceForHighGasTx.Clone())
 }
 
+func parseArbTxFilterOptions(opts servertypes.AppOptions) ArbTxFilterOptions {
+	defaults := DefaultArbTxFilterOptions()
+	return ArbTxFilterOptions{
+		SameInOutDenom:              parseBoolFromConfig(opts, "arb-filter-same-in-out-denom", defaults.SameInOutDenom),
+		MixedSwapInDenoms:           parseBoolFromConfig(opts, "arb-filter-mixed-swap-in-denoms", defaults.MixedSwapInDenoms),
+		JoinAndExitPool:             parseBoolFromConfig(opts, "arb-filter-join-and-exit-pool", defaults.JoinAndExitPool),
+		DuplicateIntermediateDenoms: parseBoolFromConfig(opts, "arb-filter-duplicate-intermediate-denoms", defaults.DuplicateIntermediateDenoms),
+		CyclicRoutes:                parseBoolFromConfig(opts, "arb-filter-cyclic-routes", defaults.CyclicRoutes),
+		MaxSwapMsgs:                 parseUint64FromConfig(opts, "arb-filter-max-swap-msgs", defaults.MaxSwapMsgs),
+	}
+}
+
+func parseBoolFromConfig(opts servertypes.AppOptions, optName string, defaultValue bool) bool {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToBoolE(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
+func parseUint64FromConfig(opts servertypes.AppOptions, optName string, defaultValue uint64) uint64 {
+	valueInterface := opts.Get("osmosis-mempool." + optName)
+	if valueInterface == nil {
+		return defaultValue
+	}
+	value, err := cast.ToUint64E(valueInterface)
+	if err != nil {
+		panic(fmt.Errorf("invalidly configured osmosis-mempool.%v, err= %v", optName, err))
+	}
+	return value
+}
+
 func parseDecFromConfig(opts servertypes.AppOptions, optName string, defaultValue sdk.Dec) sdk.Dec {
 	valueInterface := opts.Get("osmosis-mempool." + optName)
 	value := defaultValue
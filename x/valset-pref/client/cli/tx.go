@@ -2,6 +2,7 @@ package valsetprefcli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -17,6 +18,8 @@ func GetTxCmd() *cobra.Command {
 	txCmd := osmocli.TxIndexCmd(types.ModuleName)
 	txCmd.AddCommand(
 		NewSetValSetCmd(),
+		NewRedelegateValSetCmd(),
+		NewSetAutoCompoundCmd(),
 	)
 
 	return txCmd
@@ -32,25 +35,84 @@ func NewSetValSetCmd() *cobra.Command {
 	}.BuildCommandCustomFn()
 }
 
+func NewRedelegateValSetCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "redelegate-valset [delegator_addr] [validators] [weights]",
+		Short:            "Updates the validator set of the delegator and redelegates their existing stake to match the new weights",
+		Example:          "osmosisd tx valset-pref redelegate-valset osmo1... osmovaloper1abc...,osmovaloper1def...  0.56,0.44",
+		NumArgs:          3,
+		ParseAndBuildMsg: NewMsgRedelegateValidatorSet,
+	}.BuildCommandCustomFn()
+}
+
+func NewSetAutoCompoundCmd() *cobra.Command {
+	return osmocli.TxCliDesc{
+		Use:              "set-auto-compound [delegator_addr] [enabled]",
+		Short:            "Opts the delegator in or out of restaking their staking rewards into their validator set at every epoch",
+		Example:          "osmosisd tx valset-pref set-auto-compound osmo1... true",
+		NumArgs:          2,
+		ParseAndBuildMsg: NewMsgSetAutoCompound,
+	}.BuildCommandCustomFn()
+}
+
 func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	delAddr, valset, err := parseValSetArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgSetValidatorSetPreference(
+		delAddr,
+		valset,
+	), nil
+}
+
+func NewMsgRedelegateValidatorSet(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
+	delAddr, valset, err := parseValSetArgs(args)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgRedelegateValidatorSet(
+		delAddr,
+		valset,
+	), nil
+}
+
+func NewMsgSetAutoCompound(clientCtx client.Context, args []string, fs *pflag.FlagSet) (sdk.Msg, error) {
 	delAddr, err := sdk.AccAddressFromBech32(args[0])
 	if err != nil {
 		return nil, err
 	}
 
+	enabled, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return nil, err
+	}
+
+	return types.NewMsgSetAutoCompound(delAddr, enabled), nil
+}
+
+// parseValSetArgs parses the delegator, and the validators with their weights from the args.
+func parseValSetArgs(args []string) (sdk.AccAddress, []types.ValidatorPreference, error) {
+	delAddr, err := sdk.AccAddressFromBech32(args[0])
+	if err != nil {
+		return nil, nil, err
+	}
+
 	valAddrs := osmoutils.ParseSdkValAddressFromString(args[1], ",")
 
 	weights, err := osmoutils.ParseSdkDecFromString(args[2], ",")
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
 	if len(valAddrs) != len(weights) {
-		return nil, fmt.Errorf("the length of validator addresses and weights not matched")
+		return nil, nil, fmt.Errorf("the length of validator addresses and weights not matched")
 	}
 
 	if len(valAddrs) == 0 {
-		return nil, fmt.Errorf("records is empty")
+		return nil, nil, fmt.Errorf("records is empty")
 	}
 
 	var valset []types.ValidatorPreference
@@ -61,8 +123,5 @@ func NewMsgSetValidatorSetPreference(clientCtx client.Context, args []string, fs
 		})
 	}
 
-	return types.NewMsgSetValidatorSetPreference(
-		delAddr,
-		valset,
-	), nil
+	return delAddr, valset, nil
 }
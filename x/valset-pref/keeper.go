@@ -5,6 +5,7 @@ import (
 
 	"github.com/tendermint/tendermint/libs/log"
 
+	"github.com/cosmos/cosmos-sdk/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 	"github.com/gogo/protobuf/proto"
@@ -13,19 +14,22 @@ import (
 )
 
 type Keeper struct {
-	storeKey      sdk.StoreKey
-	paramSpace    paramtypes.Subspace
-	stakingKeeper types.StakingInterface
+	storeKey           sdk.StoreKey
+	paramSpace         paramtypes.Subspace
+	stakingKeeper      types.StakingInterface
+	distributionKeeper types.DistributionKeeper
 }
 
 func NewKeeper(storeKey sdk.StoreKey,
 	paramSpace paramtypes.Subspace,
 	stakingKeeper types.StakingInterface,
+	distributionKeeper types.DistributionKeeper,
 ) Keeper {
 	return Keeper{
-		storeKey:      storeKey,
-		paramSpace:    paramSpace,
-		stakingKeeper: stakingKeeper,
+		storeKey:           storeKey,
+		paramSpace:         paramSpace,
+		stakingKeeper:      stakingKeeper,
+		distributionKeeper: distributionKeeper,
 	}
 }
 
@@ -51,3 +55,72 @@ func (k Keeper) GetValidatorSetPreference(ctx sdk.Context, delegator string) (ty
 
 	return valsetPref, true
 }
+
+// SetAutoCompound sets whether the staking rewards of the delegator are restaked into their validator-set at every epoch.
+func (k Keeper) SetAutoCompound(ctx sdk.Context, delegator string, enabled bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompound)
+	if enabled {
+		store.Set([]byte(delegator), []byte{0x01})
+	} else {
+		store.Delete([]byte(delegator))
+	}
+}
+
+// IsAutoCompoundEnabled returns true if the delegator opted in to auto-compounding.
+func (k Keeper) IsAutoCompoundEnabled(ctx sdk.Context, delegator string) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompound)
+	return store.Has([]byte(delegator))
+}
+
+// GetAutoCompoundDelegators returns all the delegators that opted in to auto-compounding.
+func (k Keeper) GetAutoCompoundDelegators(ctx sdk.Context) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompound)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	delegators := []string{}
+	for ; iterator.Valid(); iterator.Next() {
+		delegators = append(delegators, string(iterator.Key()))
+	}
+	return delegators
+}
+
+// getAutoCompoundDelegatorsFrom returns at most limit delegators that opted in to auto-compounding,
+// starting from the given delegator in key order. An empty start begins from the first delegator.
+func (k Keeper) getAutoCompoundDelegatorsFrom(ctx sdk.Context, start string, limit int) []string {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.KeyPrefixAutoCompound)
+	var startKey []byte
+	if start != "" {
+		startKey = []byte(start)
+	}
+	iterator := store.Iterator(startKey, nil)
+	defer iterator.Close()
+
+	delegators := []string{}
+	for ; iterator.Valid() && len(delegators) < limit; iterator.Next() {
+		delegators = append(delegators, string(iterator.Key()))
+	}
+	return delegators
+}
+
+// setAutoCompoundCursor sets the next delegator of the auto-compounding round in progress.
+// An empty delegator resumes the round from the first delegator.
+func (k Keeper) setAutoCompoundCursor(ctx sdk.Context, delegator string) {
+	ctx.KVStore(k.storeKey).Set(types.KeyAutoCompoundCursor, []byte(delegator))
+}
+
+// getAutoCompoundCursor returns the next delegator of the auto-compounding round in progress,
+// and false if no round is in progress.
+func (k Keeper) getAutoCompoundCursor(ctx sdk.Context) (string, bool) {
+	store := ctx.KVStore(k.storeKey)
+	if !store.Has(types.KeyAutoCompoundCursor) {
+		return "", false
+	}
+	return string(store.Get(types.KeyAutoCompoundCursor)), true
+}
+
+// IsAutoCompoundInProgress returns true if an auto-compounding round has delegators left to compound.
+func (k Keeper) IsAutoCompoundInProgress(ctx sdk.Context) bool {
+	_, inProgress := k.getAutoCompoundCursor(ctx)
+	return inProgress
+}
@@ -1,10 +1,12 @@
 package keeper_test
 
 import (
+	"fmt"
 	"testing"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/osmosis-labs/osmosis/v13/app/apptesting"
+	valPref "github.com/osmosis-labs/osmosis/v13/x/valset-pref"
 	"github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 	"github.com/stretchr/testify/suite"
 )
@@ -41,6 +43,123 @@ func (suite *KeeperTestSuite) PrepareDelegateToValidatorSet() []types.ValidatorP
 	return valPreferences
 }
 
+func (suite *KeeperTestSuite) TestAutoCompoundDelegationRewards() {
+	tests := []struct {
+		name            string
+		epochIdentifier string
+		autoCompound    bool
+		expectCompound  bool
+	}{
+		{
+			name:            "Rewards are compounded at the end of the day epoch",
+			epochIdentifier: types.AutoCompoundEpochIdentifier,
+			autoCompound:    true,
+			expectCompound:  true,
+		},
+		{
+			name:            "Rewards are not compounded at the end of another epoch",
+			epochIdentifier: "week",
+			autoCompound:    true,
+			expectCompound:  false,
+		},
+		{
+			name:            "Rewards are not compounded without opting in",
+			epochIdentifier: types.AutoCompoundEpochIdentifier,
+			autoCompound:    false,
+			expectCompound:  false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+
+			delegator := sdk.AccAddress([]byte("addr1---------------"))
+			suite.FundAcc(delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)}) // 100 osmo
+
+			// setup message server
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			preferences := suite.PrepareDelegateToValidatorSet()
+			_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(delegator, preferences))
+			suite.Require().NoError(err)
+
+			_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(delegator, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))))
+			suite.Require().NoError(err)
+
+			if test.autoCompound {
+				_, err = msgServer.SetAutoCompound(c, types.NewMsgSetAutoCompound(delegator, true))
+				suite.Require().NoError(err)
+			}
+
+			valAddrs := []sdk.ValAddress{}
+			for _, val := range preferences {
+				valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+				suite.Require().NoError(err)
+				valAddrs = append(valAddrs, valAddr)
+
+				suite.AllocateRewardsToValidator(valAddr, sdk.NewInt(20_000))
+			}
+
+			sharesBefore := []sdk.Dec{}
+			for _, valAddr := range valAddrs {
+				del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, delegator, valAddr)
+				suite.Require().True(found)
+				sharesBefore = append(sharesBefore, del.Shares)
+			}
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, delegator, sdk.DefaultBondDenom)
+
+			err = suite.App.ValidatorSetPreferenceKeeper.EpochHooks().AfterEpochEnd(suite.Ctx, test.epochIdentifier, 1)
+			suite.Require().NoError(err)
+			suite.App.ValidatorSetPreferenceKeeper.AutoCompoundDelegationRewards(suite.Ctx)
+			suite.Require().False(suite.App.ValidatorSetPreferenceKeeper.IsAutoCompoundInProgress(suite.Ctx))
+
+			// the withdrawn rewards are restaked, so the balance is unchanged up to the truncated remainder
+			balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, delegator, sdk.DefaultBondDenom)
+			suite.Require().True(balanceAfter.Amount.GTE(balanceBefore.Amount))
+			suite.Require().True(balanceAfter.Amount.Sub(balanceBefore.Amount).LT(sdk.NewInt(int64(len(valAddrs)))))
+
+			for i, valAddr := range valAddrs {
+				del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, delegator, valAddr)
+				suite.Require().True(found)
+				if test.expectCompound {
+					suite.Require().True(del.Shares.GT(sharesBefore[i]))
+				} else {
+					suite.Require().Equal(sharesBefore[i], del.Shares)
+				}
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestAutoCompoundDelegationRewardsBatches() {
+	suite.SetupTest()
+	keeper := suite.App.ValidatorSetPreferenceKeeper
+
+	// the delegators have no delegations, so compounding fails for each of them without halting the round.
+	numDelegators := 2*types.MaxAutoCompoundDelegatorsPerBlock + 1
+	for i := 0; i < numDelegators; i++ {
+		keeper.SetAutoCompound(suite.Ctx, sdk.AccAddress([]byte(fmt.Sprintf("addr%016d", i))).String(), true)
+	}
+
+	// no round is in progress before the day epoch ends.
+	keeper.AutoCompoundDelegationRewards(suite.Ctx)
+	suite.Require().False(keeper.IsAutoCompoundInProgress(suite.Ctx))
+
+	err := keeper.EpochHooks().AfterEpochEnd(suite.Ctx, types.AutoCompoundEpochIdentifier, 1)
+	suite.Require().NoError(err)
+	suite.Require().True(keeper.IsAutoCompoundInProgress(suite.Ctx))
+
+	// every block compounds a batch of delegators, until all of them are processed.
+	for i := 0; i < 2; i++ {
+		keeper.AutoCompoundDelegationRewards(suite.Ctx)
+		suite.Require().True(keeper.IsAutoCompoundInProgress(suite.Ctx))
+	}
+	keeper.AutoCompoundDelegationRewards(suite.Ctx)
+	suite.Require().False(keeper.IsAutoCompoundInProgress(suite.Ctx))
+}
+
 func TestKeeperTestSuite(t *testing.T) {
 	suite.Run(t, new(KeeperTestSuite))
 }
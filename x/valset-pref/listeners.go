@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	epochtypes "github.com/osmosis-labs/osmosis/v13/x/epochs/types"
+	"github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
+)
+
+var _ epochtypes.EpochHooks = &epochhook{}
+
+type epochhook struct {
+	k Keeper
+}
+
+// EpochHooks returns the epoch hooks used to start auto-compounding staking rewards into validator-sets.
+func (k Keeper) EpochHooks() epochtypes.EpochHooks {
+	return &epochhook{k}
+}
+
+func (hook *epochhook) AfterEpochEnd(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	if epochIdentifier == types.AutoCompoundEpochIdentifier {
+		hook.k.StartAutoCompound(ctx)
+	}
+	return nil
+}
+
+func (hook *epochhook) BeforeEpochStart(ctx sdk.Context, epochIdentifier string, epochNumber int64) error {
+	return nil
+}
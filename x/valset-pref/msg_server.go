@@ -59,6 +59,43 @@ func (server msgServer) UndelegateFromValidatorSet(goCtx context.Context, msg *t
 	return &types.MsgUndelegateFromValidatorSetResponse{}, nil
 }
 
+func (server msgServer) RedelegateValidatorSet(goCtx context.Context, msg *types.MsgRedelegateValidatorSet) (*types.MsgRedelegateValidatorSetResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	unmovedRedelegations, err := server.keeper.RedelegateValidatorSet(ctx, msg.Delegator, msg.Preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRedelegateValidatorSetResponse{UnmovedRedelegations: unmovedRedelegations}, nil
+}
+
 func (server msgServer) WithdrawDelegationRewards(goCtx context.Context, msg *types.MsgWithdrawDelegationRewards) (*types.MsgWithdrawDelegationRewardsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	rewards, err := server.keeper.WithdrawDelegationRewards(ctx, msg.Delegator)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.TypeEvtWithdrawDelegationRewards,
+			sdk.NewAttribute(types.AttributeDelegator, msg.Delegator),
+			sdk.NewAttribute(types.AttributeWithdrawnAmount, rewards.String()),
+		),
+	})
+
 	return &types.MsgWithdrawDelegationRewardsResponse{}, nil
 }
+
+func (server msgServer) SetAutoCompound(goCtx context.Context, msg *types.MsgSetAutoCompound) (*types.MsgSetAutoCompoundResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := server.keeper.SetAutoCompoundPreference(ctx, msg.Delegator, msg.Enabled)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAutoCompoundResponse{}, nil
+}
@@ -2,6 +2,9 @@ package keeper_test
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	abci "github.com/tendermint/tendermint/abci/types"
+
 	valPref "github.com/osmosis-labs/osmosis/v13/x/valset-pref"
 	"github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 )
@@ -292,3 +295,316 @@ func (suite *KeeperTestSuite) TestUnDelegateFromValidatorSet() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestWithdrawDelegationRewards() {
+	tests := []struct {
+		name                  string
+		delegator             sdk.AccAddress
+		coinToStake           sdk.Coin
+		delegateOutsideValSet bool
+		setValSet             bool
+		expectPass            bool
+	}{
+		{
+			name:        "Withdraw rewards from the ValSet",
+			delegator:   sdk.AccAddress([]byte("addr1---------------")),
+			coinToStake: sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20_000_000)),
+			setValSet:   true,
+			expectPass:  true,
+		},
+		{
+			name:                  "Withdraw rewards from the ValSet and a delegation outside the ValSet",
+			delegator:             sdk.AccAddress([]byte("addr2---------------")),
+			coinToStake:           sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(20_000_000)),
+			delegateOutsideValSet: true,
+			setValSet:             true,
+			expectPass:            true,
+		},
+		{
+			name:       "Withdraw rewards without a ValSet",
+			delegator:  sdk.AccAddress([]byte("addr3---------------")),
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+
+			suite.FundAcc(test.delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)}) // 100 osmo
+
+			// setup message server
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			preferences := suite.PrepareDelegateToValidatorSet()
+			valAddrs := []sdk.ValAddress{}
+			for _, val := range preferences {
+				valAddr, err := sdk.ValAddressFromBech32(val.ValOperAddress)
+				suite.Require().NoError(err)
+				valAddrs = append(valAddrs, valAddr)
+			}
+
+			if test.setValSet {
+				_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, preferences))
+				suite.Require().NoError(err)
+
+				_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(test.delegator, test.coinToStake))
+				suite.Require().NoError(err)
+			}
+
+			if test.delegateOutsideValSet {
+				valAddr := suite.SetupValidator(stakingtypes.Bonded)
+				validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+				suite.Require().True(found)
+
+				_, err := suite.App.StakingKeeper.Delegate(suite.Ctx, test.delegator, sdk.NewInt(10_000_000), stakingtypes.Unbonded, validator, true)
+				suite.Require().NoError(err)
+				valAddrs = append(valAddrs, valAddr)
+			}
+
+			for _, valAddr := range valAddrs {
+				suite.AllocateRewardsToValidator(valAddr, sdk.NewInt(20_000))
+			}
+			c = sdk.WrapSDKContext(suite.Ctx)
+
+			balanceBefore := suite.App.BankKeeper.GetBalance(suite.Ctx, test.delegator, sdk.DefaultBondDenom)
+
+			_, err := msgServer.WithdrawDelegationRewards(c, types.NewMsgWithdrawDelegationRewards(test.delegator))
+			if test.expectPass {
+				suite.Require().NoError(err)
+
+				// the delegator received rewards
+				balanceAfter := suite.App.BankKeeper.GetBalance(suite.Ctx, test.delegator, sdk.DefaultBondDenom)
+				suite.Require().True(balanceAfter.Amount.GT(balanceBefore.Amount))
+
+				// every delegation has had its rewards withdrawn
+				for _, valAddr := range valAddrs {
+					validator, found := suite.App.StakingKeeper.GetValidator(suite.Ctx, valAddr)
+					suite.Require().True(found)
+					delegation, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, test.delegator, valAddr)
+					suite.Require().True(found)
+
+					endingPeriod := suite.App.DistrKeeper.IncrementValidatorPeriod(suite.Ctx, validator)
+					rewards := suite.App.DistrKeeper.CalculateDelegationRewards(suite.Ctx, validator, delegation, endingPeriod)
+					suite.Require().True(rewards.IsZero())
+				}
+
+				// a single aggregated event records the total rewards withdrawn
+				withdrawnAmount := balanceAfter.Sub(balanceBefore)
+				suite.AssertEventEmitted(suite.Ctx, types.TypeEvtWithdrawDelegationRewards, 1)
+				event := suite.FindEvent(suite.Ctx.EventManager().Events(), types.TypeEvtWithdrawDelegationRewards)
+				suite.Require().Contains(event.Attributes, abci.EventAttribute{Key: []byte(types.AttributeWithdrawnAmount), Value: []byte(sdk.NewCoins(withdrawnAmount).String())})
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRedelegateValidatorSet() {
+	tests := []struct {
+		name          string
+		delegator     sdk.AccAddress
+		valSetExists  bool
+		maxEntries    uint32    // staking redelegation entry limit, default if 0
+		weights       []sdk.Dec // initial validator-set weights, by validator index
+		newWeights    [][]sdk.Dec
+		expectedStake []sdk.Int // expected stake per validator after the last redelegation
+		expUnmoved    int       // expected unmoved redelegations of the last redelegation
+		expectPass    bool
+	}{
+		{
+			name:          "Redelegate to a partially overlapping validator set",
+			delegator:     sdk.AccAddress([]byte("addr1---------------")),
+			valSetExists:  true,
+			weights:       []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			newWeights:    [][]sdk.Dec{{sdk.NewDecWithPrec(2, 1), sdk.ZeroDec(), sdk.NewDecWithPrec(8, 1)}},
+			expectedStake: []sdk.Int{sdk.NewInt(2_000_000), sdk.ZeroInt(), sdk.NewInt(8_000_000), sdk.ZeroInt(), sdk.ZeroInt()},
+			expectPass:    true,
+		},
+		{
+			name:         "Redelegate between many validators",
+			delegator:    sdk.AccAddress([]byte("addr2---------------")),
+			valSetExists: true,
+			weights:      []sdk.Dec{sdk.NewDecWithPrec(2, 1), sdk.NewDecWithPrec(332, 3), sdk.NewDecWithPrec(12, 2), sdk.NewDecWithPrec(348, 3)},
+			newWeights: [][]sdk.Dec{
+				{sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2), sdk.NewDecWithPrec(25, 2), sdk.ZeroDec(), sdk.NewDecWithPrec(25, 2)},
+			},
+			expectedStake: []sdk.Int{sdk.NewInt(2_500_000), sdk.NewInt(2_500_000), sdk.NewInt(2_500_000), sdk.ZeroInt(), sdk.NewInt(2_500_000)},
+			expectPass:    true,
+		},
+		{
+			name:         "Transitive redelegation is not moved",
+			delegator:    sdk.AccAddress([]byte("addr3---------------")),
+			valSetExists: true,
+			weights:      []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			newWeights: [][]sdk.Dec{
+				{sdk.NewDecWithPrec(2, 1), sdk.ZeroDec(), sdk.NewDecWithPrec(8, 1)},
+				{sdk.ZeroDec(), sdk.ZeroDec(), sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			},
+			// validator 2 received a redelegation, so only the stake of validator 0 can be moved
+			expectedStake: []sdk.Int{sdk.ZeroInt(), sdk.ZeroInt(), sdk.NewInt(8_000_000), sdk.NewInt(2_000_000), sdk.ZeroInt()},
+			expUnmoved:    1,
+			expectPass:    true,
+		},
+		{
+			name:         "Redelegation over the entry limit is not moved",
+			delegator:    sdk.AccAddress([]byte("addr4---------------")),
+			valSetExists: true,
+			maxEntries:   1,
+			weights:      []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			newWeights: [][]sdk.Dec{
+				{sdk.NewDecWithPrec(3, 1), sdk.NewDecWithPrec(7, 1)},
+				{sdk.NewDecWithPrec(1, 1), sdk.NewDecWithPrec(9, 1)},
+			},
+			expectedStake: []sdk.Int{sdk.NewInt(3_000_000), sdk.NewInt(7_000_000), sdk.ZeroInt(), sdk.ZeroInt(), sdk.ZeroInt()},
+			expUnmoved:    1,
+			expectPass:    true,
+		},
+		{
+			name:         "Redelegate to the same validator set",
+			delegator:    sdk.AccAddress([]byte("addr5---------------")),
+			valSetExists: true,
+			weights:      []sdk.Dec{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)},
+			newWeights:   [][]sdk.Dec{{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)}},
+			expectPass:   false,
+		},
+		{
+			name:       "Redelegate without a validator set",
+			delegator:  sdk.AccAddress([]byte("addr6---------------")),
+			newWeights: [][]sdk.Dec{{sdk.NewDecWithPrec(5, 1), sdk.NewDecWithPrec(5, 1)}},
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+
+			suite.FundAcc(test.delegator, sdk.Coins{sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000_000)}) // 100 osmo
+
+			if test.maxEntries != 0 {
+				stakingParams := suite.App.StakingKeeper.GetParams(suite.Ctx)
+				stakingParams.MaxEntries = test.maxEntries
+				suite.App.StakingKeeper.SetParams(suite.Ctx, stakingParams)
+			}
+
+			// setup message server
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			valAddrs := suite.SetupMultipleValidators(5)
+			preferencesFromWeights := func(weights []sdk.Dec) []types.ValidatorPreference {
+				preferences := []types.ValidatorPreference{}
+				for i, weight := range weights {
+					if weight.IsZero() {
+						continue
+					}
+					preferences = append(preferences, types.ValidatorPreference{ValOperAddress: valAddrs[i], Weight: weight})
+				}
+				return preferences
+			}
+
+			if test.valSetExists {
+				_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, preferencesFromWeights(test.weights)))
+				suite.Require().NoError(err)
+
+				_, err = msgServer.DelegateToValidatorSet(c, types.NewMsgDelegateToValidatorSet(test.delegator, sdk.NewCoin(sdk.DefaultBondDenom, sdk.NewInt(10_000_000))))
+				suite.Require().NoError(err)
+			}
+
+			var (
+				res *types.MsgRedelegateValidatorSetResponse
+				err error
+			)
+			for _, newWeights := range test.newWeights {
+				res, err = msgServer.RedelegateValidatorSet(c, types.NewMsgRedelegateValidatorSet(test.delegator, preferencesFromWeights(newWeights)))
+			}
+
+			if test.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Len(res.UnmovedRedelegations, test.expUnmoved)
+
+				// the validator set is updated
+				valSet, found := suite.App.ValidatorSetPreferenceKeeper.GetValidatorSetPreference(suite.Ctx, test.delegator.String())
+				suite.Require().True(found)
+				suite.Require().True(suite.App.ValidatorSetPreferenceKeeper.IsValidatorSetEqual(preferencesFromWeights(test.newWeights[len(test.newWeights)-1]), valSet.Preferences))
+
+				// check the stake of every validator after redelegation
+				for i, valAddrStr := range valAddrs {
+					valAddr, err := sdk.ValAddressFromBech32(valAddrStr)
+					suite.Require().NoError(err)
+
+					stake := sdk.ZeroInt()
+					if del, found := suite.App.StakingKeeper.GetDelegation(suite.Ctx, test.delegator, valAddr); found {
+						stake = del.Shares.TruncateInt()
+					}
+					suite.Require().Equal(test.expectedStake[i], stake)
+				}
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetAutoCompound() {
+	tests := []struct {
+		name         string
+		delegator    sdk.AccAddress
+		valSetExists bool
+		enabled      bool
+		expectPass   bool
+	}{
+		{
+			name:         "Opt in to auto-compounding",
+			delegator:    sdk.AccAddress([]byte("addr1---------------")),
+			valSetExists: true,
+			enabled:      true,
+			expectPass:   true,
+		},
+		{
+			name:         "Opt out of auto-compounding",
+			delegator:    sdk.AccAddress([]byte("addr2---------------")),
+			valSetExists: true,
+			enabled:      false,
+			expectPass:   true,
+		},
+		{
+			name:       "Opt in to auto-compounding without a ValSet",
+			delegator:  sdk.AccAddress([]byte("addr3---------------")),
+			enabled:    true,
+			expectPass: false,
+		},
+	}
+
+	for _, test := range tests {
+		suite.Run(test.name, func() {
+			suite.SetupTest()
+
+			// setup message server
+			msgServer := valPref.NewMsgServerImpl(suite.App.ValidatorSetPreferenceKeeper)
+			c := sdk.WrapSDKContext(suite.Ctx)
+
+			if test.valSetExists {
+				preferences := suite.PrepareDelegateToValidatorSet()
+				_, err := msgServer.SetValidatorSetPreference(c, types.NewMsgSetValidatorSetPreference(test.delegator, preferences))
+				suite.Require().NoError(err)
+
+				// opt in first, so that opting out is observable
+				_, err = msgServer.SetAutoCompound(c, types.NewMsgSetAutoCompound(test.delegator, true))
+				suite.Require().NoError(err)
+			}
+
+			_, err := msgServer.SetAutoCompound(c, types.NewMsgSetAutoCompound(test.delegator, test.enabled))
+			if test.expectPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(test.enabled, suite.App.ValidatorSetPreferenceKeeper.IsAutoCompoundEnabled(suite.Ctx, test.delegator.String()))
+			} else {
+				suite.Require().Error(err)
+				suite.Require().False(suite.App.ValidatorSetPreferenceKeeper.IsAutoCompoundEnabled(suite.Ctx, test.delegator.String()))
+			}
+		})
+	}
+}
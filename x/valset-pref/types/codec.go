@@ -11,7 +11,9 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgSetValidatorSetPreference{}, "osmosis/valset-pref/MsgSetValidatorSetPreference", nil)
 	cdc.RegisterConcrete(&MsgDelegateToValidatorSet{}, "osmosis/valset-pref/MsgDelegateToValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgUndelegateFromValidatorSet{}, "osmosis/valset-pref/MsgUndelegateFromValidatorSet", nil)
+	cdc.RegisterConcrete(&MsgRedelegateValidatorSet{}, "osmosis/valset-pref/MsgRedelegateValidatorSet", nil)
 	cdc.RegisterConcrete(&MsgWithdrawDelegationRewards{}, "osmosis/valset-pref/MsgWithdrawDelegationRewards", nil)
+	cdc.RegisterConcrete(&MsgSetAutoCompound{}, "osmosis/valset-pref/MsgSetAutoCompound", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -19,7 +21,9 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgSetValidatorSetPreference{},
 		&MsgDelegateToValidatorSet{},
 		&MsgUndelegateFromValidatorSet{},
+		&MsgRedelegateValidatorSet{},
 		&MsgWithdrawDelegationRewards{},
+		&MsgSetAutoCompound{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,9 @@
+package types
+
+// event types.
+const (
+	TypeEvtWithdrawDelegationRewards = "withdraw_delegation_rewards"
+
+	AttributeDelegator       = "delegator"
+	AttributeWithdrawnAmount = "amount"
+)
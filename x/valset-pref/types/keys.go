@@ -10,6 +10,19 @@ var (
 	// KeyPrefixValidatorSet defines prefix key for validator set.
 	KeyPrefixValidatorSet = []byte{0x01}
 
+	// KeyPrefixAutoCompound defines prefix key for the delegators that opted in to auto-compounding.
+	KeyPrefixAutoCompound = []byte{0x02}
+
+	// KeyAutoCompoundCursor defines key for the next delegator of the auto-compounding round in progress.
+	KeyAutoCompoundCursor = []byte{0x03}
+
+	// AutoCompoundEpochIdentifier defines the epoch at the end of which staking rewards are auto-compounded.
+	AutoCompoundEpochIdentifier = "day"
+
+	// MaxAutoCompoundDelegatorsPerBlock defines the maximum number of delegators whose staking rewards
+	// are auto-compounded in a single block.
+	MaxAutoCompoundDelegatorsPerBlock = 100
+
 	// QuerierRoute defines the module's query routing key
 	QuerierRoute = ModuleName
 )
@@ -30,30 +30,7 @@ func (m MsgSetValidatorSetPreference) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
 	}
 
-	totalWeight := sdk.ZeroDec()
-	validatorAddrs := []string{}
-	for _, validator := range m.Preferences {
-		_, err := sdk.ValAddressFromBech32(validator.ValOperAddress)
-		if err != nil {
-			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid validator address (%s)", err)
-		}
-
-		totalWeight = totalWeight.Add(validator.Weight)
-		validatorAddrs = append(validatorAddrs, validator.ValOperAddress)
-	}
-
-	// check that all the validator address are unique
-	containsDuplicate := osmoutils.ContainsDuplicate(validatorAddrs)
-	if containsDuplicate {
-		return fmt.Errorf("The validator operator address are duplicated")
-	}
-
-	// check if the total validator distribution weights equal 1
-	if !totalWeight.Equal(sdk.OneDec()) {
-		return fmt.Errorf("The weights allocated to the validators do not add up to 1, Got: %d", totalWeight)
-	}
-
-	return nil
+	return validatePreferences(m.Preferences)
 }
 
 func (m MsgSetValidatorSetPreference) GetSignBytes() []byte {
@@ -142,6 +119,40 @@ func (m MsgUndelegateFromValidatorSet) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{delegator}
 }
 
+// constants
+const (
+	TypeMsgRedelegateValidatorSet = "redelegate_validator_set"
+)
+
+var _ sdk.Msg = &MsgRedelegateValidatorSet{}
+
+// NewMsgRedelegateValidatorSet creates a msg to redelegate to a new validator-set.
+func NewMsgRedelegateValidatorSet(delegator sdk.AccAddress, preferences []ValidatorPreference) *MsgRedelegateValidatorSet {
+	return &MsgRedelegateValidatorSet{
+		Delegator:   delegator.String(),
+		Preferences: preferences,
+	}
+}
+
+func (m MsgRedelegateValidatorSet) Type() string { return TypeMsgRedelegateValidatorSet }
+func (m MsgRedelegateValidatorSet) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid delegator address (%s)", err)
+	}
+
+	return validatePreferences(m.Preferences)
+}
+
+func (m MsgRedelegateValidatorSet) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgRedelegateValidatorSet) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
 // constants
 const (
 	TypeMsgWithdrawDelegationRewards = "withdraw_delegation_rewards"
@@ -174,3 +185,66 @@ func (m MsgWithdrawDelegationRewards) GetSigners() []sdk.AccAddress {
 	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
 	return []sdk.AccAddress{delegator}
 }
+
+// constants
+const (
+	TypeMsgSetAutoCompound = "set_auto_compound"
+)
+
+var _ sdk.Msg = &MsgSetAutoCompound{}
+
+// NewMsgSetAutoCompound creates a msg to opt in or out of auto-compounding staking rewards.
+func NewMsgSetAutoCompound(delegator sdk.AccAddress, enabled bool) *MsgSetAutoCompound {
+	return &MsgSetAutoCompound{
+		Delegator: delegator.String(),
+		Enabled:   enabled,
+	}
+}
+
+func (m MsgSetAutoCompound) Type() string { return TypeMsgSetAutoCompound }
+func (m MsgSetAutoCompound) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Delegator)
+	if err != nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	return nil
+}
+
+func (m MsgSetAutoCompound) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&m))
+}
+
+func (m MsgSetAutoCompound) GetSigners() []sdk.AccAddress {
+	delegator, _ := sdk.AccAddressFromBech32(m.Delegator)
+	return []sdk.AccAddress{delegator}
+}
+
+// validatePreferences checks that the validator addresses are valid and unique,
+// and that the weights add up to 1.
+func validatePreferences(preferences []ValidatorPreference) error {
+	totalWeight := sdk.ZeroDec()
+	validatorAddrs := []string{}
+	for _, validator := range preferences {
+		_, err := sdk.ValAddressFromBech32(validator.ValOperAddress)
+		if err != nil {
+			return sdkerrors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid validator address (%s)", err)
+		}
+
+		totalWeight = totalWeight.Add(validator.Weight)
+		validatorAddrs = append(validatorAddrs, validator.ValOperAddress)
+	}
+
+	// check that all the validator address are unique
+	containsDuplicate := osmoutils.ContainsDuplicate(validatorAddrs)
+	if containsDuplicate {
+		return fmt.Errorf("The validator operator address are duplicated")
+	}
+
+	// check if the total validator distribution weights equal 1
+	if !totalWeight.Equal(sdk.OneDec()) {
+		return fmt.Errorf("The weights allocated to the validators do not add up to 1, Got: %d", totalWeight)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
@@ -309,6 +310,178 @@ func (m *MsgUndelegateFromValidatorSetResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUndelegateFromValidatorSetResponse proto.InternalMessageInfo
 
+// MsgRedelegateValidatorSet allows users to update their validator-set
+// preferences and redelegate their existing stake to match the new weights.
+type MsgRedelegateValidatorSet struct {
+	// delegator is the user who is trying to redelegate.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// list of {valAddr, weight} to redelegate to
+	Preferences []ValidatorPreference `protobuf:"bytes,2,rep,name=preferences,proto3" json:"preferences" yaml:"preferences"`
+}
+
+func (m *MsgRedelegateValidatorSet) Reset()         { *m = MsgRedelegateValidatorSet{} }
+func (m *MsgRedelegateValidatorSet) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateValidatorSet) ProtoMessage()    {}
+func (*MsgRedelegateValidatorSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{6}
+}
+func (m *MsgRedelegateValidatorSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateValidatorSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateValidatorSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegateValidatorSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateValidatorSet.Merge(m, src)
+}
+func (m *MsgRedelegateValidatorSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateValidatorSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateValidatorSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegateValidatorSet proto.InternalMessageInfo
+
+func (m *MsgRedelegateValidatorSet) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgRedelegateValidatorSet) GetPreferences() []ValidatorPreference {
+	if m != nil {
+		return m.Preferences
+	}
+	return nil
+}
+
+type MsgRedelegateValidatorSetResponse struct {
+	// unmoved_redelegations are the redelegations that could not be executed,
+	// for ex: due to the redelegation entry limit or a transitive redelegation.
+	// The stake of these redelegations stays with the source validator.
+	UnmovedRedelegations []UnmovedRedelegation `protobuf:"bytes,1,rep,name=unmoved_redelegations,json=unmovedRedelegations,proto3" json:"unmoved_redelegations" yaml:"unmoved_redelegations"`
+}
+
+func (m *MsgRedelegateValidatorSetResponse) Reset()         { *m = MsgRedelegateValidatorSetResponse{} }
+func (m *MsgRedelegateValidatorSetResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRedelegateValidatorSetResponse) ProtoMessage()    {}
+func (*MsgRedelegateValidatorSetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{7}
+}
+func (m *MsgRedelegateValidatorSetResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRedelegateValidatorSetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRedelegateValidatorSetResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRedelegateValidatorSetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRedelegateValidatorSetResponse.Merge(m, src)
+}
+func (m *MsgRedelegateValidatorSetResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRedelegateValidatorSetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRedelegateValidatorSetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRedelegateValidatorSetResponse proto.InternalMessageInfo
+
+func (m *MsgRedelegateValidatorSetResponse) GetUnmovedRedelegations() []UnmovedRedelegation {
+	if m != nil {
+		return m.UnmovedRedelegations
+	}
+	return nil
+}
+
+// UnmovedRedelegation is a redelegation required to match the new
+// validator-set weights that could not be executed.
+type UnmovedRedelegation struct {
+	// src_val_oper_address is the validator the tokens should have been moved
+	// from.
+	SrcValOperAddress string `protobuf:"bytes,1,opt,name=src_val_oper_address,json=srcValOperAddress,proto3" json:"src_val_oper_address,omitempty" yaml:"src_val_oper_address"`
+	// dst_val_oper_address is the validator the tokens should have been moved
+	// to.
+	DstValOperAddress string `protobuf:"bytes,2,opt,name=dst_val_oper_address,json=dstValOperAddress,proto3" json:"dst_val_oper_address,omitempty" yaml:"dst_val_oper_address"`
+	// amount is the amount of tokens that could not be moved.
+	Amount github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"amount" yaml:"amount"`
+	// reason is why the redelegation could not be executed.
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty" yaml:"reason"`
+}
+
+func (m *UnmovedRedelegation) Reset()         { *m = UnmovedRedelegation{} }
+func (m *UnmovedRedelegation) String() string { return proto.CompactTextString(m) }
+func (*UnmovedRedelegation) ProtoMessage()    {}
+func (*UnmovedRedelegation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{8}
+}
+func (m *UnmovedRedelegation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnmovedRedelegation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnmovedRedelegation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnmovedRedelegation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnmovedRedelegation.Merge(m, src)
+}
+func (m *UnmovedRedelegation) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnmovedRedelegation) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnmovedRedelegation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnmovedRedelegation proto.InternalMessageInfo
+
+func (m *UnmovedRedelegation) GetSrcValOperAddress() string {
+	if m != nil {
+		return m.SrcValOperAddress
+	}
+	return ""
+}
+
+func (m *UnmovedRedelegation) GetDstValOperAddress() string {
+	if m != nil {
+		return m.DstValOperAddress
+	}
+	return ""
+}
+
+func (m *UnmovedRedelegation) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 // MsgWithdrawDelegationRewards allows user to claim staking rewards from the
 // validator set.
 type MsgWithdrawDelegationRewards struct {
@@ -320,7 +493,7 @@ func (m *MsgWithdrawDelegationRewards) Reset()         { *m = MsgWithdrawDelegat
 func (m *MsgWithdrawDelegationRewards) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDelegationRewards) ProtoMessage()    {}
 func (*MsgWithdrawDelegationRewards) Descriptor() ([]byte, []int) {
-	return fileDescriptor_daa95be02b2fc560, []int{6}
+	return fileDescriptor_daa95be02b2fc560, []int{9}
 }
 func (m *MsgWithdrawDelegationRewards) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -363,7 +536,7 @@ func (m *MsgWithdrawDelegationRewardsResponse) Reset()         { *m = MsgWithdra
 func (m *MsgWithdrawDelegationRewardsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgWithdrawDelegationRewardsResponse) ProtoMessage()    {}
 func (*MsgWithdrawDelegationRewardsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_daa95be02b2fc560, []int{7}
+	return fileDescriptor_daa95be02b2fc560, []int{10}
 }
 func (m *MsgWithdrawDelegationRewardsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -392,6 +565,98 @@ func (m *MsgWithdrawDelegationRewardsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgWithdrawDelegationRewardsResponse proto.InternalMessageInfo
 
+// MsgSetAutoCompound allows user to opt in or out of auto-compounding their
+// staking rewards into their validator-set.
+type MsgSetAutoCompound struct {
+	// delegator is the user who is trying to set auto-compounding.
+	Delegator string `protobuf:"bytes,1,opt,name=delegator,proto3" json:"delegator,omitempty" yaml:"delegator"`
+	// enabled is true to opt in to auto-compounding, false to opt out.
+	Enabled bool `protobuf:"varint,2,opt,name=enabled,proto3" json:"enabled,omitempty" yaml:"enabled"`
+}
+
+func (m *MsgSetAutoCompound) Reset()         { *m = MsgSetAutoCompound{} }
+func (m *MsgSetAutoCompound) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompound) ProtoMessage()    {}
+func (*MsgSetAutoCompound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{11}
+}
+func (m *MsgSetAutoCompound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompound.Merge(m, src)
+}
+func (m *MsgSetAutoCompound) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompound) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompound.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompound proto.InternalMessageInfo
+
+func (m *MsgSetAutoCompound) GetDelegator() string {
+	if m != nil {
+		return m.Delegator
+	}
+	return ""
+}
+
+func (m *MsgSetAutoCompound) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+type MsgSetAutoCompoundResponse struct {
+}
+
+func (m *MsgSetAutoCompoundResponse) Reset()         { *m = MsgSetAutoCompoundResponse{} }
+func (m *MsgSetAutoCompoundResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAutoCompoundResponse) ProtoMessage()    {}
+func (*MsgSetAutoCompoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_daa95be02b2fc560, []int{12}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAutoCompoundResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.Merge(m, src)
+}
+func (m *MsgSetAutoCompoundResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAutoCompoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAutoCompoundResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetAutoCompoundResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgSetValidatorSetPreference)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreference")
 	proto.RegisterType((*MsgSetValidatorSetPreferenceResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetValidatorSetPreferenceResponse")
@@ -399,8 +664,13 @@ func init() {
 	proto.RegisterType((*MsgDelegateToValidatorSetResponse)(nil), "osmosis.valsetpref.v1beta1.MsgDelegateToValidatorSetResponse")
 	proto.RegisterType((*MsgUndelegateFromValidatorSet)(nil), "osmosis.valsetpref.v1beta1.MsgUndelegateFromValidatorSet")
 	proto.RegisterType((*MsgUndelegateFromValidatorSetResponse)(nil), "osmosis.valsetpref.v1beta1.MsgUndelegateFromValidatorSetResponse")
+	proto.RegisterType((*MsgRedelegateValidatorSet)(nil), "osmosis.valsetpref.v1beta1.MsgRedelegateValidatorSet")
+	proto.RegisterType((*MsgRedelegateValidatorSetResponse)(nil), "osmosis.valsetpref.v1beta1.MsgRedelegateValidatorSetResponse")
+	proto.RegisterType((*UnmovedRedelegation)(nil), "osmosis.valsetpref.v1beta1.UnmovedRedelegation")
 	proto.RegisterType((*MsgWithdrawDelegationRewards)(nil), "osmosis.valsetpref.v1beta1.MsgWithdrawDelegationRewards")
 	proto.RegisterType((*MsgWithdrawDelegationRewardsResponse)(nil), "osmosis.valsetpref.v1beta1.MsgWithdrawDelegationRewardsResponse")
+	proto.RegisterType((*MsgSetAutoCompound)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoCompound")
+	proto.RegisterType((*MsgSetAutoCompoundResponse)(nil), "osmosis.valsetpref.v1beta1.MsgSetAutoCompoundResponse")
 }
 
 func init() {
@@ -408,41 +678,58 @@ func init() {
 }
 
 var fileDescriptor_daa95be02b2fc560 = []byte{
-	// 531 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x4d, 0x8b, 0xd3, 0x40,
-	0x18, 0xee, 0x6c, 0x17, 0x61, 0xa7, 0x17, 0x09, 0x8b, 0xb4, 0x41, 0xd3, 0x1a, 0x57, 0xdb, 0x4b,
-	0x33, 0xb4, 0x8b, 0xf8, 0x01, 0x82, 0x56, 0xf1, 0x56, 0xd0, 0xac, 0x1f, 0xe0, 0x41, 0x98, 0x34,
-	0xef, 0x66, 0x83, 0x49, 0x26, 0x64, 0xc6, 0xfd, 0xf8, 0x13, 0xe2, 0x4d, 0xf0, 0x27, 0x78, 0xf1,
-	0xe0, 0x2f, 0xf0, 0xb6, 0xc7, 0x3d, 0x7a, 0xaa, 0xd2, 0x1e, 0xbc, 0xef, 0x2f, 0x90, 0x7c, 0xec,
-	0x6c, 0x05, 0x27, 0x81, 0xe0, 0x9e, 0x5a, 0x98, 0xe7, 0x79, 0xde, 0xe7, 0xc9, 0xfb, 0xcc, 0xe0,
-	0x2d, 0xc6, 0x43, 0xc6, 0x7d, 0x4e, 0xf6, 0x69, 0xc0, 0x41, 0x0c, 0xe3, 0x04, 0x76, 0xc9, 0xfe,
-	0xc8, 0x01, 0x41, 0x47, 0x44, 0x1c, 0x5a, 0x71, 0xc2, 0x04, 0xd3, 0xf4, 0x02, 0x65, 0xe5, 0xa8,
-	0x14, 0x64, 0x15, 0x20, 0x7d, 0xd3, 0x63, 0x1e, 0xcb, 0x60, 0x24, 0xfd, 0x97, 0x33, 0x74, 0x63,
-	0x96, 0x51, 0x88, 0x43, 0x39, 0x48, 0xbd, 0x19, 0xf3, 0xa3, 0xe2, 0xbc, 0x5f, 0x36, 0x97, 0x0b,
-	0x2a, 0x20, 0x07, 0x9a, 0xdf, 0x11, 0xbe, 0x3a, 0xe5, 0xde, 0x0e, 0x88, 0x57, 0x34, 0xf0, 0x5d,
-	0x2a, 0x58, 0xb2, 0x03, 0xe2, 0x59, 0x02, 0xbb, 0x90, 0x40, 0x34, 0x03, 0x6d, 0x8c, 0x37, 0x5c,
-	0x08, 0xc0, 0x4b, 0x4f, 0xda, 0xa8, 0x87, 0x06, 0x1b, 0x93, 0xcd, 0xd3, 0x79, 0xf7, 0xf2, 0x11,
-	0x0d, 0x83, 0xfb, 0xa6, 0x3c, 0x32, 0xed, 0x73, 0x98, 0x16, 0xe2, 0x56, 0x2c, 0x15, 0x78, 0x7b,
-	0xad, 0xd7, 0x1c, 0xb4, 0xc6, 0xc4, 0x52, 0xa7, 0xb4, 0xe4, 0xf0, 0xf3, 0xc9, 0x13, 0xfd, 0x78,
-	0xde, 0x6d, 0x9c, 0xce, 0xbb, 0x5a, 0x3e, 0x6a, 0x45, 0xd1, 0xb4, 0x57, 0xf5, 0xcd, 0x5b, 0x78,
-	0xab, 0x2c, 0x82, 0x0d, 0x3c, 0x66, 0x11, 0x07, 0xf3, 0x2b, 0xc2, 0x9d, 0x29, 0xf7, 0x9e, 0xe4,
-	0x3e, 0xe1, 0x05, 0x5b, 0xc5, 0xd7, 0x0a, 0xfa, 0x16, 0xaf, 0xa7, 0x1f, 0xbd, 0xbd, 0xd6, 0x43,
-	0x83, 0xd6, 0xb8, 0x63, 0xe5, 0x5b, 0xb1, 0xd2, 0xad, 0xc8, 0x68, 0x8f, 0x99, 0x1f, 0x4d, 0x48,
-	0x9a, 0xe5, 0xcb, 0xcf, 0x6e, 0xdf, 0xf3, 0xc5, 0xde, 0x7b, 0xc7, 0x9a, 0xb1, 0x90, 0x14, 0x2b,
-	0xcc, 0x7f, 0x86, 0xdc, 0x7d, 0x47, 0xc4, 0x51, 0x0c, 0x3c, 0x23, 0xd8, 0x99, 0xae, 0x79, 0x03,
-	0x5f, 0x57, 0x1a, 0x96, 0xb1, 0xbe, 0x21, 0x7c, 0x6d, 0xca, 0xbd, 0x97, 0x51, 0xe1, 0x0b, 0x9e,
-	0x26, 0x2c, 0xfc, 0x6f, 0xd1, 0x9a, 0x17, 0x14, 0xad, 0x8f, 0x6f, 0x96, 0x9a, 0x96, 0xf1, 0xec,
-	0xac, 0xa0, 0xaf, 0x7d, 0xb1, 0xe7, 0x26, 0xf4, 0xa0, 0xf8, 0x16, 0x3e, 0x8b, 0x6c, 0x38, 0xa0,
-	0x89, 0xcb, 0xeb, 0x84, 0x2b, 0x1a, 0xa3, 0xd4, 0x3c, 0x9b, 0x3d, 0xfe, 0xbd, 0x8e, 0x9b, 0x53,
-	0xee, 0x69, 0x9f, 0x10, 0xee, 0xa8, 0xaf, 0xc8, 0xdd, 0xb2, 0x66, 0x97, 0x35, 0x53, 0x7f, 0x58,
-	0x97, 0x79, 0xe6, 0x50, 0xfb, 0x80, 0xf0, 0x15, 0x45, 0xa1, 0x6f, 0x57, 0x88, 0xff, 0x9b, 0xa6,
-	0x3f, 0xa8, 0x45, 0x93, 0x86, 0x3e, 0x23, 0xac, 0x97, 0x54, 0xf1, 0x5e, 0x85, 0xba, 0x9a, 0xaa,
-	0x3f, 0xaa, 0x4d, 0x95, 0xe6, 0xd2, 0x3d, 0xaa, 0x9b, 0x54, 0xb5, 0x47, 0x25, 0xb3, 0x72, 0x8f,
-	0x95, 0x4d, 0x9b, 0x3c, 0x3f, 0x5e, 0x18, 0xe8, 0x64, 0x61, 0xa0, 0x5f, 0x0b, 0x03, 0x7d, 0x5c,
-	0x1a, 0x8d, 0x93, 0xa5, 0xd1, 0xf8, 0xb1, 0x34, 0x1a, 0x6f, 0xee, 0xac, 0xdc, 0xab, 0x62, 0xca,
-	0x30, 0xa0, 0x0e, 0x27, 0xf2, 0x89, 0x1f, 0x6d, 0x93, 0xc3, 0xbf, 0x1e, 0xfa, 0xec, 0xb2, 0x39,
-	0x97, 0xb2, 0x17, 0x7e, 0xfb, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x2e, 0xc6, 0xbb, 0x74, 0x84,
-	0x06, 0x00, 0x00,
+	// 801 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0xcd, 0x4e, 0xdb, 0x4a,
+	0x14, 0x8e, 0x03, 0xca, 0xbd, 0x0c, 0xba, 0x3f, 0xf8, 0xe6, 0x56, 0xc1, 0xa5, 0x31, 0x4c, 0x29,
+	0x50, 0xa9, 0xd8, 0x22, 0xa8, 0xbf, 0x12, 0x2a, 0x04, 0x54, 0xa9, 0x8b, 0xa8, 0xd4, 0x14, 0x90,
+	0xba, 0x68, 0x34, 0x89, 0x07, 0x13, 0xd5, 0xf6, 0x58, 0x33, 0x93, 0x00, 0xaf, 0xd0, 0x45, 0xd5,
+	0x5d, 0xa5, 0x3e, 0x42, 0x37, 0xad, 0xd4, 0x75, 0x2b, 0x75, 0xc7, 0x92, 0x65, 0xd5, 0x45, 0x5a,
+	0xc1, 0x1b, 0xa4, 0x2f, 0x50, 0xd9, 0xe3, 0x98, 0x00, 0x71, 0xd2, 0xba, 0x3f, 0x2b, 0x5b, 0x9e,
+	0xef, 0xfb, 0xce, 0xf9, 0xce, 0x9c, 0x39, 0x1e, 0x30, 0x49, 0x98, 0x43, 0x58, 0x8d, 0xe9, 0x0d,
+	0x64, 0x33, 0xcc, 0x67, 0x3d, 0x8a, 0xb7, 0xf4, 0xc6, 0x5c, 0x05, 0x73, 0x34, 0xa7, 0xf3, 0x5d,
+	0xcd, 0xa3, 0x84, 0x13, 0x59, 0x09, 0x51, 0x9a, 0x40, 0xf9, 0x20, 0x2d, 0x04, 0x29, 0x59, 0x8b,
+	0x58, 0x24, 0x80, 0xe9, 0xfe, 0x9b, 0x60, 0x28, 0xf9, 0x6a, 0x40, 0xd1, 0x2b, 0x88, 0xe1, 0x48,
+	0xaf, 0x4a, 0x6a, 0x6e, 0xb8, 0x3e, 0xdd, 0x2b, 0x2e, 0xe3, 0x88, 0x63, 0x01, 0x84, 0xef, 0x25,
+	0x30, 0x56, 0x62, 0xd6, 0x1a, 0xe6, 0x1b, 0xc8, 0xae, 0x99, 0x88, 0x13, 0xba, 0x86, 0xf9, 0x2a,
+	0xc5, 0x5b, 0x98, 0x62, 0xb7, 0x8a, 0xe5, 0x02, 0x18, 0x32, 0xb1, 0x8d, 0x2d, 0x7f, 0x25, 0x27,
+	0x8d, 0x4b, 0x33, 0x43, 0xc5, 0x6c, 0xab, 0xa9, 0xfe, 0xbb, 0x87, 0x1c, 0xfb, 0x16, 0x8c, 0x96,
+	0xa0, 0x71, 0x0c, 0x93, 0x1d, 0x30, 0xec, 0x45, 0x0a, 0x2c, 0x97, 0x1e, 0x1f, 0x98, 0x19, 0x2e,
+	0xe8, 0x5a, 0xbc, 0x4b, 0x2d, 0x0a, 0x7e, 0x1c, 0xb9, 0xa8, 0xec, 0x37, 0xd5, 0x54, 0xab, 0xa9,
+	0xca, 0x22, 0x54, 0x87, 0x22, 0x34, 0x3a, 0xf5, 0xe1, 0x14, 0x98, 0xec, 0x65, 0xc1, 0xc0, 0xcc,
+	0x23, 0x2e, 0xc3, 0xf0, 0x95, 0x04, 0x46, 0x4b, 0xcc, 0x5a, 0x11, 0x79, 0xe2, 0x07, 0xa4, 0x13,
+	0x9f, 0xc8, 0xe8, 0x23, 0x30, 0xe8, 0x17, 0x3d, 0x97, 0x1e, 0x97, 0x66, 0x86, 0x0b, 0xa3, 0x9a,
+	0xd8, 0x15, 0xcd, 0xdf, 0x95, 0xc8, 0xda, 0x32, 0xa9, 0xb9, 0x45, 0xdd, 0xf7, 0xf2, 0xf2, 0x93,
+	0x3a, 0x6d, 0xd5, 0xf8, 0x76, 0xbd, 0xa2, 0x55, 0x89, 0xa3, 0x87, 0x5b, 0x28, 0x1e, 0xb3, 0xcc,
+	0x7c, 0xac, 0xf3, 0x3d, 0x0f, 0xb3, 0x80, 0x60, 0x04, 0xba, 0xf0, 0x22, 0x98, 0x88, 0x4d, 0x38,
+	0xb2, 0xf5, 0x46, 0x02, 0x17, 0x4a, 0xcc, 0x5a, 0x77, 0xc3, 0xbc, 0xf0, 0x1d, 0x4a, 0x9c, 0x9f,
+	0x66, 0x6d, 0xe0, 0x17, 0x59, 0x9b, 0x06, 0x97, 0x7a, 0x26, 0x1d, 0xd9, 0x7b, 0x27, 0x76, 0xcd,
+	0xc0, 0x6d, 0xe4, 0x0f, 0x5b, 0xfb, 0xcd, 0xed, 0xf9, 0x5a, 0x02, 0x13, 0xb1, 0x06, 0xda, 0x36,
+	0xe5, 0x27, 0x12, 0xf8, 0xbf, 0xee, 0x3a, 0xa4, 0x81, 0xcd, 0x32, 0x6d, 0x43, 0x6b, 0xc4, 0x65,
+	0x39, 0xa9, 0x7f, 0x7e, 0xeb, 0x82, 0x68, 0x74, 0xf0, 0x8a, 0x93, 0x61, 0x7e, 0x63, 0x22, 0xbf,
+	0xae, 0xda, 0xd0, 0xc8, 0xd6, 0xcf, 0x52, 0x19, 0x7c, 0x9b, 0x06, 0xff, 0x75, 0xd1, 0x94, 0x57,
+	0x41, 0x96, 0xd1, 0x6a, 0xb9, 0x81, 0xec, 0x32, 0xf1, 0x30, 0x2d, 0x23, 0xd3, 0xa4, 0x98, 0xb1,
+	0xb0, 0xf0, 0x6a, 0xab, 0xa9, 0x9e, 0x17, 0xd1, 0xba, 0xa1, 0xa0, 0x31, 0xc2, 0x68, 0x75, 0x03,
+	0xd9, 0xf7, 0x3c, 0x4c, 0x97, 0xc4, 0x37, 0x5f, 0xd1, 0x64, 0xfc, 0xac, 0x62, 0xfa, 0xb4, 0x62,
+	0x37, 0x14, 0x34, 0x46, 0x4c, 0xc6, 0x4f, 0x29, 0x6e, 0x82, 0x0c, 0x72, 0x48, 0xdd, 0xe5, 0x41,
+	0xeb, 0x0e, 0x15, 0x6f, 0xfb, 0x75, 0xf8, 0xd8, 0x54, 0xa7, 0xbe, 0xa1, 0x3f, 0xef, 0xba, 0xbc,
+	0xd5, 0x54, 0xff, 0x12, 0x11, 0x85, 0x0a, 0x34, 0x42, 0x39, 0xf9, 0x32, 0xc8, 0x50, 0x8c, 0x18,
+	0x71, 0x73, 0x83, 0x81, 0xf0, 0xc8, 0x31, 0x54, 0x7c, 0x87, 0x46, 0xa6, 0xfd, 0x12, 0x0c, 0xd5,
+	0xcd, 0x1a, 0xdf, 0x36, 0x29, 0xda, 0x59, 0x89, 0x0a, 0x68, 0xe0, 0x1d, 0x44, 0x4d, 0x96, 0xa4,
+	0x6b, 0xc3, 0x29, 0x17, 0xab, 0x19, 0x9d, 0x97, 0x06, 0x90, 0xc5, 0x34, 0x5c, 0xaa, 0x73, 0xb2,
+	0x4c, 0x1c, 0x8f, 0xd4, 0x5d, 0x33, 0xd1, 0x39, 0xb9, 0x02, 0xfe, 0xc0, 0x2e, 0xaa, 0xd8, 0xd8,
+	0x0c, 0xb6, 0xe3, 0xcf, 0xa2, 0xdc, 0x6a, 0xaa, 0x7f, 0x0b, 0x46, 0xb8, 0x00, 0x8d, 0x36, 0x04,
+	0x8e, 0x01, 0xe5, 0x6c, 0xdc, 0x76, 0x56, 0x85, 0x2f, 0x19, 0x30, 0x50, 0x62, 0x96, 0xfc, 0x5c,
+	0x02, 0xa3, 0xf1, 0x3f, 0x9b, 0x1b, 0xbd, 0x9a, 0xbc, 0xd7, 0x8c, 0x57, 0x16, 0x93, 0x32, 0xa3,
+	0x03, 0xf8, 0x54, 0x02, 0xe7, 0x62, 0x7e, 0x0d, 0x57, 0xfb, 0x88, 0x77, 0xa7, 0x29, 0x0b, 0x89,
+	0x68, 0x51, 0x42, 0x2f, 0x24, 0xa0, 0xf4, 0x18, 0xea, 0x37, 0xfb, 0xa8, 0xc7, 0x53, 0x95, 0xa5,
+	0xc4, 0xd4, 0x13, 0xd5, 0x8a, 0x19, 0xc9, 0xfd, 0xaa, 0xd5, 0x9d, 0xa6, 0x2c, 0x24, 0xa2, 0x45,
+	0x09, 0xf9, 0x8d, 0x15, 0x7f, 0xe0, 0xfa, 0x35, 0x56, 0x2c, 0x53, 0x59, 0x4c, 0xca, 0x8c, 0x32,
+	0xdb, 0x03, 0xff, 0x9c, 0x3e, 0x8d, 0x5a, 0xff, 0x6e, 0xed, 0xc4, 0x2b, 0xd7, 0xbe, 0x0f, 0xdf,
+	0x0e, 0x5d, 0xbc, 0xbf, 0x7f, 0x98, 0x97, 0x0e, 0x0e, 0xf3, 0xd2, 0xe7, 0xc3, 0xbc, 0xf4, 0xec,
+	0x28, 0x9f, 0x3a, 0x38, 0xca, 0xa7, 0x3e, 0x1c, 0xe5, 0x53, 0x0f, 0xaf, 0x77, 0x4c, 0xc3, 0x50,
+	0x7b, 0xd6, 0x46, 0x15, 0xa6, 0x47, 0x17, 0xc7, 0xb9, 0x79, 0x7d, 0xf7, 0xc4, 0xf5, 0x31, 0x18,
+	0x91, 0x95, 0x4c, 0x70, 0x6f, 0x9c, 0xff, 0x3a, 0x00, 0x4e, 0x55, 0xa9, 0x46, 0xda, 0x0a, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -467,9 +754,15 @@ type MsgClient interface {
 	// validator-set. The unbonding logic will follow the `Undelegate` logic from
 	// the sdk.
 	UndelegateFromValidatorSet(ctx context.Context, in *MsgUndelegateFromValidatorSet, opts ...grpc.CallOption) (*MsgUndelegateFromValidatorSetResponse, error)
+	// RedelegateValidatorSet takes the existing validator set and redelegates to
+	// a new set.
+	RedelegateValidatorSet(ctx context.Context, in *MsgRedelegateValidatorSet, opts ...grpc.CallOption) (*MsgRedelegateValidatorSetResponse, error)
 	// WithdrawDelegationRewards allows users to claim rewards from the
 	// validator-set.
 	WithdrawDelegationRewards(ctx context.Context, in *MsgWithdrawDelegationRewards, opts ...grpc.CallOption) (*MsgWithdrawDelegationRewardsResponse, error)
+	// SetAutoCompound allows users to opt in or out of automatically restaking
+	// their staking rewards into their validator-set at every epoch.
+	SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error)
 }
 
 type msgClient struct {
@@ -507,6 +800,15 @@ func (c *msgClient) UndelegateFromValidatorSet(ctx context.Context, in *MsgUndel
 	return out, nil
 }
 
+func (c *msgClient) RedelegateValidatorSet(ctx context.Context, in *MsgRedelegateValidatorSet, opts ...grpc.CallOption) (*MsgRedelegateValidatorSetResponse, error) {
+	out := new(MsgRedelegateValidatorSetResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/RedelegateValidatorSet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) WithdrawDelegationRewards(ctx context.Context, in *MsgWithdrawDelegationRewards, opts ...grpc.CallOption) (*MsgWithdrawDelegationRewardsResponse, error) {
 	out := new(MsgWithdrawDelegationRewardsResponse)
 	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/WithdrawDelegationRewards", in, out, opts...)
@@ -516,6 +818,15 @@ func (c *msgClient) WithdrawDelegationRewards(ctx context.Context, in *MsgWithdr
 	return out, nil
 }
 
+func (c *msgClient) SetAutoCompound(ctx context.Context, in *MsgSetAutoCompound, opts ...grpc.CallOption) (*MsgSetAutoCompoundResponse, error) {
+	out := new(MsgSetAutoCompoundResponse)
+	err := c.cc.Invoke(ctx, "/osmosis.valsetpref.v1beta1.Msg/SetAutoCompound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// SetValidatorSetPreference creates a set of validator preference.
@@ -528,9 +839,15 @@ type MsgServer interface {
 	// validator-set. The unbonding logic will follow the `Undelegate` logic from
 	// the sdk.
 	UndelegateFromValidatorSet(context.Context, *MsgUndelegateFromValidatorSet) (*MsgUndelegateFromValidatorSetResponse, error)
+	// RedelegateValidatorSet takes the existing validator set and redelegates to
+	// a new set.
+	RedelegateValidatorSet(context.Context, *MsgRedelegateValidatorSet) (*MsgRedelegateValidatorSetResponse, error)
 	// WithdrawDelegationRewards allows users to claim rewards from the
 	// validator-set.
 	WithdrawDelegationRewards(context.Context, *MsgWithdrawDelegationRewards) (*MsgWithdrawDelegationRewardsResponse, error)
+	// SetAutoCompound allows users to opt in or out of automatically restaking
+	// their staking rewards into their validator-set at every epoch.
+	SetAutoCompound(context.Context, *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -546,9 +863,15 @@ func (*UnimplementedMsgServer) DelegateToValidatorSet(ctx context.Context, req *
 func (*UnimplementedMsgServer) UndelegateFromValidatorSet(ctx context.Context, req *MsgUndelegateFromValidatorSet) (*MsgUndelegateFromValidatorSetResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UndelegateFromValidatorSet not implemented")
 }
+func (*UnimplementedMsgServer) RedelegateValidatorSet(ctx context.Context, req *MsgRedelegateValidatorSet) (*MsgRedelegateValidatorSetResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegateValidatorSet not implemented")
+}
 func (*UnimplementedMsgServer) WithdrawDelegationRewards(ctx context.Context, req *MsgWithdrawDelegationRewards) (*MsgWithdrawDelegationRewardsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method WithdrawDelegationRewards not implemented")
 }
+func (*UnimplementedMsgServer) SetAutoCompound(ctx context.Context, req *MsgSetAutoCompound) (*MsgSetAutoCompoundResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAutoCompound not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -608,6 +931,24 @@ func _Msg_UndelegateFromValidatorSet_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RedelegateValidatorSet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRedelegateValidatorSet)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RedelegateValidatorSet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/RedelegateValidatorSet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RedelegateValidatorSet(ctx, req.(*MsgRedelegateValidatorSet))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_WithdrawDelegationRewards_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgWithdrawDelegationRewards)
 	if err := dec(in); err != nil {
@@ -626,6 +967,24 @@ func _Msg_WithdrawDelegationRewards_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetAutoCompound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAutoCompound)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAutoCompound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/osmosis.valsetpref.v1beta1.Msg/SetAutoCompound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAutoCompound(ctx, req.(*MsgSetAutoCompound))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "osmosis.valsetpref.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -642,10 +1001,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UndelegateFromValidatorSet",
 			Handler:    _Msg_UndelegateFromValidatorSet_Handler,
 		},
+		{
+			MethodName: "RedelegateValidatorSet",
+			Handler:    _Msg_RedelegateValidatorSet_Handler,
+		},
 		{
 			MethodName: "WithdrawDelegationRewards",
 			Handler:    _Msg_WithdrawDelegationRewards_Handler,
 		},
+		{
+			MethodName: "SetAutoCompound",
+			Handler:    _Msg_SetAutoCompound_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "osmosis/valset-pref/v1beta1/tx.proto",
@@ -844,7 +1211,7 @@ func (m *MsgUndelegateFromValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawDelegationRewards) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegateValidatorSet) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -854,16 +1221,30 @@ func (m *MsgWithdrawDelegationRewards) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegationRewards) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegateValidatorSet) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegateValidatorSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Preferences) > 0 {
+		for iNdEx := len(m.Preferences) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Preferences[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Delegator) > 0 {
 		i -= len(m.Delegator)
 		copy(dAtA[i:], m.Delegator)
@@ -874,7 +1255,7 @@ func (m *MsgWithdrawDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, e
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRedelegateValidatorSetResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -884,41 +1265,225 @@ func (m *MsgWithdrawDelegationRewardsResponse) Marshal() (dAtA []byte, err error
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRedelegateValidatorSetResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRedelegateValidatorSetResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnmovedRedelegations) > 0 {
+		for iNdEx := len(m.UnmovedRedelegations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnmovedRedelegations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *UnmovedRedelegation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetValidatorSetPreference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *UnmovedRedelegation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnmovedRedelegation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Delegator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Preferences) > 0 {
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.DstValOperAddress) > 0 {
+		i -= len(m.DstValOperAddress)
+		copy(dAtA[i:], m.DstValOperAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DstValOperAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.SrcValOperAddress) > 0 {
+		i -= len(m.SrcValOperAddress)
+		copy(dAtA[i:], m.SrcValOperAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.SrcValOperAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDelegationRewards) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegationRewards) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegationRewards) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawDelegationRewardsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawDelegationRewardsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawDelegationRewardsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Delegator) > 0 {
+		i -= len(m.Delegator)
+		copy(dAtA[i:], m.Delegator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Delegator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAutoCompoundResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAutoCompoundResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetValidatorSetPreference) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Preferences) > 0 {
 		for _, e := range m.Preferences {
 			l = e.Size()
 			n += 1 + l + sovTx(uint64(l))
@@ -984,6 +1549,63 @@ func (m *MsgUndelegateFromValidatorSetResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgRedelegateValidatorSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Preferences) > 0 {
+		for _, e := range m.Preferences {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRedelegateValidatorSetResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.UnmovedRedelegations) > 0 {
+		for _, e := range m.UnmovedRedelegations {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnmovedRedelegation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.SrcValOperAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DstValOperAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
 func (m *MsgWithdrawDelegationRewards) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1006,13 +1628,369 @@ func (m *MsgWithdrawDelegationRewardsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgSetAutoCompound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Delegator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Enabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetAutoCompoundResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Preferences = append(m.Preferences, ValidatorPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1035,10 +2013,10 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreference: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1073,9 +2051,9 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1102,8 +2080,7 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Preferences = append(m.Preferences, ValidatorPreference{})
-			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1128,7 +2105,7 @@ func (m *MsgSetValidatorSetPreference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1151,10 +2128,10 @@ func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetValidatorSetPreferenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1178,7 +2155,7 @@ func (m *MsgSetValidatorSetPreferenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateValidatorSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1201,10 +2178,10 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateValidatorSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1241,7 +2218,7 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Preferences", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1268,7 +2245,8 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Preferences = append(m.Preferences, ValidatorPreference{})
+			if err := m.Preferences[len(m.Preferences)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1293,7 +2271,7 @@ func (m *MsgDelegateToValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRedelegateValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1316,12 +2294,46 @@ func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelegateToValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRedelegateValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnmovedRedelegations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnmovedRedelegations = append(m.UnmovedRedelegations, UnmovedRedelegation{})
+			if err := m.UnmovedRedelegations[len(m.UnmovedRedelegations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1343,7 +2355,7 @@ func (m *MsgDelegateToValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
+func (m *UnmovedRedelegation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1366,15 +2378,15 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: wiretype end group for non-group")
+			return fmt.Errorf("proto: UnmovedRedelegation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSet: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UnmovedRedelegation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SrcValOperAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1402,13 +2414,45 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Delegator = string(dAtA[iNdEx:postIndex])
+			m.SrcValOperAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DstValOperAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DstValOperAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Coin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1418,24 +2462,139 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Coin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delegator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1458,7 +2617,7 @@ func (m *MsgUndelegateFromValidatorSet) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1481,10 +2640,10 @@ func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUndelegateFromValidatorSetResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1508,7 +2667,7 @@ func (m *MsgUndelegateFromValidatorSetResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1531,10 +2690,10 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewards: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1569,6 +2728,26 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 			}
 			m.Delegator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1590,7 +2769,7 @@ func (m *MsgWithdrawDelegationRewards) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAutoCompoundResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1613,10 +2792,10 @@ func (m *MsgWithdrawDelegationRewardsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawDelegationRewardsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAutoCompoundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
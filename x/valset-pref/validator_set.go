@@ -2,10 +2,12 @@ package keeper
 
 import (
 	"fmt"
+	"math"
 	"sort"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/osmosis-labs/osmosis/v13/osmoutils"
 	"github.com/osmosis-labs/osmosis/v13/x/valset-pref/types"
 )
 
@@ -115,6 +117,320 @@ func (k Keeper) UndelegateFromValidatorSet(ctx sdk.Context, delegatorAddr string
 	return nil
 }
 
+// valSetRedelegation is a redelegation of tokens between two validators, required
+// to move a delegator's stake to the weights of their new validator-set.
+type valSetRedelegation struct {
+	srcValAddr sdk.ValAddress
+	dstValAddr sdk.ValAddress
+	amount     sdk.Int
+}
+
+// valSetAmount is an amount of tokens attributed to a validator of a validator-set.
+type valSetAmount struct {
+	valAddr sdk.ValAddress
+	amount  sdk.Int
+}
+
+// RedelegateValidatorSet updates the delegator's validator-set to the new preferences and redelegates
+// the stake of their existing validator-set to match the new weights.
+// For ex: userA has staked 10osmo with validator-set {ValA -> 0.5, ValB -> 0.5}
+// redelegating to validator-set {ValA -> 0.2, ValC -> 0.8} would redelegate 3osmo from A to C and 5osmo from B to C.
+// Redelegations that can't be executed, because of a transitive redelegation or the redelegation entry limit,
+// are skipped and returned, with the reason they failed.
+func (k Keeper) RedelegateValidatorSet(ctx sdk.Context, delegatorAddr string, preferences []types.ValidatorPreference) ([]types.UnmovedRedelegation, error) {
+	// get the existing validator set preference
+	existingSet, found := k.GetValidatorSetPreference(ctx, delegatorAddr)
+	if !found {
+		return nil, fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// checks that the new validator-set is valid and different from the existing one
+	err = k.SetValidatorSetPreference(ctx, delegatorAddr, preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	redelegations, err := k.computeValSetRedelegations(ctx, delegator, existingSet.Preferences, preferences)
+	if err != nil {
+		return nil, err
+	}
+
+	unmovedRedelegations := []types.UnmovedRedelegation{}
+	for _, redelegation := range redelegations {
+		err := k.redelegate(ctx, delegator, redelegation)
+		if err != nil {
+			unmovedRedelegations = append(unmovedRedelegations, types.UnmovedRedelegation{
+				SrcValOperAddress: redelegation.srcValAddr.String(),
+				DstValOperAddress: redelegation.dstValAddr.String(),
+				Amount:            redelegation.amount,
+				Reason:            err.Error(),
+			})
+		}
+	}
+
+	k.SetValidatorSetPreferences(ctx, delegatorAddr, types.ValidatorSetPreferences{
+		Preferences: preferences,
+	})
+
+	return unmovedRedelegations, nil
+}
+
+// computeValSetRedelegations returns the redelegations that move the delegator's stake in the existing
+// validator-set to the weights of the new validator-set.
+// The validators with more stake than their new target amount are matched greedily with the validators
+// with less stake than their target, largest difference first. Hence at most
+// (#validators with excess stake + #validators with missing stake - 1) redelegations are returned.
+func (k Keeper) computeValSetRedelegations(ctx sdk.Context, delegator sdk.AccAddress, existingPreferences, newPreferences []types.ValidatorPreference) ([]valSetRedelegation, error) {
+	// the delegator's current stake with every validator of the existing validator-set
+	valAddrs := []sdk.ValAddress{}
+	currentAmounts := map[string]sdk.Int{}
+	totalAmount := sdk.ZeroInt()
+	for _, val := range existingPreferences {
+		valAddr, validator, err := k.getValAddrAndVal(ctx, val.ValOperAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		valAddrs = append(valAddrs, valAddr)
+		currentAmounts[valAddr.String()] = sdk.ZeroInt()
+
+		delegation, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr)
+		if !found {
+			continue
+		}
+
+		amount := validator.TokensFromShares(delegation.Shares).TruncateInt()
+		currentAmounts[valAddr.String()] = amount
+		totalAmount = totalAmount.Add(amount)
+	}
+
+	// the stake every validator of the new validator-set should have, {val_distribution_weight * totalAmount}
+	targetAmounts := map[string]sdk.Int{}
+	for _, val := range newPreferences {
+		valAddr, _, err := k.getValAddrAndVal(ctx, val.ValOperAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, found := currentAmounts[valAddr.String()]; !found {
+			valAddrs = append(valAddrs, valAddr)
+			currentAmounts[valAddr.String()] = sdk.ZeroInt()
+		}
+		targetAmounts[valAddr.String()] = val.Weight.MulInt(totalAmount).TruncateInt()
+	}
+
+	// split the validators into the ones with excess stake, and the ones missing stake
+	sources, destinations := []valSetAmount{}, []valSetAmount{}
+	for _, valAddr := range valAddrs {
+		targetAmount, found := targetAmounts[valAddr.String()]
+		if !found {
+			targetAmount = sdk.ZeroInt()
+		}
+
+		diff := currentAmounts[valAddr.String()].Sub(targetAmount)
+		if diff.IsPositive() {
+			sources = append(sources, valSetAmount{valAddr: valAddr, amount: diff})
+		} else if diff.IsNegative() {
+			destinations = append(destinations, valSetAmount{valAddr: valAddr, amount: diff.Neg()})
+		}
+	}
+
+	sortValSetAmounts(sources)
+	sortValSetAmounts(destinations)
+
+	// match the largest excess with the largest missing stake, until either side is exhausted.
+	// Because of truncation, the total excess can be slightly larger than the total missing stake,
+	// in which case the remainder stays with the source validators.
+	redelegations := []valSetRedelegation{}
+	for i, j := 0, 0; i < len(sources) && j < len(destinations); {
+		amount := sdk.MinInt(sources[i].amount, destinations[j].amount)
+		redelegations = append(redelegations, valSetRedelegation{
+			srcValAddr: sources[i].valAddr,
+			dstValAddr: destinations[j].valAddr,
+			amount:     amount,
+		})
+
+		sources[i].amount = sources[i].amount.Sub(amount)
+		destinations[j].amount = destinations[j].amount.Sub(amount)
+		if sources[i].amount.IsZero() {
+			i++
+		}
+		if destinations[j].amount.IsZero() {
+			j++
+		}
+	}
+
+	return redelegations, nil
+}
+
+// redelegate executes the redelegation, if the staking module allows it.
+// The redelegation is applied atomically, no state is changed if it fails.
+func (k Keeper) redelegate(ctx sdk.Context, delegator sdk.AccAddress, redelegation valSetRedelegation) error {
+	// the staking module does not allow redelegating tokens that were redelegated to the source validator,
+	// until that redelegation has matured.
+	if k.stakingKeeper.HasReceivingRedelegation(ctx, delegator, redelegation.srcValAddr) {
+		return fmt.Errorf("transitive redelegation from %s is not allowed", redelegation.srcValAddr)
+	}
+
+	if k.stakingKeeper.HasMaxRedelegationEntries(ctx, delegator, redelegation.srcValAddr, redelegation.dstValAddr) {
+		return fmt.Errorf("too many redelegation entries from %s to %s", redelegation.srcValAddr, redelegation.dstValAddr)
+	}
+
+	return osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+		sharesAmt, err := k.stakingKeeper.ValidateUnbondAmount(cacheCtx, delegator, redelegation.srcValAddr, redelegation.amount)
+		if err != nil {
+			return err
+		}
+
+		_, err = k.stakingKeeper.BeginRedelegation(cacheCtx, delegator, redelegation.srcValAddr, redelegation.dstValAddr, sharesAmt)
+		return err
+	})
+}
+
+// sortValSetAmounts sorts the amounts in descending order, breaking ties by validator address.
+func sortValSetAmounts(amounts []valSetAmount) {
+	sort.Slice(amounts, func(i, j int) bool {
+		if !amounts[i].amount.Equal(amounts[j].amount) {
+			return amounts[i].amount.GT(amounts[j].amount)
+		}
+		return amounts[i].valAddr.String() < amounts[j].valAddr.String()
+	})
+}
+
+// WithdrawDelegationRewards withdraws the delegation rewards of the delegator from every validator in
+// their validator-set, as well as from any existing delegations to validators outside of the set.
+// Returns the total amount of rewards withdrawn.
+func (k Keeper) WithdrawDelegationRewards(ctx sdk.Context, delegatorAddr string) (sdk.Coins, error) {
+	// get the existing validator set preference
+	existingSet, found := k.GetValidatorSetPreference(ctx, delegatorAddr)
+	if !found {
+		return nil, fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
+	}
+
+	delegator, err := sdk.AccAddressFromBech32(delegatorAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	// collect the validators to withdraw from, starting with the validator-set and then
+	// every other validator the delegator has an existing delegation with.
+	valAddrs := []sdk.ValAddress{}
+	seenValidators := map[string]bool{}
+	for _, val := range existingSet.Preferences {
+		valAddr, _, err := k.getValAddrAndVal(ctx, val.ValOperAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		valAddrs = append(valAddrs, valAddr)
+		seenValidators[valAddr.String()] = true
+	}
+
+	for _, delegation := range k.stakingKeeper.GetDelegatorDelegations(ctx, delegator, math.MaxUint16) {
+		if seenValidators[delegation.ValidatorAddress] {
+			continue
+		}
+
+		valAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		valAddrs = append(valAddrs, valAddr)
+		seenValidators[delegation.ValidatorAddress] = true
+	}
+
+	totalRewards := sdk.NewCoins()
+	for _, valAddr := range valAddrs {
+		// validators in the set that the delegator has not delegated to yet have no rewards
+		if _, found := k.stakingKeeper.GetDelegation(ctx, delegator, valAddr); !found {
+			continue
+		}
+
+		rewards, err := k.distributionKeeper.WithdrawDelegationRewards(ctx, delegator, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		totalRewards = totalRewards.Add(rewards...)
+	}
+
+	return totalRewards, nil
+}
+
+// SetAutoCompoundPreference opts the delegator in or out of auto-compounding.
+// Opting in requires an existing validator-set, since the rewards are restaked into it.
+func (k Keeper) SetAutoCompoundPreference(ctx sdk.Context, delegatorAddr string, enabled bool) error {
+	if enabled {
+		if _, found := k.GetValidatorSetPreference(ctx, delegatorAddr); !found {
+			return fmt.Errorf("user %s doesn't have validator set", delegatorAddr)
+		}
+	}
+
+	k.SetAutoCompound(ctx, delegatorAddr, enabled)
+	return nil
+}
+
+// StartAutoCompound starts a round of auto-compounding, in which the staking rewards of every
+// delegator that opted in are restaked into their validator-set over the following blocks.
+// A round still in progress is left to finish rather than restarted.
+func (k Keeper) StartAutoCompound(ctx sdk.Context) {
+	if k.IsAutoCompoundInProgress(ctx) {
+		return
+	}
+	k.setAutoCompoundCursor(ctx, "")
+}
+
+// AutoCompoundDelegationRewards restakes the staking rewards of the next batch of delegators of the
+// auto-compounding round in progress into their validator-set. At most
+// types.MaxAutoCompoundDelegatorsPerBlock delegators are compounded per call, and the round ends
+// once every delegator has been compounded.
+// The rewards of each delegator are compounded atomically, so a failure for one delegator
+// does not affect the others.
+func (k Keeper) AutoCompoundDelegationRewards(ctx sdk.Context) {
+	cursor, inProgress := k.getAutoCompoundCursor(ctx)
+	if !inProgress {
+		return
+	}
+
+	// fetch one delegator past the batch, to resume the round from it.
+	delegators := k.getAutoCompoundDelegatorsFrom(ctx, cursor, types.MaxAutoCompoundDelegatorsPerBlock+1)
+	if len(delegators) > types.MaxAutoCompoundDelegatorsPerBlock {
+		k.setAutoCompoundCursor(ctx, delegators[types.MaxAutoCompoundDelegatorsPerBlock])
+		delegators = delegators[:types.MaxAutoCompoundDelegatorsPerBlock]
+	} else {
+		ctx.KVStore(k.storeKey).Delete(types.KeyAutoCompoundCursor)
+	}
+
+	for _, delegator := range delegators {
+		_ = osmoutils.ApplyFuncIfNoError(ctx, func(cacheCtx sdk.Context) error {
+			return k.compoundDelegationRewards(cacheCtx, delegator)
+		})
+	}
+}
+
+// compoundDelegationRewards withdraws the delegation rewards of the delegator, and delegates the
+// rewards in the bond denom to their validator-set.
+func (k Keeper) compoundDelegationRewards(ctx sdk.Context, delegatorAddr string) error {
+	rewards, err := k.WithdrawDelegationRewards(ctx, delegatorAddr)
+	if err != nil {
+		return err
+	}
+
+	bondDenom := k.stakingKeeper.BondDenom(ctx)
+	rewardAmt := rewards.AmountOf(bondDenom)
+	if rewardAmt.IsZero() {
+		return nil
+	}
+
+	return k.DelegateToValidatorSet(ctx, delegatorAddr, sdk.NewCoin(bondDenom, rewardAmt))
+}
+
 // GetValAddrAndVal checks if the validator address is valid and the validator provided exists on chain.
 func (k Keeper) getValAddrAndVal(ctx sdk.Context, valOperAddress string) (sdk.ValAddress, stakingtypes.Validator, error) {
 	valAddr, err := sdk.ValAddressFromBech32(valOperAddress)